@@ -14,6 +14,8 @@
 package app
 
 import (
+	"path/filepath"
+
 	"github.com/hashicorp/otto/appfile"
 	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/foundation"
@@ -85,8 +87,15 @@ type Context struct {
 	//
 	// The App implementation should function under the assumption that
 	// this cache directory can be cleared at any time between runs.
+	// App implementations should cache within AppCacheDir rather than
+	// directly in this directory.
 	CacheDir string
 
+	// GlobalCacheDir is the directory where data can be cached that is
+	// shared by all Appfiles. Multiple Otto processes may use this at the
+	// same time, so access to it must be safe across processes.
+	GlobalCacheDir string
+
 	// LocalDir is the directory where data local to this single Appfile
 	// will be stored; it isn't cleared for compilation.
 	LocalDir string
@@ -113,6 +122,14 @@ type Context struct {
 	DevIPAddress string
 }
 
+// AppCacheDir is the directory within CacheDir where the App
+// implementation caches its data, such as the output of DevDep. It is
+// namespaced by the application type so that the cached data of one
+// App implementation is never picked up by another.
+func (c *Context) AppCacheDir() string {
+	return filepath.Join(c.CacheDir, c.Tuple.App)
+}
+
 // RouteName implements the router.Context interface so we can use Router
 func (c *Context) RouteName() string {
 	return c.Action
@@ -0,0 +1,18 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestContextAppCacheDir(t *testing.T) {
+	ctx := &Context{
+		CacheDir: filepath.Join("cache", "foo"),
+		Tuple:    Tuple{App: "go", Infra: "aws", InfraFlavor: "simple"},
+	}
+
+	expected := filepath.Join("cache", "foo", "go")
+	if actual := ctx.AppCacheDir(); actual != expected {
+		t.Fatalf("bad: %s", actual)
+	}
+}
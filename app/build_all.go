@@ -0,0 +1,146 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/otto/ui"
+)
+
+// DefaultBuildParallelism is the number of applications that BuildAll
+// builds at the same time if no parallelism is given.
+const DefaultBuildParallelism = 4
+
+// BuildJob is a single application to build with BuildAll.
+type BuildJob struct {
+	App App
+	Ctx *Context
+}
+
+// BuildResult is the result of building a single application with
+// BuildAll.
+type BuildResult struct {
+	// Name is the name of the application.
+	Name string
+
+	// Err is the error the build failed with, or nil if it succeeded.
+	Err error
+
+	// Duration is how long the build took.
+	Duration time.Duration
+}
+
+// BuildAll builds the given applications with a pool of workers, so at
+// most parallelism are built at the same time. If parallelism is zero,
+// DefaultBuildParallelism is used.
+//
+// Unlike the dev dependencies, a failed build doesn't stop the others:
+// every application is built, the result of each is reported to the
+// given Ui at the end, and the results are returned in the order of the
+// jobs. The returned error contains the errors of all failed builds.
+//
+// The output of each build is prefixed with the name of its application.
+// Each job must have its own Context, but the Contexts may share the
+// same Ui and Directory.
+func BuildAll(u ui.Ui, jobs []*BuildJob, parallelism int) ([]*BuildResult, error) {
+	if parallelism <= 0 {
+		parallelism = DefaultBuildParallelism
+	}
+	if parallelism > len(jobs) {
+		parallelism = len(jobs)
+	}
+
+	results := make([]*BuildResult, len(jobs))
+	var wg sync.WaitGroup
+	idxCh := make(chan int)
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				results[idx] = buildOne(jobs[idx], parallelism > 1)
+			}
+		}()
+	}
+
+	for i := range jobs {
+		idxCh <- i
+	}
+	close(idxCh)
+	wg.Wait()
+
+	var errs error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(
+				"Error building '%s': %s", r.Name, r.Err))
+		}
+	}
+
+	reportBuildResults(u, results)
+	return results, errs
+}
+
+// buildOne builds a single application for BuildAll. If prefix is true,
+// the output is prefixed with the name of the app so that it can be told
+// apart from other builds running at the same time.
+func buildOne(job *BuildJob, prefix bool) *BuildResult {
+	ctx := job.Ctx
+	name := ctx.Appfile.Application.Name
+	if prefix {
+		prefixUi := &ui.Prefixed{
+			Ui:     ctx.Ui,
+			Prefix: fmt.Sprintf("%s: ", name),
+		}
+		defer prefixUi.Flush()
+
+		// Build with a copy of the context so the job's Ui is left alone
+		prefixCtx := *ctx
+		prefixCtx.Ui = prefixUi
+		ctx = &prefixCtx
+	}
+
+	log.Printf("[INFO] building app: %s", name)
+	start := time.Now()
+	err := job.App.Build(ctx)
+	result := &BuildResult{
+		Name:     name,
+		Err:      err,
+		Duration: time.Since(start),
+	}
+	log.Printf("[INFO] built app %s in %s, err: %v", name, result.Duration, err)
+
+	return result
+}
+
+// reportBuildResults outputs the success or failure of each build.
+func reportBuildResults(u ui.Ui, results []*BuildResult) {
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		u.Header(fmt.Sprintf(
+			"[green]Built %d application(s).", len(results)))
+	} else {
+		u.Header(fmt.Sprintf(
+			"[red]%d of %d application build(s) failed.", failed, len(results)))
+	}
+
+	for _, r := range results {
+		duration := r.Duration - r.Duration%time.Second
+		if r.Err != nil {
+			u.Message(fmt.Sprintf(
+				"[red]  %s: failed after %s", r.Name, duration))
+		} else {
+			u.Message(fmt.Sprintf(
+				"[green]  %s: succeeded in %s", r.Name, duration))
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/ui"
+)
+
+func TestBuildAll(t *testing.T) {
+	cases := []struct {
+		Errs        []error
+		Parallelism int
+		Err         bool
+	}{
+		{[]error{nil}, 0, false},
+		{[]error{nil, nil, nil}, 2, false},
+		{[]error{nil, errors.New("bad"), nil}, 0, true},
+		{[]error{errors.New("bad"), errors.New("bad")}, 1, true},
+	}
+
+	for i, tc := range cases {
+		jobs := make([]*BuildJob, len(tc.Errs))
+		for j, err := range tc.Errs {
+			ctx := &Context{}
+			ctx.Ui = new(ui.Mock)
+			ctx.Appfile = &appfile.File{
+				Application: &appfile.Application{
+					Name: fmt.Sprintf("app%d", j),
+				},
+			}
+
+			jobs[j] = &BuildJob{
+				App: &Mock{BuildErr: err},
+				Ctx: ctx,
+			}
+		}
+
+		u := new(ui.Mock)
+		results, err := BuildAll(u, jobs, tc.Parallelism)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		if len(results) != len(jobs) {
+			t.Fatalf("%d: bad: %#v", i, results)
+		}
+		for j, r := range results {
+			mock := jobs[j].App.(*Mock)
+			if !mock.BuildCalled {
+				t.Fatalf("%d: build %d not called", i, j)
+			}
+			if r.Name != jobs[j].Ctx.Appfile.Application.Name {
+				t.Fatalf("%d: bad: %#v", i, r)
+			}
+			if _, ok := jobs[j].Ctx.Ui.(*ui.Mock); !ok {
+				t.Fatalf("%d: job %d Ui replaced: %#v", i, j, jobs[j].Ctx.Ui)
+			}
+			if r.Err != tc.Errs[j] {
+				t.Fatalf("%d: bad: %#v", i, r)
+			}
+		}
+
+		if len(u.MessageBuf) != len(jobs) {
+			t.Fatalf("%d: bad: %#v", i, u.MessageBuf)
+		}
+		for j, msg := range u.MessageBuf {
+			expected := "succeeded"
+			if tc.Errs[j] != nil {
+				expected = "failed"
+			}
+			if !strings.Contains(msg, expected) {
+				t.Fatalf("%d: bad: %s", i, msg)
+			}
+		}
+	}
+}
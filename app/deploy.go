@@ -0,0 +1,41 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+// DeployReporter is an optional interface that an App can implement to
+// report the result of its deploy, such as the address the application
+// is available at. Otto shows it after a successful `otto deploy`.
+type DeployReporter interface {
+	// DeployResult returns the result of the current deploy of the
+	// application, or nil if it isn't deployed.
+	DeployResult(*Context) (*DeployResult, error)
+}
+
+// DeployResult is the result of a deploy.
+type DeployResult struct {
+	// URL is the address the deployed application is available at. It
+	// is empty if the deploy doesn't have an address.
+	URL string
+
+	// Outputs are the outputs of the deploy, such as the Terraform
+	// outputs.
+	Outputs map[string]string
+}
+
+// NewDeployResult returns the DeployResult for a deploy from the
+// directory. The URL is the "url" output of the deploy, or an HTTP URL
+// for the "ip" output if there is no "url".
+func NewDeployResult(deploy *directory.Deploy) *DeployResult {
+	result := &DeployResult{Outputs: deploy.Outputs}
+	if u := deploy.Outputs["url"]; u != "" {
+		result.URL = u
+	} else if ip := deploy.Outputs["ip"]; ip != "" {
+		result.URL = fmt.Sprintf("http://%s/", ip)
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestNewDeployResult(t *testing.T) {
+	cases := []struct {
+		Outputs map[string]string
+		URL     string
+	}{
+		{nil, ""},
+		{map[string]string{"url": "http://foo/"}, "http://foo/"},
+		{map[string]string{"ip": "1.2.3.4"}, "http://1.2.3.4/"},
+		{
+			map[string]string{"url": "http://foo/", "ip": "1.2.3.4"},
+			"http://foo/",
+		},
+	}
+
+	for i, tc := range cases {
+		actual := NewDeployResult(&directory.Deploy{Outputs: tc.Outputs})
+		if actual.URL != tc.URL {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
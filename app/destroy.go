@@ -0,0 +1,11 @@
+package app
+
+// AppDestroy is an optional interface that an App can implement to
+// destroy its deploy with `otto destroy`. If an App doesn't implement
+// it, Otto runs the "destroy" action of Deploy instead.
+type AppDestroy interface {
+	// Destroy destroys everything the deploy of the application
+	// created. It should return an error if the application isn't
+	// deployed rather than doing nothing.
+	Destroy(*Context) error
+}
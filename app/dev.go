@@ -22,6 +22,12 @@ type DevDep struct {
 	// in the CacheDir, no caching will occur. The log will note if this
 	// is happening.
 	Files []string `json:"files"`
+
+	// Version is the resolved version of the dependency's own
+	// dependencies, such as a hash of the lockfile it was built with, so
+	// that teammates can tell if they built the same thing. It is empty
+	// if the versions aren't pinned.
+	Version string `json:"version,omitempty"`
 }
 
 // RelFiles makes all the Files values relative to the given directory.
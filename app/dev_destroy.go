@@ -0,0 +1,11 @@
+package app
+
+// AppDevDestroy is an optional interface that an App can implement to
+// destroy its development environment with `otto dev destroy`. If an App
+// doesn't implement it, Otto runs the "destroy" action of Dev instead.
+type AppDevDestroy interface {
+	// DevDestroy destroys the development environment of the
+	// application. If there is no development environment, it should
+	// tell the user so rather than return an error.
+	DevDestroy(*Context) error
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	registry      = make(TupleMap)
+	registryNames = make(map[string]Factory)
+	registryLock  sync.RWMutex
+)
+
+// Register makes an app type available to Otto so that app types can be
+// added without modifying the core. This is usually called from the init
+// function of the package implementing the app.
+//
+// The tuples are the tuples that the app supports. Every tuple must be
+// for the app type name. If no tuples are given, the app is used for
+// any infrastructure and flavor.
+//
+// Register panics if the same name is registered twice or if the factory
+// is nil.
+func Register(name string, f Factory, tuples ...Tuple) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if f == nil {
+		panic(fmt.Sprintf("app: Register factory is nil for %q", name))
+	}
+	if _, ok := registryNames[name]; ok {
+		panic(fmt.Sprintf("app: Register called twice for %q", name))
+	}
+
+	if len(tuples) == 0 {
+		tuples = []Tuple{{name, "*", "*"}}
+	}
+	for _, t := range tuples {
+		if t.App != name {
+			panic(fmt.Sprintf(
+				"app: Register tuple %s isn't for app type %q", t, name))
+		}
+	}
+
+	registryNames[name] = f
+	registry.Add(TupleSlice(tuples).Map(f))
+}
+
+// Get returns the factory registered for the app type name, or nil if
+// no app with that name is registered.
+func Get(name string) Factory {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	return registryNames[name]
+}
+
+// Registered returns a copy of the tuples and factories of all registered
+// apps. This can be added to the apps given to Otto.
+func Registered() TupleMap {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	result := make(TupleMap, len(registry))
+	result.Add(registry)
+	return result
+}
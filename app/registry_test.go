@@ -0,0 +1,90 @@
+package app
+
+import (
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	mock := new(Mock)
+	Register("registry-test", func() (App, error) {
+		return mock, nil
+	}, Tuple{"registry-test", "aws", "simple"})
+
+	f := Get("registry-test")
+	if f == nil {
+		t.Fatal("should have factory")
+	}
+	if Get("registry-test-missing") != nil {
+		t.Fatal("should not have factory")
+	}
+
+	// Drive the app through tuple resolution
+	f = Registered().Lookup(Tuple{"registry-test", "aws", "simple"})
+	if f == nil {
+		t.Fatal("should find tuple")
+	}
+	impl, err := f()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx := new(Context)
+	if _, err := impl.Compile(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := impl.Build(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !mock.CompileCalled || mock.CompileContext != ctx {
+		t.Fatal("compile should be called")
+	}
+	if !mock.BuildCalled || mock.BuildContext != ctx {
+		t.Fatal("build should be called")
+	}
+
+	// Unsupported tuples shouldn't resolve
+	if Registered().Lookup(Tuple{"registry-test", "aws", "other"}) != nil {
+		t.Fatal("should not find tuple")
+	}
+}
+
+func TestRegister_wildcard(t *testing.T) {
+	Register("registry-test-wildcard", StructFactory(new(Mock)))
+
+	f := Registered().Lookup(Tuple{"registry-test-wildcard", "foo", "bar"})
+	if f == nil {
+		t.Fatal("should find tuple")
+	}
+}
+
+func TestRegister_duplicate(t *testing.T) {
+	Register("registry-test-dup", StructFactory(new(Mock)))
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("should panic")
+		}
+	}()
+
+	Register("registry-test-dup", StructFactory(new(Mock)))
+}
+
+func TestRegister_badTuple(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("should panic")
+		}
+	}()
+
+	Register("registry-test-bad", StructFactory(new(Mock)),
+		Tuple{"other", "aws", "simple"})
+}
+
+func TestRegistered_copy(t *testing.T) {
+	m := Registered()
+	m[Tuple{"registry-test-copy", "*", "*"}] = StructFactory(new(Mock))
+
+	if Registered().Lookup(Tuple{"registry-test-copy", "a", "b"}) != nil {
+		t.Fatal("should not modify registry")
+	}
+}
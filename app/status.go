@@ -0,0 +1,58 @@
+package app
+
+import (
+	"github.com/hashicorp/otto/directory"
+)
+
+// AppStatus is an optional interface that an App can implement to
+// report its own status for `otto status`. If an App doesn't implement
+// it, Otto reads the status from the directory itself.
+type AppStatus interface {
+	// Status returns the status of the application on the active
+	// infrastructure.
+	Status(*Context) (*Status, error)
+}
+
+// Status is the status of an application on the active infrastructure.
+type Status struct {
+	// InfraState is the state of the infrastructure that the app is
+	// built and deployed to. This is InfraStateInvalid if the
+	// infrastructure hasn't been created.
+	InfraState directory.InfraState
+
+	// Built is true if the app has been built, and Artifact is the
+	// artifact of the latest build, such as the AMI for each region.
+	Built    bool
+	Artifact map[string]string
+
+	// DeployState, DeployVersion, and DeployOutputs are the state,
+	// version, and outputs of the current deploy. DeployState is
+	// DeployStateInvalid if the app has never been deployed.
+	DeployState   directory.DeployState
+	DeployVersion uint64
+	DeployOutputs map[string]string
+}
+
+// NewStatus returns the Status for the given infrastructure, build,
+// and deploy from the directory. Any of them can be nil if they don't
+// exist yet.
+func NewStatus(
+	infra *directory.Infra,
+	build *directory.Build,
+	deploy *directory.Deploy) *Status {
+	var result Status
+	if infra != nil {
+		result.InfraState = infra.State
+	}
+	if build != nil {
+		result.Built = true
+		result.Artifact = build.Artifact
+	}
+	if deploy != nil {
+		result.DeployState = deploy.State
+		result.DeployVersion = deploy.Version
+		result.DeployOutputs = deploy.Outputs
+	}
+
+	return &result
+}
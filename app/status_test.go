@@ -0,0 +1,35 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestNewStatus(t *testing.T) {
+	actual := NewStatus(nil, nil, nil)
+	if !reflect.DeepEqual(actual, &Status{}) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	actual = NewStatus(
+		&directory.Infra{State: directory.InfraStateReady},
+		&directory.Build{Artifact: map[string]string{"us-east-1": "ami-1"}},
+		&directory.Deploy{
+			State:   directory.DeployStateSuccess,
+			Version: 2,
+			Outputs: map[string]string{"url": "http://foo/"},
+		})
+	expected := &Status{
+		InfraState:    directory.InfraStateReady,
+		Built:         true,
+		Artifact:      map[string]string{"us-east-1": "ami-1"},
+		DeployState:   directory.DeployStateSuccess,
+		DeployVersion: 2,
+		DeployOutputs: map[string]string{"url": "http://foo/"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
@@ -0,0 +1,8 @@
+package app
+
+// AppTuples is an optional interface that an App can implement to list
+// the infrastructures and flavors it can be built and deployed to, such
+// as for `otto app info`. The App of each tuple is the app type.
+type AppTuples interface {
+	SupportedTuples() []Tuple
+}
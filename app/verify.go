@@ -0,0 +1,11 @@
+package app
+
+// AppVerify is an optional interface that an App can implement to check
+// its compiled files with `otto compile -verify`, such as by validating
+// the Packer and Terraform templates. Nothing should be built or
+// deployed, and the infrastructure doesn't have to exist.
+type AppVerify interface {
+	// Verify checks the files compiled into the Dir of the context. It
+	// returns an error describing the problems if they're invalid.
+	Verify(*Context) error
+}
@@ -0,0 +1,107 @@
+package appfile
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// KeyError is the error for a key in the Appfile that isn't valid, such
+// as a misspelled setting.
+type KeyError struct {
+	// Key is the key that isn't valid.
+	Key string
+
+	// Line is the line of the key in the Appfile, starting at 1. It is
+	// zero if the line couldn't be found.
+	Line int
+
+	// Suggestion is the valid key that is closest to Key, if there is
+	// one that is close enough to be a likely typo.
+	Suggestion string
+}
+
+func (e *KeyError) Error() string {
+	msg := fmt.Sprintf("invalid key: %s", e.Key)
+	if e.Line > 0 {
+		msg = fmt.Sprintf("line %d: %s", e.Line, msg)
+	}
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean '%s'?)", e.Suggestion)
+	}
+
+	return msg
+}
+
+// keyLine returns the line of the first setting or block with the given
+// key in src, or zero if it isn't found.
+func keyLine(src, key string) int {
+	re := regexp.MustCompile(
+		`^\s*"?` + regexp.QuoteMeta(key) + `"?\s*[={"]`)
+	for i, line := range strings.Split(src, "\n") {
+		if re.MatchString(line) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// suggestKey returns the valid key closest to key, or an empty string if
+// none of them are close enough that key is likely a typo of it.
+func suggestKey(key string, valid []string) string {
+	// A typo is a couple of characters off. Allowing more than that for
+	// short keys would suggest keys that aren't related at all.
+	max := 2
+	if len(key) < 6 {
+		max = 1
+	}
+
+	result := ""
+	for _, v := range valid {
+		if d := editDistance(key, v); d <= max {
+			result, max = v, d-1
+		}
+	}
+
+	return result
+}
+
+// editDistance returns the number of characters that have to be
+// inserted, deleted, replaced, or swapped with the next character to turn
+// a into b. Swapped characters are counted once since they are a common
+// typo.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = d[i-1][j-1] + cost
+			if v := d[i-1][j] + 1; v < d[i][j] {
+				d[i][j] = v
+			}
+			if v := d[i][j-1] + 1; v < d[i][j] {
+				d[i][j] = v
+			}
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if v := d[i-2][j-2] + 1; v < d[i][j] {
+					d[i][j] = v
+				}
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
@@ -0,0 +1,100 @@
+package appfile
+
+import (
+	"testing"
+)
+
+func TestKeyError(t *testing.T) {
+	cases := []struct {
+		Err    *KeyError
+		Result string
+	}{
+		{
+			&KeyError{Key: "foo"},
+			"invalid key: foo",
+		},
+
+		{
+			&KeyError{Key: "nmae", Line: 3, Suggestion: "name"},
+			"line 3: invalid key: nmae (did you mean 'name'?)",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.Err.Error(); actual != tc.Result {
+			t.Fatalf("%#v: %s", tc.Err, actual)
+		}
+	}
+}
+
+func TestKeyLine(t *testing.T) {
+	src := `
+application {
+    name = "foo"
+    helth_check {
+        path = "/"
+    }
+}
+
+infrastructure "aws" {}
+`
+
+	cases := []struct {
+		Key  string
+		Line int
+	}{
+		{"application", 2},
+		{"name", 3},
+		{"helth_check", 4},
+		{"infrastructure", 9},
+		{"path_missing", 0},
+	}
+
+	for _, tc := range cases {
+		if actual := keyLine(src, tc.Key); actual != tc.Line {
+			t.Fatalf("%s: %d", tc.Key, actual)
+		}
+	}
+}
+
+func TestSuggestKey(t *testing.T) {
+	valid := []string{"name", "type", "count", "instance_type", "build_instance_type"}
+
+	cases := []struct {
+		Key    string
+		Result string
+	}{
+		{"nmae", "name"},
+		{"nam", "name"},
+		{"typ", "type"},
+		{"instance_tyep", "instance_type"},
+		{"build_instance_typ", "build_instance_type"},
+		{"regions", ""},
+	}
+
+	for _, tc := range cases {
+		if actual := suggestKey(tc.Key, valid); actual != tc.Result {
+			t.Fatalf("%s: %q", tc.Key, actual)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		A, B   string
+		Result int
+	}{
+		{"", "", 0},
+		{"name", "name", 0},
+		{"name", "", 4},
+		{"nam", "name", 1},
+		{"nmae", "name", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tc := range cases {
+		if actual := editDistance(tc.A, tc.B); actual != tc.Result {
+			t.Fatalf("%s %s: %d", tc.A, tc.B, actual)
+		}
+	}
+}
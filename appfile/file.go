@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/otto/helper/oneline"
 	"github.com/hashicorp/otto/helper/uuid"
+	"github.com/mitchellh/go-homedir"
 )
 
 const (
@@ -33,6 +34,7 @@ type File struct {
 	Application    *Application
 	Project        *Project
 	Infrastructure []*Infrastructure
+	Environments   []*Environment
 	Customization  *CustomizationSet
 
 	// Imports is the list of imports that this File made. The imports
@@ -46,6 +48,161 @@ type Application struct {
 	Name         string
 	Type         string
 	Dependencies []*Dependency `mapstructure:"dependency"`
+	HealthCheck  *HealthCheck  `mapstructure:"-"`
+
+	// Count is the number of instances of the application to deploy.
+	// If this is zero, a single instance is deployed.
+	Count int
+
+	// InstanceType is the type of the instances the application is
+	// deployed to, and BuildInstanceType is the type of the instance
+	// used to build it, such as "t2.small". The app type's defaults are
+	// used if these aren't set.
+	InstanceType      string `mapstructure:"instance_type"`
+	BuildInstanceType string `mapstructure:"build_instance_type"`
+
+	// SourceAMI is the image the application is built from, such as a
+	// hardened AMI required by a security team. It must be based on the
+	// same OS as the app type's default, which is used if this isn't set.
+	SourceAMI string `mapstructure:"source_ami"`
+
+	// VolumeSize is the size in GB of the root volume of the instances
+	// the application is built and deployed on, for applications that
+	// need more disk space than the source AMI has. It must be at least
+	// the size of the source AMI's snapshot. The size of the source AMI
+	// is used if this isn't set.
+	VolumeSize int `mapstructure:"volume_size"`
+
+	// Builders are the types of the Packer builders that build the
+	// application, such as "amazon-ebs" and "amazon-chroot", for app
+	// types that build images on AWS. One image is built by each of
+	// them. The app type's builder is used if this isn't set.
+	Builders []string `mapstructure:"builders"`
+
+	// BuildOffline, if true, makes builds and the dev environment work
+	// without network access, for disconnected environments. Nothing is
+	// downloaded: the dependencies must be vendored, and the files the
+	// app type would otherwise download are read from OfflineDir.
+	BuildOffline bool `mapstructure:"build_offline"`
+
+	// BuildSpotPrice, if set, makes the build use a spot instance with
+	// this maximum hourly price in dollars, or "auto" to bid the current
+	// average price. Builds use on-demand instances if this isn't set.
+	BuildSpotPrice string `mapstructure:"build_spot_price"`
+
+	// BuildTimeout and DeployTimeout are how long a build and a deploy
+	// may run, such as "90m", before they are cancelled so that a stalled
+	// cloud operation can't hang them forever. Generous defaults are used
+	// if these aren't set.
+	BuildTimeout  string `mapstructure:"build_timeout"`
+	DeployTimeout string `mapstructure:"deploy_timeout"`
+
+	// PostDeploy is a shell command, such as a smoke test, that is run
+	// from the source directory after the deploy is applied and healthy.
+	// The outputs of the deploy are set as environment variables such as
+	// OTTO_OUTPUT_IP. If the command fails, the deploy fails, and if
+	// PostDeployRollback is true the artifact of the previous successful
+	// deploy is deployed again.
+	PostDeploy         string `mapstructure:"post_deploy"`
+	PostDeployRollback bool   `mapstructure:"post_deploy_rollback"`
+
+	// Domain, if set, is a DNS name that is pointed at the deployed
+	// application with a Route53 record in the hosted zone DomainZoneID.
+	// The record is deleted when the deploy is destroyed.
+	Domain       string `mapstructure:"domain"`
+	DomainZoneID string `mapstructure:"domain_zone_id"`
+
+	// VPCID and SubnetID, if set, are an existing VPC and subnet that the
+	// application is built and deployed in, instead of the default VPC
+	// for the build and the network of the infrastructure for the deploy.
+	// Both must be set together.
+	VPCID    string `mapstructure:"vpc_id"`
+	SubnetID string `mapstructure:"subnet_id"`
+
+	// Ports are the TCP ports the deployed application listens on, which
+	// are opened to the world by app types that support it. The app
+	// type's defaults are used if this isn't set.
+	Ports []int `mapstructure:"ports"`
+
+	// ProvisionScript is the path to a shell script, relative to the
+	// Appfile, that is run at the end of the build to customize the
+	// image. If it isn't set, ".otto/provision.sh" is used if it exists.
+	ProvisionScript string `mapstructure:"provision_script"`
+
+	// SourcePath is the path to the source of the application, relative
+	// to the Appfile, such as "services/api" in a repository with many
+	// applications. This defaults to the directory of the Appfile.
+	SourcePath string `mapstructure:"source_path"`
+
+	// BuildEnv are environment variables set while building the
+	// application, such as GOFLAGS. An empty value is read from the
+	// environment of `otto build` instead, so that secrets such as
+	// access tokens don't have to be in the Appfile.
+	BuildEnv map[string]string `mapstructure:"-"`
+
+	// Tags are added to the resources the application creates on the
+	// infrastructure, such as the instances of the build and deploy on
+	// AWS. Otto sets the "Name" tag itself.
+	Tags map[string]string `mapstructure:"-"`
+
+	// SSHKeyName is the name of an existing key pair on the
+	// infrastructure, such as an AWS key pair in the target region, that
+	// is granted access to the instances of the build and deploy.
+	// SSHPrivateKey is the path to its private key, relative to the
+	// Appfile, which Otto uses to SSH into those instances. The key pair
+	// of the infrastructure is used if these aren't set.
+	SSHKeyName    string `mapstructure:"ssh_key_name"`
+	SSHPrivateKey string `mapstructure:"ssh_private_key"`
+
+	// UserData is passed to the deployed instances as their user data,
+	// such as a cloud-init script with settings that differ between
+	// deploys. UserDataFile is instead the path to a file with the user
+	// data, relative to the Appfile. A file ending in ".tpl" is rendered
+	// with the variables of the deploy. At most one of these is set.
+	UserData     string `mapstructure:"user_data"`
+	UserDataFile string `mapstructure:"user_data_file"`
+
+	// SSHUser is the user to SSH into the deployed instances as, which
+	// depends on the OS of the image. It defaults to "ubuntu".
+	SSHUser string `mapstructure:"ssh_user"`
+
+	// DeployBackend, if set, is a remote backend that the Terraform state
+	// of the deploys is stored in, such as an S3 bucket, rather than the
+	// directory.
+	DeployBackend *DeployBackend `mapstructure:"-"`
+}
+
+// HealthCheck is the configuration for checking that a deployed
+// application is healthy before the deploy is considered successful.
+type HealthCheck struct {
+	Path    string // Path is the HTTP path to request, i.e. "/health"
+	Port    int    // Port is the port to request, defaults to 80
+	Timeout string // Timeout is how long to wait, i.e. "5m"
+}
+
+// DeployBackend is the configuration of a remote Terraform backend
+// that the state of the deploys is stored in.
+type DeployBackend struct {
+	// Type is the type of the backend, such as "s3" or "consul".
+	Type string
+
+	// Config is the configuration of the backend, such as the bucket
+	// for S3. The location of the state defaults to the ID of the deploy.
+	Config map[string]string `mapstructure:"-"`
+}
+
+// Environment is a named set of deploy settings, such as "staging" or
+// "production", that override the application's settings when the
+// environment is deployed.
+type Environment struct {
+	Name         string
+	Count        int
+	InstanceType string `mapstructure:"instance_type"`
+
+	// Variables are Terraform variables for the deploy. These take
+	// precedence over every other variable, including the ones Otto
+	// sets from the infrastructure and the build.
+	Variables map[string]string `mapstructure:"-"`
 }
 
 // Customization is the structure of customization stanzas within
@@ -74,6 +231,19 @@ type Infrastructure struct {
 	Type   string
 	Flavor string
 
+	// BuildRegions are additional regions that builds are copied to,
+	// so that the same build can be deployed in any of them.
+	BuildRegions []string `mapstructure:"build_regions"`
+
+	// DeployRegion is the region that deploys are made in, if it isn't
+	// the region of the infrastructure. The build must have an artifact
+	// for it, such as by listing it in BuildRegions.
+	DeployRegion string `mapstructure:"deploy_region"`
+
+	// ShareAccounts are the IDs of other accounts that builds are shared
+	// with, so that the same build can be deployed in them.
+	ShareAccounts []string `mapstructure:"share_accounts"`
+
 	Foundations []*Foundation
 }
 
@@ -135,10 +305,27 @@ func (f *File) Merge(other *File) error {
 		if len(i.Foundations) == 0 {
 			i.Foundations = old.Foundations
 		}
+		if len(i.BuildRegions) == 0 {
+			i.BuildRegions = old.BuildRegions
+		}
 
 		f.Infrastructure[idx] = i
 	}
 
+	// Environments
+	envMap := make(map[string]int)
+	for i, env := range f.Environments {
+		envMap[env.Name] = i
+	}
+	for _, env := range other.Environments {
+		if idx, ok := envMap[env.Name]; ok {
+			f.Environments[idx] = env
+			continue
+		}
+
+		f.Environments = append(f.Environments, env)
+	}
+
 	// TODO: customizations
 	f.Customization = other.Customization
 
@@ -161,6 +348,76 @@ func (f *File) ActiveInfrastructure() *Infrastructure {
 	return nil
 }
 
+// Environment returns the Environment with the given name, or nil if
+// the Appfile doesn't have one.
+func (f *File) Environment(name string) *Environment {
+	for _, env := range f.Environments {
+		if env.Name == name {
+			return env
+		}
+	}
+
+	return nil
+}
+
+// SourceDir returns the directory of the source of the application. This
+// is the directory of the Appfile unless the application sets a
+// SourcePath.
+func (f *File) SourceDir() string {
+	dir := filepath.Dir(f.Path)
+	if f.Application == nil || f.Application.SourcePath == "" {
+		return dir
+	}
+
+	if filepath.IsAbs(f.Application.SourcePath) {
+		return filepath.Clean(f.Application.SourcePath)
+	}
+
+	return filepath.Join(dir, f.Application.SourcePath)
+}
+
+// OfflineDir returns the directory that the files needed to build and
+// develop without network access, such as a Vagrant box, are read from
+// when the application sets BuildOffline. This is ".otto/offline" next
+// to the Appfile.
+func (f *File) OfflineDir() string {
+	return filepath.Join(filepath.Dir(f.Path), ".otto", "offline")
+}
+
+// SSHPrivateKeyPath returns the absolute path to the private key of the
+// application's SSH key pair, or an empty string if it isn't set. A
+// leading "~" is expanded to the home directory.
+func (f *File) SSHPrivateKeyPath() (string, error) {
+	if f.Application == nil || f.Application.SSHPrivateKey == "" {
+		return "", nil
+	}
+
+	path, err := homedir.Expand(f.Application.SSHPrivateKey)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(f.Path), path)
+	}
+
+	return filepath.Clean(path), nil
+}
+
+// UserDataPath returns the absolute path to the user_data_file of the
+// application, or an empty string if it isn't set.
+func (f *File) UserDataPath() string {
+	if f.Application == nil || f.Application.UserDataFile == "" {
+		return ""
+	}
+
+	path := f.Application.UserDataFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(f.Path), path)
+	}
+
+	return filepath.Clean(path)
+}
+
 // resetID deletes the ID associated with this file.
 func (f *File) resetID() error {
 	return os.Remove(filepath.Join(filepath.Dir(f.Path), IDFile))
@@ -216,6 +473,10 @@ func (v *Application) GoString() string {
 	return fmt.Sprintf("*%#v", *v)
 }
 
+func (v *HealthCheck) GoString() string {
+	return fmt.Sprintf("*%#v", *v)
+}
+
 func (v *Customization) GoString() string {
 	return fmt.Sprintf("*%#v", *v)
 }
@@ -228,6 +489,10 @@ func (v *Infrastructure) GoString() string {
 	return fmt.Sprintf("*%#v", *v)
 }
 
+func (v *Environment) GoString() string {
+	return fmt.Sprintf("*%#v", *v)
+}
+
 func (v *Project) GoString() string {
 	return fmt.Sprintf("*%#v", *v)
 }
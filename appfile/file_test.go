@@ -32,6 +32,85 @@ func TestFileActiveInfrastructure(t *testing.T) {
 	}
 }
 
+func TestFileSourceDir(t *testing.T) {
+	cases := []struct {
+		Path       string
+		SourcePath string
+		Result     string
+	}{
+		{"/repo/Appfile", "", "/repo"},
+		{"/repo/Appfile", "services/api", "/repo/services/api"},
+		{"/repo/Appfile", "/src/api", "/src/api"},
+	}
+
+	for _, tc := range cases {
+		f := &File{
+			Path:        tc.Path,
+			Application: &Application{SourcePath: tc.SourcePath},
+		}
+
+		actual := f.SourceDir()
+		if actual != tc.Result {
+			t.Fatalf("%s %s: %s", tc.Path, tc.SourcePath, actual)
+		}
+	}
+}
+
+func TestFileSSHPrivateKeyPath(t *testing.T) {
+	cases := []struct {
+		Path          string
+		SSHPrivateKey string
+		Result        string
+	}{
+		{"/repo/Appfile", "", ""},
+		{"/repo/Appfile", "keys/deploy.pem", "/repo/keys/deploy.pem"},
+		{"/repo/Appfile", "/keys/deploy.pem", "/keys/deploy.pem"},
+	}
+
+	for _, tc := range cases {
+		f := &File{
+			Path:        tc.Path,
+			Application: &Application{SSHPrivateKey: tc.SSHPrivateKey},
+		}
+
+		actual, err := f.SSHPrivateKeyPath()
+		if err != nil {
+			t.Fatalf("%s %s: %s", tc.Path, tc.SSHPrivateKey, err)
+		}
+		if actual != tc.Result {
+			t.Fatalf("%s %s: %s", tc.Path, tc.SSHPrivateKey, actual)
+		}
+	}
+}
+
+func TestFileUserDataPath(t *testing.T) {
+	cases := []struct {
+		Path         string
+		UserData     string
+		UserDataFile string
+		Result       string
+	}{
+		{"/repo/Appfile", "", "", ""},
+		{"/repo/Appfile", "", "cloud-init.sh", "/repo/cloud-init.sh"},
+		{"/repo/Appfile", "", "/etc/cloud-init.sh.tpl", "/etc/cloud-init.sh.tpl"},
+		{"/repo/Appfile", "echo hello", "", ""},
+	}
+
+	for _, tc := range cases {
+		f := &File{
+			Path: tc.Path,
+			Application: &Application{
+				UserData:     tc.UserData,
+				UserDataFile: tc.UserDataFile,
+			},
+		}
+
+		if actual := f.UserDataPath(); actual != tc.Result {
+			t.Fatalf("%s %q: %s", tc.Path, tc.UserDataFile, actual)
+		}
+	}
+}
+
 func TestFileMerge(t *testing.T) {
 	cases := map[string]struct {
 		One, Two, Three *File
@@ -137,6 +216,28 @@ func TestFileMerge(t *testing.T) {
 			},
 		},
 
+		"Environments": {
+			One: &File{
+				Environments: []*Environment{
+					&Environment{Name: "staging"},
+					&Environment{Name: "production", Count: 2},
+				},
+			},
+			Two: &File{
+				Environments: []*Environment{
+					&Environment{Name: "production", Count: 4},
+					&Environment{Name: "qa"},
+				},
+			},
+			Three: &File{
+				Environments: []*Environment{
+					&Environment{Name: "staging"},
+					&Environment{Name: "production", Count: 4},
+					&Environment{Name: "qa"},
+				},
+			},
+		},
+
 		"Infra (override)": {
 			One: &File{
 				Infrastructure: []*Infrastructure{
@@ -25,8 +25,9 @@ func Parse(r io.Reader) (*File, error) {
 		return nil, err
 	}
 
-	// Parse the buffer
-	obj, err := hcl.Parse(buf.String())
+	// Parse the buffer. The source is kept to find the lines of errors.
+	src := buf.String()
+	obj, err := hcl.Parse(src)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing: %s", err)
 	}
@@ -36,11 +37,12 @@ func Parse(r io.Reader) (*File, error) {
 	valid := []string{
 		"application",
 		"customization",
+		"environment",
 		"import",
 		"infrastructure",
 		"project",
 	}
-	if err := checkHCLKeys(obj, valid); err != nil {
+	if err := checkHCLKeys(obj, valid, src); err != nil {
 		return nil, err
 	}
 
@@ -48,32 +50,39 @@ func Parse(r io.Reader) (*File, error) {
 
 	// Parse the imports
 	if o := obj.Get("import", false); o != nil {
-		if err := parseImport(&result, o); err != nil {
+		if err := parseImport(&result, o, src); err != nil {
 			return nil, fmt.Errorf("error parsing 'import': %s", err)
 		}
 	}
 
 	// Parse the application
 	if o := obj.Get("application", false); o != nil {
-		if err := parseApplication(&result, o); err != nil {
+		if err := parseApplication(&result, o, src); err != nil {
 			return nil, fmt.Errorf("error parsing 'application': %s", err)
 		}
 	}
 
 	// Parse the project
 	if o := obj.Get("project", false); o != nil {
-		if err := parseProject(&result, o); err != nil {
+		if err := parseProject(&result, o, src); err != nil {
 			return nil, fmt.Errorf("error parsing 'project': %s", err)
 		}
 	}
 
 	// Parse the infrastructure
 	if o := obj.Get("infrastructure", false); o != nil {
-		if err := parseInfra(&result, o); err != nil {
+		if err := parseInfra(&result, o, src); err != nil {
 			return nil, fmt.Errorf("error parsing 'infrastructure': %s", err)
 		}
 	}
 
+	// Parse the environments
+	if o := obj.Get("environment", false); o != nil {
+		if err := parseEnvironments(&result, o, src); err != nil {
+			return nil, fmt.Errorf("error parsing 'environment': %s", err)
+		}
+	}
+
 	// Parse the customizations
 	if o := obj.Get("customization", false); o != nil {
 		if err := parseCustomizations(&result, o); err != nil {
@@ -108,14 +117,22 @@ func ParseFile(path string) (*File, error) {
 	return result, err
 }
 
-func parseApplication(result *File, obj *hclobj.Object) error {
+func parseApplication(result *File, obj *hclobj.Object, src string) error {
 	if obj.Len() > 1 {
 		return fmt.Errorf("only one 'application' block allowed")
 	}
 
 	// Check for invalid keys
-	valid := []string{"name", "type", "dependency"}
-	if err := checkHCLKeys(obj, valid); err != nil {
+	valid := []string{
+		"name", "type", "dependency", "health_check", "count",
+		"instance_type", "build_instance_type", "source_ami", "provision_script",
+		"source_path", "build_env", "ports", "tags", "ssh_key_name",
+		"ssh_private_key", "build_spot_price", "domain", "domain_zone_id",
+		"vpc_id", "subnet_id", "build_timeout", "deploy_timeout",
+		"post_deploy", "post_deploy_rollback", "volume_size", "build_offline",
+		"ssh_user", "builders", "user_data", "user_data_file", "deploy_backend",
+	}
+	if err := checkHCLKeys(obj, valid, src); err != nil {
 		return multierror.Prefix(err, "application:")
 	}
 
@@ -126,7 +143,119 @@ func parseApplication(result *File, obj *hclobj.Object) error {
 
 	var app Application
 	result.Application = &app
-	return mapstructure.WeakDecode(m, &app)
+	if err := mapstructure.WeakDecode(m, &app); err != nil {
+		return err
+	}
+
+	// Zero is the default for an unset count, so an explicit zero
+	// has to be caught here rather than during validation.
+	if _, ok := m["count"]; ok && app.Count < 1 {
+		return fmt.Errorf("application: count must be at least 1")
+	}
+	if _, ok := m["volume_size"]; ok && app.VolumeSize < 1 {
+		return fmt.Errorf("application: volume_size must be at least 1")
+	}
+	if _, ok := m["builders"]; ok && len(app.Builders) == 0 {
+		return fmt.Errorf("application: builders must have at least one builder")
+	}
+
+	// Parse the build environment if we have one
+	if o := obj.Get("build_env", false); o != nil {
+		var env map[string]interface{}
+		if err := hcl.DecodeObject(&env, o); err != nil {
+			return err
+		}
+		if err := mapstructure.WeakDecode(env, &app.BuildEnv); err != nil {
+			return fmt.Errorf("error parsing 'build_env': %s", err)
+		}
+	}
+
+	// Parse the tags if we have any
+	if o := obj.Get("tags", false); o != nil {
+		var tags map[string]interface{}
+		if err := hcl.DecodeObject(&tags, o); err != nil {
+			return err
+		}
+		if err := mapstructure.WeakDecode(tags, &app.Tags); err != nil {
+			return fmt.Errorf("error parsing 'tags': %s", err)
+		}
+	}
+
+	// Parse the health check if we have one
+	if o := obj.Get("health_check", false); o != nil {
+		if err := parseHealthCheck(&app, o, src); err != nil {
+			return fmt.Errorf("error parsing 'health_check': %s", err)
+		}
+	}
+
+	// Parse the deploy backend if we have one
+	if o := obj.Get("deploy_backend", false); o != nil {
+		if err := parseDeployBackend(&app, o, src); err != nil {
+			return fmt.Errorf("error parsing 'deploy_backend': %s", err)
+		}
+	}
+
+	return nil
+}
+
+func parseDeployBackend(result *Application, obj *hclobj.Object, src string) error {
+	if obj.Len() > 1 {
+		return fmt.Errorf("only one 'deploy_backend' block allowed")
+	}
+
+	// Check for invalid keys
+	valid := []string{"type", "config"}
+	if err := checkHCLKeys(obj, valid, src); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, obj); err != nil {
+		return err
+	}
+
+	var b DeployBackend
+	if err := mapstructure.WeakDecode(m, &b); err != nil {
+		return err
+	}
+	if b.Type == "" {
+		return fmt.Errorf("type must be set")
+	}
+
+	// Parse the configuration if we have any
+	if o := obj.Get("config", false); o != nil {
+		var config map[string]interface{}
+		if err := hcl.DecodeObject(&config, o); err != nil {
+			return err
+		}
+		if err := mapstructure.WeakDecode(config, &b.Config); err != nil {
+			return fmt.Errorf("error parsing 'config': %s", err)
+		}
+	}
+
+	result.DeployBackend = &b
+	return nil
+}
+
+func parseHealthCheck(result *Application, obj *hclobj.Object, src string) error {
+	if obj.Len() > 1 {
+		return fmt.Errorf("only one 'health_check' block allowed")
+	}
+
+	// Check for invalid keys
+	valid := []string{"path", "port", "timeout"}
+	if err := checkHCLKeys(obj, valid, src); err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	if err := hcl.DecodeObject(&m, obj); err != nil {
+		return err
+	}
+
+	var hc HealthCheck
+	result.HealthCheck = &hc
+	return mapstructure.WeakDecode(m, &hc)
 }
 
 func parseCustomizations(result *File, obj *hclobj.Object) error {
@@ -167,7 +296,7 @@ func parseCustomizations(result *File, obj *hclobj.Object) error {
 	return nil
 }
 
-func parseImport(result *File, obj *hclobj.Object) error {
+func parseImport(result *File, obj *hclobj.Object, src string) error {
 	// Get all the maps of keys to the actual object
 	objects := make([]*hclobj.Object, 0, 3)
 	set := make(map[string]struct{})
@@ -192,7 +321,7 @@ func parseImport(result *File, obj *hclobj.Object) error {
 	collection := make([]*Import, 0, len(objects))
 	for _, o := range objects {
 		// Check for invalid keys
-		if err := checkHCLKeys(o, nil); err != nil {
+		if err := checkHCLKeys(o, nil, src); err != nil {
 			return multierror.Prefix(err, fmt.Sprintf(
 				"import '%s':", o.Key))
 		}
@@ -206,7 +335,7 @@ func parseImport(result *File, obj *hclobj.Object) error {
 	return nil
 }
 
-func parseInfra(result *File, obj *hclobj.Object) error {
+func parseInfra(result *File, obj *hclobj.Object, src string) error {
 	// Get all the maps of keys to the actual object
 	objects := make(map[string]*hclobj.Object)
 	for _, o1 := range obj.Elem(false) {
@@ -229,8 +358,11 @@ func parseInfra(result *File, obj *hclobj.Object) error {
 	collection := make([]*Infrastructure, 0, len(objects))
 	for n, o := range objects {
 		// Check for invalid keys
-		valid := []string{"name", "type", "flavor", "foundation"}
-		if err := checkHCLKeys(o, valid); err != nil {
+		valid := []string{
+			"name", "type", "flavor", "build_regions", "deploy_region",
+			"share_accounts", "foundation",
+		}
+		if err := checkHCLKeys(o, valid, src); err != nil {
 			return multierror.Prefix(err, fmt.Sprintf(
 				"infrastructure '%s':", n))
 		}
@@ -265,6 +397,72 @@ func parseInfra(result *File, obj *hclobj.Object) error {
 	return nil
 }
 
+func parseEnvironments(result *File, obj *hclobj.Object, src string) error {
+	// Get all the maps of keys to the actual object
+	objects := make(map[string]*hclobj.Object)
+	for _, o1 := range obj.Elem(false) {
+		for _, o2 := range o1.Elem(true) {
+			if _, ok := objects[o2.Key]; ok {
+				return fmt.Errorf(
+					"environment '%s' defined more than once",
+					o2.Key)
+			}
+
+			objects[o2.Key] = o2
+		}
+	}
+
+	if len(objects) == 0 {
+		return nil
+	}
+
+	// Go through each object and turn it into an actual result.
+	collection := make([]*Environment, 0, len(objects))
+	for n, o := range objects {
+		// Check for invalid keys
+		valid := []string{"count", "instance_type", "variables"}
+		if err := checkHCLKeys(o, valid, src); err != nil {
+			return multierror.Prefix(err, fmt.Sprintf(
+				"environment '%s':", n))
+		}
+
+		var m map[string]interface{}
+		if err := hcl.DecodeObject(&m, o); err != nil {
+			return err
+		}
+
+		var env Environment
+		if err := mapstructure.WeakDecode(m, &env); err != nil {
+			return fmt.Errorf(
+				"error parsing environment '%s': %s", n, err)
+		}
+		env.Name = n
+
+		// Like the application, an explicit zero count is an error
+		if _, ok := m["count"]; ok && env.Count < 1 {
+			return fmt.Errorf(
+				"environment '%s': count must be at least 1", n)
+		}
+
+		// Parse the variables if we have any
+		if o2 := o.Get("variables", false); o2 != nil {
+			var vars map[string]interface{}
+			if err := hcl.DecodeObject(&vars, o2); err != nil {
+				return err
+			}
+			if err := mapstructure.WeakDecode(vars, &env.Variables); err != nil {
+				return fmt.Errorf(
+					"error parsing variables of environment '%s': %s", n, err)
+			}
+		}
+
+		collection = append(collection, &env)
+	}
+
+	result.Environments = collection
+	return nil
+}
+
 func parseFoundations(result *Infrastructure, obj *hclobj.Object) error {
 	// Get all the maps of keys to the actual object
 	objects := make(map[string]*hclobj.Object)
@@ -304,14 +502,14 @@ func parseFoundations(result *Infrastructure, obj *hclobj.Object) error {
 	return nil
 }
 
-func parseProject(result *File, obj *hclobj.Object) error {
+func parseProject(result *File, obj *hclobj.Object, src string) error {
 	if obj.Len() > 1 {
 		return fmt.Errorf("only one 'project' block allowed")
 	}
 
 	// Check for invalid keys
 	valid := []string{"name", "infrastructure"}
-	if err := checkHCLKeys(obj, valid); err != nil {
+	if err := checkHCLKeys(obj, valid, src); err != nil {
 		return multierror.Prefix(err, "project:")
 	}
 
@@ -330,7 +528,10 @@ func parseProject(result *File, obj *hclobj.Object) error {
 	return nil
 }
 
-func checkHCLKeys(obj *hclobj.Object, valid []string) error {
+// checkHCLKeys returns a *KeyError for every key of obj that isn't
+// valid. src is the source of the Appfile, which is used to find the
+// lines of the keys.
+func checkHCLKeys(obj *hclobj.Object, valid []string, src string) error {
 	validMap := make(map[string]struct{}, len(valid))
 	for _, v := range valid {
 		validMap[v] = struct{}{}
@@ -339,8 +540,11 @@ func checkHCLKeys(obj *hclobj.Object, valid []string) error {
 	var result error
 	for _, o := range obj.Elem(true) {
 		if _, ok := validMap[o.Key]; !ok {
-			result = multierror.Append(result, fmt.Errorf(
-				"invald key: %s", o.Key))
+			result = multierror.Append(result, &KeyError{
+				Key:        o.Key,
+				Line:       keyLine(src, o.Key),
+				Suggestion: suggestKey(o.Key, valid),
+			})
 		}
 	}
 
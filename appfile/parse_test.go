@@ -3,6 +3,7 @@ package appfile
 import (
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -48,6 +49,287 @@ func TestParse(t *testing.T) {
 			true,
 		},
 
+		{
+			"app-health-check.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+					HealthCheck: &HealthCheck{
+						Path:    "/health",
+						Port:    8080,
+						Timeout: "2m",
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-count.hcl",
+			&File{
+				Application: &Application{
+					Name:  "foo",
+					Count: 3,
+				},
+			},
+			false,
+		},
+
+		{
+			"app-volume-size.hcl",
+			&File{
+				Application: &Application{
+					Name:       "foo",
+					VolumeSize: 50,
+				},
+			},
+			false,
+		},
+
+		{
+			"app-builders.hcl",
+			&File{
+				Application: &Application{
+					Name:     "foo",
+					Builders: []string{"amazon-ebs", "amazon-chroot"},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-user-data.hcl",
+			&File{
+				Application: &Application{
+					Name:         "foo",
+					UserDataFile: "cloud-init.sh.tpl",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-deploy-backend.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+					DeployBackend: &DeployBackend{
+						Type: "s3",
+						Config: map[string]string{
+							"bucket": "otto-state",
+							"region": "us-east-1",
+						},
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-deploy-backend-no-type.hcl",
+			nil,
+			true,
+		},
+
+		{
+			"app-build-offline.hcl",
+			&File{
+				Application: &Application{
+					Name:         "foo",
+					BuildOffline: true,
+				},
+			},
+			false,
+		},
+
+		{
+			"app-instance-type.hcl",
+			&File{
+				Application: &Application{
+					Name:              "foo",
+					InstanceType:      "m3.large",
+					BuildInstanceType: "t2.small",
+					SourceAMI:         "ami-12345678",
+					BuildSpotPrice:    "0.05",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-domain.hcl",
+			&File{
+				Application: &Application{
+					Name:         "foo",
+					Domain:       "foo.example.com",
+					DomainZoneID: "Z1234567890",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-timeout.hcl",
+			&File{
+				Application: &Application{
+					Name:          "foo",
+					BuildTimeout:  "90m",
+					DeployTimeout: "30m",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-post-deploy.hcl",
+			&File{
+				Application: &Application{
+					Name:               "foo",
+					PostDeploy:         "curl -f $OTTO_OUTPUT_URL",
+					PostDeployRollback: true,
+				},
+			},
+			false,
+		},
+
+		{
+			"app-vpc.hcl",
+			&File{
+				Application: &Application{
+					Name:     "foo",
+					VPCID:    "vpc-12345678",
+					SubnetID: "subnet-12345678",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-provision-script.hcl",
+			&File{
+				Application: &Application{
+					Name:            "foo",
+					ProvisionScript: "scripts/provision.sh",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-ports.hcl",
+			&File{
+				Application: &Application{
+					Name:  "foo",
+					Ports: []int{80, 8080},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-tags.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+					Tags: map[string]string{
+						"Team":       "payments",
+						"CostCenter": "1234",
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-ssh-key.hcl",
+			&File{
+				Application: &Application{
+					Name:          "foo",
+					SSHKeyName:    "deploy",
+					SSHPrivateKey: "~/.ssh/deploy.pem",
+					SSHUser:       "ec2-user",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-build-env.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+					BuildEnv: map[string]string{
+						"CGO_ENABLED":  "0",
+						"GITHUB_TOKEN": "",
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"app-source-path.hcl",
+			&File{
+				Application: &Application{
+					Name:       "foo",
+					SourcePath: "services/api",
+				},
+			},
+			false,
+		},
+
+		{
+			"app-count-zero.hcl",
+			nil,
+			true,
+		},
+
+		{
+			"app-volume-size-zero.hcl",
+			nil,
+			true,
+		},
+
+		{
+			"app-builders-empty.hcl",
+			nil,
+			true,
+		},
+
+		// Environments
+		{
+			"environment.hcl",
+			&File{
+				Application: &Application{
+					Name:  "foo",
+					Count: 1,
+				},
+				Environments: []*Environment{
+					&Environment{
+						Name:         "production",
+						Count:        4,
+						InstanceType: "m3.large",
+						Variables: map[string]string{
+							"aws_region": "us-west-2",
+						},
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"environment-dup.hcl",
+			nil,
+			true,
+		},
+
+		{
+			"environment-bad-key.hcl",
+			nil,
+			true,
+		},
+
 		// Customizations
 		{
 			"basic-custom.hcl",
@@ -121,6 +403,44 @@ func TestParse(t *testing.T) {
 			true,
 		},
 
+		{
+			"infra-build-regions.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+				},
+				Infrastructure: []*Infrastructure{
+					&Infrastructure{
+						Name:         "aws",
+						Type:         "aws",
+						Flavor:       "foo",
+						BuildRegions: []string{"us-west-2", "eu-west-1"},
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"infra-deploy-region.hcl",
+			&File{
+				Application: &Application{
+					Name: "foo",
+				},
+				Infrastructure: []*Infrastructure{
+					&Infrastructure{
+						Name:          "aws",
+						Type:          "aws",
+						Flavor:        "foo",
+						BuildRegions:  []string{"us-west-2"},
+						DeployRegion:  "us-west-2",
+						ShareAccounts: []string{"123456789012"},
+					},
+				},
+			},
+			false,
+		},
+
 		// Imports
 
 		{
@@ -172,3 +492,15 @@ func TestParse(t *testing.T) {
 		}
 	}
 }
+
+func TestParse_keyError(t *testing.T) {
+	_, err := ParseFile(filepath.Join("./test-fixtures", "app-key-typo.hcl"))
+	if err == nil {
+		t.Fatal("should error")
+	}
+
+	expected := "line 4: invalid key: instance_tyep (did you mean 'instance_type'?)"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("bad: %s", err)
+	}
+}
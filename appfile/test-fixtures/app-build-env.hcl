@@ -0,0 +1,8 @@
+application {
+    name = "foo"
+
+    build_env {
+        CGO_ENABLED = "0"
+        GITHUB_TOKEN = ""
+    }
+}
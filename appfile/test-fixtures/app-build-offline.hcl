@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    build_offline = true
+}
@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    builders = ["amazon-ebs", "amazon-chroot"]
+}
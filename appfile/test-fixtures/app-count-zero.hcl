@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    count = 0
+}
@@ -0,0 +1,9 @@
+application {
+    name = "foo"
+
+    deploy_backend {
+        config {
+            bucket = "otto-state"
+        }
+    }
+}
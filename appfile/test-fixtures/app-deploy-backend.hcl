@@ -0,0 +1,12 @@
+application {
+    name = "foo"
+
+    deploy_backend {
+        type = "s3"
+
+        config {
+            bucket = "otto-state"
+            region = "us-east-1"
+        }
+    }
+}
@@ -0,0 +1,5 @@
+application {
+    name = "foo"
+    domain = "foo.example.com"
+    domain_zone_id = "Z1234567890"
+}
@@ -0,0 +1,9 @@
+application {
+    name = "foo"
+
+    health_check {
+        path = "/health"
+        port = 8080
+        timeout = "2m"
+    }
+}
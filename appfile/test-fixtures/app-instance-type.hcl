@@ -0,0 +1,7 @@
+application {
+    name = "foo"
+    instance_type = "m3.large"
+    build_instance_type = "t2.small"
+    source_ami = "ami-12345678"
+    build_spot_price = "0.05"
+}
@@ -0,0 +1,5 @@
+application {
+    name = "foo"
+    type = "go"
+    instance_tyep = "t2.small"
+}
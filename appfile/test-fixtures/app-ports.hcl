@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    ports = [80, 8080]
+}
@@ -0,0 +1,5 @@
+application {
+    name = "foo"
+    post_deploy = "curl -f $OTTO_OUTPUT_URL"
+    post_deploy_rollback = true
+}
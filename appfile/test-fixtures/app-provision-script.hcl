@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    provision_script = "scripts/provision.sh"
+}
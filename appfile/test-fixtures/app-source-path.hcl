@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    source_path = "services/api"
+}
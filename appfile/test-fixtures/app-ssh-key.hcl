@@ -0,0 +1,6 @@
+application {
+    name = "foo"
+    ssh_key_name = "deploy"
+    ssh_private_key = "~/.ssh/deploy.pem"
+    ssh_user = "ec2-user"
+}
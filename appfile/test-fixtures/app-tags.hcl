@@ -0,0 +1,8 @@
+application {
+    name = "foo"
+
+    tags {
+        Team = "payments"
+        CostCenter = "1234"
+    }
+}
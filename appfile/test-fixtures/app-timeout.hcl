@@ -0,0 +1,5 @@
+application {
+    name = "foo"
+    build_timeout = "90m"
+    deploy_timeout = "30m"
+}
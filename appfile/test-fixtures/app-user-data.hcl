@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    user_data_file = "cloud-init.sh.tpl"
+}
@@ -0,0 +1,4 @@
+application {
+    name = "foo"
+    volume_size = 50
+}
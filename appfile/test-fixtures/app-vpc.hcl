@@ -0,0 +1,5 @@
+application {
+    name = "foo"
+    vpc_id = "vpc-12345678"
+    subnet_id = "subnet-12345678"
+}
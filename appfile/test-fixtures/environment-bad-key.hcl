@@ -0,0 +1,3 @@
+environment "production" {
+    name = "staging"
+}
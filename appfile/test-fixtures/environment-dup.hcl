@@ -0,0 +1,2 @@
+environment "production" {}
+environment "production" {}
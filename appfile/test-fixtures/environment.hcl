@@ -0,0 +1,13 @@
+application {
+    name = "foo"
+    count = 1
+}
+
+environment "production" {
+    count = 4
+    instance_type = "m3.large"
+
+    variables {
+        aws_region = "us-west-2"
+    }
+}
@@ -0,0 +1,8 @@
+application {
+    name = "foo"
+}
+
+infrastructure "aws" {
+    flavor = "foo"
+    build_regions = ["us-west-2", "eu-west-1"]
+}
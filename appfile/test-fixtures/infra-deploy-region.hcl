@@ -0,0 +1,10 @@
+application {
+    name = "foo"
+}
+
+infrastructure "aws" {
+    flavor = "foo"
+    build_regions = ["us-west-2"]
+    deploy_region = "us-west-2"
+    share_accounts = ["123456789012"]
+}
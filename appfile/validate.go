@@ -2,10 +2,33 @@ package appfile
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 )
 
+// envNameRegexp matches the valid names of environment variables.
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// accountIDRegexp matches the IDs of AWS accounts.
+var accountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
+// userDataUnsupported are the app types whose deploys don't use the
+// user_data of the Appfile. Docker apps start their container with user
+// data of their own, and static sites have no instances.
+var userDataUnsupported = map[string]bool{
+	"docker":          true,
+	"docker-external": true,
+	"static":          true,
+}
+
+// tagRegexp matches the characters allowed in tags. These are the
+// characters AWS allows, which are also safe in every template.
+var tagRegexp = regexp.MustCompile(`^[\pL\pZ\pN_.:/=+\-@]*$`)
+
 // Validate validates the Appfile
 func (f *File) Validate() error {
 	var result error
@@ -34,6 +57,133 @@ func (f *File) Validate() error {
 			result = multierror.Append(result, fmt.Errorf(
 				"application: type is required"))
 		}
+		if f.Application.Count < 0 {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: count must be at least 1"))
+		}
+		if f.Application.VolumeSize < 0 {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: volume_size must be at least 1"))
+		}
+
+		for _, p := range f.Application.Ports {
+			if p < 1 || p > 65535 {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: port %d must be between 1 and 65535", p))
+			}
+		}
+
+		for k := range f.Application.BuildEnv {
+			if !envNameRegexp.MatchString(k) {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: build_env name '%s' isn't a valid environment variable", k))
+			}
+		}
+
+		for k, v := range f.Application.Tags {
+			if err := validateTag(k, v); err != nil {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: tag '%s': %s", k, err))
+			}
+		}
+
+		// The build needs the private key to SSH in with the key pair
+		if f.Application.SSHKeyName != "" && f.Application.SSHPrivateKey == "" {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: ssh_private_key is required with ssh_key_name"))
+		}
+
+		if v := f.Application.BuildSpotPrice; v != "" && v != "auto" {
+			if price, err := strconv.ParseFloat(v, 64); err != nil || price <= 0 {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: build_spot_price must be a price in dollars or \"auto\""))
+			}
+		}
+
+		// The record is created in a zone the user chooses, since a domain
+		// can be in more than one hosted zone.
+		if f.Application.Domain != "" && f.Application.DomainZoneID == "" {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: domain_zone_id is required with domain"))
+		}
+
+		timeouts := []struct{ Key, Value string }{
+			{"build_timeout", f.Application.BuildTimeout},
+			{"deploy_timeout", f.Application.DeployTimeout},
+		}
+		for _, t := range timeouts {
+			if t.Value == "" {
+				continue
+			}
+			if d, err := time.ParseDuration(t.Value); err != nil || d <= 0 {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: %s must be a positive duration such as \"90m\"", t.Key))
+			}
+		}
+
+		if f.Application.UserData != "" || f.Application.UserDataFile != "" {
+			if f.Application.UserData != "" && f.Application.UserDataFile != "" {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: only one of user_data and user_data_file can be set"))
+			}
+			if userDataUnsupported[f.Application.Type] {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: user_data isn't supported by the %s app type",
+					f.Application.Type))
+			}
+		}
+
+		if f.Application.PostDeployRollback && f.Application.PostDeploy == "" {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: post_deploy is required with post_deploy_rollback"))
+		}
+
+		// A subnet belongs to a single VPC, so one is useless without
+		// the other.
+		if (f.Application.VPCID == "") != (f.Application.SubnetID == "") {
+			result = multierror.Append(result, fmt.Errorf(
+				"application: vpc_id and subnet_id must be set together"))
+		}
+
+		if hc := f.Application.HealthCheck; hc != nil {
+			if !strings.HasPrefix(hc.Path, "/") {
+				result = multierror.Append(result, fmt.Errorf(
+					"application: health_check path must start with '/'"))
+			}
+			if hc.Timeout != "" {
+				if _, err := time.ParseDuration(hc.Timeout); err != nil {
+					result = multierror.Append(result, fmt.Errorf(
+						"application: health_check timeout is invalid: %s", err))
+				}
+			}
+		}
+	}
+
+	// Validate the infrastructures
+	for _, infra := range f.Infrastructure {
+		for _, id := range infra.ShareAccounts {
+			if !accountIDRegexp.MatchString(id) {
+				result = multierror.Append(result, fmt.Errorf(
+					"infrastructure '%s': share_accounts '%s' must be a 12 digit account ID",
+					infra.Name, id))
+			}
+		}
+
+		// The network of the infrastructure is only in its own region
+		// and account, so a deploy elsewhere needs its own network.
+		if infra.DeployRegion != "" && f.Application != nil && f.Application.VPCID == "" {
+			result = multierror.Append(result, fmt.Errorf(
+				"infrastructure '%s': deploy_region requires vpc_id and subnet_id "+
+					"in the application", infra.Name))
+		}
+	}
+
+	// Validate the environments
+	for _, env := range f.Environments {
+		if env.Count < 0 {
+			result = multierror.Append(result, fmt.Errorf(
+				"environment '%s': count must be at least 1", env.Name))
+		}
 	}
 
 	// Validate the project
@@ -63,3 +213,24 @@ func (f *File) Validate() error {
 
 	return result
 }
+
+// validateTag validates a tag of the application.
+func validateTag(k, v string) error {
+	switch {
+	case k == "":
+		return fmt.Errorf("name can't be empty")
+	case k == "Name":
+		return fmt.Errorf("the Name tag is set by Otto")
+	case strings.HasPrefix(k, "aws:"):
+		return fmt.Errorf("names starting with 'aws:' are reserved")
+	case len(k) > 127:
+		return fmt.Errorf("name can't be longer than 127 characters")
+	case len(v) > 255:
+		return fmt.Errorf("value can't be longer than 255 characters")
+	case !tagRegexp.MatchString(k) || !tagRegexp.MatchString(v):
+		return fmt.Errorf(
+			"may only contain letters, numbers, spaces, and _.:/=+-@")
+	}
+
+	return nil
+}
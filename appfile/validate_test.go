@@ -54,6 +54,81 @@ func TestFileValidate(t *testing.T) {
 			"validate-project-unknown-infra",
 			true,
 		},
+
+		{
+			"validate-app-health-check",
+			false,
+		},
+
+		{
+			"validate-app-health-check-bad",
+			true,
+		},
+
+		{
+			"validate-app-build-env-bad",
+			true,
+		},
+
+		{
+			"validate-app-ports-bad",
+			true,
+		},
+
+		{
+			"validate-app-tags-bad",
+			true,
+		},
+
+		{
+			"validate-app-ssh-key-bad",
+			true,
+		},
+
+		{
+			"validate-app-spot-price-bad",
+			true,
+		},
+
+		{
+			"validate-app-domain-no-zone",
+			true,
+		},
+
+		{
+			"validate-app-vpc-no-subnet",
+			true,
+		},
+
+		{
+			"validate-app-timeout-bad",
+			true,
+		},
+
+		{
+			"validate-app-post-deploy-rollback",
+			true,
+		},
+
+		{
+			"validate-app-user-data-both",
+			true,
+		},
+
+		{
+			"validate-app-user-data-docker",
+			true,
+		},
+
+		{
+			"validate-infra-share-accounts-bad",
+			true,
+		},
+
+		{
+			"validate-infra-deploy-region-no-vpc",
+			true,
+		},
 	}
 
 	for _, tc := range cases {
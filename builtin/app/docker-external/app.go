@@ -89,6 +89,12 @@ func (a *App) Deploy(ctx *app.Context) error {
 	}).Route(ctx)
 }
 
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
 func (a *App) Dev(ctx *app.Context) error {
 	return vagrant.Dev(&vagrant.DevOptions{
 		Instructions: strings.TrimSpace(devInstructions),
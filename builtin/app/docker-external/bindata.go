@@ -93,7 +93,7 @@ func dataAwsSimpleDeployCloudInitShTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x56\x4d\x8f\xe4\x34\x10\xbd\xf7\xaf\x28\x65\x98\xd5\xac\x44\x27\xb3\xcd\x8a\x0b\x82\x0b\x08\x6e\x20\x71\xe1\x80\x56\x91\x13\x57\xba\xad\x71\x6c\xcb\x1f\x3d\xb4\x5a\xf9\xef\x94\xed\xce\x3a\x69\x7a\x97\x19\x69\x11\x99\xc3\x24\xaf\xca\x7e\x55\xf5\xca\xe5\xbe\x83\x5f\x50\xa1\x65\x1e\x39\x74\x27\xf8\xcd\x7b\xfd\x35\x70\x0d\x4a\x7b\x40\x2e\x3c\x8c\x4c\x05\x26\xe5\x69\xb3\x39\x32\x2b\x58\x27\x11\x2a\xa1\x06\xcb\x5a\xc1\x2b\x38\x4f\x0b\x98\x3d\xbb\x96\xf5\x3d\x3a\xd7\x3e\xe1\xe9\x86\xd1\x61\x6f\xd1\x7f\xc2\x68\x71\x2f\xb4\xba\x32\x90\x6b\xab\xd8\x88\x09\x5e\x2e\x18\x05\x41\xc0\x71\x60\x41\x7a\xf8\x3e\x21\xdb\xdd\xbb\x6f\xbf\x79\xe4\xef\xdf\x57\x30\xad\xa2\x75\x9e\xa9\x1e\x5b\x7f\x32\x78\xb5\xca\xef\xea\x51\xf4\x56\xaf\x57\xb8\xd0\x29\x8a\xd3\x84\x4e\x8a\xfe\x2a\xa2\xa3\xe9\xdb\x5e\x70\x7b\x03\xbe\x14\x64\x63\xac\x3e\x0a\x8e\x36\xe5\x45\xd0\x06\xa0\x94\x25\xb2\x7e\x75\xa6\x85\xf5\xba\x5c\x53\x45\x6e\xa5\x40\x6b\xb7\x82\x27\xb7\x5c\x2a\x88\xcf\xca\x2d\xe3\xe4\x42\x41\x58\x74\x3a\xd8\xbe\x54\x3e\x58\xe1\x4f\xed\xde\xea\x60\x2a\x02\x8d\xc9\x91\xc5\xea\xe6\x7d\xce\xe7\xfc\x31\x4d\xdb\xbc\xe5\x2c\x73\xe2\xcc\x09\x16\xbe\xfc\x4d\x26\xb2\x09\xb5\x27\x3a\x97\xf6\x03\xa0\xf4\xbd\xee\xb5\xcc\xe1\x6d\xdf\x25\x70\xb0\x7a\x6c\x8d\xb6\x3e\x81\x8f\x09\xf3\x7a\x46\x0a\x16\x4b\xdb\x76\x52\xf7\x4f\x8e\xb0\x3f\x17\x64\xd1\x32\x55\x1f\xc8\x6b\xfa\x37\xce\xca\xf7\xa6\xba\x41\xbb\xdb\xdd\xe0\xbd\x80\xd7\xc4\x8f\x75\xfa\x6b\x1e\x0b\x25\xfe\x67\x59\x5e\x93\x11\xdd\x1d\xfc\x84\x46\xea\x13\x30\xea\x0a\x0f\x7a\x80\xb9\x8f\xdd\x95\xb6\x33\xbe\x54\x95\x4e\x03\x94\xa7\x34\xc9\x28\x92\x98\xab\x23\x51\xcc\x2b\x38\x37\x64\x3e\x09\x24\xfc\x6a\x9f\xd5\x01\x49\x8e\xf3\x41\xbd\x22\x9c\xe1\xe4\x13\x1c\xda\x96\x33\xcf\x8a\xcf\x20\x24\x3e\xd0\x7f\xc3\xfc\xa1\x1e\x35\x0f\x12\xa7\xa6\x97\x3a\xf0\xad\x50\xc2\xd7\xee\x50\xbd\xcd\x3d\x16\x5b\x60\xdd\xc5\x14\xd5\xdc\x23\xff\x6c\xf1\x9a\x4a\x51\xc7\xfe\xfc\x10\x17\x7b\xb6\x9f\x75\xfb\x35\x06\xb9\xea\xf6\x6a\xd6\xb7\xd7\x4a\x61\xef\xe3\xd1\xca\xbe\x31\xe0\x65\x11\x43\x17\x94\x0f\xb9\xb3\x0e\xda\xf9\x75\x81\x1d\xca\xa1\xce\x25\x69\x85\x29\xdb\xde\xc1\x1f\x8c\x06\xe9\xa0\x2d\x94\xcc\xe0\x01\x95\x0b\x24\xe4\x47\x2d\x40\x38\x18\x02\x4d\x5a\xe8\xb4\x4e\xe3\x18\x69\x09\xc2\x48\xc3\x44\xed\x41\xab\xb7\x9b\xd4\x75\x47\xe1\x28\xc2\x38\x5d\x2c\x8e\xe4\xb8\xc5\xbf\xb0\xaf\x2e\x11\x0b\x25\x85\xc2\x54\x95\xe7\x03\xd5\x96\x04\xa4\x61\x6e\x9e\x84\x94\xb0\x7d\x5c\xf2\xef\x7e\x68\x38\x1e\x1b\x45\x8c\xdf\xc5\x81\xef\x24\xa2\x81\x5d\x7c\x57\x58\x7a\xfe\x7c\x9f\x02\xe7\xc2\xd2\xde\xf4\x1a\x14\x09\x48\xfc\x2d\x21\xae\xee\x82\x90\x1c\xee\xa7\x94\xe5\xcf\x1f\x8d\x40\xc5\xa5\x55\x52\x6b\x53\xff\x48\xa8\xa7\x68\xa7\x09\x1e\x92\xfb\x2b\xd3\x18\x9f\x22\xf7\xd6\x40\xe3\x47\xd3\x68\xba\x9f\x9a\x12\xc5\xf6\x26\x51\x89\x7e\xc5\x13\x7b\x6d\x26\xb8\x9c\x9f\xdc\x07\x91\x60\x9a\x9a\xac\x2b\x47\xe7\x85\xca\x69\x90\xf9\x15\xac\x37\x49\x3f\x9f\x5c\xcf\x5f\x9a\x16\x95\xef\xcd\x1b\xe8\x98\x3b\x40\xdd\x8c\x4c\xa8\x78\x34\x72\x9e\x49\x24\x54\x3c\xea\x74\xff\x02\xd1\x78\x9e\x2b\x2f\x56\x2d\xfb\x7f\x51\xd9\xf2\x96\xff\x93\x7a\x9f\x25\xff\x82\x22\x7e\x92\xe7\x55\x5a\xde\xc1\xef\xc4\x7e\x44\x60\xea\x04\x1e\x47\xba\x4b\x98\x3d\xc5\xac\x69\x54\x69\x2b\x68\x82\x3c\xd3\x90\x60\x1c\xd3\xed\xb3\x50\xdb\xc1\x83\x18\xe2\xb2\x57\x4a\x67\x47\xd8\xda\xa1\xe4\x54\xee\x24\x1d\xbc\x09\x9e\x7e\x51\x5d\x6e\x99\x23\x93\xe1\x72\x7d\x2c\x2f\xa2\x34\x7b\x97\x83\x70\xda\xfc\x1d\x00\x00\xff\xff\xde\x51\x4c\xe4\x67\x0a\x00\x00"
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc4\x56\x4d\x6f\xdc\x36\x13\xbe\xeb\x57\x0c\xe4\x38\x70\x5e\xbc\xab\xdd\x38\x69\x0f\x2d\x5c\xa0\x68\xd1\x1e\x0a\xb4\x45\x0f\xed\xa1\x30\x04\xae\x38\xb2\x07\x4b\x71\x54\x7e\x6c\xb2\x51\xf5\xdf\x0b\x92\x92\xf5\x91\xcd\x87\x01\x17\xb5\x2f\xab\x87\xc3\x79\x86\xf3\xcc\x70\x78\x01\x3f\xa2\x46\x23\x1c\x4a\xd8\x9f\xe0\x17\xe7\xf8\xff\x20\x19\x34\x3b\x40\x49\x0e\x1a\xa1\xbd\x50\xea\x94\x65\x47\x61\x48\xec\x15\x42\x4e\xba\x36\xa2\x24\x99\x43\xd7\xcf\x60\xf1\xc6\x96\xa2\xaa\xd0\xda\xf2\x80\xa7\x1c\x3a\x90\x58\x0b\xaf\x1c\xdc\x40\x9e\xc3\xda\xd4\x62\x65\xd0\x7d\x96\xa9\xe3\x03\xea\x4f\x5a\x19\xbc\x23\xd6\xab\xa0\x0e\x78\x2a\xb5\x68\x30\xc2\xf3\x0d\x0d\xad\x1c\x8a\x86\x36\xd7\x2f\xbf\x7c\xb5\x93\xaf\x5f\x2f\x9d\x93\xb6\x4e\xe8\x0a\x4b\x77\x6a\x71\xb5\xab\xeb\x60\xb1\xfc\xf7\xb0\xf6\x55\xee\xae\x8b\x86\x2a\xc3\x39\xf4\xfd\x07\xfc\x55\xec\xb5\x5b\x39\x7c\xb9\xb4\x45\x7d\x24\xc3\xba\x41\xed\x4a\xeb\xeb\x9a\xde\x7e\x34\x0f\xd6\xef\x35\xba\xb2\xf5\x7b\x45\xd5\x2a\x15\xc7\xb6\x2a\x2b\x92\xe6\x0c\x3c\x68\x99\xb5\x86\x8f\x24\xd1\xc4\x84\xe6\xd0\x65\x00\x93\xa2\x81\xed\x59\x77\x14\xa6\x58\x2a\xdd\xe7\x19\xc0\xa4\xe6\xd2\x6c\xc2\xa3\x59\x54\x72\x69\x11\xa1\xb8\x98\x04\x84\xf0\xb7\xb0\x48\x78\x9f\x67\x7d\x96\x19\xb4\xec\x4d\x35\xd5\x90\x37\xe4\x4e\xe5\x9d\x61\xdf\xe6\x90\x8b\xb6\x4d\x61\x07\xcd\x93\x9f\xae\x4b\x1f\x7d\xbf\x49\x2e\xc7\xf2\xed\xd3\xe7\xfb\x19\x8e\xc1\xa4\xb4\x4c\x81\xa4\xef\x3e\xcf\x32\x00\xd2\x77\x06\xad\x8d\x44\x00\xad\x61\xc7\x15\xab\x14\xf7\xe6\x65\x04\x6b\xc3\x4d\xd9\xb2\x71\x11\xdc\x45\xcc\xf1\x88\x4c\x58\x10\xa4\xdc\x2b\xae\x0e\x16\x6e\xe0\xcf\x19\x59\x58\xe9\xf3\xdb\x0c\xa0\xff\x14\x67\xee\xaa\x36\x3f\x43\x7b\x7d\x7d\x86\x77\x00\xd7\xc4\xbb\x22\xfe\x6f\x77\x13\x25\xfe\x6b\xa7\x3c\x43\xd6\x5d\x02\xd5\xe0\xc4\x9d\x85\xcb\x3e\x83\xf4\x2b\x51\x77\x97\x50\xb3\x01\x07\xa4\x47\x83\xa0\xaa\x2b\x7e\xc2\x53\x6c\xae\xa4\xb2\x2b\x7e\x17\xca\x07\xa1\xf3\x71\x1b\x6a\x19\x76\x46\x87\x7d\x36\x42\x54\x07\xa4\xcf\xb2\x0b\xf8\x1e\x5b\xc5\x27\x10\x60\xd1\x01\xd7\x0f\xbd\x6c\x57\x85\x36\xe2\xf3\x12\x8b\xdd\x0b\xe3\xdf\x43\xa1\x2c\xbb\x3b\xc6\x22\x1a\x02\x78\xdf\x52\x34\x14\x97\x17\x17\xc8\x19\x47\x01\x4e\x4d\x96\xba\x9b\xe4\xd2\xcf\xa2\xe9\xa3\xe1\x78\xeb\xad\x08\x47\x38\xda\x78\x8b\xa6\x94\xc2\x89\xc9\xa6\x26\x85\x57\xf9\xb3\xae\x15\xee\xbe\x68\x58\x7a\x85\xfd\xb6\x52\xec\xe5\x86\x34\xb9\xc2\xde\xe7\x2f\x52\x07\x84\x02\x5d\x36\x5f\x49\x72\xac\xe0\xf7\x3b\xb3\x10\x6d\x5b\x84\xee\xb9\x9d\xa4\x3e\xb2\xf2\x0d\x96\x96\xde\x61\x12\xc8\x30\xbb\x54\x24\xa5\xc4\x23\x55\x38\xc8\x3f\x37\x4c\x4a\xcf\x91\xa4\xf6\x5a\xdc\x65\x01\xfd\x2c\x9a\x71\xeb\x70\x15\xe4\x4f\x5a\x58\xb1\x18\xb4\xc6\xca\x11\xeb\x81\x33\xa4\x77\x2e\xb9\xdf\x7b\xed\x7c\x72\x70\xcf\x76\x55\x38\x16\x55\x5d\x24\x01\x4b\x6a\x87\x23\x65\x00\x17\xf0\x87\x20\x17\xa3\x9c\x74\x80\x2b\xd4\xd6\x1b\xb4\x0f\x95\x03\x64\xa1\xf6\x4a\x9d\x60\xcf\x1c\x67\x39\xd6\x6c\x10\x1a\x3e\x92\xbe\x03\xd6\x2f\xb2\xd8\xc1\x47\xb2\xc4\x1a\x0d\xe4\x06\x1b\x76\xb8\xc1\xb7\x58\xe5\x43\xc4\xa4\x15\x69\x8c\x1a\xbe\xb9\x27\x85\x60\xbd\x64\x68\x0f\xa4\x14\x6c\x76\x73\xfe\xeb\x6f\xb6\x12\x8f\x5b\xed\x95\xfa\x1a\x24\x83\x55\x88\x2d\x5c\x87\xdf\x1a\x17\x2d\x1d\x02\x97\x64\x80\x34\xd4\xec\xb5\x14\x21\x43\xa5\x24\x63\x8b\xbd\x27\x25\x53\x06\x2f\xe0\x87\x87\x45\xe8\xba\xb0\x4b\x31\xb7\xc5\x77\xa1\x83\xd0\x40\xdf\xc3\x55\x34\x7f\xe4\x31\x9a\x43\xe0\xde\xb4\xb0\x75\x4d\xbb\x65\xe7\x78\x3b\x45\xb1\x39\x4b\x34\x45\xbf\xe0\x09\x9d\x31\x12\x0c\xf7\x42\xaa\x8d\x40\xd0\xf7\xdb\xa4\xab\x44\xeb\x48\xa7\x63\xdc\x40\xfe\x08\xd6\xb3\xa4\x1f\x3f\x5c\x25\x3f\xf7\x58\x7d\x0f\xcf\x9f\xc3\x5e\xd8\x7b\x28\xb6\x8d\x20\x1d\x1a\xf9\x76\xd1\x35\x43\x31\x7f\x52\x34\x99\xee\xcb\xcf\x56\x2d\xd9\x3f\xa9\x6c\xc9\xe5\x7f\xa4\xde\x47\xc9\x9f\x50\xc4\x0f\xf2\x3c\x4a\xcb\x0b\xf8\x0d\x1b\x3e\x22\x08\x7d\x02\x87\x4d\xcb\x46\x98\x53\x38\x35\x56\x8e\x0d\xa1\x85\x37\x08\x8d\x90\x18\x27\xf9\x4c\x6d\x0b\x57\x54\x87\x6d\x8f\x94\xce\x34\xb0\x31\xf5\x74\xa6\x21\xb4\x3e\xcb\xd8\xbb\xd6\x3b\xc8\x69\x98\x9e\xc7\x78\xa5\xde\xc0\x30\x2f\xc6\x9b\x2c\x4e\x8a\xdd\xe2\x2a\xec\xb3\x2c\x4d\x0c\xc9\xe1\xc0\x70\x39\x7f\xbd\x26\x6c\xf5\xa4\x4d\x60\xf9\x8e\x35\x3e\x3c\x6d\x2f\xe0\x57\x26\xed\xc0\xdd\xe3\xe8\x88\xeb\xf8\x25\xda\x56\x51\x95\x74\x17\xc9\xe0\x43\xcf\x00\xc3\xde\xe1\x17\xaf\x4a\x83\x15\x1b\x99\xcf\xe8\x33\x80\x81\x6e\x1a\xb7\xcb\x30\x62\x7d\x8c\x43\x79\x65\x13\xd7\xe2\xf4\x4f\x6b\xdf\xc6\x6f\xa7\xc6\x01\xf1\x6a\xb7\x4b\x0f\xe4\x40\x3b\x1f\xb3\x8b\xb4\xfd\x6f\x9e\xb6\xdb\x79\xd2\xe7\x61\xae\x12\xbf\x3c\xd2\x10\x4f\x51\xff\x25\xd3\x83\x7b\x3e\x54\xff\x19\x00\x0c\x4f\xff\xbd\x2d\x0e\x00\x00"
 
 func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +133,7 @@ func dataAwsVpcPublicPrivateDeployCloudInitShTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x56\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x0c\x94\x66\x91\x05\x2a\xc9\x9b\x2e\x7a\x29\xda\x4b\x8b\xf6\xd6\x02\xbd\xf4\x50\x2c\x04\x4a\x1c\xd9\x44\x28\x92\xe0\x87\x53\xc3\xd0\x7f\xef\x90\xb4\x22\xc9\x49\x16\x31\x90\xa2\x0e\xe2\x48\x6f\x86\x7c\x33\xf3\x86\xc3\xdc\xc0\x6f\xa8\xd0\x32\x8f\x1c\xda\x23\xfc\xe1\xbd\xfe\x16\xb8\x06\xa5\x3d\x20\x17\x1e\x06\xa6\x02\x93\xf2\xb8\xd9\x1c\x98\x15\xac\x95\x08\x85\x50\xbd\x65\x8d\xe0\x05\x9c\xc6\x05\xcc\x1e\x5d\xc3\xba\x0e\x9d\x6b\x1e\xf0\xf8\x82\xd1\x61\x67\xd1\xbf\x62\xb4\xb8\x13\x5a\x5d\x18\xc8\xb5\x51\x6c\xc0\x04\x2f\x17\x0c\x82\x20\xe0\xd8\xb3\x20\x3d\xfc\x98\x90\xf2\xfe\xd3\xf7\xdf\x6d\xf9\xe7\xcf\x05\x8c\xab\x68\x9d\x67\xaa\xc3\xc6\x1f\x0d\x5e\xac\xf2\xf7\xd5\x20\x3a\xab\xd7\x2b\x8c\x15\x07\xaa\x48\xe3\x42\xab\x28\xde\x67\x89\x9a\xd0\x4a\xd1\xbd\x6a\x3e\x98\xae\xe9\x04\xb7\x2f\xc0\x67\xdf\x05\xda\x32\xe7\x29\xef\x66\xaf\x9d\xbf\x58\x30\x99\x82\xc3\xbc\xd7\xc6\x58\x7d\x10\x1c\x6d\xaa\x18\x41\x1b\x80\xb9\xe0\x31\x9f\x6f\x4e\xb4\xbc\x5a\x0b\x31\x16\xe4\x36\x97\x7e\xed\x36\xe3\xc9\x2d\x8b\x00\xf1\xb3\x72\xcb\x38\xb9\x50\x10\x16\x9d\x0e\xb6\x9b\x35\x0d\x56\xf8\x63\xb3\xb3\x3a\x98\x82\x40\x63\x72\x64\x51\xb7\xbc\xcf\xe9\x94\x5f\xc6\xb1\xcc\x5b\x4e\x0d\x94\x38\x73\x5d\x66\xbe\xfc\x4e\x26\xb2\x09\xb5\x23\x3a\x97\xf6\x03\xa0\xf4\xbd\xee\xb4\xcc\xe1\x95\x9f\x12\xd8\x5b\x3d\x34\x46\x5b\x9f\xc0\x6d\xc2\xbc\x9e\x90\x19\x8b\x8a\x34\xad\xd4\xdd\x83\x23\xec\xef\x05\x59\xb4\x8c\xc5\x17\xf2\x1a\xe9\x17\xff\x33\xc6\x6d\x95\x7e\xea\xed\x99\x8b\x6a\x79\x03\xbf\xa0\x91\xfa\x08\x8c\x14\xf2\xa0\x7b\x98\xba\xd5\x5d\xd4\x79\xc2\x97\x15\xa6\x9e\x87\xf9\x33\x0b\x36\x88\x54\xd8\x55\xe3\xcf\xe6\x15\x9c\x9b\x63\x6a\xe4\xf5\x3e\xcf\x8e\x41\x72\x9e\x8e\xe4\x05\xe9\x04\x27\x9f\xd8\xb1\x0d\x67\x9e\xcd\x3e\xbd\x90\x78\x47\x7f\x0d\xf3\xfb\x6a\xd0\x3c\x48\x1c\xeb\x4e\xea\xc0\x4b\xa1\x84\xaf\xdc\xbe\xf8\x98\x35\x8f\x92\xac\xbb\x8a\xa8\x27\xcd\x9e\xb7\x5c\x45\xe5\xa8\x62\x6c\x5f\xe2\x62\xcf\x76\x93\x76\xbf\xc7\x20\x57\xdd\x57\xa4\xb2\xd3\x57\xa7\x95\xc2\x2e\x1e\xae\xb3\x6f\x0c\x78\x59\xc8\xd0\x06\xe5\x43\x91\x6c\xf1\x64\xae\x8b\xec\x50\xf6\x4f\xd5\x11\x66\xcc\x7e\xcb\x93\x3c\xd7\x65\x89\x5e\x38\x26\xd2\x67\x8e\x11\x9d\x23\xbd\x81\xbf\x18\x4d\xe1\x5e\x5b\x98\x8b\x05\x77\xa8\x5c\xa0\xfe\x78\x92\x18\x84\x83\x3e\xd0\x98\x86\x56\xeb\x34\xcb\x91\x96\x20\x0c\x34\x2f\xd4\x0e\xb4\xfa\xb8\x49\xcd\x7c\x10\x8e\x38\xe2\x00\xb1\x38\x90\x63\x89\xff\x60\x57\x9c\x8b\x20\x94\x14\x0a\x53\xa1\x1f\xf7\x24\x17\xf5\x05\xdd\x04\xe6\x41\x48\x09\xe5\x76\xc9\x7f\xff\x53\xcd\xf1\x50\x2b\x62\xfc\x21\xde\x16\x4e\x22\x1a\xb8\x8f\xcf\x0a\xcf\xdd\x4d\x5f\xa7\xdb\x14\x38\x17\x96\xf6\xa6\xc7\xa0\xa8\x27\x62\x8e\x84\xb8\xaa\x0d\x42\x72\xb8\x1d\x53\x96\xbf\x3e\x19\x81\xf4\xa2\x55\x52\x6b\x53\xfd\x4c\xa8\xa7\x68\xc7\x11\xee\x92\xfb\x95\x69\x0c\x0f\x91\xbb\x34\x50\xfb\xc1\xd4\x9a\x2e\xb7\x7a\x8e\xa2\x7c\x91\x68\x8e\x7e\xc5\x13\xdb\x77\x22\x38\x1f\xcb\xdc\x5a\x91\x60\x1c\xeb\xac\x2c\x47\xd2\x50\xe5\x34\xc8\x7c\x05\xeb\x8b\xa4\x5f\x4f\xae\xe3\x6f\x4d\x8b\xca\xf7\xe1\x43\x6c\xbb\x3d\x54\xf5\xc0\x84\x8a\xa7\x6d\x9a\x77\x24\x12\x2a\x1e\x75\xba\x7d\x83\x68\x3c\x8f\xab\x37\xab\x96\xfd\xdf\x55\xb6\xbc\xe5\xff\xa4\xde\x57\xc9\xdf\x51\xc4\x57\x79\xae\xd2\xf2\x06\xfe\x24\xf6\x03\x02\x53\x47\xf0\x38\xd0\x15\xc5\xec\x31\x66\x4d\xd3\x4f\x5b\x41\x13\xe4\x91\x86\x04\xe3\x98\x2e\xb5\x85\xda\x0e\xee\x44\x1f\x97\x5d\x29\x9d\x1d\xa0\xb4\xfd\x9c\xd3\x7c\xd5\xe9\xe0\x4d\xf0\xf4\xef\xd8\xf9\xf2\x3a\x30\x19\xce\xb7\xd2\xf2\x7e\x4b\xe3\x7c\x35\x5b\xc7\xcd\xbf\x01\x00\x00\xff\xff\x54\xe8\x6e\x8c\xa5\x0a\x00\x00"
+var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc4\x56\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x0c\x94\xdd\x45\x16\xa8\x65\x6f\xba\xe8\xa1\x45\x7a\x69\xd1\x1e\x0a\xb4\x40\x0f\xed\xa1\x58\x08\xb4\x38\x4a\x06\xa6\x38\x02\x3f\x94\x75\x55\xfd\xf7\x82\xa4\x14\x49\x76\x92\x4d\x80\x14\xb5\x2f\xd6\xe3\x70\xde\x90\xef\xcd\xc8\x17\xf0\x33\x6a\x34\xc2\xa1\x84\xfd\x11\x7e\x73\x8e\xbf\x02\xc9\xa0\xd9\x01\x4a\x72\xd0\x08\xed\x85\x52\xc7\x2c\xeb\x84\x21\xb1\x57\x08\x39\xe9\xda\x88\x92\x64\x0e\xfd\xb0\x80\xc5\x9d\x2d\x45\x55\xa1\xb5\xe5\x01\x8f\x39\xf4\x20\xb1\x16\x5e\x39\xb8\x86\x3c\x87\xd3\x50\x8b\x95\x41\xf7\xac\x50\xc7\x07\xd4\x5f\x8c\x32\x78\x43\xac\x4f\x8a\x3a\xe0\xb1\xd4\xa2\xc1\x08\x2f\x37\x34\x74\x92\x50\x34\xb4\xb9\xfa\xf0\xcd\xd7\x3b\xf9\xf1\xe3\x3a\x39\x69\xeb\x84\xae\xb0\x74\xc7\x16\x4f\x76\xf5\x3d\xac\x96\xff\x19\xd7\xbe\xcd\xdd\x55\xd1\x50\x65\x38\x87\x61\x78\x24\x5f\xc5\x5e\xbb\x93\x84\x1f\xd6\xb1\xa8\x3b\x32\xac\x1b\xd4\xae\xb4\xbe\xae\xe9\xf3\x93\xf7\xd0\x1a\xea\x84\xc3\xd2\xfa\xbd\x46\x77\xae\x51\xeb\xf7\x8a\xaa\x47\x97\xbb\xb6\x2a\x2b\x92\xe6\x01\x78\x8c\x5d\xa0\x7b\x61\x1d\xb1\x2e\x6f\xd9\xba\x93\x0d\xd3\x92\xb7\x98\x72\x65\xad\xe1\x8e\x24\x9a\x28\x55\x0e\x7d\x06\x30\x7b\x25\x9c\xe3\x4d\xdf\x09\x53\xac\x3d\x34\xe4\x19\xc0\xec\x93\x75\xd8\x8c\xc7\xb0\xe8\x91\x75\x44\x84\xe2\x62\xb2\x06\x84\xcf\x2a\x22\xe1\x43\x9e\x0d\x59\x66\xd0\xb2\x37\xd5\xec\x4e\x6f\xc8\x1d\xcb\x1b\xc3\xbe\xcd\x21\x17\x6d\x9b\xca\x0e\x6e\x4a\x79\xfa\x3e\x3d\x0c\xc3\x26\xa5\x9c\x1a\x63\x48\x8f\xe7\xda\xc5\x62\xd2\x6d\xce\x85\xa4\xe7\x21\xcf\x32\x00\xd2\x37\x06\xad\x8d\x44\x00\xad\x61\xc7\x15\xab\x54\xf7\xe6\x43\x04\x6b\xc3\x4d\xd9\xb2\x71\x11\xdc\x45\xcc\xf1\x84\xcc\x58\xd0\xb1\xdc\x2b\xae\x0e\x16\xae\xe1\xaf\x05\x59\x58\x19\xf2\x4f\x19\xc0\x90\x01\xe0\x7f\xc6\xb8\x2b\xe2\x77\xbb\x1b\xb9\x32\x80\xfe\x2d\x50\x0d\x4e\xdc\x58\x78\x1b\xc8\xe3\xaf\x44\xdd\xbf\x85\x9a\x0d\x38\x20\x3d\x05\x84\x1b\x76\xc5\x2f\x78\x8c\x2d\x94\x6e\xdc\x15\x7f\x08\xe5\xc3\xa5\xe7\xd3\x36\xd4\x32\xec\x8c\x09\x87\x6c\x82\xa8\x0e\xc8\x90\x65\x17\xf0\x23\xb6\x8a\x8f\x20\xc0\xa2\x03\xae\xef\x3b\xd6\x9e\x88\x3e\xe1\x4b\xb9\x63\x8f\xc2\xf4\xb9\x17\x6d\xdd\xc3\xb1\x16\xd1\x10\xc0\x79\xa4\x68\x28\x2e\xaf\xc6\xc4\x03\x89\x02\x9c\x0c\x3f\x35\xe7\x3a\xcf\x59\x6b\xc7\xe0\x69\xbe\x9d\x90\x4e\x70\x8c\x09\x5d\x58\x4a\xe1\xc4\x1c\x53\x93\xc2\xcb\xfc\x4d\xdf\x0a\x77\x5b\x34\x2c\xbd\xc2\x61\x5b\x29\xf6\x72\x43\x9a\x5c\x61\x6f\xf3\xf7\xc9\x91\xc1\x30\xeb\x66\x28\x49\x4e\x8e\x3a\xef\x94\x42\xb4\x6d\x11\x6a\xfb\x34\xcb\xdd\xb1\xf2\x0d\x96\x96\xfe\xc6\x24\x92\x61\x76\xc9\x28\xa5\xc4\x8e\x2a\x1c\x2d\xb0\x0c\x4c\x6a\x2f\x91\xa4\xf8\xa9\xc0\x6b\x13\xfd\x2a\x9a\x69\xeb\xd8\x9a\xf9\xab\x9a\x2b\x1a\x42\x6b\xac\xc2\x78\x1b\x39\xc3\xf5\x2e\x65\xf7\x7b\xaf\x9d\x4f\x09\xc2\x6c\x5c\x5b\xc2\xa2\xaa\xef\xb5\xa4\x76\x24\x5a\xce\xd2\x59\xc5\x25\x7a\x12\x18\x49\xcf\x02\x03\x3a\xde\x52\x06\x70\x01\x7f\x0a\x72\xf1\xe0\xb3\xb4\x70\x89\xda\x7a\x83\xf6\xde\x90\x40\x16\x6a\xaf\xd4\x11\xf6\xcc\xf1\x8f\x00\xd6\x6c\x10\x1a\xee\x48\xdf\x00\xeb\xf7\x59\x1c\x0c\x1d\x59\x62\x8d\x06\x72\x83\x0d\x3b\xdc\xe0\x67\xac\xf2\xf1\x12\x48\x2b\xd2\x18\x6d\x71\x77\x4b\x0a\xc1\x7a\xc9\xd0\x1e\x48\x29\xd8\xec\x96\xfc\x57\xdf\x6f\x25\x76\x5b\xed\x95\xfa\x0e\x24\x83\x55\x88\x2d\x5c\x85\xdf\x1a\x57\x93\x22\x14\x2e\xc9\x00\x69\xa8\xd9\x6b\x29\xe2\x19\x25\x19\x5b\xec\x3d\x29\x99\x44\xb9\x80\x9f\xee\x17\xa1\xef\xc3\x2e\xc5\xdc\x16\x3f\x84\xc6\x44\x03\xc3\x00\x97\x31\xfc\x85\xc7\x68\x0e\x81\x7b\xd3\xc2\xd6\x35\xed\x96\x9d\xe3\xed\x5c\xc5\xe6\x41\xa2\xb9\xfa\x15\x4f\x68\xb6\x89\x60\x1c\x37\xc9\x6e\x81\x60\x18\xb6\x49\x59\x89\xd6\x91\x4e\xc7\xb8\x86\xfc\x05\xac\x0f\x92\x3e\x7d\xb8\x4a\x3e\xf7\x58\xc3\x00\xef\xde\x05\xdb\xdd\x42\xb1\x6d\x04\xe9\x30\x1b\x3e\xad\x1a\x71\xec\x8f\x2f\x8a\x26\xd3\x18\x7e\xb6\x6a\x29\xfe\x55\x65\x4b\x29\xff\x27\xf5\x9e\x24\x7f\x45\x11\x1f\xe5\x79\x91\x96\x17\xf0\x3b\x36\xdc\x21\x08\x7d\x04\x87\x4d\xcb\x46\x98\x63\x38\x35\x56\x8e\x0d\xa1\x85\x3b\x84\x46\x48\x8c\x7f\x10\x16\x6a\x5b\xb8\xa4\x3a\x6c\x7b\xa1\x74\xa6\x81\x8d\xa9\xe7\x33\x8d\xa5\x0d\x59\xc6\xde\xb5\xde\x41\x4e\xe3\x4b\xb9\x8b\x53\xfa\x1a\xc6\x57\xd0\x34\xc9\xe2\xcb\x67\xb7\x9e\xae\x43\xf6\xef\x00\x51\x04\x64\x32\xe4\x0c\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1,13 +1,16 @@
 # Generated by Otto, do not edit manually
 
 variable "infra_id" {}
-variable "aws_access_key" {}
-variable "aws_secret_key" {}
+variable "aws_access_key" { default = "" }
+variable "aws_secret_key" { default = "" }
+variable "aws_token" { default = "" }
 variable "aws_region" {}
 variable "key_name" {}
 
 variable "ami" { default = "ami-21630d44" }
-variable "instance_type" { default = "t2.micro" }
+variable "instance_type" { default = "{{ instance_type|default:"t2.micro" }}" }
+variable "instance_count" { default = "1" }
+variable "environment_suffix" { default = "" }
 variable "subnet_public" {}
 variable "vpc_cidr" {}
 variable "vpc_id" {}
@@ -15,11 +18,12 @@ variable "vpc_id" {}
 provider "aws" {
   access_key = "${var.aws_access_key}"
   secret_key = "${var.aws_secret_key}"
+  token = "${var.aws_token}"
   region     = "${var.aws_region}"
 }
 
 resource "aws_security_group" "app" {
-  name   = "{{ name }}-${var.infra_id}"
+  name   = "{{ name }}-${var.infra_id}${var.environment_suffix}"
   vpc_id = "${var.vpc_id}"
 
   ingress {
@@ -42,10 +46,18 @@ resource "aws_security_group" "app" {
     to_port     = 0
     cidr_blocks = ["0.0.0.0/0"]
   }
+
+  {% if tags %}
+  tags {
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
+  }
+  {% endif %}
 }
 
 # Deploy a set of instances
 resource "aws_instance" "app" {
+  count         = "${var.instance_count}"
   ami           = "${var.ami}"
   instance_type = "${var.instance_type}"
   subnet_id     = "${var.subnet_public}"
@@ -54,8 +66,16 @@ resource "aws_instance" "app" {
 
   vpc_security_group_ids = ["${aws_security_group.app.id}"]
 
+  {% if volume_size %}
+  root_block_device {
+    volume_size = "{{ volume_size }}"
+  }
+  {% endif %}
+
   tags {
     Name = "{{ name }}"
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
   }
 
   connection {
@@ -107,5 +127,23 @@ resource "aws_instance" "app" {
 }
 
 output "ip" {
-  value = "${aws_instance.app.public_ip}"
+  value = "${aws_instance.app.0.public_ip}"
+}
+
+{% if domain %}
+variable "domain" {}
+variable "domain_zone_id" {}
+
+# Point the domain of the application at the instances
+resource "aws_route53_record" "domain" {
+  zone_id = "${var.domain_zone_id}"
+  name    = "${var.domain}"
+  type    = "A"
+  ttl     = "300"
+  records = ["${aws_instance.app.*.public_ip}"]
+}
+
+output "domain" {
+  value = "${aws_route53_record.domain.fqdn}"
 }
+{% endif %}
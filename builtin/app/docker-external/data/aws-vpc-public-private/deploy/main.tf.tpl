@@ -1,13 +1,16 @@
 # Generated by Otto, do not edit manually
 
 variable "infra_id" {}
-variable "aws_access_key" {}
-variable "aws_secret_key" {}
+variable "aws_access_key" { default = "" }
+variable "aws_secret_key" { default = "" }
+variable "aws_token" { default = "" }
 variable "aws_region" {}
 variable "key_name" {}
 
 variable "ami" { default = "ami-21630d44" }
-variable "instance_type" { default = "t2.micro" }
+variable "instance_type" { default = "{{ instance_type|default:"t2.micro" }}" }
+variable "instance_count" { default = "1" }
+variable "environment_suffix" { default = "" }
 variable "private_subnet_id" {}
 variable "public_subnet_id" {}
 variable "vpc_cidr" {}
@@ -19,11 +22,12 @@ variable "bastion_user" {}
 provider "aws" {
   access_key = "${var.aws_access_key}"
   secret_key = "${var.aws_secret_key}"
+  token = "${var.aws_token}"
   region     = "${var.aws_region}"
 }
 
 resource "aws_security_group" "app" {
-  name   = "{{ name }}-${var.infra_id}"
+  name   = "{{ name }}-${var.infra_id}${var.environment_suffix}"
   vpc_id = "${var.vpc_id}"
 
   ingress {
@@ -38,10 +42,18 @@ resource "aws_security_group" "app" {
     to_port     = 0
     cidr_blocks = ["0.0.0.0/0"]
   }
+
+  {% if tags %}
+  tags {
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
+  }
+  {% endif %}
 }
 
 # Deploy a set of instances
 resource "aws_instance" "app" {
+  count         = "${var.instance_count}"
   ami           = "${var.ami}"
   instance_type = "${var.instance_type}"
   subnet_id     = "${var.private_subnet_id}"
@@ -50,8 +62,16 @@ resource "aws_instance" "app" {
 
   vpc_security_group_ids = ["${aws_security_group.app.id}"]
 
+  {% if volume_size %}
+  root_block_device {
+    volume_size = "{{ volume_size }}"
+  }
+  {% endif %}
+
   tags {
     Name = "{{ name }}"
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
   }
 
   connection {
@@ -105,5 +125,5 @@ resource "aws_instance" "app" {
 }
 
 output "ip" {
-  value = "${aws_instance.app.private_ip}"
+  value = "${aws_instance.app.0.private_ip}"
 }
@@ -0,0 +1,151 @@
+package dockerapp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/directory"
+	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/helper/compile"
+	"github.com/hashicorp/otto/helper/packer"
+	"github.com/hashicorp/otto/helper/schema"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/helper/vagrant"
+)
+
+//go:generate go-bindata -pkg=dockerapp -nomemcopy -nometadata ./data/...
+
+// App is an implementation of app.App
+type App struct{}
+
+func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
+	var opts compile.AppOptions
+	custom := &customizations{Opts: &opts}
+	opts = compile.AppOptions{
+		Ctx: ctx,
+		Bindata: &bindata.Data{
+			Asset:    Asset,
+			AssetDir: AssetDir,
+			Context:  map[string]interface{}{},
+		},
+		Customizations: []*compile.Customization{
+			&compile.Customization{
+				Type:     "docker",
+				Callback: custom.processDocker,
+				Schema: map[string]*schema.FieldSchema{
+					"image": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "",
+						Description: "Repository to push the built image to",
+					},
+
+					"base_image": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "ubuntu:14.04",
+						Description: "Image to build the app image from",
+					},
+
+					"run_command": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "",
+						Description: "Command to run in the container",
+					},
+
+					"run_args": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "",
+						Description: "Args to pass to `docker run`",
+					},
+				},
+			},
+		},
+	}
+
+	return compile.App(&opts)
+}
+
+func (a *App) Build(ctx *app.Context) error {
+	// The image is built with Docker and pushed to a registry, so the
+	// artifact is an image reference rather than a per-region AMI.
+	return packer.Build(ctx, &packer.BuildOptions{
+		ArtifactParser: packer.ParseArtifactDocker,
+		ProgressPhases: packer.ProgressPhasesDocker,
+		InfraOutputMap: map[string]string{
+			"region": "aws_region",
+		},
+	})
+}
+
+func (a *App) Deploy(ctx *app.Context) error {
+	return terraform.Deploy(&terraform.DeployOptions{
+		ArtifactExtractors: map[string]terraform.DeployArtifactExtractor{
+			"aws": deployArtifactExtract,
+		},
+		InfraOutputMap: map[string]string{
+			"region":         "aws_region",
+			"subnet-private": "private_subnet_id",
+			"subnet-public":  "public_subnet_id",
+		},
+	}).Route(ctx)
+}
+
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and Terraform configuration.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	return terraform.Verify(ctx, &terraform.DeployOptions{})
+}
+
+func (a *App) Dev(ctx *app.Context) error {
+	return vagrant.Dev(&vagrant.DevOptions{
+		Instructions: strings.TrimSpace(devInstructions),
+	}).Route(ctx)
+}
+
+func (a *App) DevDep(dst, src *app.Context) (*app.DevDep, error) {
+	// Nothing needs to be done for this
+	return nil, nil
+}
+
+// deployArtifactExtract turns the image that was pushed by the build
+// into the variables for the deploy.
+func deployArtifactExtract(
+	ctx *app.Context,
+	build *directory.Build,
+	infra *directory.Infra) (map[string]string, error) {
+	image, ok := build.Artifact["image"]
+	if !ok {
+		return nil, fmt.Errorf(
+			"A Docker image could not be found for this build. Please run\n" +
+				"`otto build` and try again.")
+	}
+
+	return map[string]string{
+		"docker_image": image,
+	}, nil
+}
+
+const devInstructions = `
+A development environment has been created for writing a Docker-based app.
+
+Docker is pre-installed. To work on your project, edit files locally on your
+own machine. The file changes will be synced to the development environment.
+
+When you're ready to run your project, run 'otto dev ssh' to enter the
+development environment. You'll be placed directly into the working
+directory where you can run "docker build", "docker run", etc.
+
+When you run 'otto build', the app is installed into an image which is
+pushed to the registry for the configured image name. Make sure the
+Docker client on this machine is logged in to that registry.
+`
@@ -0,0 +1,13 @@
+package dockerapp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+)
+
+func TestApp_impl(t *testing.T) {
+	var _ app.App = new(App)
+	var _ app.DeployReporter = new(App)
+	var _ app.AppVerify = new(App)
+}
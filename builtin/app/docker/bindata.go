@@ -0,0 +1,320 @@
+// Code generated by go-bindata.
+// sources:
+// data/aws-simple/build/template.json.tpl
+// data/aws-simple/deploy/cloud-init.sh.tpl
+// data/aws-simple/deploy/main.tf.tpl
+// data/common/dev/Vagrantfile.tpl
+// DO NOT EDIT!
+
+package dockerapp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x92\x4d\x8e\xdb\x30\x0c\x85\xf7\x39\x05\x21\xc0\xbb\x38\x99\x65\x31\xdb\x1e\xa3\x08\x3c\x8a\xcd\x38\x44\xac\x1f\x50\x74\xda\x8c\xa1\xbb\x17\xb2\x6c\xd5\x40\x32\x45\xbb\x25\x1f\xf5\x3e\x91\x6f\xda\x01\x00\x28\x43\xb6\xf1\xba\xbd\x21\x37\x77\xe4\x40\xce\xaa\x77\x50\x6f\x87\x6f\x87\x37\xb5\xdf\x65\xcd\x5d\x33\xe9\xf3\x80\x41\xbd\x43\x1e\x03\x50\xfa\x67\x68\x74\xdb\x62\x08\xcd\x0d\x1f\x69\x48\xed\xb7\xbd\x80\x2d\xa3\xbc\xee\x89\xbb\xa1\x7d\x2e\x33\xf6\xd9\xdf\x8e\xc3\x50\x3a\x61\x18\xfb\xc6\x6b\xb9\x2e\x8d\xb9\x1e\x57\x36\xcf\xee\x4e\x09\x1b\x39\xe1\xfd\x58\xa6\x56\x4c\x00\x25\x0f\x8f\xc9\xeb\x42\x03\x16\x3f\x00\x15\xdc\xc8\xed\xdc\x99\x2a\xb8\x23\x9f\xb5\x90\x81\x2a\x4e\x13\x8c\x01\x19\x3e\x8a\xf1\x07\xc4\x38\x55\x80\xb6\xdb\xc8\xb6\x4f\x75\x18\x84\xac\x96\x65\x7b\x47\x31\xfe\xe8\x44\x5c\xad\xbd\x3f\x48\xff\xa9\x16\x69\xdc\x7f\x8d\x17\xae\x38\x0c\xdb\x47\xc9\x0e\x64\x71\xf3\xa7\x7c\xaf\x5b\x47\x0c\xb5\x87\xa3\xf6\x7e\x23\x07\x50\xa2\x19\xea\xcf\x5f\x17\x78\xf2\x87\xfa\xfb\x0b\x3d\x1b\xf8\x92\x14\xe0\xb4\x32\x4f\x15\xd0\x05\xca\x9e\x9b\xd0\x32\x79\x81\xea\xbf\x3e\x93\x87\xe6\x65\x4f\xcf\x6f\xc5\xa8\x36\x6e\x68\x3b\xba\x40\x15\xe7\xd2\x69\x3d\xf4\x79\xa4\xa1\x5b\x8e\xbc\x3a\x2a\xab\xcd\xec\x97\xbe\x50\xec\x0a\x45\xe7\x52\xae\xff\xd4\xc9\xe8\x1e\x17\x86\xb3\x0e\xd8\xcc\x85\xe4\x5e\x24\xad\x33\x86\x12\xa7\xf0\x88\x73\x31\x16\x02\xef\x82\xd4\x9e\x5d\xca\xbc\xcb\x20\x7f\x89\x5b\x36\xaf\x45\xf7\xdb\x3d\x30\x7a\x17\x48\x1c\x3f\x16\x8e\x2c\x7b\x26\x99\xef\xd9\xbf\x4a\xa7\x90\xc1\x20\xda\xf8\x57\xa1\xfc\x87\xa0\x2d\x60\x7e\x0c\xd7\x22\xcf\xab\x3e\xed\xe2\xee\xf7\x00\x30\xd3\x7e\x05\x17\x04\x00\x00"
+
+func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildTemplateJsonTpl,
+		"data/aws-simple/build/template.json.tpl",
+	)
+}
+
+func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployCloudInitShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x4c\x92\x41\x8b\xd4\x40\x10\x85\xef\xf5\x2b\xde\xee\x78\xed\x69\x50\xf7\xb2\xac\x5e\x5c\x05\x61\x41\x70\x11\x0f\x22\xd2\xd3\xa9\x99\x34\x76\xba\x9b\xae\xca\x8c\x43\xcc\x7f\x97\x24\x33\x6b\x6e\xe1\xe5\xbd\x57\x5f\x15\xbd\xb9\xb1\xbb\x90\xec\xce\x49\x4b\xc2\x0a\xc3\x44\x1b\x7c\x4e\xa2\x2e\x46\xf8\x6f\x5f\x9f\x10\xf6\x38\x31\x5a\x77\x64\x68\x26\x57\xd4\x1c\x58\xd1\x97\xc6\x29\xc3\x9c\x5f\x94\x70\x09\x99\x33\x7c\x5f\xe3\xba\xe7\x31\xfb\xdf\x5c\x69\x92\x61\xe4\xf9\x09\xad\x6a\x91\x7b\x6b\x0f\xac\xdb\x66\xfe\xb9\xf5\xb9\xb3\xf8\x0b\x69\xa7\xe0\x87\xca\x53\xbb\xb6\x0c\x9f\x93\xba\x90\xb8\x62\x5f\x73\x37\x4b\xa1\x73\x07\x46\xe9\xa5\xe5\x06\xbb\xf3\xac\xed\xfa\x10\x1b\x5a\xba\x50\xfa\x18\xf1\x6a\x98\x7d\xe3\x55\xf4\x4b\xe7\x30\xa0\xf6\xe9\x97\xab\x07\xc1\x38\xc2\x98\xe4\x3a\x7e\x77\x3b\x0c\x98\x3e\x30\x8e\xb7\x30\x27\x58\x57\xca\x4b\xc3\x35\xe3\x73\xd7\xb9\xd4\x60\x1c\x27\xc6\xef\x35\x5c\x10\x85\xeb\x31\x78\x26\xef\x14\xef\x2d\xab\xb7\x21\x05\xb5\xff\x2b\xb7\x3e\xa7\x3d\x1e\x1e\x3e\x7e\xf9\x44\x0d\x8b\xaf\xa1\x68\xc8\x09\xb7\x8f\x17\xb4\xeb\x8e\xf7\x58\x71\x10\x89\xba\xaa\xc8\x09\xfb\x10\x59\xce\xa2\xdc\x61\x02\x98\x75\x6e\xb0\x6c\x46\xa2\xb9\x4c\xae\xda\xa7\xc8\x47\x8e\xf8\x71\xf3\xfa\xcd\xdb\xbb\x9f\x44\x95\xa5\xb8\x53\x22\x2a\x59\xd4\xcc\x3e\xfe\xc3\x1e\x12\x99\x0b\xee\x88\x16\x16\x02\x6c\x2f\x75\x7e\x0a\x97\x6b\x2d\xa3\x8d\x5b\x01\x11\x4f\xa3\x97\xc0\xb4\x09\x6d\xf0\x3c\xbb\xd6\x37\x58\x72\xab\xd0\xbf\x01\x00\xf8\x88\x6d\x8a\x62\x02\x00\x00"
+
+func dataAwsSimpleDeployCloudInitShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployCloudInitShTpl,
+		"data/aws-simple/deploy/cloud-init.sh.tpl",
+	)
+}
+
+func dataAwsSimpleDeployCloudInitShTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployCloudInitShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/cloud-init.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc4\x57\x4b\x8f\x1c\x35\x10\xbe\xf7\xaf\x28\x79\xb3\xd1\x06\x31\x3d\x93\x4d\xe0\x00\x5a\x24\x04\x82\x03\x12\x20\x84\xe0\x80\x56\x2d\x4f\xbb\x7a\xc7\x1a\xb7\xcb\xf8\x31\x9b\xc9\xd0\xff\x1d\xd9\xee\xde\x7e\xec\xe4\xb1\x52\x10\x9b\xcb\x74\xb9\xec\xaf\xaa\xbe\xaa\xcf\xce\x05\xfc\x88\x1a\x2d\xf7\x28\x60\x7b\x84\x5f\xbc\xa7\xcf\x41\x10\x68\xf2\x80\x42\x7a\x68\xb9\x0e\x5c\xa9\x63\x51\x1c\xb8\x95\x7c\xab\x10\x98\xd4\x8d\xe5\x95\x14\x0c\x4e\xdd\xc4\xcc\xef\x5d\xc5\xeb\x1a\x9d\xab\xf6\x78\x64\x70\x02\x81\x0d\x0f\xca\xc3\x0d\x30\x06\x4b\x57\x87\xb5\x45\xff\x51\xae\x9e\xf6\xa8\x3f\xe8\x65\xf1\x4e\x92\x5e\x04\xb5\xc7\x63\xa5\x79\x8b\xc9\x3c\xb1\x0b\xaa\xf7\x68\x2b\xd9\xf2\xbb\x47\x6b\xbc\x95\x0b\x30\xde\xca\xd5\xf5\xcb\x2f\x5f\x6d\xc4\xeb\xd7\x73\x60\xa9\x9d\xe7\xba\xc6\xca\x1f\x0d\x2e\x76\x9d\x4e\x30\x5b\xfe\xa7\x5f\xfb\x8a\xf9\xeb\xb2\x95\xb5\x25\x06\x5d\xf7\x8e\xf3\x6a\x0a\xda\x2f\x0e\x7c\x39\xf7\x45\x7d\x90\x96\x74\x8b\xda\x57\x2e\x34\x8d\x7c\xf3\xde\x1a\xb9\xb0\xd5\xe8\x2b\x13\xb6\x4a\xd6\x8b\x32\x1d\x4c\x5d\xd5\x52\xd8\x33\xe6\x9e\xe7\xc2\x58\x3a\x48\x81\x36\x15\x9b\xc1\xa9\x00\x18\xd9\x8e\x68\xcf\x4e\x07\x6e\xcb\x79\x17\x74\xac\x00\x18\x99\x9e\xbb\x8d\xf6\xe4\x96\x58\x9e\x7b\x24\x53\x5a\xcc\xe4\x42\xfc\x9b\x79\x64\x7b\xc7\x8a\xae\x28\x2c\x3a\x0a\xb6\x1e\xfb\x2b\x58\xe9\x8f\xd5\x9d\xa5\x60\x18\x30\x6e\x4c\x0e\x3b\xf6\x43\x3e\xe7\x74\xca\x1f\x5d\xb7\xca\x47\x0e\xad\xdd\xe5\xcf\xc7\x15\x4e\xc1\xe4\xb2\x8c\x81\xe4\xef\x8e\x15\x05\x80\xd4\x77\x16\x9d\x4b\x40\x00\xc6\x92\xa7\x9a\x54\x8e\x7b\xf5\x32\x19\x1b\x4b\x6d\x65\xc8\xfa\x64\xdc\x24\x9b\xa7\xc1\x32\xda\x22\x21\xd5\x56\x51\xbd\x77\x70\x03\x7f\x4d\xc0\xe2\x4a\xc7\x6e\x0b\x80\xee\x43\x98\xcc\xd7\x86\x9d\x81\xbd\xbe\x3e\x83\xdb\x1b\x97\xc0\x9b\x32\xfd\x5b\x6f\x46\x48\xfc\xcf\xb2\x3c\x03\x76\xba\x04\xd9\x80\xe7\x77\x0e\x2e\xbb\x02\xf2\xaf\x0c\x7d\xba\x84\x86\x2c\x78\x90\x7a\x70\x88\xac\xfa\xf2\x27\x3c\xa6\xe1\xca\x2c\xfb\xf2\x0f\xae\x42\x24\x9a\x0d\xdb\x50\x8b\xb8\x33\x1d\xd8\x15\x83\x49\x36\xd1\xd2\x15\xc5\x05\xfc\xbe\x43\x70\x9e\x5b\x1f\x0c\xb8\xda\x4a\xe3\xc1\x06\xed\xc0\xef\x10\x92\x6e\x80\xdf\x71\x0f\xf7\xdc\x81\x09\x6e\x97\x15\x34\x2e\x6e\x83\x54\x62\xd2\x8d\x1e\x5b\xa3\xb8\xc7\xaa\x91\x0a\x19\xb0\x5a\x51\x10\x95\xd4\xd2\xe7\x7e\x1c\xd6\x73\x43\x45\xa7\x2b\xf6\xec\x64\xb8\xdf\x95\x2d\x89\xa0\xb0\x5b\xa7\x2d\xab\xb8\xa5\x74\x3b\xf6\x22\xb7\xda\x81\xdb\xa1\x0c\x39\x9e\x87\x86\x9c\xaa\x5b\xca\xb8\x4f\xe9\x7b\x34\x8a\x8e\xc0\xc1\xa1\x07\x6a\x1e\xe4\xc9\x2d\x66\x67\xb0\x4f\xa7\x26\x09\x12\x0c\x7f\x0f\x50\x73\xc1\x4a\x60\xbc\x95\x00\x8f\x3d\x79\x2b\xd3\xf2\x4c\x13\xcf\x1c\x14\xcd\xc9\xb1\x17\x2c\x29\xe6\xe7\xcc\x74\x2c\x39\x0e\x22\xbf\x00\x1c\xcc\xc9\x27\x38\xb4\x95\xe0\x9e\x8f\x3e\x33\x5e\xca\x91\x95\xd2\xa2\x16\x68\xb1\x9f\xe8\x38\x70\x73\x31\xa9\xa4\x18\x26\xf2\xb1\xd2\x94\xdc\x98\x32\xaa\xc1\xed\xd8\xba\x07\x52\xa1\xc5\xca\xc9\xb7\x98\x1b\xce\x12\xf9\xdc\xf4\x95\xc0\x83\xac\xb1\xe7\x71\xea\x98\x3b\x77\x6a\xe9\x7a\x2e\xe7\xcd\x3a\x1f\x88\x9f\x79\x3b\x6c\xed\xa5\x8d\x7d\xd2\x41\x49\x9d\xa0\x35\xd6\x5e\x92\xee\x31\x63\x6d\xa7\x7c\x87\x6d\xd0\x3e\xe4\x03\x76\xe4\x16\x5d\xe3\x50\x35\x65\x66\xaf\x92\xa6\x4f\xa9\x00\xb8\x80\x3f\xb9\xf4\x29\xca\xb1\xdd\xe1\x0a\xb5\x0b\x16\xdd\x43\xdb\x80\x74\xd0\x04\xa5\x8e\xb0\x25\x4a\xef\x16\x6c\xc8\x22\xb4\x74\x90\xfa\x0e\x48\xbf\x28\x92\x22\x1d\xa4\x93\xa4\xd1\x02\xb3\xd8\x92\xc7\x15\xbe\xc1\x9a\x0d\xf3\xa2\x95\xd4\x98\x38\xbc\xdf\x49\x85\xe0\x82\x20\x30\x7b\xa9\x14\xac\x36\x53\xfc\xeb\x6f\xd6\x02\x0f\x6b\x1d\x94\xfa\x1a\x04\x81\x53\x88\x06\xae\xe3\x6f\x8d\x33\x89\x8a\x81\x0b\x69\x41\x6a\x68\x28\x68\xc1\x63\x85\x2a\x21\xad\x2b\x93\x22\xe4\x0a\x5e\xc0\x0f\x0f\x8b\x70\x3a\xc5\x5d\x8a\xc8\x94\xdf\xc5\xf1\x41\x0b\x5d\x07\x57\xc9\xfd\x89\x69\xb4\xfb\x88\xbd\x32\xb0\xf6\xad\x59\x93\xf7\xb4\x1e\xa3\x58\x9d\x05\x1a\xa3\x9f\xe1\x64\x95\xca\x00\xbd\x28\xe4\xde\x88\x00\x5d\xb7\xce\xbc\x0a\x74\x5e\xea\x9c\xc6\x0d\xb0\x27\xa0\x9e\x05\x7d\x7f\x72\xb5\xf8\xd8\xb4\xba\x0e\x9e\x3f\x87\x2d\x77\x3b\x28\xd7\x2d\x97\x3a\xea\xe5\xed\x6c\x6a\xfa\x66\xfe\x20\x69\x22\x8b\xe5\x47\xb3\x96\xfd\x3f\x29\x6d\xf9\xc8\xff\x89\xbd\xf7\x82\x7f\x42\x12\xdf\x89\xf3\x24\x2e\x2f\xe0\x37\x6c\xe9\x80\xc0\xf5\x31\xdd\xa8\x64\xb9\x3d\xc6\xac\xb1\xf6\x64\x25\x3a\xb8\x47\x68\xb9\xc0\xf4\x32\x99\xb0\xed\xe0\x4a\x36\x71\xdb\x13\xa9\xb3\x2d\xac\x6c\x33\xe6\xd4\x87\xd6\x15\x05\x05\x6f\x82\x07\x26\xfb\xab\xf3\x90\x24\xf5\x06\xfa\xfb\x62\x50\xb2\x74\x53\x6c\x66\x52\xd8\x15\x45\xbe\x31\x04\xc5\x84\xe1\x72\xfa\x1a\xcf\xb6\xc5\x13\x3d\x1b\xab\xb7\xa4\xf1\xe1\xa9\x7e\x01\xbf\x92\xd4\x3e\x3d\x46\xfa\x83\xa8\x49\x5f\xdc\x18\x25\xeb\xcc\x3b\xcf\x0e\xef\x7a\x03\x58\x0a\x1e\xbf\x78\x55\x59\xac\xc9\x0a\x36\x81\x2f\x00\x7a\xb8\xe9\x8b\x63\x1a\x46\xea\x8f\xe1\x46\x5e\xf8\xa4\xb5\x74\xf5\xe7\xb5\x6f\xd3\xb7\x57\xc3\x05\xf1\x6a\xb3\xc9\x0f\xfe\x08\x3b\xbd\x66\x67\x65\xfb\x6c\x5a\xb6\xdb\x69\xd1\xa7\x61\x2e\x0a\x3f\x4f\xa9\x8f\xa7\x6c\xfe\x16\xf9\x3f\x10\xd3\x4b\xf5\xdf\x01\x00\xe5\x69\x80\x16\x19\x0f\x00\x00"
+
+func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployMainTfTpl,
+		"data/aws-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x55\x5f\x6f\xdb\xb6\x17\x7d\xe7\xa7\x38\x95\xdd\x7f\x40\x2c\xfd\x1a\xfc\xb0\x87\xb4\x0e\xda\x26\x6e\x1b\x20\x4b\x06\x3b\xdd\xcb\x30\xb8\xb4\x78\x25\x11\x95\x79\x09\x92\x72\x63\xd8\xfa\xee\x03\x25\x39\x4e\xb6\x61\xdb\x83\x01\xfa\xf0\xde\x73\xef\x39\x97\xa4\x46\xf8\x4c\x86\x9c\x0c\xa4\xb0\xda\xe2\x36\x04\x3e\x81\x62\x18\x0e\x20\xa5\xc3\x33\x31\x12\x23\xdc\x55\xda\x43\x7b\x84\x8a\xf0\xab\x2c\x9d\x34\xa1\xd0\x35\xa1\xfc\x73\x2e\x0a\x76\x5d\x94\xa2\x0d\xd5\x6c\xd7\x64\x02\xb8\x10\x23\x84\x48\x21\xad\xad\x75\x2e\x83\x66\x93\x79\x72\x1b\x9d\x53\x8a\xab\x00\x5f\x71\x53\xab\xae\xe8\x8a\x50\x49\xa3\x26\xb1\x38\xa9\x14\x77\x8c\x35\x2b\x5d\x6c\x23\xad\x18\x3d\x2e\x7f\x82\xc6\x53\x57\xed\x83\xb5\x11\x48\x85\x18\xb6\xd3\x9c\x4d\xa1\xcb\xc6\xd1\xab\xe4\x34\x79\x1d\x15\xed\x7b\x68\x2f\x80\x7e\x95\x6e\xd6\xe9\x8a\xef\x31\x45\x52\x49\x5f\xe9\x9c\x9d\xcd\xac\xa3\x5c\x7b\xfa\xe9\xff\x89\x10\xc0\x08\x5f\xd8\x07\xb0\xa9\xb7\x30\x14\x7e\xb0\xfb\xfe\x24\x7d\xc0\x90\x58\xa7\x37\x32\xd0\x72\x00\x92\x13\x68\x7b\x86\x64\xb7\x8b\x46\x2c\xb5\x5d\x4a\xa5\x1c\x79\x8f\xb6\x1d\x88\x17\x14\x1a\x0b\x09\xbf\x35\x39\x29\x14\x5c\x2b\x72\x28\x1c\xaf\xc1\x8d\x43\x64\xd1\xa6\x84\xd2\x8e\xf2\xc0\x6e\x8b\xc0\xc8\x36\xbd\xba\x27\x3d\xf4\x04\xcb\x81\x20\x96\xb4\x32\x54\xe9\x81\xa0\x6d\x93\x13\x24\x87\xcc\xe4\x44\x00\x00\xff\x30\xe4\xce\x90\x3c\xa0\x28\x1d\x37\xf6\x11\xd2\x37\x39\x33\x72\x55\x13\x16\x8b\x2f\x90\x65\x1c\x65\xc1\xee\x87\x74\x2a\x12\x7b\x46\x49\x21\xc4\xe5\xa0\x1e\x8a\x2c\x19\x45\x26\xd7\xe4\x3b\x05\xfe\xd8\xa9\xf7\x55\x3a\x64\x2f\x7b\xae\x29\x82\x6b\xa8\x2f\xf4\x89\x1b\xa3\xba\x73\x81\xc3\xe4\xfa\x7f\xaf\x74\x01\x69\xb6\xaf\x05\xb0\x7b\x1e\xcb\x47\x47\xa0\x0d\x8a\x87\x8c\xa5\xd2\xce\xa7\x8a\x36\x78\xde\x0a\x74\xfb\x53\x24\x19\x87\xc0\xd9\x31\x6a\xb2\xdb\xc5\xf4\x9a\xd9\xa6\x17\xdc\x98\x40\xae\x1b\xc6\x3f\x5b\x19\xc9\x3a\x07\x95\x76\x4f\x42\xad\xe3\x8d\xf6\xb1\xc3\xc4\x57\x54\xd7\x71\xe2\xa6\xd6\x86\xce\x90\xe4\x0a\xa3\x9d\xd2\xae\xc5\x8b\x17\x58\x49\x5f\x0d\x7f\xb3\xb5\xd4\x26\xf5\x55\xd2\x8b\x21\xa3\xa2\x9e\xe7\x6d\x6f\xc1\x35\x4b\x05\x59\xd7\xdd\xf8\x0b\x27\xcb\x78\x77\x3c\x2a\x72\xd4\xe9\x96\x66\xfb\xc4\xe0\xf4\x68\xc9\x21\x3a\xfa\x12\xcf\xdb\x31\xbb\x73\x24\x2a\x1f\x90\xbd\x23\xa9\xd0\xb6\x7f\xdb\xc1\x95\xf1\x21\x36\xb0\x6a\x74\xad\x40\x66\xa3\x1d\x9b\x98\xf5\x5f\x95\x8f\x7d\xee\xb4\x0d\x4b\x69\xad\x20\xa3\x84\x78\x04\x60\x8a\x77\xef\x16\x17\xf3\xab\x5f\xee\xc4\xe8\x59\xb6\xd2\x26\x8b\xd6\x08\xe1\x29\x60\xc2\x30\xdc\x98\x61\x49\xce\xd1\xbd\xee\x96\x56\x5b\x2a\xa4\xae\x07\x38\x38\x99\x93\x10\xe4\x1c\xbb\x57\xaf\xb1\x13\x00\x6a\xce\x65\x0d\xcf\x8d\xcb\x29\x3e\x02\xd3\xf1\x9b\x23\x1c\xfb\x32\x3c\x1d\x9f\x46\x88\xf2\x8a\x91\xcc\xe6\xf3\xdb\x39\x64\xc0\x78\x77\x4c\x6a\xcf\xc6\xbb\x3e\xb6\x7d\x8b\x6b\xe9\x03\x6a\x2e\xfd\x59\x9c\x14\x4a\x47\x16\x1c\xfa\xfb\xe7\xb2\x9a\xcb\xcc\x6f\x7d\xcd\x25\xf6\x08\x5d\x6f\x06\xa7\xff\x13\xad\x08\x4e\x5a\xbc\xec\x9a\x43\x32\xde\x7d\xfc\xb0\xf8\xb2\x5c\xdc\x7e\x9d\x5f\xcc\xda\x24\x02\xd7\x57\x37\xb3\x9b\xdb\x36\x79\x89\xd9\x7c\x2e\x04\x53\x94\x80\x64\xfc\x3e\xc1\xe9\xf9\x8b\x37\xd8\xc7\xa2\x25\x39\x4c\x42\x5f\xef\x1c\x99\xa2\x4d\x66\x9a\xba\x7e\x8b\x56\x70\xdd\x25\xf4\x32\x7e\x8b\x11\xbf\x63\xfc\x3e\x89\x5b\x62\x84\x9f\xe5\x77\x82\x0e\xf0\x8c\x50\xc9\x80\x6f\xc3\x55\x86\xf7\xd5\x37\x94\x4c\x7e\x78\x4c\xea\xee\x2d\x89\xcf\x66\xce\x2e\x02\x11\x17\x3d\x6b\xae\x1e\x1e\x99\x04\xe7\xe7\xc8\x2a\x5e\xd3\x01\xc9\xd2\x38\x31\x97\xc7\x6a\x17\xc3\x2d\x8d\xd7\x3f\x3e\x0f\xdd\x31\x94\x3e\x90\x8b\x22\xb4\x11\xba\xc0\xb3\xde\xba\xe4\xab\xa7\xcb\x9b\x05\x0c\x27\xc8\x28\xe4\x99\xf7\x55\xfc\xa9\x65\x7f\xa8\x70\xfe\x48\x65\xa8\xc8\x88\xc3\xa8\x1e\x25\xee\xe1\x1b\xc5\x08\x44\x98\xc8\x7f\xa3\x11\x00\x53\x9f\x30\x7c\x65\xa2\x09\x70\xe4\x83\x74\x41\x14\x5a\x08\xae\x91\x0c\xe7\x3d\x4a\xb8\xe4\xfc\x3b\xb9\x34\x4d\x13\x41\xf7\x96\x5d\xc0\xe5\xec\xe3\xd5\x87\x9b\xe5\xa7\xf9\xed\xcd\xdd\xec\xe6\x72\x6a\xd8\x68\x13\xc8\xc9\x3c\xe8\x0d\x89\x03\xbf\xb4\x61\x52\x52\x40\x63\x95\x0c\x84\xc9\xf6\x2f\x3b\xba\xaf\x82\xc9\x16\x79\xe3\x6a\x94\x3a\x88\x6e\x31\xf1\x8b\x6b\x54\x21\x58\x7f\x96\x65\x25\x85\x54\xf5\x4d\xe4\xbc\xce\xb0\x3f\x2a\xa8\x1e\x28\x1b\x4f\x6e\xcd\x0a\x13\xf9\x19\x7d\x30\x0e\x5f\x84\xe1\x66\xfd\x31\x00\x04\x71\x7f\xdb\xcf\x07\x00\x00"
+
+func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevVagrantfileTpl,
+		"data/common/dev/Vagrantfile.tpl",
+	)
+}
+
+func dataCommonDevVagrantfileTpl() (*asset, error) {
+	bytes, err := dataCommonDevVagrantfileTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev/Vagrantfile.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
+	"data/aws-simple/deploy/cloud-init.sh.tpl": dataAwsSimpleDeployCloudInitShTpl,
+	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
+	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"aws-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"template.json.tpl": &bintree{dataAwsSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"cloud-init.sh.tpl": &bintree{dataAwsSimpleDeployCloudInitShTpl, map[string]*bintree{
+				}},
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+		"common": &bintree{nil, map[string]*bintree{
+			"dev": &bintree{nil, map[string]*bintree{
+				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,49 @@
+{
+    "min_packer_version": "0.8.0",
+
+    "variables": {
+      "aws_access_key": "",
+      "aws_secret_key": "",
+      "aws_token": "",
+      "aws_region": null,
+      "slug_path": null
+    },
+
+    "provisioners": [
+      {
+        "type": "file",
+        "source": "{% verbatim %}{{ user `slug_path` }}{% endverbatim %}",
+        "destination": "/tmp/otto-app.tgz"
+      },
+      {
+        "type": "shell",
+        "inline": [
+          "mkdir -p /app",
+          "tar -zxf /tmp/otto-app.tgz -C /app",
+          "rm /tmp/otto-app.tgz"
+        ]
+      }{% if provision_script %},
+      {
+        "type": "shell",
+        "script": "{{ provision_script }}"
+      }{% endif %}
+    ],
+
+    "builders": [{
+      "name": "otto",
+      "type": "docker",
+      "image": "{{ base_image }}",
+      "commit": true
+    }],
+
+    "post-processors": [[
+      {
+        "type": "docker-tag",
+        "repository": "{{ docker_image }}",
+        "tag": "{% verbatim %}{{timestamp}}{% endverbatim %}"
+      },
+      {
+        "type": "docker-push"
+      }
+    ]]
+}
@@ -0,0 +1,67 @@
+# Generated by Otto, do not edit!
+#
+# This is the Vagrantfile generated by Otto for the development of
+# this application/service. It should not be hand-edited. To modify the
+# Vagrantfile, use the Appfile.
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "hashicorp/precise64"
+
+  # Host only network
+  config.vm.network "private_network", ip: "{{ dev_ip_address }}"
+
+  # Setup a synced folder from our working directory to /vagrant
+  config.vm.synced_folder "{{ path.working }}", "/vagrant",
+    owner: "vagrant", group: "vagrant"
+
+  # Enable SSH agent forwarding so getting private dependencies works
+  config.ssh.forward_agent = true
+
+  # Foundation configuration (if any)
+  {% for dir in foundation_dirs.dev %}
+  dir = "/otto/foundation-{{ forloop.Counter }}"
+  config.vm.synced_folder "{{ dir }}", dir
+  config.vm.provision "shell", inline: "cd #{dir} && bash #{dir}/main.sh"
+  {% endfor %}
+
+  # Load all our fragments here for any dependencies.
+  {% for fragment in dev_fragments %}
+  {{ fragment|read }}
+  {% endfor %}
+
+  # Install build environment
+  config.vm.provision "shell", inline: $script_app
+end
+
+$script_app = <<SCRIPT
+#!/bin/bash
+
+set -o nounset -o errexit -o pipefail -o errtrace
+
+error() {
+   local sourcefile=$1
+   local lineno=$2
+   echo "ERROR at ${sourcefile}:${lineno}; Last logs:"
+   grep otto /var/log/syslog | tail -n 20
+}
+trap 'error "${BASH_SOURCE}" "${LINENO}"' ERR
+
+oe() { "$@" 2>&1 | logger -t otto > /dev/null; }
+ol() { echo "[otto] $@"; }
+
+# Make it so that `vagrant ssh` goes directly to the correct dir
+echo "cd /vagrant" >> /home/vagrant/.bashrc
+
+# Configuring SSH for faster login
+if ! grep "UseDNS no" /etc/ssh/sshd_config >/dev/null; then
+  echo "UseDNS no" | sudo tee -a /etc/ssh/sshd_config >/dev/null
+  oe sudo service ssh restart
+fi
+
+ol "Installing Docker..."
+export DEBIAN_FRONTEND=noninteractive
+oe sudo apt-get update -y
+oe sudo apt-get install -y curl git
+curl -sSL https://get.docker.com/ | oe sudo sh
+oe sudo usermod -aG docker vagrant
+SCRIPT
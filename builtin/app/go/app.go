@@ -2,14 +2,17 @@ package goapp
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/foundation"
 	"github.com/hashicorp/otto/helper/bindata"
 	"github.com/hashicorp/otto/helper/compile"
-	"github.com/hashicorp/otto/helper/schema"
+	"github.com/hashicorp/otto/helper/packer"
+	"github.com/hashicorp/otto/helper/terraform"
 	"github.com/hashicorp/otto/helper/vagrant"
 )
 
@@ -39,35 +42,25 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 				},
 			},
 		},
+		Offline:  true,
+		Builders: true,
 		Customizations: []*compile.Customization{
 			&compile.Customization{
 				Type:     "go",
 				Callback: custom.processGo,
-				Schema: map[string]*schema.FieldSchema{
-					"go_version": &schema.FieldSchema{
-						Type:        schema.TypeString,
-						Default:     "1.5",
-						Description: "Go version to install",
-					},
-
-					"import_path": &schema.FieldSchema{
-						Type:        schema.TypeString,
-						Default:     "",
-						Description: "Go import path for where to put this in the GOPATH",
-					},
-				},
+				Schema:   goSchema,
 			},
 
 			&compile.Customization{
 				Type:     "dev-dep",
 				Callback: custom.processDevDep,
-				Schema: map[string]*schema.FieldSchema{
-					"run_command": &schema.FieldSchema{
-						Type:        schema.TypeString,
-						Default:     "{{ dep_binary_path }}",
-						Description: "Command to run this app as a dep",
-					},
-				},
+				Schema:   devDepSchema,
+			},
+
+			&compile.Customization{
+				Type:     "vagrant",
+				Callback: custom.processVagrant,
+				Schema:   vagrantSchema,
 			},
 		},
 	}
@@ -75,25 +68,163 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 	return compile.App(&opts)
 }
 
+// SupportedTuples implements app.AppTuples with the infrastructures and
+// flavors that there are templates for.
+func (a *App) SupportedTuples() []app.Tuple {
+	return compile.SupportedTuples("go", &bindata.Data{
+		Asset:    Asset,
+		AssetDir: AssetDir,
+	})
+}
+
 func (a *App) Build(ctx *app.Context) error {
-	return fmt.Errorf(strings.TrimSpace(buildErr))
+	return packer.Build(ctx, &packer.BuildOptions{
+		InfraOutputMap: map[string]string{
+			"region": "aws_region",
+		},
+	})
 }
 
 func (a *App) Deploy(ctx *app.Context) error {
-	return fmt.Errorf(strings.TrimSpace(buildErr))
+	return terraform.Deploy(deployOptions(ctx)).Route(ctx)
+}
+
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Destroy implements app.AppDestroy by destroying the deploy with
+// Terraform, using the state stored for the deploy. The options are the
+// same as Deploy's, so blue-green deploys are destroyed as well.
+func (a *App) Destroy(ctx *app.Context) error {
+	return terraform.Destroy(ctx, deployOptions(ctx))
+}
+
+// deployOptions returns the options to deploy with the deploy_strategy
+// of the Appfile. Blue-green deploys sit behind a public load balancer,
+// so they're deployed into the public subnet. The simple flavor only has
+// a public subnet.
+func deployOptions(ctx *app.Context) *terraform.DeployOptions {
+	strategy := deployStrategy(ctx.Appfile)
+	subnet := "subnet-private"
+	if strategy == terraform.DeployStrategyBlueGreen {
+		subnet = "subnet-public"
+	}
+
+	return &terraform.DeployOptions{
+		InfraOutputMap: map[string]string{
+			"region":        "aws_region",
+			"subnet_public": "subnet_id",
+			subnet:          "subnet_id",
+		},
+		Strategy: strategy,
+	}
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and both the inplace and bluegreen Terraform configurations.
+// Infrastructures without blue-green deploys have no bluegreen
+// configuration, so it's only validated if it was compiled.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	for _, dir := range []string{"deploy", "deploy-bluegreen"} {
+		path := filepath.Join(ctx.Dir, dir)
+		if _, err := os.Stat(path); dir != "deploy" && os.IsNotExist(err) {
+			continue
+		}
+
+		err := terraform.Verify(ctx, &terraform.DeployOptions{
+			Dir: path,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Status implements app.AppStatus by reading the infrastructure, build,
+// and deploy of the app from the directory.
+func (a *App) Status(ctx *app.Context) (*app.Status, error) {
+	lookup := directory.Lookup{
+		AppID:       ctx.Appfile.ID,
+		Infra:       ctx.Tuple.Infra,
+		InfraFlavor: ctx.Tuple.InfraFlavor,
+	}
+
+	infra, err := ctx.Directory.GetInfra(&directory.Infra{
+		Lookup: directory.Lookup{
+			Infra: ctx.Appfile.ActiveInfrastructure().Name}})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading infra status: %s", err)
+	}
+
+	build, err := ctx.Directory.GetBuild(&directory.Build{Lookup: lookup})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading build status: %s", err)
+	}
+
+	deploy, err := ctx.Directory.GetDeploy(&directory.Deploy{Lookup: lookup})
+	if err != nil {
+		return nil, fmt.Errorf("Error loading deploy status: %s", err)
+	}
+
+	return app.NewStatus(infra, build, deploy), nil
 }
 
 func (a *App) Dev(ctx *app.Context) error {
+	provider, syncType := vagrantOptions(ctx.Appfile)
+	ports, err := devForwardedPorts(ctx.Appfile)
+	if err != nil {
+		return err
+	}
+
 	return vagrant.Dev(&vagrant.DevOptions{
-		Instructions: strings.TrimSpace(devInstructions),
+		Instructions:   strings.TrimSpace(devInstructions),
+		Provider:       provider,
+		SyncType:       syncType,
+		ForwardedPorts: ports,
+		WatchScript:    "/home/vagrant/.otto-watch.sh",
 	}).Route(ctx)
 }
 
+// DevDestroy implements app.AppDevDestroy by destroying the Vagrant
+// machine of the development environment.
+func (a *App) DevDestroy(ctx *app.Context) error {
+	return vagrant.DevDestroy(ctx, &vagrant.DevOptions{})
+}
+
 func (a *App) DevDep(dst, src *app.Context) (*app.DevDep, error) {
+	binaries, err := devDepBinaries(src.Appfile)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, len(binaries))
+	for i, b := range binaries {
+		files[i] = b.Output
+	}
+
+	// The vendored dependencies and the lockfile, if any, pin the
+	// versions the dependency is built with.
+	lockFiles := []string{"vendor"}
+	if lock := detectLock(src); lock != nil {
+		lockFiles = append(lockFiles, lock.Path)
+	}
+
+	provider, _ := vagrantOptions(src.Appfile)
 	return vagrant.DevDep(dst, src, &vagrant.DevDepOptions{
-		Dir:    filepath.Join(src.Dir, "dev-dep"),
-		Script: "/otto/build.sh",
-		Files:  []string{"dev-dep-output"},
+		Dir:       filepath.Join(src.Dir, "dev-dep"),
+		Script:    vagrantOption(src.Appfile, "dev_build_script"),
+		Files:     files,
+		Provider:  provider,
+		LockFiles: lockFiles,
 	})
 }
 
@@ -107,13 +238,7 @@ When you're ready to build your project, run 'otto dev ssh' to enter
 the development environment. You'll be placed directly into the working
 directory where you can run 'go get' and 'go build' as you normally would.
 The GOPATH is already completely setup.
-`
-
-const buildErr = `
-Build isn't supported yet for Go!
 
-Early versions of Otto are focusing on creating a fantastic development
-experience. Because of this, build/deploy are still lacking for many
-application types. These will be fixed very soon in upcoming versions of
-Otto. Sorry!
+To rebuild and restart your application automatically whenever a Go file
+changes, run 'otto dev watch'.
 `
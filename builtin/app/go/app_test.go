@@ -1,6 +1,7 @@
 package goapp
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/otto/app"
@@ -8,4 +9,36 @@ import (
 
 func TestApp_impl(t *testing.T) {
 	var _ app.App = new(App)
+	var _ app.AppStatus = new(App)
+	var _ app.AppDestroy = new(App)
+	var _ app.DeployReporter = new(App)
+	var _ app.AppDevDestroy = new(App)
+	var _ app.AppVerify = new(App)
+	var _ app.AppTuples = new(App)
+}
+
+func TestApp_SupportedTuples(t *testing.T) {
+	expected := []app.Tuple{
+		{"go", "aws", "simple"},
+		{"go", "aws", "vpc-public-private"},
+		{"go", "digitalocean", "simple"},
+		{"go", "google", "simple"},
+	}
+
+	actual := new(App).SupportedTuples()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestApp_registered(t *testing.T) {
+	if app.Get("go") == nil {
+		t.Fatal("go app should be registered")
+	}
+
+	for _, tuple := range Tuples {
+		if app.Registered().Lookup(tuple) == nil {
+			t.Fatalf("tuple should be registered: %s", tuple)
+		}
+	}
 }
@@ -1,13 +1,31 @@
 // Code generated by go-bindata.
 // sources:
+// data/aws-simple/build/build-go.sh.tpl
+// data/aws-simple/build/template.json.tpl
+// data/aws-simple/deploy/main.tf.tpl
+// data/aws-simple/deploy/variables.tf
+// data/aws-simple/deploy-bluegreen/main.tf.tpl
+// data/aws-simple/deploy-bluegreen/variables.tf
+// data/aws-vpc-public-private/build/build-go.sh.tpl
 // data/aws-vpc-public-private/build/template.json.tpl
 // data/aws-vpc-public-private/deploy/main.tf.tpl
 // data/aws-vpc-public-private/deploy/variables.tf
+// data/aws-vpc-public-private/deploy-bluegreen/main.tf.tpl
+// data/aws-vpc-public-private/deploy-bluegreen/variables.tf
 // data/common/dev/Vagrantfile.tpl
+// data/common/dev/watch.sh.tpl
 // data/common/dev-dep/Vagrantfile.fragment.tpl
 // data/common/dev-dep/Vagrantfile.tpl
 // data/common/dev-dep/build.sh.tpl
-// data/common/dev-dep/upstart.conf.tpl
+// data/digitalocean-simple/build/build-go.sh.tpl
+// data/digitalocean-simple/build/template.json.tpl
+// data/digitalocean-simple/deploy/main.tf.tpl
+// data/digitalocean-simple/deploy/variables.tf
+// data/google-simple/build/build-go.sh.tpl
+// data/google-simple/build/template.json.tpl
+// data/google-simple/deploy/main.tf.tpl
+// data/google-simple/deploy/variables.tf
+// data/upstart/upstart.conf.tpl
 // DO NOT EDIT!
 
 package goapp
@@ -75,7 +93,147 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataAwsVpcPublicPrivateBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x91\xef\x6a\xc3\x20\x14\xc5\xbf\xe7\x29\x44\xe8\xb7\x36\x64\x6b\x19\x63\xaf\x32\x86\xbd\x31\x97\x4e\x1a\x35\xf8\xa7\xa3\x13\xdf\x7d\x26\x92\x4d\xc7\xd6\xe6\x4b\x82\xbf\x93\x73\xcf\x3d\x86\x86\xa4\x87\x4a\xa1\xd8\x04\xfc\x8c\x86\x5d\xd0\x58\xa1\x15\x7d\x21\xb4\x6b\x9f\xdb\x8e\x6e\x9b\xac\xb9\x80\x11\xd0\x8f\x68\x13\xca\xbf\x2d\xc7\xf0\x61\x19\x70\x8e\xd6\xb2\x33\x5e\x13\x53\x7e\x1c\xb7\x35\xb7\xc8\x0d\xba\xff\xb9\xc1\x53\x1e\x39\xb3\x05\xc5\x75\x6a\xef\xc5\x38\xa4\x48\x09\xbe\x16\x53\x15\x48\x9c\x23\x6a\xe7\x34\x2d\xdc\xdc\x75\x5a\xce\x41\xc2\xa7\x56\x3b\xec\x6d\x49\xab\x9c\x34\x6c\x48\x5a\xb6\x07\x27\x24\xd9\xc4\x10\x88\xb7\x68\xc8\xb1\x5e\xe8\x48\x62\x4c\x42\x54\x43\xa1\x2d\x3d\xab\xdd\x6e\x79\xfe\x08\xef\x7a\x7e\xf7\x71\xcb\x2f\x8b\xee\xe7\xd3\xde\x70\x64\x20\x45\xee\x45\xec\x1e\x1f\x9e\xf6\xdd\x70\x38\x94\x2a\xa1\xac\x03\x95\x74\x6b\x81\x7c\xdf\x8e\x60\x4e\x58\x59\xd9\x77\x36\xcf\x5f\xcb\xf7\xbd\x57\xce\x57\x05\x4b\xc1\x56\x1a\xc2\xfc\x15\x23\xf9\xbd\x43\x7a\x63\x9a\x26\xa7\xbf\x92\xe7\xdb\x7f\x6b\x62\xf3\x15\x00\x00\xff\xff\x25\x3b\xb6\x91\x9b\x02\x00\x00"
+var _dataAwsSimpleBuildBuildGoShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x95\x56\x6d\x4f\xdc\x38\x10\xfe\x9e\x5f\x31\x04\x38\xb5\x12\x49\x8e\x5e\xe9\x07\x5a\xd0\x71\x65\x8f\xe3\xc3\x15\x04\xb4\x3a\x09\x21\xe4\x4d\x66\xb3\x2e\x89\x9d\xda\xce\xbe\x40\xf7\xbf\xdf\x8c\x9d\x5d\xc2\x42\x2b\x15\x21\xed\xae\x67\xe6\xf1\xcc\x33\x6f\xde\xdc\xc8\x86\x52\x65\x43\x61\xc7\xd1\x66\xb4\x09\x47\xad\xd3\x49\x89\x0a\x8d\x70\x58\xc0\x70\x0e\x67\xce\xe9\xd4\xcb\xae\xc6\xd2\x02\xfd\xbb\x31\xc2\xb0\x95\x55\x01\x36\x37\xb2\x71\x30\xd2\x06\x0a\x6c\x2a\x3d\x97\xaa\x04\x01\x27\x3a\x21\x40\x32\x6f\x8c\xfe\x8a\xb9\x4b\x23\x8b\x0e\x12\x8c\xa2\x87\x6d\x90\xa3\x60\x7c\x5b\xe8\xfc\x0e\x0d\x6c\x2f\x3c\x34\xc2\x71\xf8\x2d\x6b\x51\x22\x5f\xc3\x5a\x0e\xa4\x22\xc0\x5c\x2b\x27\x24\x39\x05\x53\xe9\xc6\xba\x75\x60\xe7\xb6\xd2\xe5\x0e\x58\xed\xdd\xa1\xa3\xa6\x75\x04\x44\x76\x85\xb4\xb9\x30\x05\x5d\x4f\x12\x83\x69\x44\x37\x5e\x43\x72\x09\x59\x81\x93\x8c\xac\xe0\xe6\x3d\x8b\x54\x04\xf4\xa7\xf1\xd5\x6b\x78\x80\xad\x3f\xe1\xcd\xe1\x6f\xbb\xf0\x1d\x48\xa1\xa4\x8b\x12\x07\x9a\x22\x87\xc3\x60\xa6\xda\xaa\x7a\x0f\x8b\x08\x2b\x8b\x6b\x76\x3d\x0d\x8f\xc1\x6a\x23\xc9\xa1\xb2\x32\xc7\xf7\x8b\x77\xb0\xa5\x2a\xc8\x6b\x36\xad\xbc\x29\xe6\x63\x0d\xf1\x35\x6b\xdf\x10\x4e\xcc\x6a\x4f\xc8\xd4\xa3\x51\x45\x04\x05\x36\xff\xe2\x23\x4e\x45\x77\xba\x0f\xe7\xc2\x73\xdb\x52\x8e\x04\x33\x73\xa2\x61\x64\x74\x1d\xb8\xeb\x4c\x0b\x69\x28\x57\xda\xcc\x9f\xb8\x5e\x41\x7c\xac\xa7\x8a\xed\x18\x91\x0c\x1f\x1e\x28\xd9\x93\xdb\x52\xdf\x4e\xd0\x58\xa9\x15\x2c\x16\x69\x9a\xc6\x14\x26\x4c\x4b\x4e\xf4\x37\x48\xce\x20\x73\x75\x93\x95\x3a\x75\xc2\xa4\xe5\x3d\x8c\x9d\x6b\xec\x7e\x96\x59\xba\x81\x12\x9c\x96\x5a\x97\x15\x8a\x46\xda\x34\xd7\x35\x29\x56\x42\x95\xf4\xf1\x22\x3a\xf9\xd7\xce\x12\x51\x17\xef\xde\x76\x78\x4f\x48\xf2\x5e\x7e\xa6\x12\x31\x26\xf8\xb8\x74\xc7\xb6\x05\xd5\x87\x20\xa6\x3f\x42\xd6\x5a\x43\xd9\xcf\x45\x05\xc9\xec\x7e\xb4\xe6\x5f\x14\xe1\xac\xd1\xc6\xc1\xc9\xd9\xf9\xd1\xd5\x3f\x07\x99\x6e\x1c\x49\x1b\xe1\xc6\x4b\x89\x3f\xdf\x0a\x72\x6e\x9a\xfd\x47\x44\xd2\xf4\x27\x5b\x2c\x5b\x26\x46\xd6\x6c\x76\xcb\x10\xb0\x71\x00\x71\xcc\xae\x1e\x9d\x9f\xdf\x1e\x9f\x5e\x1c\xc4\x4b\x20\x6b\xf2\x8c\x62\xee\x2b\x2f\x16\x71\x3f\x05\x3f\x32\x51\xa2\xc6\x95\xee\x8a\x8a\x70\xb7\xd2\xee\x79\x61\x30\x4b\xa7\xca\x3a\x51\x55\x4c\xd3\x97\x8f\x97\xd6\xb7\x6e\xa9\x81\xd2\xe6\x39\xdb\x84\x64\x00\x77\x88\x8d\x05\xa1\xe6\xdc\xbf\xb3\x39\x50\xf3\x3a\x32\xb0\x8f\x25\x83\x6a\x22\x8d\x56\x35\xaa\xd0\xfc\xa2\x71\x34\x34\xdc\x8a\x72\x02\xe9\x8e\xa8\xe4\x0a\x9a\x24\x90\xcc\x5f\x12\xca\xe0\x0d\x49\xa1\x94\xe4\xf1\xbd\x81\x1a\x4d\xde\x1a\x29\xaa\xe7\x19\x1e\xcc\x9c\x11\xb9\xf3\x33\xa6\x69\xbc\xbf\x1e\xb0\xbe\xa3\xd2\x85\xa4\x81\xad\x8e\xaa\x70\x4c\x2d\x33\x55\x90\x5c\xc0\xd6\xab\xe9\x58\x8b\x5a\xbe\x86\x8e\xc1\xc8\x97\xc4\xaa\x08\xb8\xab\x12\x46\x74\x54\xa7\x54\x29\x2b\x98\xbc\x78\xfc\xfe\xa4\xdb\x28\xfe\xc7\xb9\x15\x46\x61\x9f\x12\x1a\x42\x3c\xf0\x68\x78\x86\xbe\x4b\xe1\x4c\x55\x73\xcf\x1c\x27\x8d\xb8\x35\xcb\x91\xb5\x43\x20\x56\xaa\x1c\xbd\x74\x22\xaa\x96\xc4\xb5\x98\xc3\x90\xd8\xc2\xdc\xa0\xb3\xa9\x0f\x7e\xd5\xd3\x3c\x01\xfb\xb7\xed\x73\x43\xae\xdc\xfa\xfe\x55\x53\x1d\xc6\x3b\x10\x2f\x4b\x83\xf3\x73\xc7\x63\xb4\xef\x7a\x57\xd2\x64\x79\x47\x7a\x1d\xd5\xac\xb9\xbd\x78\xa9\x9c\x26\x74\xa0\xbb\x51\x7d\x8c\x0d\xfd\x42\x95\xcb\x2e\x90\x20\xc4\xc2\x0f\xe3\xd6\xfa\x48\x6a\xa0\xfd\x41\xd3\x97\xbe\x0b\x05\x23\x74\xf9\x98\x7d\x67\xc9\x63\xa3\xed\xee\x7d\x19\x7c\x3a\x3e\xbb\x18\xfc\x77\x3e\xb8\x38\xfd\x77\xf0\xe9\xea\x60\x77\x7d\xf6\x9c\x84\xda\xe3\xf5\xb2\xba\xd5\x67\x3e\x14\x2d\x24\x05\x24\x13\x48\x33\x3a\x7b\xc9\xf1\x10\xf3\x84\x14\x3b\xbc\x8b\x56\x29\xc6\x23\xf3\x49\x57\xf3\xa4\xb6\xd1\xfd\x0e\x40\xfd\xed\x40\x26\x9d\xc8\x20\xbb\x1d\x96\xda\xb0\xc2\xda\x6e\xf4\xb2\x3f\x15\x94\x72\xa7\x9b\x86\xe4\x61\x25\xcd\x41\x21\x4d\xb0\x78\x05\x63\x50\xe4\x63\xda\x65\x61\x51\x0a\x82\x20\xf6\x9c\x1c\x51\x45\xa7\xf0\xb7\x9c\x05\xda\x84\x2a\x3a\x48\x51\xd2\xc6\x4b\x83\x3d\xce\xa8\x3b\x76\x97\x5b\xe5\xc5\x18\x1d\xda\x67\x41\xf2\x99\x7d\x12\xa3\xd7\x7a\x29\xc8\x2b\x56\x85\x91\x90\x15\x16\x3f\x09\x4c\xc0\xd0\xe8\x3b\xec\x8a\x69\x3d\xc4\x21\xd2\x28\xe7\xaa\xf8\x69\x90\xc1\xad\x5f\x8b\xb4\xdf\x01\xcb\xf4\x07\xe3\x44\x87\x26\x7e\x1c\x88\xdd\x50\x98\xac\x9f\xf7\x36\x80\x7f\xf3\xf4\x2c\xd6\x47\x23\x7b\x69\xd1\x4c\x64\x8e\xfe\xb6\x5c\x38\xf8\xf0\xe1\xf3\xf9\xe5\xd5\xd1\xc5\x15\xed\xef\xb0\x57\x10\x21\xa3\xca\xce\xa4\x92\xae\x87\x46\xfb\x4c\x8d\xfa\x3b\x3d\x2a\x30\x3c\x94\x78\x9b\xc5\x3d\x87\x12\x38\x59\x7f\x69\xc5\x51\x64\xd0\x36\x62\xaa\x96\x9f\x50\xc9\x9a\x39\xd9\x83\xbd\x28\x22\x0f\xa9\x75\x08\xc6\xb4\xaa\x22\xf2\x2b\xb8\x7e\xf3\xc7\xdb\xbd\x9b\x88\x73\xf4\xf4\xfc\xf7\x77\x37\xa4\xef\xef\x25\x66\x7f\x18\x3b\x1c\x1e\x66\x13\xc1\xb2\xb2\x1f\x03\x3f\x93\xf8\xb1\x12\x51\x0e\xba\x67\x5e\xd4\xc5\x1f\xd8\x22\x5e\x0a\xad\x70\x23\x8e\xfe\x07\x7f\x27\x13\xaa\x43\x0a\x00\x00"
+
+func dataAwsSimpleBuildBuildGoShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildBuildGoShTpl,
+		"data/aws-simple/build/build-go.sh.tpl",
+	)
+}
+
+func dataAwsSimpleBuildBuildGoShTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildBuildGoShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/build-go.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x58\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\x21\x08\x48\x9f\x2a\x29\x69\x83\x61\x08\x90\xa7\xa1\x03\x86\x0d\xd9\x30\x14\x03\x86\xd4\x50\x69\x89\xb6\x09\x4b\xa4\x20\x52\x6a\x1d\x57\xff\x7b\x8f\xa4\x7e\x90\x12\x25\x39\xc1\xba\xbc\xc4\x24\xef\x8e\x77\xc7\x8f\xc7\xef\x74\xbe\xf2\xe0\xcf\xcf\x09\x8d\x0b\x94\x1c\x71\x19\xd7\xb8\xe4\x84\x51\xff\xde\xf3\xcf\xd7\x1e\xd9\x79\xdb\x8a\x64\x69\x9c\x32\xb9\xea\x5d\x37\x37\xe1\xed\x4d\x78\x03\x4b\x38\xe3\x58\x8d\x7f\xd6\x43\x9a\x82\xf0\x75\xe3\xbf\xbd\xd2\x36\x6b\x54\x12\xb4\xcd\x30\x07\x53\x67\x35\x25\xff\x40\x72\xc7\x4a\xef\xe8\x11\xda\x5a\xc6\xb4\x06\xb5\x5e\xc0\xef\x67\xe3\xf3\x19\xe4\x9a\x46\xba\x02\x56\x0d\x0b\xb0\x97\x34\x62\x6a\xa1\x2f\x3c\x46\x49\x82\x39\x8f\x8f\xf8\x34\x52\x51\xab\x1c\x27\x25\x16\x73\xab\x82\x1d\x31\x1d\x2f\x70\x7e\x90\xf2\x31\x45\x39\x76\xad\x15\x25\xa9\x91\xc0\x4a\x66\x47\x32\xec\x32\x5c\xe2\xbd\x4e\x27\xad\xb2\xcc\xd4\xcf\xaa\x3d\xe4\x5c\x1c\xa6\x4b\x3a\x03\x5a\x91\x4f\xf6\x3d\xa0\x12\xcb\x50\x59\x45\xc5\x64\x55\xab\x12\xca\x05\xa2\x09\x8e\xc5\xa9\x50\x4e\x41\x26\x1d\x2b\xdf\x52\xbc\x43\x55\x26\xee\xfd\xe4\x7d\x98\xa1\x72\x8f\x7d\x99\x6e\x73\x33\x56\x95\x20\x8c\x72\xd2\x5a\x19\x26\x06\x65\x18\x04\xef\x6e\x7f\x7a\x7f\x93\xde\xdd\x8d\x0d\xd4\x45\x12\x93\x74\x12\x43\xb5\xa5\x70\x14\x8e\x85\x82\x09\x99\xd5\x04\xcf\xaf\xc4\xa8\x12\x0c\x7e\xb2\xb4\x4a\x84\x12\x53\x52\x4d\x87\x3b\x58\xa9\x89\x84\x30\x00\x19\x96\x9f\x4c\xe0\x4c\xe1\x3c\xac\xf6\xbf\x94\x95\x2e\x73\xfc\x80\xb3\xcc\x70\x44\x2d\x32\x9a\x49\x10\x3d\xf9\x4c\x08\x16\x68\x5b\xfe\x66\x24\x44\x68\x46\x28\xb6\x3c\x18\x80\x51\x88\x60\x8f\x85\x57\x15\x29\xe0\xc7\x0b\x4e\xa3\x1d\x2c\x21\x75\x66\x59\x06\x52\x1e\xaf\x52\xe6\x7d\x91\x93\x09\x0a\x12\x5c\x0a\xb2\x23\x09\x58\xe0\xbe\xa5\xbe\xe9\x47\xcd\xf8\xde\xa8\x3b\x3a\xbe\x8d\x29\x29\xe5\x7d\xdc\x01\xa4\xc0\x1f\x48\x5d\x0c\x33\x3c\x54\xa9\xba\x3c\x47\xcb\xf9\x55\x1a\xf8\x6b\x82\x0b\xe1\x48\x9d\xcb\xb9\x51\x16\xfd\xfc\x28\xfd\x0c\x0a\x2f\x12\x79\x11\x49\xfd\x68\xf0\x38\x00\x70\x42\x28\x19\x63\x45\xf8\x8b\xbc\x1a\xb0\x3b\x40\xd1\x9d\x09\x77\x18\xea\x06\xff\x98\x28\xf4\xb5\x69\xef\x90\x8c\xa2\x69\xa2\x31\xa8\x52\xcc\x05\xa1\x2a\x18\x29\xf8\x82\x20\x5f\x10\xe3\xff\x74\x54\x49\x7a\xe9\x21\x35\x8d\xf7\xe6\x8d\xb7\x45\xfc\xe0\x85\x51\x8e\x08\x0d\xf9\xc1\x5f\xc0\xef\xa8\xee\x9b\x81\xb0\xdd\x4e\x3a\x70\x01\x60\xf5\x49\x2f\x1c\x51\x6b\x2a\xde\xb3\x18\x95\xc9\x81\xd4\xd8\xae\x6b\xb3\x07\xb6\x67\xa1\x40\x65\xb8\x7f\xf6\x2f\xbe\x82\xaf\x72\xf1\xda\x83\x77\x7a\x0b\x7b\xe7\x60\x0b\x1c\xae\x38\x1c\xe1\xe7\xfe\x4d\xf9\x0c\xee\xea\xcd\x0c\xb1\x4b\x01\x17\xa0\xa2\x08\xc5\x5c\x08\x2f\x28\x93\x3c\x29\x89\x82\x90\x7e\x96\x02\x48\x0e\x1c\xae\x79\x64\xf2\xed\x87\x03\x85\x92\xfd\xf5\xd4\x12\x81\x91\x0d\x98\x24\x25\xa3\x39\xa6\x22\x06\x46\xc1\x9d\xf5\xb4\x2d\x63\xb5\x2c\x62\xa6\xad\x69\x4d\x85\x54\xd5\xe1\x23\x3c\xe7\x90\xa0\x07\x35\xf8\x07\x65\x95\xe3\x74\x6d\xe9\x6f\x55\x51\xa8\xbb\x36\xd2\xd1\xb1\x50\x26\x7a\x58\xff\x81\xb8\x90\x21\x99\xdc\x66\xf6\xca\xcc\xc2\xfa\x62\xb6\x64\xba\x7a\x54\xfe\xb9\x91\x61\x90\xaa\x31\x2c\x5a\x4d\x5b\xd1\x0d\xa0\x99\x70\x5f\x1b\xe1\xc6\xa5\xd5\xe8\x4d\xfa\x57\x3c\xd6\x28\xb2\x90\xf1\x3a\x10\x42\xa0\x13\xab\x56\xf5\x1c\xbb\xb3\xe9\x28\x85\xca\x5e\x4b\x27\xda\x23\x69\xa7\xfa\x83\x81\x55\x99\xcb\x81\xb0\x74\x9c\x51\xde\xa8\x69\xde\x7e\x85\xf7\x55\xc6\x14\x74\xcc\x4c\xa1\xcb\xa6\xd1\x93\xf8\x2c\x59\x8b\x67\x5a\xc4\x77\x06\x02\x36\x43\x5e\xab\x10\xbe\x4d\x97\x17\x6c\x0e\x82\xab\x36\x7b\x92\xbd\x60\x4e\xc9\xac\x5a\xea\x59\xf5\x92\x29\x2d\xb4\x1e\xa9\x4d\x72\x67\x4a\x6b\x2f\xb4\x66\xcf\xa8\x6f\xa0\xf8\xf0\x00\xc7\x93\xa3\x67\x78\xfc\xf0\x96\xfb\x56\xcf\x32\x90\xe3\x99\x4d\xb5\xc0\x7a\x00\x26\x9d\x9e\xf3\xbf\x93\x59\xb5\x36\x6d\x1e\x96\x4a\x8a\x25\xbd\xee\xa9\xc5\xef\xe7\x5c\xed\x85\x5e\x60\x6f\xd2\x15\xac\x1a\xb7\x34\xd6\x77\x82\x76\x4f\x5a\xe8\xae\x75\xb5\x05\xfa\x52\x39\x9a\xc5\x02\x91\xb2\x6f\x18\xe7\x9c\x30\xfa\xca\x8b\x76\x76\x35\x9a\x0b\xb6\xc7\xe2\x17\x40\x76\x52\x87\x65\x5b\x67\xf6\xa0\x8b\x28\x68\xe5\x56\x63\x91\x36\xa5\xde\x92\x45\xbb\xc1\xfd\xcf\x6e\x9b\x16\xac\x59\x56\xe5\x38\xe6\xe4\xd9\xe2\x89\x7e\x86\x2a\x9a\x1c\xe2\x6d\x06\x2c\x37\x4e\x71\x0d\xf8\xd0\xd5\x7e\x4c\x99\xe4\x4a\x7f\xbc\x11\x8c\x23\x9e\xa2\xdb\xf1\xa3\x63\x6c\x23\xbf\x7e\x9c\xad\x7d\x9b\xc6\x2d\xdd\xdd\xb7\x7d\xf1\x6e\x4a\xd5\x32\x0c\xe7\x09\xef\x16\x50\xe6\x7c\x20\x6d\xa2\xac\xf0\xf0\x7e\x6d\x56\x9b\x3d\x98\x10\x68\xcf\xad\xd0\xcb\x0a\xac\xc2\xa4\xf5\x99\xc6\x20\x1f\xb2\x01\xed\xb4\xe4\x13\x24\xc2\xdf\xf1\xa9\xfd\x2c\xa3\x86\x6b\x6c\x68\x81\x1e\x38\xa9\xc1\x7a\xcf\xea\x06\x6b\x7f\xe9\xce\xf2\x57\xd3\xcc\xbf\xba\xde\xdc\xab\xeb\x8d\x31\x09\xff\x81\x25\xa3\xbc\x70\xa1\xf0\xca\xe0\x2b\x2b\x51\x9b\x81\x3a\x9b\xaf\xb7\xde\x0c\x77\xe8\x1a\x2f\x07\x1f\x98\xae\x90\x1c\xed\x8d\xf2\x74\x7f\x7b\x17\xde\xdc\x99\x02\x09\xcb\x73\x22\x5a\xe4\x98\xf3\x07\x44\xf7\x1a\xf2\xfe\x87\xc7\x8f\x7f\xff\xfb\xd7\x9f\xbf\x3d\x7e\xf4\x9e\x3e\xf9\x51\xc5\xcb\x08\xae\x05\xca\xa2\x2d\xa1\x11\x64\x8e\x6a\xfa\xfc\xc9\xdf\xb4\x8d\x9a\x99\xc1\x8d\x3b\xba\xee\xdb\x0c\xe3\x22\x80\x92\x2b\x59\x08\xd3\x7c\xea\x69\x8d\xd5\x69\x23\x01\x20\xf0\x55\x9f\x61\x4a\x0c\x9b\x12\xc1\xca\x53\xd7\x7e\x2b\xb9\x78\x98\x77\xb4\x76\x72\x33\x47\x85\x5a\x47\xc3\x65\xdd\x52\x1b\x52\x51\x41\x3b\x74\x51\x4c\x83\x6d\x4d\x4c\x2d\xe6\xdc\x5c\x7d\x07\xf2\x6a\xc5\x6f\xc6\x15\x00\x00"
+
+func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildTemplateJsonTpl,
+		"data/aws-simple/build/template.json.tpl",
+	)
+}
+
+func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x54\x4d\x6f\x13\x31\x10\xbd\xe7\x57\x8c\x36\x54\xb4\x28\x5d\xd2\x1f\xd0\x1b\x12\x07\x24\xb8\x20\x2e\xa8\x5a\x39\xde\x49\x62\xea\xb5\x2d\x7b\xbc\x6d\x88\xf2\xdf\xf1\xc7\x3a\xbb\x4d\x22\x40\xa0\x26\x97\xe4\xcd\xf3\xcc\xf8\xcd\xf8\xcd\xe1\x23\x2a\xb4\x8c\xb0\x85\xd5\x0e\xbe\x10\xe9\x05\xb4\x1a\x94\x26\xc0\x56\x10\x74\x4c\x79\x26\xe5\x6e\x36\x33\x56\xf7\xa2\x45\x0b\x15\x7b\x72\x15\xec\x67\x10\x3e\x8c\x73\x74\xae\x79\xc4\x1d\xdc\x43\xf5\x66\xdf\x33\x5b\x87\x70\x33\xe2\x87\x2a\x11\x1d\x72\x8b\x74\x4e\x1c\xf1\x81\x48\xfa\x11\xd5\x4b\x4e\x82\x86\xb0\xc5\x8d\xd0\x27\xf1\x8c\x05\xc2\x61\x36\xb3\xe8\xb4\xb7\x1c\x53\x97\x31\xbb\xb7\x82\x76\xcd\xc6\x6a\x6f\x2a\xa8\xf6\x7b\x50\xac\x43\x38\x1c\xca\x0d\xd2\xdf\xfb\x69\x24\x27\x46\xd5\x0b\xab\x55\x87\x8a\x1a\xe7\xd7\x6b\xf1\x3c\x74\xd0\x1b\xde\x88\x76\xec\x20\xff\x0f\xc1\x14\xdd\x5f\x81\x58\x03\xb1\x8d\x83\xab\x43\xbe\x50\xfc\x9d\x6b\x0d\x84\xb5\xb6\x40\x20\x54\xa1\xc5\xda\x54\x7f\x0a\xd2\xc4\xb6\x72\x2f\x54\x7f\x63\xd2\xa7\x46\xa7\x47\x51\xb5\xf1\xf4\x90\xfa\x30\x1b\xe1\x50\x35\xa0\x41\x81\x39\x7c\xdd\x22\x18\x6d\xc9\x01\xb3\x08\xda\x84\x09\xb7\xf0\x24\x68\x1b\xa6\x60\x58\x1c\x36\x58\x2f\xd1\x2d\x40\x2b\x4c\xdd\x20\xe3\xdb\x74\x64\x01\x4e\x28\x8e\x31\x09\x5a\xcb\x42\xac\x83\x70\x49\xc1\x56\x81\x0f\x9c\xa9\xb7\x04\x2b\x04\x29\x1c\xb9\xfa\xb7\x62\x37\xb1\xc4\x0b\xc5\x6f\x85\xda\x84\x13\xc7\xdd\xe1\xda\x2b\xca\x3a\x4a\x54\x1b\xda\x5e\x3b\x23\x05\x5d\x57\x8b\x6a\x11\x8b\xd6\xe9\x0e\x37\x37\x65\x31\x76\x26\x0d\xaa\x64\x49\x60\x58\x4a\xd2\x5c\xcb\x18\x20\x6e\x32\xb8\xb6\xba\x6b\xe2\xe1\x9c\x1c\x25\xc6\x29\x5e\xce\xbe\xc8\x6d\xd4\x42\xb5\xf8\x7c\x2c\xa5\xff\xeb\x38\x17\xad\x6d\x56\x52\xf3\x47\x17\x52\x7c\xaf\x96\x75\xfa\xbe\x5f\x56\x0f\xe5\x2d\x4c\x85\x2a\xcb\x74\xae\x61\x3d\x8a\x57\xa7\x15\xfb\xc3\x82\x5f\xd0\x1c\x5f\x48\x5e\x34\xc4\xcb\x12\xde\xde\x9d\xe9\xb7\x3c\x11\x64\xf9\xfa\x37\x9c\xc3\x07\x34\x52\xef\x80\x85\x3c\x04\x7a\x1d\x9e\x8a\x23\x16\xd6\xd2\x9d\xdc\xbe\xe0\x17\x1f\xf6\x64\xbd\xe2\xbc\x0a\xb7\x49\xf8\x30\x29\xd6\x89\x89\x95\x74\x62\x80\x8f\xdc\xa2\xd7\x49\x8a\x08\x0f\xd4\x60\x5c\x4d\xb1\x90\xcc\x2a\xc8\x40\xf0\x0e\x6d\xd3\x32\x62\x23\xe3\x08\x15\x6f\xf4\x2b\x15\x3c\x70\x6a\x2a\x47\x68\x62\x3a\x67\xa2\x66\xed\xff\x46\xd6\x87\xa9\x39\xf5\x5a\xfa\x0e\x1b\x27\x7e\x62\x31\x12\xab\x35\xe5\x79\x36\x2d\xf6\x22\xe8\x3b\x1a\xd6\x94\x9e\xbd\x69\x8a\x14\x7f\x3a\xb7\xa2\x4b\xe6\xf7\xf9\xcc\x6c\xab\x57\x32\xc6\x50\x5f\x7b\x32\x9e\xa0\x32\x56\xf4\xc1\xf3\x1a\x61\xca\x6a\xf4\x29\x43\x12\xfb\x87\x16\x2a\x3f\xea\xe9\x42\x4d\xf5\x7b\x57\x8f\x09\x6e\xca\x86\x46\x8b\x75\xa2\x33\x12\x43\xbb\x6b\xcb\x1c\x59\xcf\xc9\x07\xbb\xdd\x32\x17\x6c\x55\xc6\xed\x35\x7e\x25\x05\x1f\xc6\x1b\x9c\x55\x03\x6d\x71\xb2\xcc\xf3\x68\xa8\xd1\x4e\x6d\xf4\xdf\xe0\xd1\xad\xb0\xc8\x49\xee\xea\x63\xef\xff\xde\x73\xaa\x7d\x6c\xf9\x17\x61\xd9\xb5\x7b\xe8\x07\x00\x00"
+
+func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployMainTfTpl,
+		"data/aws-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x93\x4f\x6f\xdb\x30\x0c\xc5\xef\xfe\x14\x84\x73\x6d\x8a\x2d\xa7\x5d\x76\x28\xb2\xc3\x82\x62\x43\xb1\xec\xcf\xd1\x60\x64\xba\x11\x6a\x4b\x06\x29\x25\x0b\x86\x7d\xf7\x51\x4a\xb6\xb5\x06\xd4\x6c\x40\x6a\xf8\x60\x98\x4f\xbf\x27\x51\x8f\xb3\xf9\x05\x9e\x6a\x06\x37\xc6\x90\x08\xac\x5c\xe7\xab\xd9\x45\x98\xd5\x0e\xd9\xe2\xa6\x27\xa8\x71\x2f\x0d\x66\x83\xe6\x81\x0e\x35\xfc\xa8\x40\x9f\x96\xc4\xb0\x1d\x83\xf5\x0e\xde\x42\x7d\xda\x81\x0a\xa0\xf3\x0c\x37\xdf\xd6\xf5\x49\xd6\x61\xec\x43\x92\xd4\xd5\xcf\x29\x56\xc8\x30\x85\x67\xb0\xeb\x2c\xf8\x5f\x6c\xf0\x0f\xe4\x8a\x44\x91\xf4\x99\x35\x19\x1a\x68\x18\x3d\x23\x1f\x12\x1e\xd4\xaf\x25\x17\x2c\xf6\xf2\x2f\x56\x4c\xf7\x4a\x2b\x78\x7d\xca\x45\xd8\x6f\x89\x09\xf6\xfa\xda\xbe\x07\x3f\x12\x63\xa0\xeb\x0c\x9b\x5d\x28\x00\xef\x68\xec\xfd\xe1\xa5\x02\x30\xd8\xd2\xad\x7f\x58\x69\x23\xf5\x6f\x72\x9f\x74\xc7\x3a\x09\xe8\x0c\x35\xe1\x30\x52\x61\xfd\xea\xa4\x81\xac\x99\xb6\x3b\x2c\xae\x07\x6b\xd8\x97\xc0\xc6\x47\x17\x0a\xe4\x8f\x71\xd8\x10\x83\xef\xe0\xb7\x5c\x1e\xef\x74\xe2\xf4\x7a\x62\x41\x6e\x67\xd9\xbb\x41\x83\xd0\x48\xec\x3a\xfb\xbd\x94\xa6\x5c\x3c\xc6\x68\x4b\xe0\x70\x50\x1f\x35\x65\x12\x1f\x39\x99\x5a\x07\xe8\xe0\x11\xf0\x5c\xaa\x34\xeb\x4d\xe2\x14\x1c\x6f\x75\x14\x46\xb4\x0c\xf7\x8c\x2e\x50\x0b\xeb\xf5\x7b\x38\x8e\x67\x3a\x60\xda\xc5\x9f\x13\x9f\xb3\x8a\x42\xdc\xb4\x18\xb0\xe0\xf5\x45\xeb\x90\xea\xe9\x48\x4f\xc8\x57\x20\xd1\x6c\x01\x05\x10\x4c\xef\x63\x3b\xb7\xce\x06\x38\xae\x3e\x67\x2b\x71\xe3\x74\xea\x6d\x5b\x6c\x6a\xaa\xff\xbd\x2e\xb5\x0d\xd3\x10\xec\x46\x53\x06\x7c\xbd\x5b\x3e\xbf\x5a\xe7\x3d\x48\x61\xf1\xd2\x0f\x03\xce\x85\x46\x4c\x63\xda\xc2\xe7\xe5\x1d\x64\x7d\x42\xea\xf0\xba\xf3\x6d\x5e\x2c\xae\xde\xbc\x4a\x96\xbf\x00\xb1\xa1\xc0\xbc\xe0\x05\x00\x00"
+
+func dataAwsSimpleDeployVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployVariablesTf,
+		"data/aws-simple/deploy/variables.tf",
+	)
+}
+
+func dataAwsSimpleDeployVariablesTf() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployBluegreenMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xed\x58\x4b\x6f\x1b\x37\x10\xbe\xeb\x57\x10\xeb\x18\x8d\x0a\x79\xad\xd4\x28\x10\x18\xf0\x21\x70\x8b\x36\x28\x92\xfa\x60\xf4\x52\x04\x0b\x8a\x3b\x92\x58\x51\xe4\x96\xe4\xca\x91\x65\xfd\xf7\xce\x90\xdc\x87\x1e\x7e\x04\x45\xda\x1e\xea\xc0\x88\x34\x33\xfc\x38\x9c\xf9\x38\x33\xf4\x09\xfb\x09\x34\x58\xee\xa1\x64\x93\x35\xfb\xd5\x7b\x33\x62\xa5\x61\xda\x78\x06\xa5\xf4\x6c\xc9\x75\xcd\x95\x5a\x0f\x4e\x06\x27\xec\x76\x2e\x1d\x2b\xa1\x52\x66\xed\xd8\x9d\xf4\x73\xe6\xe7\xc0\x26\xaa\x86\xb3\x99\x05\xd0\xcc\x79\x82\x9a\xad\x73\x34\x05\x0b\x8c\xe3\xaf\xbf\x33\xcc\x81\x77\xcc\x4c\x11\x42\x6a\xe7\xb9\x16\xe0\x46\x61\x1d\xe3\xba\x64\x61\xed\x28\x7c\x24\x3c\x65\x38\x3a\xc3\x15\x99\x59\x5c\xaa\x4b\xc7\x10\x77\x3a\x95\x82\x79\x43\x26\x88\xc3\x85\x97\x2b\x60\x46\x43\x1e\xbc\x66\x13\x2b\xf5\xcc\xb1\xba\x0a\x18\x52\x27\x03\xdc\xb9\xf3\x54\xc3\x1d\x7b\xf7\xe1\xfd\x88\xdd\x71\x89\x0e\x4d\x8d\x25\x8f\x3c\xa1\x4e\x80\xcd\x81\x2b\x3f\x5f\x8f\xf0\xcc\x0b\x70\x24\x8f\x18\xad\x67\x78\x3e\xc1\x15\xaa\x08\xcb\xa8\x32\x80\xe3\xda\x7b\xb0\x26\x1f\x0c\x2a\x6b\x56\xb2\x44\x97\x33\x7e\xe7\x32\xb6\x19\x30\xfc\xe1\x02\xcf\xea\x8a\x05\xac\xd9\x15\xcb\x5e\x6d\x56\xdc\xe6\xa8\x2e\x3a\xf9\x36\x0b\x86\x0e\x84\x05\x7f\x68\xd8\xc9\x93\xa1\x37\x0b\xf4\x64\xc7\x26\x88\x92\xda\xc2\x4c\x9a\x3d\x7d\x94\xa1\xc1\x76\x10\xb2\x08\xac\x32\xd6\x53\x2a\xa7\xbc\x56\x3e\x45\x35\x08\x5f\x90\x81\x11\x73\x06\x61\xc8\x10\x37\x90\x7c\xa2\x30\xde\x04\x26\x14\xe6\xbb\x64\x21\xf3\xc8\x03\xfc\x1f\x8d\xb8\xc6\x64\xb4\x86\x2e\xf7\xd3\x9c\xbd\x9f\xb6\xfb\x39\xca\xa5\x0d\x79\x1a\x91\x70\xcd\x96\xb5\xf3\xb8\x44\xa8\xba\x44\x5c\x9f\x0f\xda\x4d\xb2\xb0\xa0\x89\x6c\x09\x4e\x58\x59\xf9\x74\xda\x6b\xb3\x5c\xf2\x33\x07\x15\x8f\x6c\xbe\xbd\xbe\x49\xa7\xc4\xd3\x99\x0a\x43\x96\x4e\xd9\x32\x30\x4b\x30\x31\x06\x08\xb1\xd9\x24\x0e\x14\x62\x0e\x62\x91\xdf\xe0\xf2\x87\xa4\xbf\x7c\x3b\x66\xdb\x18\x41\x0b\xce\xd4\x56\x40\xc8\x33\xe5\xa7\xb6\xd2\xaf\x8b\x99\x35\x75\x95\x05\x14\xcd\x97\x40\xd6\xc9\xd3\xf0\xf5\xaa\xaf\x39\x23\xee\x07\xda\xc7\x24\x81\x5e\x49\x6b\xf4\x12\xb4\x2f\x5c\x8d\x71\xfe\x9c\xb2\xb9\xaa\x44\x21\xcb\x2e\x9b\xf1\x3b\x2a\x83\x76\x73\xca\x24\x86\x92\x23\xef\x4f\xb7\x91\x1c\xf4\x39\xee\x9a\x0c\x90\xe4\x8c\xe2\xd9\x98\x91\x17\x3e\xff\x05\x03\x4d\x0e\x46\xaf\x7c\xfe\x1b\xa7\xcb\xb8\x4d\xbb\xa6\xa5\x98\x77\x5a\x9d\xa0\xb7\x83\x4e\x8c\xbb\xa2\x74\x8f\x4d\x94\x46\x0a\x34\x06\x3f\xdc\xb8\x26\x17\xcc\xd6\x8a\xee\x3b\xde\xd5\xe0\x0d\x70\x31\x0f\x4b\x90\x48\x98\x67\xba\xcd\xb7\x60\x91\x5e\xc6\x2e\x3b\xa2\x30\xc1\xf5\x37\x9e\xee\xa5\x92\xce\xbb\xfc\xc9\xb0\x17\xb4\xc5\x4e\xec\xcf\xb0\x1a\xe0\x8a\x96\x2d\xc2\xd4\xda\xc7\x38\x2a\xd0\x33\x3f\x7f\xed\x2a\x25\xfd\xeb\x6c\x94\x8d\x68\xd3\x3c\x9c\x61\x38\x6c\x2e\xd9\xba\x0a\x29\x6b\x50\x82\x10\x2f\xb8\x37\xc2\x28\x52\x78\x51\x45\xe1\xd4\x9a\x65\x11\x6e\x4e\x00\x07\x05\x94\xc5\xe3\xe8\xa3\xe8\x46\x2e\x75\x09\x9f\xdb\xad\xcc\xdf\x5a\x2e\x64\x69\x8b\x89\x32\x62\xe1\x10\xe2\xf7\x6c\x9c\x87\x7f\xe7\xe3\xec\x53\x53\x57\xfa\x81\x6a\xc8\x74\x18\xc3\xbc\x0b\x5e\x1e\x28\xf6\x0c\xd5\x8f\xc4\x1c\x76\x42\xde\xc4\x10\x8e\x87\xf0\xec\xcd\x41\xfc\xc6\x7b\x01\x19\xff\xd3\x27\x6c\x6a\x03\x1e\x8b\x6e\xe8\x11\xf2\x50\x36\x48\x55\x04\x59\xca\x01\x5f\xca\x3d\x2d\x4a\x92\xae\x81\x2c\x9a\x70\x44\xab\x1d\x71\x32\xc5\x1a\x5f\x34\xb5\x22\x5a\x35\x92\x64\x50\x3b\xb0\x45\xc9\x3d\xef\x2c\x5a\x51\xd3\x46\xea\x89\xc6\x76\xd1\xaf\x19\xad\xa8\xf1\xd6\x39\x23\x24\x5e\xcc\xa2\xaa\x27\x4a\x62\x41\xa9\x0a\x5e\x96\x94\x24\x5c\xe4\x6d\x0d\x6d\xe9\x39\x08\x6d\xcc\xc0\x4b\x82\xfb\xa9\x5f\xa2\x56\x46\xd5\x4b\x28\x9c\xbc\x87\xa6\x9c\x58\x63\x7c\xcc\x6a\x51\xc2\x4a\x62\x06\xba\xb2\xd5\x37\x8f\x15\xaa\x2f\x69\xaa\xd4\x61\x41\x3a\x56\x02\x3f\x1e\x2f\xbe\xd9\x57\xaa\x91\x4f\x51\x2a\x14\xfc\x47\x38\x15\x74\x8f\x93\x2a\xaa\xff\x67\xd5\x7f\x98\x55\x31\xbb\x5f\x8f\x56\xb1\xd5\x3e\x3f\x1c\x77\x03\x0e\x4e\xdc\x41\x90\x46\x61\x2c\xbb\xc6\xe6\x3b\xdd\x76\xce\x1d\x8e\xfa\xa8\xc1\xb3\xd2\x20\xc5\x95\xa3\xe9\x6e\x07\x86\xbd\xff\x21\x20\x85\xb6\x1d\x30\xa8\xd3\x23\xcc\x1f\x46\x52\xaf\x6f\xc6\xf6\x6e\x22\xa7\x69\xb0\x92\x62\x81\x4a\x53\x7b\x7a\x57\x84\x8e\xb5\xdf\xc2\x41\x4d\x5e\x38\x2e\x3d\x33\x24\x45\x2a\x36\x24\xda\x23\xe7\xb1\x1e\xf1\xc5\x7c\xa3\x11\x84\xde\x49\x3d\x06\xb4\x57\x2d\xf5\xaa\xe7\x87\xc7\x23\x4b\x7b\x03\xc5\xdc\xfb\xaa\xa3\x80\x9a\x34\xb8\x6f\xc7\x3b\xc2\xa3\x2b\x12\x47\xfb\xfb\xf7\x3c\x4d\xef\x9a\xc2\xcf\x31\xfe\x73\x7a\xb7\x5c\xb1\xef\x5a\x6d\xad\x9f\xd6\x7b\xb9\x04\xca\xe2\x15\xbb\xe8\x64\xdc\xce\x20\x14\xaf\x9f\x6f\x6f\x6f\x2e\x9f\x3f\xfa\x81\x05\xbe\x0e\x5a\x8b\xec\x3c\xdb\xa1\xbf\xd4\x1e\xec\x8a\xd3\x19\xdf\x8c\xfb\xe7\xeb\x88\x1d\xd3\xd7\x1b\x92\xf6\xe6\xa6\x07\x14\x11\xc1\x39\x7e\x3e\x75\x0f\xa7\x0e\xbf\x13\x5d\xa3\x71\xbf\x2c\x87\x76\x9d\x7f\x8b\xc9\x1e\x3e\x6a\x12\x6e\x76\xb4\x19\x0e\xe3\x40\x16\xc9\x5e\xc4\x49\x6c\xb8\x57\x97\xfe\xcd\x81\x1c\x53\x55\x61\xb6\xb2\xda\xaa\xe6\x3e\xad\x02\x54\x22\xcc\xe5\xf9\x79\xe4\x3d\xde\xbe\x3e\xd9\x4b\xed\x62\x4f\x38\xcf\xfa\x30\x61\x98\x91\xd5\x01\xd4\xab\xcd\xd3\xe1\x6c\x7b\xc0\x70\xbb\x83\x17\xfb\xd8\x97\x00\x36\xc1\xdf\x47\x8c\xa1\x2e\xcd\x92\x63\x28\xf1\xec\xdd\x2b\x31\xca\x70\x87\x43\x61\x71\x8f\xe5\x09\xcb\x42\x50\x62\x09\xbb\xc1\x3d\xe3\x93\x37\x01\x35\x15\xb3\x42\x1e\x09\x1e\xde\x96\xfc\xc8\x9b\x38\x14\x49\xe9\x11\x61\x6a\x94\x32\x77\xee\xa0\xce\x86\x27\x10\xdd\x1b\x81\x2f\xe0\x19\x3e\x23\xf6\xab\x1f\x56\x1b\x0f\xdf\x5f\xe0\xdb\x5c\x18\x8b\x0e\x75\x6e\x87\xc0\x24\x47\xbb\x7e\xbb\x7b\x80\xc4\x8b\xdd\xbe\x1e\x4d\xf6\x5e\x30\xd7\x1f\xdf\x7d\xf8\x31\x89\x7c\xa8\x1b\x17\xe3\x71\xf3\xa7\x02\xda\xba\x5f\x0c\x1f\x23\x05\xf2\xbb\x97\xc4\x5d\x4f\x7b\x29\x3c\x3c\x57\xf2\x29\x9f\xfe\x59\xc6\xbf\x3f\xf4\xe9\xfa\x17\xec\x20\x62\xe9\x7d\x12\x00\x00"
+
+func dataAwsSimpleDeployBluegreenMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployBluegreenMainTfTpl,
+		"data/aws-simple/deploy-bluegreen/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployBluegreenMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployBluegreenMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy-bluegreen/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployBluegreenVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x95\xdf\x6b\xdb\x30\x10\xc7\xdf\xfd\x57\x1c\xce\x4b\x07\x4d\x69\xf7\x38\xd8\x43\xb7\x42\x17\x46\xdb\xb1\xb0\xed\xd1\x9c\xe5\x73\x22\x6a\x4b\x46\x3a\x25\x35\x63\xff\xfb\x24\x39\x29\x9b\x1b\x25\x29\xa4\xc2\x0f\x86\x3b\x7d\xbe\xf7\xd3\x9e\x4c\x4f\x70\xb2\x09\x5c\x0b\x41\xd6\xc2\x4c\xd5\x3a\x9b\x9c\x84\x99\xad\xd0\x48\x2c\x1b\x82\x1c\xd7\xb6\xc0\x28\x50\x3c\x52\x9f\xc3\xef\x0c\xfc\xa9\xc8\x0a\x23\x3b\x96\x5a\xc1\x47\xc8\x37\x11\x78\x07\xa8\xb5\x81\xeb\x5f\xf3\x7c\xe3\x56\xa3\x6b\x38\xb8\xe4\xd9\x9f\x31\xd6\x92\x30\xc4\x7b\xb0\xf3\xe8\xf0\x5a\x2c\xeb\x47\x52\x49\xa2\xb5\xe1\x35\xfa\x44\x28\x53\xdb\x69\x83\xa6\x0f\x78\xf0\x7a\x15\x29\x96\xd8\xd8\x63\xa4\x0c\x2d\x3c\x2d\xa1\xf5\x3d\x1a\x61\xbd\x24\x43\xb0\xf6\x8f\x6c\x1a\xd0\x1d\x19\x64\xba\x88\xb0\xc9\x89\x06\xe0\x86\xba\x46\xf7\x6f\x34\x00\x52\x59\x46\x25\xa8\xe0\xbe\xa3\x44\xaa\xb3\x8d\x0f\x44\x9f\x71\xe1\xf8\xfd\x45\x2b\x85\xd1\xa3\x02\xfa\xb6\x16\x0a\xdb\x14\xf3\xab\xef\x7a\x87\xd2\xc0\xc2\xa0\x62\xaa\x60\x3e\xff\x02\xc3\x24\xfa\xf6\x01\x2f\x09\xb6\xa1\x1d\xec\x95\xb3\x64\x8a\x0a\x19\x13\x5a\x3f\xbc\x1d\x82\x1d\x74\xfd\x3f\xf9\x1c\xac\x13\x4b\x40\x0b\x08\xa2\xd1\xae\x9a\x4a\x25\x19\x86\xdb\x87\x64\xad\x2b\x95\x1f\x70\x59\xa5\xa6\x31\xda\x43\x36\xd5\xd0\x41\xa9\x78\x5c\xa5\x55\x27\xd2\x80\x9f\xdf\x3e\xef\xbf\xfd\xdc\x3c\xa1\x9d\xe2\x04\xe5\xde\xb5\xa5\xcf\xdf\xa7\xfe\x9c\xf6\xb6\x0e\x28\x58\xae\x08\x84\x6e\xb4\x79\x91\xed\xd5\x48\x8c\xd4\x4a\x1a\xad\x5a\xbf\x40\x85\x75\x75\x2d\x9f\x92\x79\x07\xe3\xb0\x7e\x5e\x24\x0c\x41\x54\x34\x64\xb5\x33\x41\x5e\x2a\x40\x05\xff\x00\x77\x97\xfa\x54\x0b\xf4\xa9\x71\x34\xbd\x35\xe4\xbf\x09\x61\x89\xe0\xcc\xfa\xb6\x94\x3d\x3c\x30\xeb\x77\x6f\xf0\x4d\x8d\x55\x2d\xa4\xaa\xe8\x29\xb9\x51\xde\xb6\xab\x0b\x1f\xe0\x32\x16\xae\xf4\x21\x9f\xc3\x55\x7c\x5f\x84\xc8\x5f\x54\xe8\x72\xd4\x9e\x70\xe3\xc8\x39\x08\xae\x7b\xb6\x6b\x27\x19\x5b\x99\xfa\x3b\xdc\xcd\xb6\x99\x1c\x00\x8f\xb8\x31\xaf\x23\x43\x8e\xbe\xaf\x88\x79\x60\x1f\x15\xf4\x21\x74\x20\xff\x05\x7f\xc4\xb3\xab\xcc\x07\x00\x00"
+
+func dataAwsSimpleDeployBluegreenVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployBluegreenVariablesTf,
+		"data/aws-simple/deploy-bluegreen/variables.tf",
+	)
+}
+
+func dataAwsSimpleDeployBluegreenVariablesTf() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployBluegreenVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy-bluegreen/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateBuildBuildGoShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x95\x56\x6d\x4f\xdc\x38\x10\xfe\x9e\x5f\x31\x04\x38\xb5\x12\x49\x8e\x5e\xe9\x07\x5a\xd0\x71\x65\x8f\xe3\xc3\x15\x04\xb4\x3a\x09\x21\xe4\x4d\x66\xb3\x2e\x89\x9d\xda\xce\xbe\x40\xf7\xbf\xdf\x8c\x9d\x5d\xc2\x42\x2b\x15\x21\xed\xae\x67\xe6\xf1\xcc\x33\x6f\xde\xdc\xc8\x86\x52\x65\x43\x61\xc7\xd1\x66\xb4\x09\x47\xad\xd3\x49\x89\x0a\x8d\x70\x58\xc0\x70\x0e\x67\xce\xe9\xd4\xcb\xae\xc6\xd2\x02\xfd\xbb\x31\xc2\xb0\x95\x55\x01\x36\x37\xb2\x71\x30\xd2\x06\x0a\x6c\x2a\x3d\x97\xaa\x04\x01\x27\x3a\x21\x40\x32\x6f\x8c\xfe\x8a\xb9\x4b\x23\x8b\x0e\x12\x8c\xa2\x87\x6d\x90\xa3\x60\x7c\x5b\xe8\xfc\x0e\x0d\x6c\x2f\x3c\x34\xc2\x71\xf8\x2d\x6b\x51\x22\x5f\xc3\x5a\x0e\xa4\x22\xc0\x5c\x2b\x27\x24\x39\x05\x53\xe9\xc6\xba\x75\x60\xe7\xb6\xd2\xe5\x0e\x58\xed\xdd\xa1\xa3\xa6\x75\x04\x44\x76\x85\xb4\xb9\x30\x05\x5d\x4f\x12\x83\x69\x44\x37\x5e\x43\x72\x09\x59\x81\x93\x8c\xac\xe0\xe6\x3d\x8b\x54\x04\xf4\xa7\xf1\xd5\x6b\x78\x80\xad\x3f\xe1\xcd\xe1\x6f\xbb\xf0\x1d\x48\xa1\xa4\x8b\x12\x07\x9a\x22\x87\xc3\x60\xa6\xda\xaa\x7a\x0f\x8b\x08\x2b\x8b\x6b\x76\x3d\x0d\x8f\xc1\x6a\x23\xc9\xa1\xb2\x32\xc7\xf7\x8b\x77\xb0\xa5\x2a\xc8\x6b\x36\xad\xbc\x29\xe6\x63\x0d\xf1\x35\x6b\xdf\x10\x4e\xcc\x6a\x4f\xc8\xd4\xa3\x51\x45\x04\x05\x36\xff\xe2\x23\x4e\x45\x77\xba\x0f\xe7\xc2\x73\xdb\x52\x8e\x04\x33\x73\xa2\x61\x64\x74\x1d\xb8\xeb\x4c\x0b\x69\x28\x57\xda\xcc\x9f\xb8\x5e\x41\x7c\xac\xa7\x8a\xed\x18\x91\x0c\x1f\x1e\x28\xd9\x93\xdb\x52\xdf\x4e\xd0\x58\xa9\x15\x2c\x16\x69\x9a\xc6\x14\x26\x4c\x4b\x4e\xf4\x37\x48\xce\x20\x73\x75\x93\x95\x3a\x75\xc2\xa4\xe5\x3d\x8c\x9d\x6b\xec\x7e\x96\x59\xba\x81\x12\x9c\x96\x5a\x97\x15\x8a\x46\xda\x34\xd7\x35\x29\x56\x42\x95\xf4\xf1\x22\x3a\xf9\xd7\xce\x12\x51\x17\xef\xde\x76\x78\x4f\x48\xf2\x5e\x7e\xa6\x12\x31\x26\xf8\xb8\x74\xc7\xb6\x05\xd5\x87\x20\xa6\x3f\x42\xd6\x5a\x43\xd9\xcf\x45\x05\xc9\xec\x7e\xb4\xe6\x5f\x14\xe1\xac\xd1\xc6\xc1\xc9\xd9\xf9\xd1\xd5\x3f\x07\x99\x6e\x1c\x49\x1b\xe1\xc6\x4b\x89\x3f\xdf\x0a\x72\x6e\x9a\xfd\x47\x44\xd2\xf4\x27\x5b\x2c\x5b\x26\x46\xd6\x6c\x76\xcb\x10\xb0\x71\x00\x71\xcc\xae\x1e\x9d\x9f\xdf\x1e\x9f\x5e\x1c\xc4\x4b\x20\x6b\xf2\x8c\x62\xee\x2b\x2f\x16\x71\x3f\x05\x3f\x32\x51\xa2\xc6\x95\xee\x8a\x8a\x70\xb7\xd2\xee\x79\x61\x30\x4b\xa7\xca\x3a\x51\x55\x4c\xd3\x97\x8f\x97\xd6\xb7\x6e\xa9\x81\xd2\xe6\x39\xdb\x84\x64\x00\x77\x88\x8d\x05\xa1\xe6\xdc\xbf\xb3\x39\x50\xf3\x3a\x32\xb0\x8f\x25\x83\x6a\x22\x8d\x56\x35\xaa\xd0\xfc\xa2\x71\x34\x34\xdc\x8a\x72\x02\xe9\x8e\xa8\xe4\x0a\x9a\x24\x90\xcc\x5f\x12\xca\xe0\x0d\x49\xa1\x94\xe4\xf1\xbd\x81\x1a\x4d\xde\x1a\x29\xaa\xe7\x19\x1e\xcc\x9c\x11\xb9\xf3\x33\xa6\x69\xbc\xbf\x1e\xb0\xbe\xa3\xd2\x85\xa4\x81\xad\x8e\xaa\x70\x4c\x2d\x33\x55\x90\x5c\xc0\xd6\xab\xe9\x58\x8b\x5a\xbe\x86\x8e\xc1\xc8\x97\xc4\xaa\x08\xb8\xab\x12\x46\x74\x54\xa7\x54\x29\x2b\x98\xbc\x78\xfc\xfe\xa4\xdb\x28\xfe\xc7\xb9\x15\x46\x61\x9f\x12\x1a\x42\x3c\xf0\x68\x78\x86\xbe\x4b\xe1\x4c\x55\x73\xcf\x1c\x27\x8d\xb8\x35\xcb\x91\xb5\x43\x20\x56\xaa\x1c\xbd\x74\x22\xaa\x96\xc4\xb5\x98\xc3\x90\xd8\xc2\xdc\xa0\xb3\xa9\x0f\x7e\xd5\xd3\x3c\x01\xfb\xb7\xed\x73\x43\xae\xdc\xfa\xfe\x55\x53\x1d\xc6\x3b\x10\x2f\x4b\x83\xf3\x73\xc7\x63\xb4\xef\x7a\x57\xd2\x64\x79\x47\x7a\x1d\xd5\xac\xb9\xbd\x78\xa9\x9c\x26\x74\xa0\xbb\x51\x7d\x8c\x0d\xfd\x42\x95\xcb\x2e\x90\x20\xc4\xc2\x0f\xe3\xd6\xfa\x48\x6a\xa0\xfd\x41\xd3\x97\xbe\x0b\x05\x23\x74\xf9\x98\x7d\x67\xc9\x63\xa3\xed\xee\x7d\x19\x7c\x3a\x3e\xbb\x18\xfc\x77\x3e\xb8\x38\xfd\x77\xf0\xe9\xea\x60\x77\x7d\xf6\x9c\x84\xda\xe3\xf5\xb2\xba\xd5\x67\x3e\x14\x2d\x24\x05\x24\x13\x48\x33\x3a\x7b\xc9\xf1\x10\xf3\x84\x14\x3b\xbc\x8b\x56\x29\xc6\x23\xf3\x49\x57\xf3\xa4\xb6\xd1\xfd\x0e\x40\xfd\xed\x40\x26\x9d\xc8\x20\xbb\x1d\x96\xda\xb0\xc2\xda\x6e\xf4\xb2\x3f\x15\x94\x72\xa7\x9b\x86\xe4\x61\x25\xcd\x41\x21\x4d\xb0\x78\x05\x63\x50\xe4\x63\xda\x65\x61\x51\x0a\x82\x20\xf6\x9c\x1c\x51\x45\xa7\xf0\xb7\x9c\x05\xda\x84\x2a\x3a\x48\x51\xd2\xc6\x4b\x83\x3d\xce\xa8\x3b\x76\x97\x5b\xe5\xc5\x18\x1d\xda\x67\x41\xf2\x99\x7d\x12\xa3\xd7\x7a\x29\xc8\x2b\x56\x85\x91\x90\x15\x16\x3f\x09\x4c\xc0\xd0\xe8\x3b\xec\x8a\x69\x3d\xc4\x21\xd2\x28\xe7\xaa\xf8\x69\x90\xc1\xad\x5f\x8b\xb4\xdf\x01\xcb\xf4\x07\xe3\x44\x87\x26\x7e\x1c\x88\xdd\x50\x98\xac\x9f\xf7\x36\x80\x7f\xf3\xf4\x2c\xd6\x47\x23\x7b\x69\xd1\x4c\x64\x8e\xfe\xb6\x5c\x38\xf8\xf0\xe1\xf3\xf9\xe5\xd5\xd1\xc5\x15\xed\xef\xb0\x57\x10\x21\xa3\xca\xce\xa4\x92\xae\x87\x46\xfb\x4c\x8d\xfa\x3b\x3d\x2a\x30\x3c\x94\x78\x9b\xc5\x3d\x87\x12\x38\x59\x7f\x69\xc5\x51\x64\xd0\x36\x62\xaa\x96\x9f\x50\xc9\x9a\x39\xd9\x83\xbd\x28\x22\x0f\xa9\x75\x08\xc6\xb4\xaa\x22\xf2\x2b\xb8\x7e\xf3\xc7\xdb\xbd\x9b\x88\x73\xf4\xf4\xfc\xf7\x77\x37\xa4\xef\xef\x25\x66\x7f\x18\x3b\x1c\x1e\x66\x13\xc1\xb2\xb2\x1f\x03\x3f\x93\xf8\xb1\x12\x51\x0e\xba\x67\x5e\xd4\xc5\x1f\xd8\x22\x5e\x0a\xad\x70\x23\x8e\xfe\x07\x7f\x27\x13\xaa\x43\x0a\x00\x00"
+
+func dataAwsVpcPublicPrivateBuildBuildGoShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateBuildBuildGoShTpl,
+		"data/aws-vpc-public-private/build/build-go.sh.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateBuildBuildGoShTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateBuildBuildGoShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/build/build-go.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x58\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\x21\x08\x48\x9f\x2a\x29\x69\x83\x61\x08\x90\xa7\xa1\x03\x86\x0d\xd9\x30\x14\x03\x86\xd4\x50\x69\x89\xb6\x09\x4b\xa4\x20\x52\x6a\x1d\x57\xff\x7b\x8f\xa4\x7e\x90\x12\x25\x39\xc1\xba\xbc\xc4\x24\xef\x8e\x77\xc7\x8f\xc7\xef\x74\xbe\xf2\xe0\xcf\xcf\x09\x8d\x0b\x94\x1c\x71\x19\xd7\xb8\xe4\x84\x51\xff\xde\xf3\xcf\xd7\x1e\xd9\x79\xdb\x8a\x64\x69\x9c\x32\xb9\xea\x5d\x37\x37\xe1\xed\x4d\x78\x03\x4b\x38\xe3\x58\x8d\x7f\xd6\x43\x9a\x82\xf0\x75\xe3\xbf\xbd\xd2\x36\x6b\x54\x12\xb4\xcd\x30\x07\x53\x67\x35\x25\xff\x40\x72\xc7\x4a\xef\xe8\x11\xda\x5a\xc6\xb4\x06\xb5\x5e\xc0\xef\x67\xe3\xf3\x19\xe4\x9a\x46\xba\x02\x56\x0d\x0b\xb0\x97\x34\x62\x6a\xa1\x2f\x3c\x46\x49\x82\x39\x8f\x8f\xf8\x34\x52\x51\xab\x1c\x27\x25\x16\x73\xab\x82\x1d\x31\x1d\x2f\x70\x7e\x90\xf2\x31\x45\x39\x76\xad\x15\x25\xa9\x91\xc0\x4a\x66\x47\x32\xec\x32\x5c\xe2\xbd\x4e\x27\xad\xb2\xcc\xd4\xcf\xaa\x3d\xe4\x5c\x1c\xa6\x4b\x3a\x03\x5a\x91\x4f\xf6\x3d\xa0\x12\xcb\x50\x59\x45\xc5\x64\x55\xab\x12\xca\x05\xa2\x09\x8e\xc5\xa9\x50\x4e\x41\x26\x1d\x2b\xdf\x52\xbc\x43\x55\x26\xee\xfd\xe4\x7d\x98\xa1\x72\x8f\x7d\x99\x6e\x73\x33\x56\x95\x20\x8c\x72\xd2\x5a\x19\x26\x06\x65\x18\x04\xef\x6e\x7f\x7a\x7f\x93\xde\xdd\x8d\x0d\xd4\x45\x12\x93\x74\x12\x43\xb5\xa5\x70\x14\x8e\x85\x82\x09\x99\xd5\x04\xcf\xaf\xc4\xa8\x12\x0c\x7e\xb2\xb4\x4a\x84\x12\x53\x52\x4d\x87\x3b\x58\xa9\x89\x84\x30\x00\x19\x96\x9f\x4c\xe0\x4c\xe1\x3c\xac\xf6\xbf\x94\x95\x2e\x73\xfc\x80\xb3\xcc\x70\x44\x2d\x32\x9a\x49\x10\x3d\xf9\x4c\x08\x16\x68\x5b\xfe\x66\x24\x44\x68\x46\x28\xb6\x3c\x18\x80\x51\x88\x60\x8f\x85\x57\x15\x29\xe0\xc7\x0b\x4e\xa3\x1d\x2c\x21\x75\x66\x59\x06\x52\x1e\xaf\x52\xe6\x7d\x91\x93\x09\x0a\x12\x5c\x0a\xb2\x23\x09\x58\xe0\xbe\xa5\xbe\xe9\x47\xcd\xf8\xde\xa8\x3b\x3a\xbe\x8d\x29\x29\xe5\x7d\xdc\x01\xa4\xc0\x1f\x48\x5d\x0c\x33\x3c\x54\xa9\xba\x3c\x47\xcb\xf9\x55\x1a\xf8\x6b\x82\x0b\xe1\x48\x9d\xcb\xb9\x51\x16\xfd\xfc\x28\xfd\x0c\x0a\x2f\x12\x79\x11\x49\xfd\x68\xf0\x38\x00\x70\x42\x28\x19\x63\x45\xf8\x8b\xbc\x1a\xb0\x3b\x40\xd1\x9d\x09\x77\x18\xea\x06\xff\x98\x28\xf4\xb5\x69\xef\x90\x8c\xa2\x69\xa2\x31\xa8\x52\xcc\x05\xa1\x2a\x18\x29\xf8\x82\x20\x5f\x10\xe3\xff\x74\x54\x49\x7a\xe9\x21\x35\x8d\xf7\xe6\x8d\xb7\x45\xfc\xe0\x85\x51\x8e\x08\x0d\xf9\xc1\x5f\xc0\xef\xa8\xee\x9b\x81\xb0\xdd\x4e\x3a\x70\x01\x60\xf5\x49\x2f\x1c\x51\x6b\x2a\xde\xb3\x18\x95\xc9\x81\xd4\xd8\xae\x6b\xb3\x07\xb6\x67\xa1\x40\x65\xb8\x7f\xf6\x2f\xbe\x82\xaf\x72\xf1\xda\x83\x77\x7a\x0b\x7b\xe7\x60\x0b\x1c\xae\x38\x1c\xe1\xe7\xfe\x4d\xf9\x0c\xee\xea\xcd\x0c\xb1\x4b\x01\x17\xa0\xa2\x08\xc5\x5c\x08\x2f\x28\x93\x3c\x29\x89\x82\x90\x7e\x96\x02\x48\x0e\x1c\xae\x79\x64\xf2\xed\x87\x03\x85\x92\xfd\xf5\xd4\x12\x81\x91\x0d\x98\x24\x25\xa3\x39\xa6\x22\x06\x46\xc1\x9d\xf5\xb4\x2d\x63\xb5\x2c\x62\xa6\xad\x69\x4d\x85\x54\xd5\xe1\x23\x3c\xe7\x90\xa0\x07\x35\xf8\x07\x65\x95\xe3\x74\x6d\xe9\x6f\x55\x51\xa8\xbb\x36\xd2\xd1\xb1\x50\x26\x7a\x58\xff\x81\xb8\x90\x21\x99\xdc\x66\xf6\xca\xcc\xc2\xfa\x62\xb6\x64\xba\x7a\x54\xfe\xb9\x91\x61\x90\xaa\x31\x2c\x5a\x4d\x5b\xd1\x0d\xa0\x99\x70\x5f\x1b\xe1\xc6\xa5\xd5\xe8\x4d\xfa\x57\x3c\xd6\x28\xb2\x90\xf1\x3a\x10\x42\xa0\x13\xab\x56\xf5\x1c\xbb\xb3\xe9\x28\x85\xca\x5e\x4b\x27\xda\x23\x69\xa7\xfa\x83\x81\x55\x99\xcb\x81\xb0\x74\x9c\x51\xde\xa8\x69\xde\x7e\x85\xf7\x55\xc6\x14\x74\xcc\x4c\xa1\xcb\xa6\xd1\x93\xf8\x2c\x59\x8b\x67\x5a\xc4\x77\x06\x02\x36\x43\x5e\xab\x10\xbe\x4d\x97\x17\x6c\x0e\x82\xab\x36\x7b\x92\xbd\x60\x4e\xc9\xac\x5a\xea\x59\xf5\x92\x29\x2d\xb4\x1e\xa9\x4d\x72\x67\x4a\x6b\x2f\xb4\x66\xcf\xa8\x6f\xa0\xf8\xf0\x00\xc7\x93\xa3\x67\x78\xfc\xf0\x96\xfb\x56\xcf\x32\x90\xe3\x99\x4d\xb5\xc0\x7a\x00\x26\x9d\x9e\xf3\xbf\x93\x59\xb5\x36\x6d\x1e\x96\x4a\x8a\x25\xbd\xee\xa9\xc5\xef\xe7\x5c\xed\x85\x5e\x60\x6f\xd2\x15\xac\x1a\xb7\x34\xd6\x77\x82\x76\x4f\x5a\xe8\xae\x75\xb5\x05\xfa\x52\x39\x9a\xc5\x02\x91\xb2\x6f\x18\xe7\x9c\x30\xfa\xca\x8b\x76\x76\x35\x9a\x0b\xb6\xc7\xe2\x17\x40\x76\x52\x87\x65\x5b\x67\xf6\xa0\x8b\x28\x68\xe5\x56\x63\x91\x36\xa5\xde\x92\x45\xbb\xc1\xfd\xcf\x6e\x9b\x16\xac\x59\x56\xe5\x38\xe6\xe4\xd9\xe2\x89\x7e\x86\x2a\x9a\x1c\xe2\x6d\x06\x2c\x37\x4e\x71\x0d\xf8\xd0\xd5\x7e\x4c\x99\xe4\x4a\x7f\xbc\x11\x8c\x23\x9e\xa2\xdb\xf1\xa3\x63\x6c\x23\xbf\x7e\x9c\xad\x7d\x9b\xc6\x2d\xdd\xdd\xb7\x7d\xf1\x6e\x4a\xd5\x32\x0c\xe7\x09\xef\x16\x50\xe6\x7c\x20\x6d\xa2\xac\xf0\xf0\x7e\x6d\x56\x9b\x3d\x98\x10\x68\xcf\xad\xd0\xcb\x0a\xac\xc2\xa4\xf5\x99\xc6\x20\x1f\xb2\x01\xed\xb4\xe4\x13\x24\xc2\xdf\xf1\xa9\xfd\x2c\xa3\x86\x6b\x6c\x68\x81\x1e\x38\xa9\xc1\x7a\xcf\xea\x06\x6b\x7f\xe9\xce\xf2\x57\xd3\xcc\xbf\xba\xde\xdc\xab\xeb\x8d\x31\x09\xff\x81\x25\xa3\xbc\x70\xa1\xf0\xca\xe0\x2b\x2b\x51\x9b\x81\x3a\x9b\xaf\xb7\xde\x0c\x77\xe8\x1a\x2f\x07\x1f\x98\xae\x90\x1c\xed\x8d\xf2\x74\x7f\x7b\x17\xde\xdc\x99\x02\x09\xcb\x73\x22\x5a\xe4\x98\xf3\x07\x44\xf7\x1a\xf2\xfe\x87\xc7\x8f\x7f\xff\xfb\xd7\x9f\xbf\x3d\x7e\xf4\x9e\x3e\xf9\x51\xc5\xcb\x08\xae\x05\xca\xa2\x2d\xa1\x11\x64\x8e\x6a\xfa\xfc\xc9\xdf\xb4\x8d\x9a\x99\xc1\x8d\x3b\xba\xee\xdb\x0c\xe3\x22\x80\x92\x2b\x59\x08\xd3\x7c\xea\x69\x8d\xd5\x69\x23\x01\x20\xf0\x55\x9f\x61\x4a\x0c\x9b\x12\xc1\xca\x53\xd7\x7e\x2b\xb9\x78\x98\x77\xb4\x76\x72\x33\x47\x85\x5a\x47\xc3\x65\xdd\x52\x1b\x52\x51\x41\x3b\x74\x51\x4c\x83\x6d\x4d\x4c\x2d\xe6\xdc\x5c\x7d\x07\xf2\x6a\xc5\x6f\xc6\x15\x00\x00"
 
 func dataAwsVpcPublicPrivateBuildTemplateJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -95,7 +253,7 @@ func dataAwsVpcPublicPrivateBuildTemplateJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x8f\x41\x4e\xc3\x30\x10\x45\xf7\x3e\xc5\x97\xcb\x12\x71\x83\xee\x90\xd8\xc1\x11\xa2\x69\x3c\x54\x16\x89\x1d\xd9\x93\x22\x2b\xf2\xdd\x99\x86\xa4\x26\x62\x96\x6f\x9e\xfd\xff\x9c\xf0\xc6\x81\x13\x09\x3b\x5c\x0a\x3e\x44\xe2\x33\x5c\x44\x88\x02\x76\x5e\x30\x52\x98\x69\x18\x8a\x31\x53\x8a\x37\xef\x38\xc1\xd2\x77\xb6\x58\x0c\x74\xa8\xef\x39\xe7\xee\x8b\x0b\xce\xb0\x4f\xcb\x8d\xd2\x8b\xae\xbb\xc6\xab\x5d\xc5\xcc\x7d\x62\xf9\x2f\x36\xbe\x89\x89\xaf\x3e\x86\xa3\xf4\xcb\x54\xa8\xc6\x9c\xf0\xca\xd3\x10\x0b\x48\xff\x14\xc4\x4f\xf8\x90\x85\x82\xe6\x99\xc4\x39\xce\xa9\xe7\xb5\x62\xb7\x73\x0b\xbb\x2c\x08\x34\x32\x6a\x7d\x14\x1f\xfd\x9f\x8c\xd1\x6f\xe9\xfb\x9b\x4e\xca\xc4\x4d\x38\xe0\xfd\xa2\xf9\x12\xb4\xb9\x77\x4d\x7b\x20\x55\x56\x47\xe8\x9a\xb7\xc0\xfb\xbc\xdf\x3b\x9c\x0f\x75\xd6\x5d\xd5\xc3\x7e\x02\x00\x00\xff\xff\xa1\x87\x8b\xff\x8a\x01\x00\x00"
+var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x54\x4d\x6f\x13\x31\x10\xbd\xe7\x57\x8c\xb6\x54\x34\x28\x5d\xc2\x0f\xe8\x0d\x89\x03\x12\x5c\x10\x17\x54\x59\x8e\x77\x92\x98\xec\xda\x96\x3d\xde\x36\x44\xf9\xef\xf8\x63\x9d\xdd\x26\x11\x20\xa1\x26\x97\xe4\xcd\xf3\xcc\xf8\xcd\xf8\xdd\xc0\x27\x54\x68\x39\x61\x03\xab\x3d\x7c\x25\xd2\x0b\x68\x34\x28\x4d\x80\x8d\x24\xe8\xb8\xf2\xbc\x6d\xf7\xb3\x99\xb1\xba\x97\x0d\x5a\xa8\xf8\x93\xab\xe0\x30\x83\xf0\xe1\x42\xa0\x73\x6c\x87\x7b\x78\x80\xea\xcd\xa1\xe7\xb6\x0e\x61\x36\xe2\xc7\x2a\x11\x1d\x0a\x8b\x74\x49\x1c\xf1\x81\x48\x7a\x87\xea\x25\x27\x41\x43\xd8\xe2\x46\xea\xb3\x78\xc6\x02\xe1\x38\x9b\x59\x74\xda\x5b\x81\xa9\xcb\x98\xdd\x5b\x49\x7b\xb6\xb1\xda\x9b\x0a\xaa\xc3\x01\x14\xef\x10\x8e\xc7\x72\x83\xf4\xf7\x61\x1a\xc9\x89\x51\xf5\xd2\x6a\xd5\xa1\x22\xe6\xfc\x7a\x2d\x9f\x87\x0e\x7a\x23\x98\x6c\xc6\x0e\xf2\xff\x10\x4c\xd1\xc3\x2d\xc8\x35\x10\xdf\x38\xb8\x3d\xe6\x0b\xc5\xdf\xb9\xd6\x40\x58\x6b\x0b\x04\x52\x15\x5a\xac\x4d\xf5\xe7\x20\x4d\x6c\x2b\xf7\x42\xf5\x77\xde\xfa\xd4\xe8\xf4\x28\xaa\x26\x9e\x1e\x52\x1f\x67\x23\x1c\xaa\x06\x34\x28\x70\x03\xdf\xb6\x08\x46\x5b\x72\xc0\x2d\x82\x36\x61\xc2\x0d\x3c\x49\xda\x86\x29\x18\x1e\x87\x0d\xd6\xb7\xe8\x16\xa0\x15\xa6\x6e\x90\x8b\x6d\x3a\xb2\x00\x27\x95\xc0\x98\x04\xad\xe5\x21\xd6\x41\xb8\xa4\xe4\xab\xc0\x07\xc1\xd5\x5b\x82\x15\x42\x2b\x1d\xb9\xfa\x8f\x62\xb3\x58\xe2\x85\xe2\xf7\x52\x6d\xc2\x89\xd3\xee\x08\xed\x15\x65\x1d\x5b\x54\x1b\xda\xde\x39\xd3\x4a\xba\xab\x16\xd5\x22\x16\xad\xd3\x1d\xe6\xf3\xb2\x18\x7b\x93\x06\x55\xb2\x24\x30\x2c\x25\x69\xa1\xdb\x18\x20\x61\x32\xb8\xb6\xba\x63\xf1\x70\x4e\x8e\x2d\xc6\x29\x5e\xcf\xbe\xc8\x6d\xd4\x52\x35\xf8\x7c\x2a\xa5\xff\xeb\xb8\x90\x8d\x65\xab\x56\x8b\x9d\x0b\x29\x7e\x54\xcb\x3a\x7d\xdf\x2f\xab\xc7\xf2\x16\xa6\x42\x95\x65\xba\xd4\xb0\x1e\xc5\xab\xd3\x8a\xfd\x65\xc1\xaf\x68\x8e\x2f\x24\x2f\x1a\xe2\x75\x09\xef\x3f\x5c\xe8\xb7\x3c\x13\x64\xf9\xfa\x37\xbc\x81\x8f\x68\x5a\xbd\x07\x1e\xf2\x10\xe8\x75\x78\x2a\x8e\x78\x58\x4b\x77\x76\xfb\x82\x5f\x7d\xd8\x93\xf5\x8a\xf3\x2a\x5c\x96\xf0\x61\x52\xbc\x93\x13\x2b\xe9\xe4\x00\x9f\xb8\x45\xaf\xb3\x14\x11\x1e\xa8\xc1\xb8\x58\xb1\x90\xcc\x2a\xc8\x40\xf0\x0e\x2d\x6b\x38\xf1\x91\x71\x82\x8a\x37\xfa\x95\x0a\x1e\x38\x35\x95\x13\x34\x31\x9d\x0b\x51\xb3\xf6\xff\x22\xeb\xe3\xd4\x9c\x7a\xdd\xfa\x0e\x99\x93\xbf\xb0\x18\x89\xd5\x9a\xf2\x3c\x59\x83\xbd\x0c\xfa\x8e\x86\x35\xa5\x67\x6f\x9a\x22\xc5\x9f\x2e\xad\xe8\x9a\xf9\x7d\xb9\x30\xdb\xea\x95\x8c\x31\xd4\xd7\x9e\x8c\x27\xa8\x8c\x95\x7d\xf0\x3c\x26\x4d\x59\x8d\x3e\x65\x48\x62\xff\xd4\x52\xe5\x47\x3d\x5d\xa8\xa9\x7e\xef\xea\x31\xc1\x3c\x6d\xe8\x6f\xda\xb5\xee\x91\x35\x07\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -115,7 +273,7 @@ func dataAwsVpcPublicPrivateDeployMainTfTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x91\x31\x4b\xc5\x30\x10\xc7\xf7\x7c\x8a\x23\x6f\xf6\x0d\xee\x0e\x0f\x5c\x3a\xb8\xd8\xc1\xb1\xa4\xe9\x55\x0f\xd3\x24\x5c\xae\x96\x22\x7e\x77\xd3\x50\x10\x0a\xd1\xa5\x1e\x9d\x7a\xbf\xfc\xee\xf8\xdf\xe5\xee\x84\x52\x17\xb8\x59\x8b\x29\x41\xe3\xc7\xa0\xce\x71\xaa\x0f\xc3\x64\x7a\x87\xa0\xcd\x92\x3a\x53\x06\x74\xef\xb8\x6a\xf8\x54\x90\x6b\xc0\x64\x99\xa2\x50\xf0\xf0\x00\x7a\xdf\x20\x03\x30\x06\x86\xdb\x4b\xab\xd5\xd7\xd1\x92\xd0\x32\xca\x2f\x96\xb6\x00\x7f\x58\x18\x5f\x33\x5e\x31\x3c\x97\x26\x2c\x6f\xc8\x08\x4b\xfe\xc8\x39\x08\x11\xd9\x08\x5e\x8b\xec\xac\xcc\x1f\x31\xba\xb0\xfe\x57\xe6\x13\xd5\x82\x7e\x6a\x40\x42\xfe\xbb\x4d\x3f\xa4\x43\x3e\x89\xf1\x16\x3b\x59\x23\x56\xde\x37\x3b\x03\x85\xd9\x89\xd1\xcc\x4e\xb6\xae\xdc\x5f\x27\xb2\x1c\x0e\xe2\x34\xf7\x3e\x1f\x8e\x86\xda\xdd\x4a\xff\x67\x2f\x20\x2f\xc5\xf1\x1d\x00\x00\xff\xff\xca\x58\x39\x4a\xe1\x02\x00\x00"
+var _dataAwsVpcPublicPrivateDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x93\x41\x6f\xdb\x30\x0c\x85\xef\xfe\x15\x84\x73\x5d\x8a\x6d\xa7\x5d\x76\x28\xd2\xc3\x82\x62\x43\xb1\xac\xdb\xd1\x60\x64\xba\x11\x6a\x4b\x06\x29\x25\x0d\x86\xfd\xf7\x51\x4a\xb6\xb6\x06\xd4\x6c\x40\x6a\xf8\x60\x98\x4f\xdf\x93\xa8\xc7\xd9\xfc\x0c\x4f\x35\x83\x4b\x63\x48\x04\x96\xae\xf3\xd5\xec\x2c\xcc\x6a\x8b\x6c\x71\xdd\x13\xd4\xb8\x93\x06\xb3\x41\x73\x4f\xfb\x1a\x7e\x56\xa0\x4f\x4b\x62\xd8\x8e\xc1\x7a\x07\x1f\xa1\x3e\xee\x40\x05\xd0\x79\x86\xcb\x1f\xab\xfa\x28\xeb\x30\xf6\x21\x49\xea\xea\xd7\x14\x2b\x64\x98\xc2\x0b\xd8\x55\x16\xfc\x2f\x36\xf8\x7b\x72\x45\xa2\x48\xfa\xcc\x9a\x0c\x0d\x34\x8c\x9e\x91\xf7\x09\x0f\xea\xd7\x92\x0b\x16\x7b\xf9\x17\x2b\xa6\x3b\xa5\x15\xbc\xbe\xe6\x22\xec\x36\xc4\x04\x3b\x7d\x6d\xdf\x83\x1f\x89\x31\xd0\x45\x86\xcd\xce\x14\x80\x2b\x1a\x7b\xbf\x7f\xad\x00\x0c\xb6\x74\xeb\x9f\x97\xda\x48\xfd\x9b\xdc\x27\xdd\xb1\x4e\x02\x3a\x43\x4d\xd8\x8f\x54\x58\xbf\x3c\x6a\x20\x6b\xa6\xed\x0e\xef\x2f\x06\x6b\xd8\x97\xc0\xc6\x47\x17\x0a\xe4\x2f\x71\x58\x13\x83\xef\xe0\x8f\x5c\x9e\xee\x74\xe2\xf4\x6e\x62\x41\x6e\x6b\xd9\xbb\x41\x83\xd0\x48\xec\x3a\xfb\x50\x4a\x53\x2e\x1e\x62\xb4\x21\x70\x38\xa8\x8f\x9a\x32\x89\x8f\x9c\x4c\xad\x03\x74\xf0\x04\x78\x2a\x55\x9a\xf5\x26\x71\x0a\x8e\xd7\x3a\x0a\x23\x5a\x86\x3b\x46\x17\xa8\x85\xd5\xea\x13\x1c\xc6\x33\x1d\x30\xed\xe2\xef\x89\x4f\x59\x45\x21\x6e\x5a\x0c\x58\xf0\xba\xd5\x3a\xa4\x7a\x3a\xd2\x33\xf2\x1b\x90\x68\x36\x80\x02\x08\xa6\xf7\xb1\x9d\x5b\x67\x03\x1c\x56\x9f\xb2\x95\xb8\x76\x3a\xf5\xb6\x2d\x36\x35\xd5\x1f\xaf\x4b\x6d\xc3\x34\x04\xdb\xd1\x94\x01\xdf\x6f\x16\x2f\xaf\xd6\x79\x0f\x52\x58\xbc\xf0\xc3\x80\x73\xa1\x11\xd3\x98\xb6\xf0\x6d\x71\x03\x59\x9f\x90\x3a\xbc\xee\x74\x9b\x3f\xbc\x4d\x7e\xbf\x01\x0a\x0d\x91\xa8\xdd\x05\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployVariablesTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -135,7 +293,47 @@ func dataAwsVpcPublicPrivateDeployVariablesTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x56\x6d\x6f\xd4\xb8\x13\x7f\x9f\x4f\x31\xa4\x2d\xb4\x52\xe3\x14\x84\x78\x51\x68\x05\x2a\x7f\xa0\xd2\xff\x54\xee\xda\xe3\x0d\x42\x8b\x37\x71\x12\xab\x8e\xed\xb3\x9d\x7d\xa0\xdd\xef\x7e\x33\x76\xb6\xdb\x05\xca\xdd\x49\xdd\xd6\x3b\x9e\xf9\xcd\xd3\x6f\xc6\xdd\x81\xf7\x42\x0b\xc7\x83\xa8\x61\xba\x84\x8b\x10\xcc\x21\xd4\x06\xb4\x09\x20\x6a\x19\x1e\x65\x3b\xd9\x0e\x5c\x75\xd2\x03\xfe\x84\x4e\xc0\x27\xde\x3a\xae\x43\x23\x95\x80\xf6\x7b\x5b\x68\x8c\x8b\x5a\xb5\x98\x09\x65\x6c\x2f\x74\x00\xd3\x20\x44\x20\x08\x6e\xad\x92\x15\x0f\xd2\xe8\xd2\x0b\x37\x93\x95\x60\x70\x1e\xc0\x77\x66\x50\x75\x74\x3a\x15\xd0\x71\x5d\x17\xe4\x5c\xd4\x0c\xae\x0c\xf4\xa6\x96\xcd\x92\x60\x11\xe7\x9e\xfb\x43\x18\xbc\x88\xde\xde\x58\x4b\x02\x96\x65\xe3\x35\xab\x8c\x6e\x64\x3b\x38\xb1\x9f\x3f\xcb\x0f\x28\xa3\xdb\x24\xba\xcd\x00\xd2\x89\xcd\x7a\x36\x35\x0b\x38\x81\xbc\xe3\xbe\x93\x95\x71\xb6\xb4\x4e\x54\xd2\x8b\x17\xcf\xf3\x0c\x15\x77\xe0\x52\x84\xc1\x02\x07\xbf\xd4\x15\xa6\xd9\x18\x55\x0b\x07\x8d\x33\x3d\x98\xc1\xc1\xdc\xb8\x6b\xa9\x5b\xa8\x25\xda\x05\xe3\x30\x4a\x03\xe5\x2c\x05\xb1\xe5\x29\x01\x4c\x46\x80\xfc\xe6\x06\x2c\x0f\x1d\x5b\x03\xac\x56\xf9\x61\x94\xfa\x8e\xbb\x3b\xbd\x09\xe9\xc4\x3b\xc4\x02\x30\x73\x2c\xf7\x31\xe4\x23\x3e\x5a\xb4\xce\x0c\xf6\x9e\x84\x82\xbe\xd9\x03\xd9\x80\xec\xad\x71\x21\x01\x3c\xc2\x14\x73\xd8\x5b\xc5\x8c\xde\x4a\xcf\xa7\x4a\x8c\x5d\x6a\xf8\xa0\xc2\x76\x76\xbf\x0a\x9b\x51\x94\xe5\xc6\x7f\x9d\xc0\xea\x63\x08\x6e\x10\xc9\xb9\xd0\xd8\x2e\xf2\x16\xdd\xfd\x4f\x47\x6f\x97\x97\x1f\x80\xb7\xc4\x06\x64\xc8\x9c\xbb\x9a\x92\xf6\x06\x19\x14\x02\x1d\xad\x93\x33\x24\x12\x46\x64\xd1\x5e\xe8\x4a\x0a\x1f\xab\xeb\x37\xe1\x78\xdf\xb1\xd1\x7a\x92\xb0\x4e\x92\xdb\xe8\xe8\x9d\x19\x74\x1d\xa9\x05\xeb\xe6\xa7\x6f\xfb\x18\x0d\xd7\xcb\x83\x14\x1d\x11\x14\xbb\x05\x52\xe3\x71\x6d\x31\x41\x89\x67\xc8\xd9\x54\x24\xba\xc7\x92\x95\x06\x19\x5d\x6e\xb4\x0a\x6c\x0f\x9a\x2b\x63\x2c\x3b\x43\x69\xc0\x8a\x60\x6f\xfe\xa1\xcd\x04\x16\xbb\x8b\x87\x2d\x55\xeb\xcc\x4c\x7a\x8a\x30\xf7\x9d\x50\x0a\x55\xa4\x56\x52\x0b\xec\x67\x55\xc3\xce\x0d\x1a\xac\xe0\xf1\x63\x98\x22\x3b\xc7\xaf\x65\xcf\xa5\x66\xbe\xcb\xef\x4a\x4d\xf9\xac\x6b\xfd\x7f\xc3\x6b\xe0\x4a\x45\x6a\x36\x8e\xb7\x34\x7e\x1e\x3a\xe1\x44\xcc\x1b\xab\xb0\x55\x60\xb6\x29\xc9\x5a\x9b\xea\x82\x75\x98\x6c\xac\x63\x45\x28\xf3\x51\x72\xeb\x04\x7a\x59\xad\x7e\x1a\xc1\xb9\xf6\x81\x02\x78\x6f\x60\x3a\x48\x1c\x69\xa1\x67\xd2\x19\x4d\x86\xff\x36\xf9\x5d\x5f\x39\x69\xc3\xa4\x35\x8a\xeb\x36\xe1\xfe\xc6\xaf\x05\xc8\x40\x8c\x09\x1d\x0f\xf0\x75\xa4\x20\x20\x27\xbe\x42\x6b\x90\x2c\x69\x04\x55\x9c\x40\xe2\x36\x8e\x33\x09\xfe\x43\xd9\xe3\x8c\xed\xfd\xfe\x59\x54\x9d\x89\x2d\x78\x70\x1c\xe1\xf4\x14\xca\xce\xf4\x62\x3d\x0a\x25\xa3\x26\xb9\xea\x4b\x0a\xf7\x6e\x5f\x9a\xc8\x61\xe0\x8e\x48\x84\xd1\xf7\x02\xeb\xd2\x7a\x70\xb2\xed\x02\xee\xbb\x39\xaa\x7f\xce\x67\x3d\x72\x5a\x4c\x9a\x81\xc2\xa2\x09\x1b\x05\x91\xff\x21\x72\x2f\xff\xc2\x04\xaf\xba\xb8\xc8\x34\xef\xc5\x6d\x0c\xf6\xbb\xac\x30\xc4\x7d\xba\x4c\xfb\xce\x26\x1d\x00\xcb\x44\x1c\xc2\xc9\xac\x77\x83\x9e\x48\x3b\x41\x0a\x5f\xe3\x5a\x3b\x81\x86\x2b\x2f\xa2\x1a\x36\x32\x4b\xbf\xe9\x93\x6d\x37\x01\x35\x5f\xbd\xba\x3c\xfb\xe3\xfc\xe3\x55\xe6\x45\x80\x02\x67\xce\x88\xfd\x03\xb8\x81\xdd\xd7\xf0\xec\xf4\xf1\x53\xb8\x05\x65\xda\x16\x39\x5f\xe0\xc2\xa7\x97\x00\x2b\x84\x44\x2a\xf5\xa0\xd4\x4b\x58\x65\x46\x45\xf5\x54\xdb\xcf\xa4\xf1\x05\x6d\x73\xba\xc2\xad\x7e\xde\xc0\x9c\x16\xff\x4c\x20\x75\x0e\xa9\x7f\x7a\x7c\x85\x3a\xdc\x0e\x19\x4e\x70\x65\xfa\x1e\xdf\x05\x28\x66\xd8\x6e\x38\xbd\xc3\x8e\xde\x5f\x46\x8b\xb4\x23\x15\xe4\xc8\x3e\xae\x88\xa6\x4b\x6c\x6d\x24\xa4\xa8\x1f\xa5\xf7\x69\x6e\xf4\x93\xb0\x96\xa2\x2f\x96\xa7\xec\x17\x48\xae\xa3\xac\x91\x98\x17\x02\xbc\xc5\x4d\xab\x70\x98\x68\x33\x21\x18\x4d\x31\xce\x44\x6b\x26\x33\xe1\x22\x75\x56\x2b\xc6\xd0\xd4\x08\x98\xb7\x54\x8f\xbf\xa0\xb8\xf8\x8e\x11\xad\x61\x81\x3b\xd6\x7e\x83\x2e\x04\xeb\x8f\xcb\xd2\xe3\x03\x81\x7b\x8b\xb5\xc6\xb4\x4a\x70\x2b\x3d\x3e\x53\x7d\x99\x4a\x8c\x7f\x7e\xea\x06\x99\x39\x2c\x0a\xde\xd7\x2f\x9e\x8f\x78\x29\xc4\x3f\x35\x7e\x73\x29\xc0\x75\x2c\x7e\xc0\x9a\xa1\x18\x8a\x33\x28\x07\xef\x4a\x65\x2a\xae\xa0\x58\x7c\x6b\x1e\x0a\x2e\x81\xe1\x78\x45\xa4\x8b\x8f\x6f\xae\x3e\x6c\xa1\xf5\xd7\xb4\xbf\x0a\x0b\xa5\xb1\x64\x46\x13\x90\x35\x3e\x2c\xad\x38\xd9\xdd\x6f\x24\x76\xe4\xde\x0d\x14\x3d\x4a\x84\xc5\xd3\x11\x9e\xf9\xe2\xee\x4c\x06\x80\xdd\xc3\x3d\x8f\x8f\x37\xe4\x7b\xef\xf2\x83\xec\x47\xf3\x84\x0c\xbb\x37\xe9\xb0\x1a\x0d\x8e\x90\x5e\x0b\xee\x70\x70\x0a\xc4\xd2\xf0\xf4\xe8\x08\x90\x48\x73\x0d\x63\x42\xc7\xeb\x27\x37\xa6\x73\x39\x3e\x2a\x48\xf1\xbb\x84\x22\xf1\x9e\x88\x05\xbd\x8a\x51\x7a\x72\xcf\x71\x39\x95\xfa\x78\x53\x31\x94\x46\xc9\x2e\xe9\x3d\x79\x70\xd8\xb7\x31\x53\xf1\xee\xa3\xfe\xc2\x32\x86\x39\x2e\x4b\x8a\xf4\xd3\xd9\xa5\x8f\x7b\xb8\x8d\x4f\xe2\x56\x0b\xb8\x0d\x05\x91\x6c\xb0\x35\xbd\x90\xc5\xf2\x87\x9b\x35\x9d\x8b\x25\xb4\xc8\xe3\xe9\x37\x07\xbd\x70\xd5\xe0\x24\x57\xc9\xd5\xd9\xf8\x22\x8e\x84\xc6\x41\xa0\x7f\x9d\xe8\x51\x26\x5b\x5a\xe8\xa6\x81\x0f\x57\x57\x1f\xa3\x67\x02\x49\xab\x05\x8a\xa2\x55\x66\x8a\x24\x1a\x9c\x62\x39\x5e\xbc\xc6\x4f\x37\x4c\x89\xb9\xc7\x39\x1b\xad\x2f\xb0\xa3\x6b\x96\x6f\xee\xcb\x3c\x1b\x77\xc6\xdf\x01\x00\x00\xff\xff\xd1\x74\x56\xa0\x66\x0a\x00\x00"
+var _dataAwsVpcPublicPrivateDeployBluegreenMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xed\x58\x4b\x6f\x1b\x37\x10\xbe\xeb\x57\x10\xeb\x18\x8d\x0a\x79\xad\xd4\x28\x10\x18\xf0\x21\x70\x8b\x36\x28\x92\xfa\x60\xf4\x52\x04\x0b\x8a\x3b\x92\x58\x51\xe4\x96\xe4\xca\x91\x65\xfd\xf7\xce\x90\xdc\x87\x1e\x7e\x04\x45\xda\x1e\xea\xc0\x88\x34\x33\xfc\x38\x9c\xf9\x38\x33\xf4\x09\xfb\x09\x34\x58\xee\xa1\x64\x93\x35\xfb\xd5\x7b\x33\x62\xa5\x61\xda\x78\x06\xa5\xf4\x6c\xc9\x75\xcd\x95\x5a\x0f\x4e\x06\x27\xec\x76\x2e\x1d\x2b\xa1\x52\x66\xed\xd8\x9d\xf4\x73\xe6\xe7\xc0\x26\xaa\x86\xb3\x99\x05\xd0\xcc\x79\x82\x9a\xad\x73\x34\x05\x0b\x8c\xe3\xaf\xbf\x33\xcc\x81\x77\xcc\x4c\x11\x42\x6a\xe7\xb9\x16\xe0\x46\x61\x1d\xe3\xba\x64\x61\xed\x28\x7c\x24\x3c\x65\x38\x3a\xc3\x15\x99\x59\x5c\xaa\x4b\xc7\x10\x77\x3a\x95\x82\x79\x43\x26\x88\xc3\x85\x97\x2b\x60\x46\x43\x1e\xbc\x66\x13\x2b\xf5\xcc\xb1\xba\x0a\x18\x52\x27\x03\xdc\xb9\xf3\x54\xc3\x1d\x7b\xf7\xe1\xfd\x88\xdd\x71\x89\x0e\x4d\x8d\x25\x8f\x3c\xa1\x4e\x80\xcd\x81\x2b\x3f\x5f\x8f\xf0\xcc\x0b\x70\x24\x8f\x18\xad\x67\x78\x3e\xc1\x15\xaa\x08\xcb\xa8\x32\x80\xe3\xda\x7b\xb0\x26\x1f\x0c\x2a\x6b\x56\xb2\x44\x97\x33\x7e\xe7\x32\xb6\x19\x30\xfc\xe1\x02\xcf\xea\x8a\x05\xac\xd9\x15\xcb\x5e\x6d\x56\xdc\xe6\xa8\x2e\x3a\xf9\x36\x0b\x86\x0e\x84\x05\x7f\x68\xd8\xc9\x93\xa1\x37\x0b\xf4\x64\xc7\x26\x88\x92\xda\xc2\x4c\x9a\x3d\x7d\x94\xa1\xc1\x76\x10\xb2\x08\xac\x32\xd6\x53\x2a\xa7\xbc\x56\x3e\x45\x35\x08\x5f\x90\x81\x11\x73\x06\x61\xc8\x10\x37\x90\x7c\xa2\x30\xde\x04\x26\x14\xe6\xbb\x64\x21\xf3\xc8\x03\xfc\x1f\x8d\xb8\xc6\x64\xb4\x86\x2e\xf7\xd3\x9c\xbd\x9f\xb6\xfb\x39\xca\xa5\x0d\x79\x1a\x91\x70\xcd\x96\xb5\xf3\xb8\x44\xa8\xba\x44\x5c\x9f\x0f\xda\x4d\xb2\xb0\xa0\x89\x6c\x09\x4e\x58\x59\xf9\x74\xda\x6b\xb3\x5c\xf2\x33\x07\x15\x8f\x6c\xbe\xbd\xbe\x49\xa7\xc4\xd3\x99\x0a\x43\x96\x4e\xd9\x32\x30\x4b\x30\x31\x06\x08\xb1\xd9\x24\x0e\x14\x62\x0e\x62\x91\xdf\xe0\xf2\x87\xa4\xbf\x7c\x3b\x66\xdb\x18\x41\x0b\xce\xd4\x56\x40\xc8\x33\xe5\xa7\xb6\xd2\xaf\x8b\x99\x35\x75\x95\x05\x14\xcd\x97\x40\xd6\xc9\xd3\xf0\xf5\xaa\xaf\x39\x23\xee\x07\xda\xc7\x24\x81\x5e\x49\x6b\xf4\x12\xb4\x2f\x5c\x8d\x71\xfe\x9c\xb2\xb9\xaa\x44\x21\xcb\x2e\x9b\xf1\x3b\x2a\x83\x76\x73\xca\x24\x86\x92\x23\xef\x4f\xb7\x91\x1c\xf4\x39\xee\x9a\x0c\x90\xe4\x8c\xe2\xd9\x98\x91\x17\x3e\xff\x05\x03\x4d\x0e\x46\xaf\x7c\xfe\x1b\xa7\xcb\xb8\x4d\xbb\xa6\xa5\x98\x77\x5a\x9d\xa0\xb7\x83\x4e\x8c\xbb\xa2\x74\x8f\x4d\x94\x46\x0a\x34\x06\x3f\xdc\xb8\x26\x17\xcc\xd6\x8a\xee\x3b\xde\xd5\xe0\x0d\x70\x31\x0f\x4b\x90\x48\x98\x67\xba\xcd\xb7\x60\x91\x5e\xc6\x2e\x3b\xa2\x30\xc1\xf5\x37\x9e\xee\xa5\x92\xce\xbb\xfc\xc9\xb0\x17\xb4\xc5\x4e\xec\xcf\xb0\x1a\xe0\x8a\x96\x2d\xc2\xd4\xda\xc7\x38\x2a\xd0\x33\x3f\x7f\xed\x2a\x25\xfd\xeb\x6c\x94\x8d\x68\xd3\x3c\x9c\x61\x38\x6c\x2e\xd9\xba\x0a\x29\x6b\x50\x82\x10\x2f\xb8\x37\xc2\x28\x52\x78\x51\x45\xe1\xd4\x9a\x65\x11\x6e\x4e\x00\x07\x05\x94\xc5\xe3\xe8\xa3\xe8\x46\x2e\x75\x09\x9f\xdb\xad\xcc\xdf\x5a\x2e\x64\x69\x8b\x89\x32\x62\xe1\x10\xe2\xf7\x6c\x9c\x87\x7f\xe7\xe3\xec\x53\x53\x57\xfa\x81\x6a\xc8\x74\x18\xc3\xbc\x0b\x5e\x1e\x28\xf6\x0c\xd5\x8f\xc4\x1c\x76\x42\xde\xc4\x10\x8e\x87\xf0\xec\xcd\x41\xfc\xc6\x7b\x01\x19\xff\xd3\x27\x6c\x6a\x03\x1e\x8b\x6e\xe8\x11\xf2\x50\x36\x48\x55\x04\x59\xca\x01\x5f\xca\x3d\x2d\x4a\x92\xae\x81\x2c\x9a\x70\x44\xab\x1d\x71\x32\xc5\x1a\x5f\x34\xb5\x22\x5a\x35\x92\x64\x50\x3b\xb0\x45\xc9\x3d\xef\x2c\x5a\x51\xd3\x46\xea\x89\xc6\x76\xd1\xaf\x19\xad\xa8\xf1\xd6\x39\x23\x24\x5e\xcc\xa2\xaa\x27\x4a\x62\x41\xa9\x0a\x5e\x96\x94\x24\x5c\xe4\x6d\x0d\x6d\xe9\x39\x08\x6d\xcc\xc0\x4b\x82\xfb\xa9\x5f\xa2\x56\x46\xd5\x4b\x28\x9c\xbc\x87\xa6\x9c\x58\x63\x7c\xcc\x6a\x51\xc2\x4a\x62\x06\xba\xb2\xd5\x37\x8f\x15\xaa\x2f\x69\xaa\xd4\x61\x41\x3a\x56\x02\x3f\x1e\x2f\xbe\xd9\x57\xaa\x91\x4f\x51\x2a\x14\xfc\x47\x38\x15\x74\x8f\x93\x2a\xaa\xff\x67\xd5\x7f\x98\x55\x31\xbb\x5f\x8f\x56\xb1\xd5\x3e\x3f\x1c\x77\x03\x0e\x4e\xdc\x41\x90\x46\x61\x2c\xbb\xc6\xe6\x3b\xdd\x76\xce\x1d\x8e\xfa\xa8\xc1\xb3\xd2\x20\xc5\x95\xa3\xe9\x6e\x07\x86\xbd\xff\x21\x20\x85\xb6\x1d\x30\xa8\xd3\x23\xcc\x1f\x46\x52\xaf\x6f\xc6\xf6\x6e\x22\xa7\x69\xb0\x92\x62\x81\x4a\x53\x7b\x7a\x57\x84\x8e\xb5\xdf\xc2\x41\x4d\x5e\x38\x2e\x3d\x33\x24\x45\x2a\x36\x24\xda\x23\xe7\xb1\x1e\xf1\xc5\x7c\xa3\x11\x84\xde\x49\x3d\x06\xb4\x57\x2d\xf5\xaa\xe7\x87\xc7\x23\x4b\x7b\x03\xc5\xdc\xfb\xaa\xa3\x80\x9a\x34\xb8\x6f\xc7\x3b\xc2\xa3\x2b\x12\x47\xfb\xfb\xf7\x3c\x4d\xef\x9a\xc2\xcf\x31\xfe\x73\x7a\xb7\x5c\xb1\xef\x5a\x6d\xad\x9f\xd6\x7b\xb9\x04\xca\xe2\x15\xbb\xe8\x64\xdc\xce\x20\x14\xaf\x9f\x6f\x6f\x6f\x2e\x9f\x3f\xfa\x81\x05\xbe\x0e\x5a\x8b\xec\x3c\xdb\xa1\xbf\xd4\x1e\xec\x8a\xd3\x19\xdf\x8c\xfb\xe7\xeb\x88\x1d\xd3\xd7\x1b\x92\xf6\xe6\xa6\x07\x14\x11\xc1\x39\x7e\x3e\x75\x0f\xa7\x0e\xbf\x13\x5d\xa3\x71\xbf\x2c\x87\x76\x9d\x7f\x8b\xc9\x1e\x3e\x6a\x12\x6e\x76\xb4\x19\x0e\xe3\x40\x16\xc9\x5e\xc4\x49\x6c\xb8\x57\x97\xfe\xcd\x81\x1c\x53\x55\x61\xb6\xb2\xda\xaa\xe6\x3e\xad\x02\x54\x22\xcc\xe5\xf9\x79\xe4\x3d\xde\xbe\x3e\xd9\x4b\xed\x62\x4f\x38\xcf\xfa\x30\x61\x98\x91\xd5\x01\xd4\xab\xcd\xd3\xe1\x6c\x7b\xc0\x70\xbb\x83\x17\xfb\xd8\x97\x00\x36\xc1\xdf\x47\x8c\xa1\x2e\xcd\x92\x63\x28\xf1\xec\xdd\x2b\x31\xca\x70\x87\x43\x61\x71\x8f\xe5\x09\xcb\x42\x50\x62\x09\xbb\xc1\x3d\xe3\x93\x37\x01\x35\x15\xb3\x42\x1e\x09\x1e\xde\x96\xfc\xc8\x9b\x38\x14\x49\xe9\x11\x61\x6a\x94\x32\x77\xee\xa0\xce\x86\x27\x10\xdd\x1b\x81\x2f\xe0\x19\x3e\x23\xf6\xab\x1f\x56\x1b\x0f\xdf\x5f\xe0\xdb\x5c\x18\x8b\x0e\x75\x6e\x87\xc0\x24\x47\xbb\x7e\xbb\x7b\x80\xc4\x8b\xdd\xbe\x1e\x4d\xf6\x5e\x30\xd7\x1f\xdf\x7d\xf8\x31\x89\x7c\xa8\x1b\x17\xe3\x71\xf3\xa7\x02\xda\xba\x5f\x0c\x1f\x23\x05\xf2\xbb\x97\xc4\x5d\x4f\x7b\x29\x3c\x3c\x57\xf2\x29\x9f\xfe\x59\xc6\xbf\x3f\xf4\xe9\xfa\x17\xec\x20\x62\xe9\x7d\x12\x00\x00"
+
+func dataAwsVpcPublicPrivateDeployBluegreenMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateDeployBluegreenMainTfTpl,
+		"data/aws-vpc-public-private/deploy-bluegreen/main.tf.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateDeployBluegreenMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateDeployBluegreenMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/deploy-bluegreen/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateDeployBluegreenVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x95\xdf\x6b\xdb\x30\x10\xc7\xdf\xfd\x57\x1c\xce\x4b\x07\x4d\x69\xf7\x38\xd8\x43\xb7\x42\x17\x46\xdb\xb1\xb0\xed\xd1\x9c\xe5\x73\x22\x6a\x4b\x46\x3a\x25\x35\x63\xff\xfb\x24\x39\x29\x9b\x1b\x25\x29\xa4\xc2\x0f\x86\x3b\x7d\xbe\xf7\xd3\x9e\x4c\x4f\x70\xb2\x09\x5c\x0b\x41\xd6\xc2\x4c\xd5\x3a\x9b\x9c\x84\x99\xad\xd0\x48\x2c\x1b\x82\x1c\xd7\xb6\xc0\x28\x50\x3c\x52\x9f\xc3\xef\x0c\xfc\xa9\xc8\x0a\x23\x3b\x96\x5a\xc1\x47\xc8\x37\x11\x78\x07\xa8\xb5\x81\xeb\x5f\xf3\x7c\xe3\x56\xa3\x6b\x38\xb8\xe4\xd9\x9f\x31\xd6\x92\x30\xc4\x7b\xb0\xf3\xe8\xf0\x5a\x2c\xeb\x47\x52\x49\xa2\xb5\xe1\x35\xfa\x44\x28\x53\xdb\x69\x83\xa6\x0f\x78\xf0\x7a\x15\x29\x96\xd8\xd8\x63\xa4\x0c\x2d\x3c\x2d\xa1\xf5\x3d\x1a\x61\xbd\x24\x43\xb0\xf6\x8f\x6c\x1a\xd0\x1d\x19\x64\xba\x88\xb0\xc9\x89\x06\xe0\x86\xba\x46\xf7\x6f\x34\x00\x52\x59\x46\x25\xa8\xe0\xbe\xa3\x44\xaa\xb3\x8d\x0f\x44\x9f\x71\xe1\xf8\xfd\x45\x2b\x85\xd1\xa3\x02\xfa\xb6\x16\x0a\xdb\x14\xf3\xab\xef\x7a\x87\xd2\xc0\xc2\xa0\x62\xaa\x60\x3e\xff\x02\xc3\x24\xfa\xf6\x01\x2f\x09\xb6\xa1\x1d\xec\x95\xb3\x64\x8a\x0a\x19\x13\x5a\x3f\xbc\x1d\x82\x1d\x74\xfd\x3f\xf9\x1c\xac\x13\x4b\x40\x0b\x08\xa2\xd1\xae\x9a\x4a\x25\x19\x86\xdb\x87\x64\xad\x2b\x95\x1f\x70\x59\xa5\xa6\x31\xda\x43\x36\xd5\xd0\x41\xa9\x78\x5c\xa5\x55\x27\xd2\x80\x9f\xdf\x3e\xef\xbf\xfd\xdc\x3c\xa1\x9d\xe2\x04\xe5\xde\xb5\xa5\xcf\xdf\xa7\xfe\x9c\xf6\xb6\x0e\x28\x58\xae\x08\x84\x6e\xb4\x79\x91\xed\xd5\x48\x8c\xd4\x4a\x1a\xad\x5a\xbf\x40\x85\x75\x75\x2d\x9f\x92\x79\x07\xe3\xb0\x7e\x5e\x24\x0c\x41\x54\x34\x64\xb5\x33\x41\x5e\x2a\x40\x05\xff\x00\x77\x97\xfa\x54\x0b\xf4\xa9\x71\x34\xbd\x35\xe4\xbf\x09\x61\x89\xe0\xcc\xfa\xb6\x94\x3d\x3c\x30\xeb\x77\x6f\xf0\x4d\x8d\x55\x2d\xa4\xaa\xe8\x29\xb9\x51\xde\xb6\xab\x0b\x1f\xe0\x32\x16\xae\xf4\x21\x9f\xc3\x55\x7c\x5f\x84\xc8\x5f\x54\xe8\x72\xd4\x9e\x70\xe3\xc8\x39\x08\xae\x7b\xb6\x6b\x27\x19\x5b\x99\xfa\x3b\xdc\xcd\xb6\x99\x1c\x00\x8f\xb8\x31\xaf\x23\x43\x8e\xbe\xaf\x88\x79\x60\x1f\x15\xf4\x21\x74\x20\xff\x05\x7f\xc4\xb3\xab\xcc\x07\x00\x00"
+
+func dataAwsVpcPublicPrivateDeployBluegreenVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateDeployBluegreenVariablesTf,
+		"data/aws-vpc-public-private/deploy-bluegreen/variables.tf",
+	)
+}
+
+func dataAwsVpcPublicPrivateDeployBluegreenVariablesTf() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateDeployBluegreenVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/deploy-bluegreen/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x58\xff\x4f\xdb\x48\x16\xff\xdd\x7f\xc5\x5b\x07\x0a\x48\xd8\xa6\x7b\xbb\x2b\x5d\xda\x54\xe5\x0a\xa5\x48\x5b\xe0\x20\x45\x27\x55\xbd\xec\xc4\xf3\x6c\x8f\xea\xcc\xb8\x33\xe3\x84\x34\xe4\x7f\x3f\xbd\x99\x89\x93\x50\xe0\xba\x95\x68\xe2\x99\xf7\xfd\xcb\xe7\x3d\xa7\x07\x67\x28\x51\x33\x8b\x1c\xc6\x73\xb8\xb4\x56\x1d\x02\x57\x20\x95\x05\xe4\xc2\xfe\x12\xf5\xa2\x1e\x0c\x2b\x61\x40\x18\xb0\x15\xc2\x2d\x2b\x35\x93\xb6\x10\x35\x42\xf9\x90\x17\x0a\xa5\x1d\x15\xc7\x29\xd6\xaa\x99\xa0\xb4\xa0\x8a\xa8\x07\x96\x44\xb0\xa6\xa9\x45\xce\xac\x50\x32\x33\xa8\xa7\x22\xc7\x14\xce\x2d\x98\x4a\xb5\x35\x77\x4a\xc7\x08\x15\x93\x3c\x21\xe5\xc8\x53\x18\x2a\x98\x28\x2e\x8a\x39\x89\x8d\x7a\x9b\xea\x0f\xa1\x35\xe8\xb4\x1d\x37\x0d\x1d\xa4\x51\xb4\xd8\x05\x51\x90\xf6\x51\xa3\xd5\x54\x70\xd4\xb0\xbb\x8c\x7a\x70\x82\x05\x6b\x6b\x0b\x56\x39\x86\xee\xb2\xd0\x6a\xb2\x29\x02\x0c\x11\x30\x0b\xba\x95\x52\xc8\x72\xa5\x2f\xea\x01\x17\x1a\x73\x5b\xcf\x49\xab\x0f\x85\x61\x93\x0d\x51\xcc\xb8\x10\xa4\xd1\xe9\xc5\xed\xe7\xf8\xf6\xf8\xec\xfa\xf8\x62\x38\x3a\x39\x7d\x7f\xfc\xe9\xcf\xe1\xe8\xea\xfa\xf2\xf6\xfc\xe4\xf4\x3a\xfe\x02\x03\x88\x17\x8b\x6d\x1b\x97\xcb\x98\x4c\x47\xc9\x45\x41\x06\x47\x41\x6d\x9a\x2b\x59\x88\xb2\xd5\xb8\x1f\xff\x1a\x1f\x50\x66\xee\xfd\xd1\x7d\x04\xe0\xbf\xa5\xd3\x49\x3a\x56\x77\x24\xb6\x62\xa6\x12\xb9\xd2\x4d\xd6\x68\xcc\x85\xc1\x3f\x7e\x8b\x23\x00\x1f\x14\x55\x14\xb5\x90\x38\x22\xda\xdd\x65\x04\xd0\x83\x7f\xb5\xa2\xe6\xe4\x65\xb8\x3b\xf4\xee\x23\x10\x0d\xe5\x8b\x73\xe4\xeb\x18\x05\xaa\x10\x09\xa5\xe7\x0f\x6d\x18\xb5\xba\x26\x3b\x28\x92\xfd\x2c\x5b\x2c\xb6\x94\x2e\x97\xc1\x98\xb5\x9b\x64\xc4\x0d\xda\xb6\x01\x06\x66\x2e\x73\xd2\xa6\xea\x2e\x31\xaa\xd5\x30\x53\xfa\x2b\x99\xd8\x29\x05\xab\x20\x9b\x86\xb4\x6c\x1a\xe0\x05\x8c\x82\x00\x8a\x71\xc3\x6c\x95\xae\x04\x2c\x97\xf1\xa1\x3b\x35\x15\xd3\x1d\xdd\x88\x68\xdc\x5d\x04\xb0\x0a\x15\xe5\x86\xa4\x8d\xec\xbc\x41\xd8\x5d\xd2\x47\xbf\xcb\xda\xfa\x86\xd8\x36\xfd\x01\x00\x50\x33\x89\xba\x0f\x71\xb0\x30\x3e\x84\x52\xab\xb6\xd9\x38\x89\xba\x94\x88\x49\xa3\xb4\xf5\x26\xfc\x32\x80\x38\x5e\x25\xe6\x44\x18\x36\xae\x31\xb4\x92\x2f\xdd\xad\xf8\x3c\xe7\x78\x4a\x7e\x66\x6b\xfd\xdc\x0b\xe3\x7d\xb0\xba\xc5\xc7\x52\x70\xa5\xb4\x35\xd4\xbb\x33\xa6\x29\xe3\xa1\x4b\x2a\x65\x6c\xea\xfb\xda\xa0\xf5\x35\x8f\x72\x2a\xb4\x92\xae\xb1\xa7\x4c\x0b\x67\xa6\x28\x9c\x18\x61\xc1\x60\x8d\xb9\x45\x0e\x0c\xb8\x28\x0a\xd4\x44\x47\x72\x80\x3c\x85\x31\xe6\x6c\xd5\xb4\x5d\x69\x73\x50\x12\x41\x18\x10\x92\x7a\x2b\xf5\x16\x12\x92\x34\x74\x44\x21\xef\x4c\x1b\x35\xce\x54\x17\xa6\x75\x00\x24\x5a\xca\x32\xc4\xdb\x74\x14\xfb\x16\x8d\xed\x03\xd5\x42\x7a\x46\xdf\x61\xb9\xf4\x99\x26\xa3\xfa\x70\x7a\x71\x9b\x16\x68\xf3\x6a\x3f\xbe\x1c\x0e\x2f\x47\x27\xa7\xb7\xa3\xab\xcb\xeb\xe1\x68\x8b\x23\x14\x4e\x93\x7e\x50\xc6\x52\xb0\xe8\xec\x20\xb5\x6a\x24\xba\x70\x92\xbd\xab\x78\x9e\x4a\x17\x96\x9b\x9b\x0f\xc0\x4a\x8a\x40\xb0\x8b\xca\xd0\x28\x28\xd1\x5a\xfa\xda\x68\x31\x65\x96\x32\xdc\xa0\xe4\x28\x73\x81\xc6\xd5\xbb\x59\x7b\x67\x4c\x95\x06\xee\x91\x97\x35\xf0\x69\xec\x8a\xa8\xd1\xea\x6e\x3e\x42\x39\x5d\x15\xcf\xa7\x10\x60\x77\xe1\xd3\x37\x63\x06\x72\x35\x69\x44\x8d\x1c\x66\xc2\x56\x2e\xbc\xac\xae\x81\xab\x99\xac\x15\xe3\x2e\xfa\x0e\xdf\x3f\x6e\x85\xd6\x41\x94\x11\x4a\x42\x6c\x2a\xac\xeb\xf8\x10\x84\xa4\x96\xee\xc3\x8e\xc9\xb5\x68\xec\xc8\xe9\x79\xac\xac\xde\xab\x56\x72\x87\xf6\x5d\xb2\xfd\xd3\xbe\x28\x80\xc9\xf9\xc1\x3a\xd3\x5c\x68\x32\xa0\xe8\x38\x46\x5c\x68\x93\x72\x0c\x5e\xd1\xfd\x00\xe2\x4c\x59\xab\xb2\x35\x55\xb2\x58\x10\x7b\xad\x54\x93\xbe\x53\xad\xb4\x01\x4b\x9f\x87\x05\x12\xe6\x92\xca\x85\xfe\x59\x67\xe3\x9c\x43\x6f\xc1\x85\x5e\xc2\x8b\x17\x30\x66\xa6\x0a\x8f\xd9\x84\x09\x99\x9a\x2a\x7e\xb4\x12\xfe\x54\x8c\xbb\x38\x13\x94\x15\x9a\x95\xd4\x38\x06\x2a\xd4\xe8\x53\x20\xe7\x5b\xe9\xdf\x28\xfe\x15\x75\xd7\x03\x1d\xb7\x8b\x08\x79\x1e\x4e\xee\x35\x32\x0e\xcb\xe5\x23\x16\xf8\x12\x19\x13\xce\x8f\x56\xf0\x1d\xca\xe4\x4c\xf9\x9e\x33\x96\xd5\xf5\xb3\x30\xef\x88\x48\x85\x2a\xba\x7a\x41\xfe\x7f\x82\xbc\x42\xfe\x2e\xd8\x3e\x79\x49\x38\x7f\x74\x14\x9c\x7b\x63\xc8\x34\x67\xf2\x26\xda\xfc\xdd\xaa\x2c\x55\xcd\x64\xe9\xe5\x7e\x64\x5f\xd1\xa1\x53\x18\xee\x7f\x05\x70\x04\x63\xaa\xbf\xa0\x54\x68\xd6\xd3\x3d\x60\x5f\xae\x34\x1d\xfc\x8d\x12\x71\xa8\xb2\xfb\xef\xcf\x98\x57\xca\x95\xcb\x93\xa3\x06\xde\xbc\x81\xac\x52\x13\x5c\x81\x74\x96\x52\x41\xe9\xfc\x8b\x37\xf7\x53\x43\x11\xf6\x2b\x86\x73\xc6\xd5\xc3\x1e\x85\x8f\x2a\x01\x66\xcc\xe6\xd5\x5e\x40\x66\xdd\x4a\xe3\xb7\xab\xce\x34\x37\x1e\x7a\xc0\x4a\x26\x24\x8c\xb1\x50\x1a\x3d\x4f\xc0\x1f\x17\x03\x92\x5e\x33\x8b\xc6\xae\x91\x21\x68\x13\x86\x70\x98\xa7\x4f\x39\xee\xf2\xe8\x04\xc6\x87\x10\x66\x23\x8d\xfc\x30\x43\x8d\x6a\x75\x4e\x67\x8e\x84\x5a\xe3\x10\x38\x1a\x2b\xa4\xeb\xd9\x3e\xc4\x0f\x9c\x5f\xcb\x73\x7d\xe4\x8c\xef\x56\x4e\xe5\x10\x11\x98\xa6\xa6\x07\xa3\x26\x08\xe3\xb6\x34\xa0\x45\x59\x59\x90\x6a\x16\x01\x7c\x8e\xa7\x93\x19\xd3\x38\x2a\x5a\xb2\x90\x8a\x2d\x1c\x10\xaf\xb1\x4e\x6f\xfc\x25\x45\x96\x57\x6e\x87\x92\x6c\x82\xf7\xce\xd8\x07\x0e\x72\xd4\xfb\x74\xe9\x57\xad\xc6\xd3\x00\x34\x29\x3a\x48\x1f\x4d\x27\xba\x95\x23\xd1\x8c\x6a\xa5\xbe\xb6\x0d\x0c\xa0\x60\xb5\x41\x47\x86\x92\x47\xfe\x7f\xfa\x8b\x1e\x41\xe7\x2d\xc4\x84\x01\xbc\x7e\x7d\xf3\xee\xfa\xfc\x6a\x18\x19\xb4\x90\x60\x14\xf5\xe0\x1a\x9b\x9a\xe5\x9b\x00\x6e\xfc\xb4\x30\xbe\x41\x09\x30\x1a\x8d\x53\xa1\xda\x8d\x8c\x47\x06\x39\x24\x02\x12\x84\xbd\xec\xbf\x95\xb5\x8d\xd7\x31\xc8\xce\xf9\xde\xc6\xa9\xf9\xf1\x58\xaa\xcd\xb3\x0c\x6d\x9e\x6d\x76\x5d\x00\xa3\x29\x08\xb9\xed\x8b\x2b\xf3\xbd\xc5\x02\xa6\xe9\x05\xed\xc1\xcb\xe5\xc0\x3d\xdc\xb2\xba\xa5\xa7\x3d\x57\xe5\x0f\xc5\x3d\xe0\xba\x6f\x9b\x06\xf5\x4f\xf2\x6e\x63\x5b\x0f\x58\x63\x93\x12\x5d\xb9\xea\x56\xfa\xb1\x66\x5a\xae\x0e\x61\x56\x09\x97\x68\x34\x72\xcf\xc2\x57\xc4\xe6\xe1\xee\x12\xe5\xcc\x42\xd0\xc1\x1a\x4b\x7f\x6e\xd7\x4e\x79\xf6\xcf\xdf\x5d\x3d\xfa\xe0\xbf\x7e\x7d\x7a\xf9\xfe\xc9\x20\xf8\x14\x87\x00\x0c\x68\x01\xef\x22\x4f\xab\xdc\x71\xfe\xad\x15\x1a\xfb\x7d\x3a\xee\xf7\xaf\x9c\xc4\x78\xcb\xd3\xf8\x95\x73\xab\xfe\x51\x8c\x79\x42\x8e\x79\x56\x50\x80\xd3\xcd\x50\x91\x03\xa1\xcc\xb6\x00\x77\x1b\x28\x1f\xab\x46\x85\xfb\x07\xb0\x80\x9d\xb7\xf0\xeb\x9b\x17\x2f\xe1\x1e\x6a\x55\x96\xa8\x21\xb1\xe0\xb0\xe8\x0d\x64\x1c\xa7\x99\x6c\xeb\xfa\x15\x2c\x23\x55\x3b\x72\x8f\x7f\x9f\x89\xe2\x0b\xec\xbc\x8d\xe9\x2a\xea\xc1\x79\x01\x33\x7a\xb7\x9b\xfa\xda\xd6\xf8\x8d\x96\x2b\xe4\x30\x45\xed\x60\x45\x15\x70\xa6\x0e\xe9\x52\x86\x37\x50\xc2\xab\x94\x18\x19\x3d\xa0\x8e\x7a\x1d\xf1\xe6\xe4\x3a\x04\x1d\x9a\x66\x05\xf1\x8f\x89\xef\x40\x4d\x14\x04\x78\x13\x26\x39\x24\x53\x28\x15\xbc\xe9\xbc\x70\x7e\xbe\x72\x26\xb8\x8e\x16\x05\xdd\xaf\x24\xdc\x43\xa9\xb1\x81\xe4\x1b\xc4\xa5\x0a\xef\x02\xa5\x1a\xad\xae\x97\x4b\x88\x37\x78\xe9\x9f\xaa\x21\x3e\x53\xf0\x28\x2d\xab\x69\x6a\xcf\xd7\x6e\xfc\x12\x56\x35\x45\x35\x2b\xba\x49\x98\xc6\x9d\x38\xbc\x13\x16\x8e\xdc\x63\x21\xa2\x68\xa5\xc1\x43\x06\x61\x7b\x27\x0b\x76\xf6\xb7\x0c\xcf\x5b\x0b\x09\xdf\x83\x3d\x48\x8a\x7f\x1c\xf8\x56\x79\xc2\xb0\x34\x0d\x1a\x15\xba\x6e\x02\x3d\x81\x44\x17\x90\xb5\x46\x67\xb5\xca\x59\x9d\x95\x2a\x22\xfd\x4f\xec\x16\x64\xd2\x3b\xd5\xcc\xc9\xa0\xa7\x9c\x7f\x7a\xd7\x70\xea\x15\x42\xde\xc0\xd6\xbe\x90\x3d\x1e\xf2\xb4\x16\xb2\xbd\x4b\xd8\x84\xff\xf1\x5b\x6a\x99\x4e\xcb\xef\x0f\x46\x6b\xa9\xc2\xb9\x6f\x34\xd3\xd9\x78\x12\x36\x99\x67\xec\x5c\x19\x33\x23\x98\x49\xbe\x41\x72\xf9\x94\x70\xf0\xad\x99\x65\xc6\x2a\xcd\x4a\x4c\x4b\xa5\xca\x1a\x59\x23\x4c\x9a\xab\x49\xe6\x9b\xec\xe7\x9d\xd8\x6e\x55\x32\xf7\x93\xb4\x4c\x6b\x6f\xec\xca\x2e\x97\x1f\xcb\x34\x24\xef\x36\xf2\x03\xc9\xdd\xf7\xe2\xc9\x28\x38\x61\x1f\x99\x7b\x25\x3e\xbb\xbc\x3a\x1e\x7e\xd8\x92\x36\xf9\xca\x85\x86\x84\xa2\xdf\x10\x1b\xed\x2c\x51\x61\x68\xca\x0f\x76\xf6\x0b\x21\xf9\xe6\x0d\x24\x13\x21\x39\x36\xb6\x82\x23\x48\x26\xec\xae\xfb\x4e\x0c\xc0\x21\x69\xb4\x90\xb6\x80\x78\xf7\x7d\x7c\x10\xfd\xc8\xee\x25\xc3\xce\xc2\x7f\x59\x06\x86\x23\xb8\x87\x3b\xa6\x4b\x03\xc9\x11\x24\x12\x5e\x1e\x1d\x41\x5e\xa9\x99\x84\xe0\x50\x3f\x7c\x7a\x77\x6e\xc2\x0b\x55\xdb\x40\xe7\x90\x9f\x34\x78\xe7\xde\x3b\xe9\x74\xb0\xa1\x38\x1b\x0b\xd9\xdf\xaa\x68\x77\xb2\x43\x74\x7b\x4f\xae\x67\xdb\x32\xcf\x2e\x1f\x4a\x7d\x86\x33\x4c\x0a\x94\xdc\x8f\xaf\x07\x92\x5e\xfe\x7e\x7b\x7a\x71\x72\x79\x7d\xfa\x9f\xab\xd3\xeb\xf3\x8f\xa7\x17\xc3\xc1\xcb\xe7\xa5\xad\x8b\xc3\x8b\x76\xbf\x9d\x3d\xd6\x8a\x61\xa7\x76\xbf\x65\xbd\xbb\x71\x6f\xf9\x50\xba\x77\xd0\xad\xbc\xaf\x86\x69\xdb\x70\x66\x11\x92\xf9\x0f\x37\x2b\x48\x4a\xe6\x50\x0a\x0b\xe3\xef\x1a\x26\xa8\xf3\x56\x0b\x56\x47\xa1\xeb\xfd\x4b\x5e\xe8\x28\xab\xdc\x0f\x74\xf4\x16\xbc\xf1\x02\xf1\x61\x38\xbc\x72\x9a\x49\x88\xdf\xbe\x20\x49\xca\x5a\x8d\x59\x0d\xad\xae\xd3\xb8\x14\xf6\x6d\x29\x6c\xd5\x8e\xa9\x75\xfa\x71\x1a\xb8\x2f\x8b\x30\x19\xfb\x59\xb6\xbe\xcf\xb6\x7f\x39\x0b\x23\xec\x7f\x03\x00\x15\x29\xdb\xd4\xd8\x14\x00\x00"
 
 func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -155,7 +353,27 @@ func dataCommonDevVagrantfileTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevDepVagrantfileFragmentTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x93\x31\x8f\xdb\x30\x0c\x85\x77\xff\x0a\xd6\xe9\xdd\x74\x67\xef\x87\xbb\x29\x40\x3b\xb6\x40\xbb\x74\x32\x1c\x89\x89\x85\x5a\xa2\x20\xd1\x0e\x8c\x20\xff\xbd\xa4\x1d\x24\x2e\xda\x06\xbd\xcd\x92\x9e\x3e\xbd\x47\xd2\x1b\xf8\x8c\x01\x53\xcb\x68\x61\x37\x81\xc5\x88\xc1\x62\x30\x13\xec\x29\xc9\x72\xc4\x9e\xa2\xc7\xc0\x2f\x70\x3a\x41\x68\x3d\xc2\xf9\x5c\x14\x1f\x6f\x8b\x26\x23\x0f\x11\xde\xe0\xf5\x75\xfb\xe5\xeb\x8f\x62\x03\x5b\x8a\x13\xd0\x90\x60\xe7\x42\x9b\xa6\x22\x0f\x96\xc0\x8f\x50\xb3\x8f\xb5\xbc\xf0\x7c\xbb\xac\x50\xd9\x69\x16\x65\x13\x5b\xee\x66\xfe\x05\xc2\x1d\xc2\x10\x33\xb7\x89\x61\xef\x7a\xbc\x87\xaa\x2e\xc2\xca\x50\xd8\x43\x8d\x6c\x6a\x17\x1c\xd7\x2b\x85\x9e\x28\xfb\xdb\x0c\x74\xfc\x61\xe1\x2d\xfc\x55\xbc\x39\x87\xea\xa6\x60\xe6\x20\x74\x0c\x6a\x53\x6a\xd2\x5b\x4c\xe0\x82\x3a\x4b\x58\x28\xd0\x1d\xaa\xd1\x57\x59\xa4\x68\x9b\x8b\xa0\x14\x98\x66\xa9\x8e\x94\x7e\xba\x70\x10\x68\xf9\x74\xdb\x3d\x0c\x98\xb9\x59\x9d\x5d\x03\x6b\xc8\x2c\x7c\x26\x68\x81\xd1\x47\xb0\x2e\xa1\x61\x4a\x53\x05\xdf\xa5\x1a\xd9\x24\x17\x19\x8e\xae\xef\xc1\xd3\x88\x6a\xc4\x57\x2b\x23\x31\xd1\xe8\xb2\xa3\x00\xa5\xc2\xca\xa7\x02\x20\x4b\x06\x83\x2f\x37\x03\xa6\x35\x9d\x26\x95\x12\x8e\xcf\x5a\x46\x1a\x38\x0e\x3c\x8b\xad\x78\x93\x6e\xb0\x20\xe4\xc6\x5f\x0a\x2d\x6e\xdf\xfb\x1c\xf9\x28\x87\x76\xf5\x62\xbd\xee\xd6\xff\xbd\xfb\x5b\x83\xff\x65\x22\x77\xd8\xf7\x33\xcf\x85\xde\x05\x71\xf1\xc7\xa8\x6a\xb1\x3f\xd1\x10\xec\xfc\x16\x2c\x98\x21\x2d\xab\xcb\xd4\x6b\xb7\x8b\xd3\xc3\xb2\x74\x73\xc7\xf7\xd7\x2b\x8d\xec\xe4\x4a\x54\x8d\xce\xc4\xc3\xb9\x50\xc5\x9b\x78\x26\x66\xaa\x6f\xba\x95\x77\xfd\x14\x56\x4f\x14\xab\xad\x08\x58\x86\x44\x2b\x79\x6f\x80\x94\x3a\xcf\x8d\x7c\xdc\x0d\x7b\x8d\x5a\x1a\x0b\x9b\x93\xc8\xcf\xf0\xf8\x08\xbb\x36\x77\x97\x65\xed\x5b\x17\xaa\xdc\x95\x9a\x49\x7e\x70\x8d\x25\xbe\x7f\x05\x00\x00\xff\xff\xb6\x2e\xa8\x1d\xfd\x03\x00\x00"
+var _dataCommonDevWatchShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x74\x94\xdf\x6f\xdb\x36\x10\xc7\xdf\xf9\x57\x7c\x23\xbb\x53\x32\xcc\xf2\x3a\x60\x4f\x81\x8b\x6e\xc3\x30\xf4\x61\x3f\xb0\x04\xd8\x43\x57\x04\xb4\x78\xb2\x08\x53\x3c\x81\x3c\xd9\x31\x9c\xfc\xef\x05\x69\x4b\x69\xd2\x94\x4f\x04\xef\x78\xf7\xbd\xcf\x1d\x39\xbb\x58\xae\xad\x5f\xae\x75\x6c\xd5\x4c\xcd\xf0\xcb\x20\xbc\xd8\x90\xa7\xa0\x85\x0c\xd6\x07\xfc\x2d\xc2\x55\xb6\xdd\xb6\x36\xc2\x46\x48\x4b\x88\x75\xb0\xbd\x20\x0c\x3e\xf9\x94\x2c\xc2\x30\xb4\xc3\x5e\x4b\xdd\x96\x15\x3e\x08\x02\xad\x07\xeb\x4c\x84\xf6\x06\x81\xa2\xe8\x20\x51\xcd\xf2\x75\xdd\xf7\xce\xd6\x5a\x2c\x7b\xec\x5b\xf2\xb4\xa3\x00\x8d\x3f\x18\x8d\x75\x84\xba\xd5\x7e\x43\xb1\xc2\x6d\x4b\xf9\x24\x42\x07\x42\xcf\xce\x91\x41\xb4\xbe\x26\x35\x3b\xb9\xc6\x43\x14\xea\x40\x3b\xf2\x12\x61\xd8\x97\x82\x3d\x87\x2d\xf6\x56\x5a\x74\x1c\x05\xf1\xe0\x6b\x32\x68\xd8\x19\x0a\xb1\x52\x8a\xdd\xe5\x15\x8e\xa0\xba\x65\x14\x1f\x93\xf6\x4f\x98\xbf\x2f\xae\xf1\xa8\xd4\x0c\x37\x24\x90\x54\xaa\x30\x1a\xbd\xa5\x2c\x38\x01\x0a\x35\xd8\x23\x72\x47\xe8\x9d\x96\x86\x43\x97\x60\x68\xc1\x9e\xca\x40\xb0\x5e\x28\xe8\x5a\xec\x8e\x2a\x45\xf7\x3d\x07\xc1\x3f\x37\x6f\x57\xc5\x3b\x14\xaa\xc2\xb2\xe5\x8e\x96\x3b\xbd\x09\xda\xcb\xb2\x3a\x45\x54\xaa\x36\x38\x1e\x11\x5b\x1d\xc8\xdc\x9d\x34\xde\xf5\x5a\x5a\x3c\x3e\x2a\xb5\xb6\x7e\xf5\xe2\x5e\x92\xbb\xc8\x98\x97\xc7\x23\xbc\xee\x28\x79\x76\x5b\x63\x03\x16\x3d\xe6\x97\xc6\x86\x7c\x3a\x5f\x5b\x7f\xa5\x7a\x6b\x56\x45\x91\xea\x8a\x5e\xf7\xb1\x65\x19\xe9\xbe\x06\xde\x46\x68\x63\xc8\xfc\x80\x40\x1d\xef\xd2\x86\x03\x3a\x36\xb6\xb1\x64\xd4\x18\x22\xe1\x53\x00\xd0\x58\x6f\x50\x61\x91\x13\x96\xdf\x57\x1b\x2e\xb1\xe8\x83\xf5\xd2\xa0\x7c\xd3\xe3\xcd\xed\xfb\xff\x7d\x89\x9f\xde\x2d\x0d\xed\x96\x7e\x70\x0e\x0f\x88\x09\xcc\x03\x3a\xf3\x73\x1c\x3a\xf5\xa8\x54\x14\xee\xa7\x90\xb6\xc1\x47\x2c\x3c\x8a\x79\x6f\x4d\x81\x4f\xd7\x89\xbf\xcf\xa6\xb4\xb6\xd6\x39\x24\xd3\x97\x41\x27\xeb\x5e\x5b\xf9\xb6\xf5\xcc\xe2\x24\x3c\x25\x3e\x4f\xe5\x94\x3b\x09\x51\x79\xc7\x0e\xc5\xaf\x69\x7c\xad\xdf\xe0\x89\x73\x55\x55\xc5\xa8\xf2\x02\x1b\x46\x1e\x71\x2c\x18\x45\xc2\x5d\x24\x57\x43\xbb\xbb\xdc\x9f\xbb\x5e\xd7\x5b\xbd\x49\xf7\x5e\x14\x31\x45\x47\xa3\xad\x23\x53\xe1\x3f\x6d\x25\xa5\x6a\x38\x4c\xd3\x3f\xe6\x4a\x2b\x90\x0c\xc1\x8f\xd2\x27\x89\x37\x49\xfe\x37\x24\xbe\xa6\x48\x87\x4d\x7c\x88\xba\x49\x8e\xf8\x4e\x8d\x50\xe6\x17\x89\x86\x04\xdd\xa3\x4c\x0c\xae\x41\xf7\x56\xf0\x63\x89\x0f\x7f\xdd\xe2\xf6\xf7\x7f\xff\x54\xca\xe9\x28\xab\xf9\xe5\x38\x02\x57\x23\x3c\xb5\x6f\xd3\xe4\x48\x18\xe8\x1a\x86\x4f\x1c\x1d\x51\x8f\xb7\x79\x5f\x0f\x21\x90\x7f\x7e\x75\xea\x73\x31\x3f\x9b\x0b\xac\x56\x28\xe6\x29\xc9\xd7\x3d\xaf\xd9\x8b\xf5\x03\x3d\x2b\x7f\x96\x99\x65\x60\xd2\x12\xc8\x58\xc9\x4f\x36\x92\x88\x23\x44\x3e\x3d\xcd\xa8\x77\x89\x4f\xa7\xfd\x61\xfc\x49\x04\x9c\x3e\x90\x53\x10\xf6\xee\xf0\xf4\x55\x25\x43\x95\x2d\xaf\x54\xf5\x54\xd9\x33\xa6\x86\xd6\x3c\xf8\x3a\x3f\xc3\xd1\xcd\xd3\xfd\xd7\x15\x7f\x51\x75\xb2\x9f\x4b\x9e\x00\xbc\xac\x3a\xad\x75\x20\xbd\x9d\x4e\x1a\xfb\x84\x64\xa4\x9a\x22\xe5\x53\xc3\x9e\x4e\x64\x4e\x9d\x3a\x7b\x4c\xa3\xf2\x5b\x9e\x2a\x18\x12\xaa\x85\xcc\x79\x48\xc6\x26\xe6\xdb\x9f\x07\x00\xb7\x5d\xad\x09\x0d\x06\x00\x00"
+
+func dataCommonDevWatchShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevWatchShTpl,
+		"data/common/dev/watch.sh.tpl",
+	)
+}
+
+func dataCommonDevWatchShTpl() (*asset, error) {
+	bytes, err := dataCommonDevWatchShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev/watch.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevDepVagrantfileFragmentTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x53\x41\x6f\xdb\x3c\x0c\xbd\xeb\x57\xbc\xcf\xf9\xd2\x53\x6b\xdf\x83\xf6\x54\x60\x3b\xb6\x40\x77\xd9\x29\x70\x2c\xa6\x26\x66\x8b\x82\x44\xbb\x30\x0c\xff\xf7\x41\x4a\x5a\xaf\xdd\x96\xa1\x37\x51\x22\xf9\xde\xe3\xa3\x36\xf8\x4a\x8e\x42\xad\x64\x71\x98\x60\xc9\x93\xb3\xe4\x9a\x09\x47\x09\xb0\x34\x52\x27\xbe\x27\xa7\x3b\xcc\x33\x5c\xdd\x13\x96\xc5\x98\xff\xd7\x60\x1f\x49\x07\x8f\x3b\xdc\xde\xde\x3f\x3c\x7e\x37\xf3\x36\x97\x1e\xc0\x2e\xb5\xdb\x1f\xd8\xd5\x81\x29\x62\xbb\x98\x0d\xee\xc5\x4f\xd0\x96\x90\xaf\x27\xd4\xce\x82\x35\x62\xf0\x51\xeb\xa0\x38\x72\x47\x26\x0e\x56\xd0\x8f\xa8\xb4\xf7\x95\x25\x7f\x33\xcf\x38\x94\x4f\x14\x46\x6e\x12\x24\x72\xfc\x58\x6b\x9b\xc8\xfc\x2b\xbb\x3c\xf7\x2e\x1b\x71\x47\x54\xa4\x4d\xc5\x8e\xb5\xfa\x98\x96\x9e\x8d\xd9\xe0\x29\x13\x61\xfd\xef\xd4\xf9\xc4\xeb\x43\x72\x52\x49\xce\x26\xa1\xdb\xc5\x64\xdd\xa9\x72\x72\x0d\x64\x08\x90\x97\xac\x1d\x47\xe9\x2c\x05\xb0\x4b\x92\x03\x99\x04\xc1\xcf\xe5\xd8\x97\x71\x72\x0d\xd9\xfd\x39\xa1\x98\x67\xf8\x5a\xdb\xf2\x45\xc2\x0f\x76\xcf\x58\x96\xe2\x7a\xbd\x7d\x1e\x28\xea\xfe\x97\x37\xf3\x3a\xc9\x34\xae\x08\x76\x2a\xa8\xa1\xd4\x7b\x58\x0e\xd4\xa8\x84\xa9\xc4\xb7\x96\x10\x9b\xc0\x5e\xf1\xc2\x5d\x87\x5e\x46\x4a\x44\xfa\xf2\xa2\x49\x2b\x49\x1f\x64\xe4\xc8\xe2\x50\x24\xa0\xe2\xda\x00\x51\x86\xd0\xd0\x6e\x25\xd7\xd4\x4d\x9b\x46\x72\x9a\xe7\xc3\xa0\x7e\xd0\xcc\xdf\x00\x96\xa2\xb2\xab\x95\xc5\xed\x50\xfc\xcd\xa0\xc2\x7c\x1a\x53\x7a\xcf\x1d\xd9\x04\x6b\x69\xbc\xb1\xe4\xab\x4b\xae\x7f\x82\xcc\xfb\xba\xf7\x3e\xff\x99\x66\x6c\xa9\xeb\x32\x02\xbb\x8e\x1d\xed\xf0\xdb\xef\x48\x7e\x7d\x91\xc1\xd9\x8c\x8e\x53\x9b\x21\x9c\xa2\xf3\x47\x4b\x3e\xbc\xfa\x62\x39\x2f\xcd\xf1\xad\x64\x6f\x39\xc4\xd2\xd2\xb8\x4f\x6b\xb5\x5d\x4c\xca\xb8\x43\x51\x89\xaa\x54\x6b\xde\xcd\x8a\x9c\x8e\x47\x09\x9d\x88\x2f\xef\x65\x70\x4a\x21\xcf\xfa\xd2\x0e\xa6\xae\x79\xf5\x2c\x87\x8b\x62\xdf\xa4\x16\x8d\xc5\x66\xb6\x1c\x16\x5c\x5d\xe1\x50\xc7\xf6\x1c\x56\x7d\xcd\xae\x8c\xed\x87\x11\xfe\x1c\x00\x19\xc7\x1c\x99\x70\x04\x00\x00"
 
 func dataCommonDevDepVagrantfileFragmentTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -175,7 +393,7 @@ func dataCommonDevDepVagrantfileFragmentTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevDepVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x55\x6d\x4f\xdc\x38\x10\xfe\x9e\x5f\x31\x04\x38\x40\x22\x09\xad\xaa\x7e\xd8\x16\xd4\x0a\xee\x80\x0f\x27\xd0\xed\x5e\xef\xc3\xe9\x84\xbc\xc9\x24\xb1\xea\xd8\x3e\xdb\xd9\x17\x60\xff\xfb\x8d\xed\xec\x2e\xdb\x5e\x5f\x24\xd0\x3a\x63\xcf\xf3\xcc\xcb\xe3\xf1\x3e\x5c\xa3\x44\xc3\x1c\x56\x30\x5d\xc2\x9d\x73\xea\x14\x2a\x05\x52\x39\xc0\x8a\xbb\xbd\x64\x3f\xd9\x87\x49\xcb\x2d\xd0\x9f\x6b\x11\x3e\xb1\xc6\x30\xe9\x6a\x2e\x10\x9a\x2f\x7d\xa1\x56\x06\xa6\x3d\x17\x15\x97\x8d\x3f\x4e\xce\x53\x2e\x99\x59\xd2\x07\x73\x1e\xa3\xb7\x74\x9c\x59\x60\x50\xa1\x46\x59\xa1\x2c\x97\xc1\xad\xc2\x19\x0a\xa5\x3b\x94\x2e\x0f\xac\x57\x31\x8c\x96\xc9\x2a\xf3\xb1\x10\x04\xf9\x7b\xe2\x1c\x26\x0a\x3a\x55\xf1\x7a\x19\x8c\xa7\x1e\x35\x44\xf7\x51\xeb\x70\x20\x49\x86\x38\xf3\x52\xc9\x9a\x37\xbd\xc1\xe3\xf4\x75\x7a\xe2\x73\x7b\x8e\xa6\xe7\x04\x20\xae\xf2\x59\x97\x4f\xd5\x02\xce\x21\x6d\x99\x6d\x79\xa9\x8c\x2e\xb4\xc1\x92\x5b\x7c\xfb\x26\x4d\xe8\xe0\x3e\x8c\xd1\xf5\x9a\xa2\xb6\x4b\x59\x52\x06\xb5\x12\x15\x1a\xa8\x8d\xea\x40\xf5\x06\xe6\xca\x7c\xf6\x39\x57\x9c\xfc\x9c\xf2\x09\x2b\x28\x66\x31\x88\x1d\xa6\x08\xf0\x30\x00\xa4\x4f\x4f\xa0\x99\x6b\xf3\x35\xc0\x6a\x95\x9e\x06\xab\x6d\x99\xd9\x9c\x7b\xf0\x67\xc2\x1e\x61\x01\xa8\x39\x15\x7e\x04\xe9\x80\x4f\x1e\x8d\x51\xbd\x7e\x61\xf1\x41\x3f\x1d\x02\xaf\x81\x77\x5a\x19\x17\x01\xf6\x28\xc5\x14\x0e\x57\x21\xa3\x2b\x6e\xd9\x54\xc4\xba\x55\x58\xb3\x5e\xb8\xdd\xec\xbe\x17\x76\xee\xa3\x2c\xb6\xfc\x55\x04\xab\x46\xe0\x4c\x8f\x91\x9c\xba\x4b\xfc\xc4\x16\xe8\x7e\x95\x81\x6d\x3c\xbe\x01\x46\xc2\x71\xbe\xe9\x73\x66\x82\x52\xac\x22\x2d\x39\xe7\x97\xda\xf0\x19\x49\x6a\xab\x0e\x8e\x36\x54\xd7\x6e\xc3\xb1\xb6\xcd\x07\xef\x87\x88\x75\x1e\x69\x7f\xd4\xa9\x79\x8b\x06\x43\xbf\x4a\xd5\x69\x12\x4a\x05\x15\x73\x2c\x68\x5b\x05\xe7\x42\x91\x8c\x73\xf8\x0b\xbd\x52\x82\xe0\xa8\x8f\xac\x2c\xd1\x46\xf9\x07\x71\x83\x2d\x0d\xd7\x24\xd3\x9f\xe8\xeb\x86\x68\xb5\x2a\x48\xe2\x19\xe5\x15\x4a\xe7\x79\x7e\x2c\xad\x18\xb0\x27\x2e\x59\xe9\xfb\xc4\x0d\x05\xfb\x53\xbc\xe1\x7c\x54\x53\x20\xcb\x82\x65\xa0\xbc\x95\xd6\x31\x21\xe0\x5a\x0d\x19\xa1\x9c\x71\xa3\xa4\xbf\x7d\x3b\xe8\xda\xa8\x19\xb7\x5c\x49\x48\x6d\x8b\x42\x10\x1c\x97\x82\x4b\x1c\xc1\x41\xac\xc2\x43\xa3\x04\x93\x4d\x42\xdd\x4a\x92\x5d\x1b\xb5\xe5\xfd\xfb\xf1\xe5\x1f\xb7\xf7\x93\xc4\xa2\x83\x8c\x3a\xa4\xf0\xf8\x04\x9e\xe0\xe0\x03\xbc\xbe\xf8\xe5\x15\x3c\x83\x50\x4d\x43\x81\x67\x0e\x7c\x94\x70\x01\xbe\x4c\x85\xec\x85\x78\x07\xab\x44\x89\x70\x1c\xcb\x56\x41\xfa\xb7\x3f\xf1\x0f\xf9\xa6\x7e\x8b\x46\xc4\x6d\x0d\x73\xa4\x09\x31\x43\xca\xe4\xd4\xd7\x49\x0e\xd3\xab\x25\x2d\x25\xa4\x3e\x2a\x7f\x47\x03\x04\xb2\x19\x34\x04\xbe\xc1\x0e\xec\xef\x82\x47\xbc\x51\x02\x52\x2a\x06\x13\x06\x59\xb5\xa4\x1c\x43\x7d\xb0\xda\x8b\x73\x6d\xae\xe4\x91\x5b\x5b\x89\x2b\x4f\x83\x17\x2e\x68\x2c\x9d\x25\x35\xa7\xbc\x08\xe0\x8a\xee\xa5\x50\x2c\x48\x9a\xc0\xa8\x15\x44\x47\xb5\x78\x98\xa1\x09\x35\x5c\xad\xf2\x9c\x5c\x15\xc2\xbc\xf1\xf5\xf8\x17\xb2\x3b\x28\x5a\xd5\xe1\xfa\x2a\x15\x8d\xca\x1d\x33\x79\xf3\x08\xad\x73\xda\x8e\x8a\xc2\xd2\x38\x21\x95\xe7\x8d\x52\x8d\x40\xa6\xb9\xf5\xa2\x2a\x62\x89\xe9\xe7\x7f\x69\xa8\x45\xfd\x22\x63\x5d\xf5\xf6\xcd\x80\x17\x43\xfc\x53\xd2\x97\x89\x01\xae\x63\xb1\xbd\xd7\x3a\xa3\x1e\x5c\x42\xd1\x5b\x53\x08\x55\x32\x01\xd9\xe2\xb1\xfe\x56\x70\x11\xec\x77\x16\x46\xd6\xf5\xdd\xfd\xc7\xc9\xcd\x0e\x5a\xf7\xd9\x2b\x35\xd3\x74\x9f\xb4\x77\xf3\x92\x4c\x6a\xeb\x96\x1a\xcf\x0f\x8e\x6b\x4e\x1d\x79\xb1\x03\x59\x47\x16\xd4\xb4\x3a\xa3\x35\x5b\x6c\xd6\xde\x01\xa8\x7b\x34\x15\xe8\xcd\x81\xf4\xf0\xb7\xf4\x24\xf9\xda\x3d\x22\xc3\xc1\x53\x5c\xac\x06\x87\x33\x92\xd7\x82\x99\xc6\x42\x46\x58\x12\x5e\x9d\x9d\x01\x09\x69\x2e\x61\x48\x68\xb4\x1e\xd0\x21\x9d\xf1\x30\x82\xe8\x32\x6e\x12\x0a\xc2\x3b\xc2\x85\x9f\xa1\xc1\x7a\xfe\x82\xb8\xa0\xb7\x6d\xb4\xad\x18\x59\x83\xe5\xc0\x9f\x3b\x82\x8b\x8b\x2f\xaa\x97\x4f\xe9\x75\x31\xe5\x2e\x66\x2c\xde\x4b\xd4\xef\x78\x86\x30\x87\xbb\xeb\x23\xfd\x74\x39\xb6\xe1\xf1\x6c\xc2\x00\xdd\x69\x01\xd3\x2e\xf3\x22\xeb\x75\xe5\xe7\x69\xb6\xfc\x6a\x67\x2d\xe7\x6c\x09\x0d\xe9\x78\xfa\x68\xa0\x43\x53\xf6\x86\x33\x11\xa9\x2e\x87\xc7\x73\x10\x34\x5d\x04\xff\xd0\xfa\x11\xee\x7d\xe9\xa6\x80\xaa\xe1\x66\x32\xb9\x0f\xcc\x1e\x24\x4e\x0e\xc8\xb2\x46\xa8\x29\x89\xa8\x37\x22\x4f\x69\xe3\x03\xfd\xb7\xfd\xd4\x2b\x77\x94\xe6\x83\xf7\x1d\x75\x74\xad\xf2\xed\x7e\x91\x26\xc3\xcc\xf8\x2f\x00\x00\xff\xff\x62\x31\x57\x7d\x9e\x08\x00\x00"
+var _dataCommonDevDepVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x57\x6d\x6f\xdb\x38\x12\xfe\xae\x5f\x31\x2b\x27\x97\x16\x88\xa4\xec\xde\xde\x02\xe7\x36\x45\x73\x4d\x9a\x06\xb8\x6d\x82\x24\xcd\x7d\x58\xec\x19\xb4\x38\x92\x88\xca\xa4\x4a\x52\x76\x5c\x47\xff\xfd\x30\x24\xad\x48\xcd\xcb\xe6\x0a\x14\xb1\xf9\xf2\xcc\x33\xcf\xbc\xd1\x13\x38\x45\x89\x9a\x59\xe4\x30\x5f\xc3\xb9\xb5\x6a\x1f\xb8\x02\xa9\x2c\x20\x17\xf6\xa7\x68\x12\x4d\xe0\xba\x12\x06\x84\x01\x5b\x21\xdc\xb0\x52\x33\x69\x0b\x51\x23\x94\x3f\xde\x85\x42\x69\x98\xb7\xa2\xe6\x42\x96\x74\x3c\x9a\xc0\x5c\x48\xa6\xd7\x60\x2b\x66\x09\xa3\x35\xc8\x81\x19\x60\xc0\xb1\x41\xc9\x51\xe6\x6b\x77\x8d\xe3\x12\x6b\xd5\x2c\x50\xda\xd4\x59\x3d\xf6\x34\x2a\x26\x79\x42\x5c\xc0\x12\x0d\x32\x9c\xc2\xb5\x82\x85\xe2\xa2\x58\xbb\xc5\x7d\x42\x75\xec\x8e\x9a\xc6\x1d\x88\xa2\xcd\x2e\x88\x82\x40\x67\x8d\x56\x4b\xc1\x51\xc3\x6e\x47\xa8\x58\xb0\xb6\xb6\x60\x95\xbb\xd0\x6f\x16\x5a\x2d\x86\x10\x60\x94\xe7\xac\x5b\x29\xc9\x9b\xe0\x78\x34\x01\x2e\x34\xe6\xb6\x5e\x93\x55\x2f\x8a\x61\x8b\x01\x14\x33\x4e\x8c\x34\x3a\xf9\x7c\xf3\x47\x7c\x73\x74\x7a\x79\xf4\xf9\x7a\x76\x7c\xf2\xf1\xe8\xcb\xbf\xaf\x67\x17\x97\xe7\x37\x67\xc7\x27\x97\xf1\x9f\x70\x08\xf1\x66\x33\xe6\xd8\x75\x31\x51\x47\xc9\x45\x41\x84\xa3\x60\x36\xcd\x95\x2c\x44\xd9\x6a\x7c\x15\xff\x12\xbf\xa6\x18\xdd\xf9\xa5\xbb\x08\xc0\x7f\x4a\x97\x8b\x74\xae\x6e\x09\xb6\x62\xa6\x12\xb9\xd2\x4d\xd6\x68\xcc\x85\xc1\xdf\x7e\x8d\x23\x00\x2f\x8a\x2a\x8a\x5a\x48\x9c\xd1\xd9\xdd\x2e\x02\x98\xc0\xbf\xb6\x31\x0b\x7b\xfb\xde\x7d\x04\x3a\x23\x0c\x30\xce\x91\xdf\x6b\x14\x4e\x05\x25\x94\x5e\xff\xc8\x61\xd6\xea\x9a\x78\x90\x92\xd3\x2c\xdb\x6c\x46\x46\xbb\x2e\x90\xb9\x77\x93\x48\x5c\xa1\x6d\x1b\x60\x60\xd6\x32\x27\x6b\xaa\xee\x03\xa3\x5a\x0d\x2b\xa5\xbf\x12\xc5\xde\x28\x58\x05\xd9\x32\x84\x65\x48\xc0\x03\xcc\x02\x00\x69\xdc\x30\x5b\xa5\x5b\x80\xae\x8b\xf7\xdd\xaa\xa9\x98\xee\xcf\xcd\xe8\x8c\xdb\x8b\x00\x00\xd4\x4a\xa2\x9e\x42\x1c\xf0\xe3\x7d\x28\xb5\x6a\x9b\xc1\x4a\xd4\x0b\x2a\x16\x8d\xd2\xd6\x03\xfc\x74\x08\x71\xbc\x95\xf5\x58\x18\x36\xaf\x7d\x6a\xf2\x90\x78\x23\xef\x9e\xa3\x9d\x12\xcb\xec\xde\x3e\xf7\x60\x7c\x0a\x56\xb7\xf8\x98\x80\x27\xd2\x59\xbb\xba\xfa\x04\xac\x44\x69\xa9\xae\x56\x4c\xbb\xc0\x1a\x05\x25\x5a\x4b\x1f\x1b\x2d\x96\xcc\xe2\x7d\x01\x0a\x34\x4e\x5d\x73\x4f\xc7\x98\x2a\x0d\xb7\x67\x1e\xeb\xd0\x9b\xfd\xab\x48\xad\x2a\xd4\xe8\xe2\x95\xab\x45\x23\x6a\xe4\xc0\x99\x65\xae\x7d\x28\x77\x39\x53\x54\x1c\xf0\x1f\x04\xae\x7c\x4d\x5b\x05\x2c\xcf\xd1\xf8\x62\x72\xfd\x03\x4c\xae\x45\x63\xd3\x97\xc4\xb5\x37\xd4\x75\x19\xc7\x65\xc2\xb1\x71\xd2\x91\x9d\xf8\x85\x84\xc9\x70\xce\xf2\xca\xe5\x34\x08\xf3\x22\xbb\xee\x7c\xd7\xf5\xc6\x12\xb7\x32\x48\x8c\x46\xab\xdb\xf5\x0c\xe5\x72\x9b\x10\x5f\x42\x9f\x72\x1b\xbe\x65\xae\x98\xb9\x97\x6a\x25\x6c\xe5\xba\x21\xab\x6b\xe0\x6a\x25\x6b\xc5\xb8\x01\x21\x7d\xef\xfd\x7d\x44\xcb\x35\x0d\x23\x94\x84\xd8\x54\x58\xd7\xf1\x3e\x08\x49\x45\x36\x85\x1d\x2f\xdf\xcc\xd9\x79\x98\x2a\x9e\x9d\x13\x7a\xb6\xad\xe5\xc0\xf0\x54\x51\xa8\x84\x34\x96\xd5\xf5\xb3\x35\xef\x0e\x21\xe3\xa0\x8a\x9e\x2a\xf2\xbf\x10\x6e\xdb\x06\x48\xe5\xa1\x72\x61\xfd\xd1\xbe\x70\xe6\xc9\x10\x35\x9f\x1b\x28\x97\x42\x2b\x49\xa3\xe2\xff\x15\xa4\x54\x35\x93\x65\x84\x92\x6f\x47\xc4\x28\x46\x23\xdd\xe0\x10\xde\xbe\xbd\xfa\x70\x79\x76\x71\x1d\x19\xb4\x90\x60\x14\x4d\xe0\x12\x9b\x9a\xe5\xc3\x30\x1a\x5f\x57\xc6\x6b\xc5\xe4\x1a\x1a\x8d\x4b\xa1\x5a\x03\x3d\xa3\x88\xc6\x5e\x22\x20\x41\xd8\xcb\xfe\x5b\x59\xdb\x78\x1b\x87\xd9\x19\xdf\x1b\xac\x9a\x87\xcb\x52\x0d\xd7\x32\xb4\x79\x36\x14\x60\xb3\xeb\x12\x66\x09\x42\x8e\x7d\xc1\xbc\x52\xb0\xb7\xd9\xc0\x32\xfd\x4c\xf3\xa9\xeb\x0e\xdd\x97\x1b\x56\xb7\xf4\x6d\x0f\xde\xbd\x7b\x08\xf7\xc3\xad\xbb\xb6\x69\x50\xbf\xf0\xae\x8f\x1c\xb1\xa1\xd0\x4d\x80\x35\x36\x29\xd1\x8d\x7d\xdd\x4a\x9f\xdc\xa6\xe5\x6a\x1f\x56\x95\xc8\x2b\xe0\x0a\x8d\xdc\xb3\xf0\x15\xb1\x71\x7a\x0e\xc1\x72\x66\x21\xd8\x60\x8d\xa5\xff\x6e\x06\xa6\x3c\xfb\xe7\x3f\x5c\xca\x78\xf1\xdf\xbe\x3d\x39\xff\xf8\xa4\x08\x3e\xc4\x41\x80\x43\x1a\x8c\xbd\xf2\xd4\xa4\x8f\xf2\x6f\xad\xd0\x38\x9d\xd2\xf2\x74\x7a\xe1\x10\xe3\x91\xa7\xf1\x1b\xe7\x56\xfd\x10\xc6\x3c\x81\x63\x9e\x05\x0a\x99\x3d\x94\x8a\x1c\x08\x69\x36\xca\xfd\x71\xce\x3e\x96\x8d\x0a\x5f\xbd\x86\x0d\xec\xbc\x87\x5f\xde\xfd\xed\x67\xb8\x83\x5a\x95\x25\x6a\x48\x2c\x90\x44\xa4\x1f\xc7\x65\x26\xdb\xba\x7e\x03\x5d\xa4\x6a\x77\xdc\x85\x38\xfe\x83\x4e\xfc\x09\x3b\xef\x63\xda\x8a\x26\x70\x56\xc0\x0a\xa1\x62\x4b\x9f\xdb\x1a\xbf\xb5\x68\x2c\x72\x58\xa2\x76\x45\xa5\x0a\x38\x55\xfb\xb4\x29\xc3\x1b\xb1\x12\xb2\x4c\xe9\x22\xa3\x2f\xa8\xa3\x49\x7f\x78\xd8\x44\xf6\x41\x87\xa2\x11\x76\xfb\xb0\x78\x08\x1f\x1e\x87\x69\x24\x0a\x6a\x88\x0b\x26\x39\x24\x4b\x28\x15\xbc\xeb\xbd\x70\x7e\xbe\x71\x14\xdc\x94\x16\x05\xed\x6f\x11\xee\xa0\xd4\xd8\x40\xf2\x0d\xe2\x52\x85\x97\x55\xa9\x66\xdb\xed\xae\x83\x78\x70\x97\xfe\xa9\x1a\xe2\x53\x05\x8f\x9e\x65\xb5\x46\xc6\xd7\xf7\x6e\xfc\x14\x1a\xb6\xa2\x9c\x15\x7d\x53\x4a\xe3\x1e\x0e\x6f\x85\x85\x03\xf7\xb5\x10\x51\xb4\xb5\xe0\x5b\x06\x8d\xde\x1e\x0b\x76\x5e\x8d\x88\xe7\xad\x85\x84\xef\xc1\x1e\x24\xc5\xdf\x5f\xfb\x52\x79\x82\x58\x9a\x06\x8b\x0a\x5d\x35\x81\x5e\x40\xa2\x0b\xc8\x5a\xa3\xb3\x5a\xe5\xac\xce\x4a\x15\x91\xfd\x27\xda\x3c\x51\xfa\xa0\x9a\x35\x11\x7a\xca\xf9\xa7\xdb\xbe\x33\xaf\x10\xf2\x06\x46\xad\x3b\x7b\x5c\xf2\xb4\x16\xb2\xbd\x4d\xd8\x82\xff\xf6\x6b\x6a\x99\x4e\xcb\xef\x90\x55\x6a\x81\xdb\x97\x4d\x56\xaa\xb0\xee\x0b\xcd\xf4\x1c\x8f\xc3\x50\x79\x86\xe7\x96\xcc\xaa\xa4\x8a\xf8\x06\xc9\xf9\x53\xe0\xe0\x4b\x33\xcb\x8c\x55\x9a\x95\x98\x96\x4a\x95\x35\xb2\x46\x18\x7a\x40\x64\xbe\xc8\x5e\xee\xc4\xb8\x54\x89\xee\x17\x69\x99\xd6\x9e\xec\x96\x97\x8b\x8f\x65\x1a\x92\x0f\x83\xf8\x40\x72\xfb\xbd\x78\x52\x05\x07\xf6\x3b\x73\x4f\xd5\xd3\xf3\x8b\xa3\xeb\x4f\x23\xb4\xc5\x57\x9a\x9d\x09\xa9\xdf\xd0\x35\x7a\x8a\x44\x85\xb1\xeb\x06\x0f\x77\x5e\x15\x42\xf2\xe1\x0e\x24\x0b\x21\x39\x36\xb6\x82\x03\x48\x16\xec\xb6\xff\x4c\x17\x80\x43\xd2\x68\x21\x6d\x01\xf1\xee\xc7\xf8\x75\xf4\xf0\xba\x47\x86\x9d\x8d\xff\xd0\x85\x0b\x07\x70\x07\xb7\x4c\x97\x06\x92\x03\x48\x24\xfc\x7c\x70\x00\x79\xa5\x56\x12\x82\x43\xd3\xf0\xd7\xbb\x73\x15\x9e\x9e\x6d\x03\xbd\x43\x7e\xd2\xe0\x2d\xbd\x9d\xdd\xea\xe1\xc0\x70\x36\x17\x72\x3a\xca\x68\xb7\xb2\x43\xe7\xfc\xf0\x19\xa9\x97\xce\x99\xa9\x74\x3e\xc6\x3c\x3d\xff\x11\xf5\x99\x9b\xa1\x58\xa4\xb2\x8f\x17\x4c\x78\x84\xb8\x5f\x82\x1f\xae\x8c\x1b\x37\xa5\x7b\x53\x8f\xa2\xb3\x1d\x79\x6d\xc3\x99\x45\x48\xd6\x0f\x76\xb6\x8d\x23\x59\x43\x29\x2c\xcc\xbf\x6b\x58\xa0\xce\x5b\x2d\x58\x1d\x85\xda\xf4\x3f\xf5\x42\xde\x5b\xe5\x7e\xde\xd2\xab\x7e\xf0\xe2\xfa\x74\x7d\x7d\xe1\x2c\x13\x88\x7f\x02\x41\x92\x94\xb5\x9a\xb3\x1a\x5a\x5d\xa7\x71\x29\xec\xfb\x52\xd8\xaa\x9d\x53\x82\x4f\xe3\x34\xdc\x3e\x2f\xc2\xfc\x9a\x66\xd9\xfd\x7e\x36\xfe\xdd\x19\x06\xcd\xff\x06\x00\xf3\x51\x0d\xeb\x20\x10\x00\x00"
 
 func dataCommonDevDepVagrantfileTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -195,7 +413,7 @@ func dataCommonDevDepVagrantfileTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevDepBuildShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x92\x41\x8f\xd3\x30\x10\x85\xef\xfe\x15\x8f\x14\x69\xe1\x90\x44\x9c\x11\x08\xb8\xf4\x08\xd2\xc2\x09\xa1\x95\x6b\x4f\x12\xb3\xae\x27\x72\x26\xed\x56\xd5\xfe\x77\xc6\xce\xa2\xad\x14\xe5\xe0\xb1\xdf\xfb\xe6\xcd\xec\xde\xf4\x87\x90\xfa\x83\x5d\x26\xb3\x33\x3b\x7c\x5d\x85\xdb\x91\x12\x65\x2b\xe4\x71\xb8\xe0\xbb\x08\x77\xb5\xf6\x73\x0a\x0b\xf4\x93\x89\x70\x58\x43\xf4\x58\x5c\x0e\xb3\x60\xe0\x0c\x8b\x3d\xb7\x2a\xa3\x8f\xe6\xcc\x7f\xc9\x49\x67\x16\x12\xb4\x64\x0c\xc7\x77\xef\x71\x05\xb9\x89\xd1\xfc\x66\x15\xfc\x83\xb7\x5f\x9a\x8f\x78\x36\x2a\x7b\xaf\xb7\xa4\x48\x0b\x63\xb0\x8f\xb4\xe9\x2b\x50\x76\xe0\x84\x85\x8f\x84\x39\x5a\x51\x97\x63\x31\xb7\x82\x33\xdd\x65\x42\x48\xa2\x98\x4e\xc2\x89\x14\x10\xbf\x0e\x6b\x92\x55\x4f\x31\xdb\x2c\xc1\xad\xd1\xe6\xd2\x80\xa7\xc1\xae\x51\x1f\x71\xba\x13\x44\xb6\xfe\xd6\x21\x0c\x9b\x79\x58\xb4\xaa\x2a\xca\xdc\x19\x7a\x9a\x39\x0b\x7e\xdc\x7f\xf8\xd4\x7c\x46\x53\x29\x79\xcd\x8e\xa0\xff\xd2\xdf\x10\x22\x29\x99\x82\x60\x54\xfc\x7a\x6a\x65\x2a\xa5\x99\x72\xbc\x14\x99\x75\x36\x1d\xfa\x49\xf1\xfb\x93\x1d\xb3\x4d\xd2\x77\x9b\x69\xd1\xdb\x73\xe1\xe7\xfa\xf4\xcc\xf9\x31\xa4\x11\x3e\x64\xcd\x8d\xf3\xc5\x38\x8f\xeb\x15\xcb\x64\x33\xf9\x87\x81\xa3\xa7\xfc\x50\x0d\x9e\x6b\x64\x7b\xf5\xb4\x31\xd6\x3e\x3c\xcd\x94\x3c\x25\x17\x68\xd1\xa4\xd1\x68\x51\xaa\xda\x4d\xa1\xeb\xba\xc6\x8c\x5c\x61\xdb\x13\xba\x5e\x0f\x8a\xd0\xb7\x3a\xc6\x22\xf3\x32\x34\xd8\xe4\x71\xce\x41\xb6\x31\xf0\x2a\xf3\x2a\xaf\xa4\x1b\xd1\x0d\xe8\x0e\xe7\xa0\x58\xe5\xae\xe3\xe3\xac\xb1\xdc\x54\x4b\x42\x2f\xf3\x82\xb3\x09\x64\x97\xa0\xd9\xd0\x93\x94\xb1\x21\x68\xd2\x05\xb8\x42\x28\xf1\x7f\xc8\x6d\xb7\x5a\xdd\x95\xbe\xec\x4a\xeb\xac\x9b\xa8\xf7\x74\x6a\xb5\xa5\x76\x43\x6a\xcc\xbf\x00\x00\x00\xff\xff\x93\x14\x33\xb5\xba\x02\x00\x00"
+var _dataCommonDevDepBuildShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x9c\x54\x51\x6b\x1c\x37\x10\x7e\xd7\xaf\x98\xee\xd6\xb8\x85\x9e\x16\x3f\xf4\xa9\xb8\x34\x25\xe6\x9a\x87\xd8\xc6\x71\x4b\xa1\x14\xa3\x95\x66\x77\x95\xd3\x6a\x84\x34\xeb\xf3\x71\xec\x7f\x2f\x92\xec\xe6\x1a\x68\x48\xf2\xb2\x77\x68\x66\xbe\xf9\xbe\x19\xe9\x6b\xbf\xe9\x7a\xeb\xbb\x5e\xa5\x49\xb4\xa2\x85\x57\x0b\xd3\x66\x44\x8f\x51\x31\x1a\xe8\x0f\x70\xc3\x4c\xb2\xc4\xee\x27\x9b\xc0\x26\xe0\x09\xa1\x5f\xac\x33\x90\x74\xb4\x81\x61\xa0\x08\x0a\xb6\xb4\xe9\x55\x42\x03\x21\xd2\x7b\xd4\x2c\x45\x42\x86\x0d\x0a\x41\xee\xbb\xef\xe1\x08\xa8\x27\x82\xe6\x2f\x62\xa6\xbf\xe1\xdb\x5f\x9a\x9f\x60\x15\xa2\x85\x77\xc8\xc0\x19\x9a\x09\x06\xb5\xc3\x8a\xaf\xd2\x14\x35\x90\x87\x44\x33\x42\x70\x8a\x07\x8a\x73\x6e\xae\x18\xf6\x78\x1e\x11\xac\x67\x8c\x4a\xb3\x7d\x44\x29\x5a\xf8\xbd\x5f\x3c\x2f\x60\x3d\x04\x15\xd9\xea\xc5\xa9\x98\x05\x18\x1c\xd4\xe2\x18\xf6\xe4\xcf\x19\x1c\x29\x73\xda\xc1\x0e\xb5\xb9\x4d\xfe\x9c\x45\x0b\x09\x59\x0a\x7c\x0a\x14\x19\x6e\xdf\x5d\x5c\x36\x3f\x43\x53\x58\xd2\x12\x35\x02\x2d\x31\xeb\x1b\xac\x43\x48\x04\x7b\x84\x11\xb9\x9e\x2a\x9e\x72\x28\x60\x74\x87\x0c\xb3\x04\x21\xa1\x9b\x68\xc6\xee\x51\x8d\x51\x79\xee\x64\x6d\x9a\xf1\xb6\x94\xf9\x53\x29\xdd\x53\xdc\x59\x3f\x82\xb1\x11\x35\x53\x3c\x08\x6d\xe0\x78\x84\x34\xa9\x88\xe6\x61\x20\x67\x30\x3e\x94\x06\xeb\x2a\xc4\xf1\x2c\xb3\x7e\x44\x6f\x28\xc2\xd9\x2a\x5a\x78\x8d\x01\xbd\x41\xaf\x2d\x26\x50\x11\x9f\x83\x68\x7e\xc8\x24\x97\x54\x66\x3a\x43\x54\x3c\x61\xcc\x23\xf4\x30\x20\xeb\x29\x37\xcd\x91\x17\xc1\xdb\x9b\x8b\x1f\xff\xb8\xba\x7e\x7d\x73\x77\xf5\xe7\xed\xd5\xdd\x9b\xb7\x57\xd7\xf7\x97\x17\xb9\x21\x3a\x3b\x80\xc1\xf0\xe0\x48\xef\xe0\xf2\x12\x1a\x83\xa1\xa9\xdd\xdf\xf8\xc4\xca\x39\xc0\x27\xa5\xd9\x1d\xca\x74\xcd\x29\xa3\x60\xbd\xaf\x97\x69\x4b\x61\x37\xca\x8c\x21\xc8\x41\xf3\x5c\x99\x69\xe4\x33\x34\xff\xad\xdb\x5b\x9e\xf2\x89\x94\xb2\x11\x23\x95\x51\x6f\x1e\x61\xb4\x3c\x2d\xbd\xd4\x34\x77\x23\x39\xe5\xc7\xce\x60\xe8\xf4\x6c\xf2\xaf\x30\x18\x00\x7d\x5a\x22\xc2\xa6\x8e\x61\x43\xde\x1d\xbe\x46\xe2\xe8\xac\xc1\x2f\x17\x59\xca\xbe\x4c\x64\x2d\xf9\x5f\x99\x6f\x55\x62\x8c\xb3\xf5\x26\x75\x25\x55\x94\x2f\xd8\x0a\xfd\x55\xda\xe8\xc3\x02\xef\x30\x31\x45\xfc\xbc\x05\x1a\x0c\x49\xbe\x4f\xe4\xeb\xfd\xcd\xb9\xdb\x9b\xdb\x57\xf7\xbf\x15\xb1\x15\xea\xd3\x5a\xe9\x93\x2b\x65\x22\x97\xba\x92\x24\xca\x17\x62\xa5\x57\x75\x24\xac\x9c\xb7\xc8\x90\xf7\xf1\x31\xd7\xc2\x62\x8b\xcc\xe5\x41\x9d\x04\x3e\xea\x28\x3b\x29\x65\x81\xf4\xc6\x0e\x19\x53\xb4\xf0\x6b\x71\xb5\x0c\xf9\xec\x61\xa0\xbc\x81\x7d\xb4\x5c\x5d\x89\x16\x0e\x0b\x7f\x78\xb8\xf5\x81\x9e\xbc\xdb\xb6\x4a\xcc\xb9\x9a\xe6\x60\xdd\x69\x14\x12\xbd\xd8\x17\x68\xe5\x01\x55\xb2\xee\x00\xf8\xc4\xd9\xc5\xc0\x72\xe1\x93\xcd\xb4\x07\xeb\xcb\xba\x7a\xeb\x55\xcc\x93\x3b\x5b\x8b\xb0\x42\x30\x2b\x3b\x1e\xa1\x97\xd7\x6a\x46\x58\xd7\x17\x65\xd5\x93\x37\x04\x4d\x47\xcc\xb4\xd1\x4a\x4f\xd8\x95\xcc\x9b\x4a\x7c\x5d\x9b\x6a\x1e\xbd\xbc\x55\x7a\xa7\xc6\x3c\x4c\x68\x8e\xc7\x93\x83\x9a\xf3\xef\x54\xea\xff\xa1\x3a\xcd\x3f\x03\x00\x13\xa4\xf6\x35\x2e\x06\x00\x00"
 
 func dataCommonDevDepBuildShTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -215,22 +433,182 @@ func dataCommonDevDepBuildShTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevDepUpstartConfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x8f\x41\x4b\x03\x41\x0c\x85\xef\xf9\x15\x8f\x0a\x7a\xaa\x6b\xab\xeb\x71\xaf\x1e\x3d\x7a\x90\x52\xc6\x9d\x54\x16\x66\x93\x61\x26\xb6\x4a\xd9\xff\x6e\x1c\x14\xe9\x29\x24\x79\x7c\xef\xbd\xc8\x75\x2c\x53\xb6\x49\x05\xab\xf3\x19\x12\x66\xc6\xb2\x60\x8d\x27\x16\x2e\xc1\x38\xe2\xed\x0b\xcf\x66\xba\x22\x2a\x5c\x73\x38\xc9\xdf\x44\x9a\xe6\xc9\xb0\xe9\xd1\x13\x55\x0b\xc5\xe0\x98\xf2\x21\x89\x8f\x9c\xf0\xba\xbd\x7f\xe8\x77\xfe\xd0\x7c\x79\xbf\x7b\xdc\x11\x5d\xe1\x85\x11\x55\x6e\x0c\xa7\x20\x06\x53\x38\xb6\x41\x4c\x15\x87\x50\x0d\x07\x2d\x88\x9c\x2b\x65\xad\xb6\x6e\x20\xfe\xe4\x11\x35\x31\xe7\x66\xda\xc2\x13\xe0\xd1\x5d\xb8\x77\x8f\xfd\xa8\xf3\x1c\x24\xfe\xb4\x18\x86\xee\x18\x4a\x97\xf4\xbd\xfb\xef\x76\xeb\x2b\xb6\xc3\xf5\x86\xd8\x55\xbf\x84\xef\x00\x00\x00\xff\xff\xb4\x52\xf1\xf2\x08\x01\x00\x00"
+var _dataDigitaloceanSimpleBuildBuildGoShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x95\x56\x6d\x4f\xdc\x38\x10\xfe\x9e\x5f\x31\x04\x38\xb5\x12\x49\x8e\x5e\xe9\x07\x5a\xd0\x71\x65\x8f\xe3\xc3\x15\x04\xb4\x3a\x09\x21\xe4\x4d\x66\xb3\x2e\x89\x9d\xda\xce\xbe\x40\xf7\xbf\xdf\x8c\x9d\x5d\xc2\x42\x2b\x15\x21\xed\xae\x67\xe6\xf1\xcc\x33\x6f\xde\xdc\xc8\x86\x52\x65\x43\x61\xc7\xd1\x66\xb4\x09\x47\xad\xd3\x49\x89\x0a\x8d\x70\x58\xc0\x70\x0e\x67\xce\xe9\xd4\xcb\xae\xc6\xd2\x02\xfd\xbb\x31\xc2\xb0\x95\x55\x01\x36\x37\xb2\x71\x30\xd2\x06\x0a\x6c\x2a\x3d\x97\xaa\x04\x01\x27\x3a\x21\x40\x32\x6f\x8c\xfe\x8a\xb9\x4b\x23\x8b\x0e\x12\x8c\xa2\x87\x6d\x90\xa3\x60\x7c\x5b\xe8\xfc\x0e\x0d\x6c\x2f\x3c\x34\xc2\x71\xf8\x2d\x6b\x51\x22\x5f\xc3\x5a\x0e\xa4\x22\xc0\x5c\x2b\x27\x24\x39\x05\x53\xe9\xc6\xba\x75\x60\xe7\xb6\xd2\xe5\x0e\x58\xed\xdd\xa1\xa3\xa6\x75\x04\x44\x76\x85\xb4\xb9\x30\x05\x5d\x4f\x12\x83\x69\x44\x37\x5e\x43\x72\x09\x59\x81\x93\x8c\xac\xe0\xe6\x3d\x8b\x54\x04\xf4\xa7\xf1\xd5\x6b\x78\x80\xad\x3f\xe1\xcd\xe1\x6f\xbb\xf0\x1d\x48\xa1\xa4\x8b\x12\x07\x9a\x22\x87\xc3\x60\xa6\xda\xaa\x7a\x0f\x8b\x08\x2b\x8b\x6b\x76\x3d\x0d\x8f\xc1\x6a\x23\xc9\xa1\xb2\x32\xc7\xf7\x8b\x77\xb0\xa5\x2a\xc8\x6b\x36\xad\xbc\x29\xe6\x63\x0d\xf1\x35\x6b\xdf\x10\x4e\xcc\x6a\x4f\xc8\xd4\xa3\x51\x45\x04\x05\x36\xff\xe2\x23\x4e\x45\x77\xba\x0f\xe7\xc2\x73\xdb\x52\x8e\x04\x33\x73\xa2\x61\x64\x74\x1d\xb8\xeb\x4c\x0b\x69\x28\x57\xda\xcc\x9f\xb8\x5e\x41\x7c\xac\xa7\x8a\xed\x18\x91\x0c\x1f\x1e\x28\xd9\x93\xdb\x52\xdf\x4e\xd0\x58\xa9\x15\x2c\x16\x69\x9a\xc6\x14\x26\x4c\x4b\x4e\xf4\x37\x48\xce\x20\x73\x75\x93\x95\x3a\x75\xc2\xa4\xe5\x3d\x8c\x9d\x6b\xec\x7e\x96\x59\xba\x81\x12\x9c\x96\x5a\x97\x15\x8a\x46\xda\x34\xd7\x35\x29\x56\x42\x95\xf4\xf1\x22\x3a\xf9\xd7\xce\x12\x51\x17\xef\xde\x76\x78\x4f\x48\xf2\x5e\x7e\xa6\x12\x31\x26\xf8\xb8\x74\xc7\xb6\x05\xd5\x87\x20\xa6\x3f\x42\xd6\x5a\x43\xd9\xcf\x45\x05\xc9\xec\x7e\xb4\xe6\x5f\x14\xe1\xac\xd1\xc6\xc1\xc9\xd9\xf9\xd1\xd5\x3f\x07\x99\x6e\x1c\x49\x1b\xe1\xc6\x4b\x89\x3f\xdf\x0a\x72\x6e\x9a\xfd\x47\x44\xd2\xf4\x27\x5b\x2c\x5b\x26\x46\xd6\x6c\x76\xcb\x10\xb0\x71\x00\x71\xcc\xae\x1e\x9d\x9f\xdf\x1e\x9f\x5e\x1c\xc4\x4b\x20\x6b\xf2\x8c\x62\xee\x2b\x2f\x16\x71\x3f\x05\x3f\x32\x51\xa2\xc6\x95\xee\x8a\x8a\x70\xb7\xd2\xee\x79\x61\x30\x4b\xa7\xca\x3a\x51\x55\x4c\xd3\x97\x8f\x97\xd6\xb7\x6e\xa9\x81\xd2\xe6\x39\xdb\x84\x64\x00\x77\x88\x8d\x05\xa1\xe6\xdc\xbf\xb3\x39\x50\xf3\x3a\x32\xb0\x8f\x25\x83\x6a\x22\x8d\x56\x35\xaa\xd0\xfc\xa2\x71\x34\x34\xdc\x8a\x72\x02\xe9\x8e\xa8\xe4\x0a\x9a\x24\x90\xcc\x5f\x12\xca\xe0\x0d\x49\xa1\x94\xe4\xf1\xbd\x81\x1a\x4d\xde\x1a\x29\xaa\xe7\x19\x1e\xcc\x9c\x11\xb9\xf3\x33\xa6\x69\xbc\xbf\x1e\xb0\xbe\xa3\xd2\x85\xa4\x81\xad\x8e\xaa\x70\x4c\x2d\x33\x55\x90\x5c\xc0\xd6\xab\xe9\x58\x8b\x5a\xbe\x86\x8e\xc1\xc8\x97\xc4\xaa\x08\xb8\xab\x12\x46\x74\x54\xa7\x54\x29\x2b\x98\xbc\x78\xfc\xfe\xa4\xdb\x28\xfe\xc7\xb9\x15\x46\x61\x9f\x12\x1a\x42\x3c\xf0\x68\x78\x86\xbe\x4b\xe1\x4c\x55\x73\xcf\x1c\x27\x8d\xb8\x35\xcb\x91\xb5\x43\x20\x56\xaa\x1c\xbd\x74\x22\xaa\x96\xc4\xb5\x98\xc3\x90\xd8\xc2\xdc\xa0\xb3\xa9\x0f\x7e\xd5\xd3\x3c\x01\xfb\xb7\xed\x73\x43\xae\xdc\xfa\xfe\x55\x53\x1d\xc6\x3b\x10\x2f\x4b\x83\xf3\x73\xc7\x63\xb4\xef\x7a\x57\xd2\x64\x79\x47\x7a\x1d\xd5\xac\xb9\xbd\x78\xa9\x9c\x26\x74\xa0\xbb\x51\x7d\x8c\x0d\xfd\x42\x95\xcb\x2e\x90\x20\xc4\xc2\x0f\xe3\xd6\xfa\x48\x6a\xa0\xfd\x41\xd3\x97\xbe\x0b\x05\x23\x74\xf9\x98\x7d\x67\xc9\x63\xa3\xed\xee\x7d\x19\x7c\x3a\x3e\xbb\x18\xfc\x77\x3e\xb8\x38\xfd\x77\xf0\xe9\xea\x60\x77\x7d\xf6\x9c\x84\xda\xe3\xf5\xb2\xba\xd5\x67\x3e\x14\x2d\x24\x05\x24\x13\x48\x33\x3a\x7b\xc9\xf1\x10\xf3\x84\x14\x3b\xbc\x8b\x56\x29\xc6\x23\xf3\x49\x57\xf3\xa4\xb6\xd1\xfd\x0e\x40\xfd\xed\x40\x26\x9d\xc8\x20\xbb\x1d\x96\xda\xb0\xc2\xda\x6e\xf4\xb2\x3f\x15\x94\x72\xa7\x9b\x86\xe4\x61\x25\xcd\x41\x21\x4d\xb0\x78\x05\x63\x50\xe4\x63\xda\x65\x61\x51\x0a\x82\x20\xf6\x9c\x1c\x51\x45\xa7\xf0\xb7\x9c\x05\xda\x84\x2a\x3a\x48\x51\xd2\xc6\x4b\x83\x3d\xce\xa8\x3b\x76\x97\x5b\xe5\xc5\x18\x1d\xda\x67\x41\xf2\x99\x7d\x12\xa3\xd7\x7a\x29\xc8\x2b\x56\x85\x91\x90\x15\x16\x3f\x09\x4c\xc0\xd0\xe8\x3b\xec\x8a\x69\x3d\xc4\x21\xd2\x28\xe7\xaa\xf8\x69\x90\xc1\xad\x5f\x8b\xb4\xdf\x01\xcb\xf4\x07\xe3\x44\x87\x26\x7e\x1c\x88\xdd\x50\x98\xac\x9f\xf7\x36\x80\x7f\xf3\xf4\x2c\xd6\x47\x23\x7b\x69\xd1\x4c\x64\x8e\xfe\xb6\x5c\x38\xf8\xf0\xe1\xf3\xf9\xe5\xd5\xd1\xc5\x15\xed\xef\xb0\x57\x10\x21\xa3\xca\xce\xa4\x92\xae\x87\x46\xfb\x4c\x8d\xfa\x3b\x3d\x2a\x30\x3c\x94\x78\x9b\xc5\x3d\x87\x12\x38\x59\x7f\x69\xc5\x51\x64\xd0\x36\x62\xaa\x96\x9f\x50\xc9\x9a\x39\xd9\x83\xbd\x28\x22\x0f\xa9\x75\x08\xc6\xb4\xaa\x22\xf2\x2b\xb8\x7e\xf3\xc7\xdb\xbd\x9b\x88\x73\xf4\xf4\xfc\xf7\x77\x37\xa4\xef\xef\x25\x66\x7f\x18\x3b\x1c\x1e\x66\x13\xc1\xb2\xb2\x1f\x03\x3f\x93\xf8\xb1\x12\x51\x0e\xba\x67\x5e\xd4\xc5\x1f\xd8\x22\x5e\x0a\xad\x70\x23\x8e\xfe\x07\x7f\x27\x13\xaa\x43\x0a\x00\x00"
+
+func dataDigitaloceanSimpleBuildBuildGoShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataDigitaloceanSimpleBuildBuildGoShTpl,
+		"data/digitalocean-simple/build/build-go.sh.tpl",
+	)
+}
+
+func dataDigitaloceanSimpleBuildBuildGoShTpl() (*asset, error) {
+	bytes, err := dataDigitaloceanSimpleBuildBuildGoShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/digitalocean-simple/build/build-go.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataDigitaloceanSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa5\x55\xcf\x6f\x9b\x30\x14\xbe\xe7\xaf\x40\x48\xe9\x29\x90\x74\xca\xa6\x6a\xd2\x4e\xbb\x4e\x3b\xee\x52\x45\xd4\x80\x01\x2b\xc6\xb6\x6c\x83\xda\x32\xff\xef\x7b\x36\x84\x1a\x4a\x9a\xa4\xcb\x25\x89\xdf\xf3\xf7\xbe\xef\xfd\x72\xb7\x0a\xe0\x13\xd6\x84\x25\x02\x65\x47\x2c\x93\x16\x4b\x45\x38\x0b\xbf\x07\xe1\x2e\x7e\x88\x77\xe1\x66\xd5\xfb\xb4\x48\x12\x94\x52\xac\xc0\xd4\xb9\x23\xfb\xe9\xd6\x41\xc1\x65\x70\x0c\x08\x0b\xd2\x86\xd0\x3c\xc1\xac\x0d\xd6\x66\x74\x08\xc7\xd3\xa4\xeb\xc0\xcf\x18\x0b\x0d\xa8\x1e\x02\x66\xb9\x05\xf1\x6f\xe5\x3c\xd1\xfc\x88\x2d\x0f\xd6\x50\xba\x99\x58\x24\x2e\x7b\x8a\x33\x93\xa2\x4d\x09\x3a\x74\x35\x98\x9c\xc5\x9c\x04\x08\xc9\x5b\x62\xb5\x81\x42\x70\x78\x9c\x6b\xc8\x89\xb4\x2a\x0a\xde\xb0\x1c\x69\xf0\x4b\xe0\x44\xc5\x8e\xbf\xcf\xed\x4d\xbc\x83\xd5\x2f\x02\x5b\x49\xaa\xc2\x94\x7a\xba\x9c\x91\x30\x4a\x98\x35\x3f\x86\xf5\xd1\x06\x88\x44\xb0\xd5\xb5\xd8\x72\xad\xf9\xf6\x2d\x54\x04\xb9\x01\x0e\x94\x73\x11\xff\x84\x53\x8d\xa5\xcd\xd4\x61\x44\x33\x9b\x4b\xf1\x0b\x42\xf1\x3c\xbc\xe2\x8d\xcc\x9c\x15\xf0\x6d\x78\x63\xb6\x73\x9f\x1c\x2b\x4d\x98\x63\x61\x1d\x6f\x60\x77\x03\xb9\x4b\xc9\xc9\xf2\x6b\xd3\x62\x4c\x70\x77\x17\xa4\x48\x55\x41\xbc\xad\x11\x61\xb1\xaa\xce\xe4\x69\xa9\xb1\xe0\x90\x14\x43\xa7\xf2\xa2\xb0\x04\xae\xa8\xed\xc5\xdc\x0e\x50\x49\xc9\x13\x24\xb3\x8a\xb4\xd8\x26\xe8\x8a\x4c\x97\x3c\xd6\x48\xc6\xe5\x6b\x78\x5e\x02\x10\xfe\x6f\x8a\xeb\x00\x06\x3b\x85\xd8\x35\x60\x01\xe1\x46\x41\x0d\x9f\xc6\x81\x79\x02\xba\x7d\x30\xcf\xed\xda\x4e\x89\x90\x10\xb1\x3e\x27\xe1\x86\x86\x50\x99\x24\x42\x5b\xb3\x2b\x50\x04\xc9\x81\xe2\xfa\x25\xb3\xcb\x05\x0a\x0a\xa3\xfc\xfc\x32\x6c\x9a\x19\x06\x1c\x12\xc9\x59\x8d\x99\x4e\x60\x65\x4d\x27\x7d\x36\xf1\xad\x9d\x77\x1f\xeb\x9d\xa3\xad\x6d\x1b\xff\x46\xb5\xad\xe7\x0f\xf7\xe7\x0f\xa2\xcd\x42\x75\xa7\xde\x7f\x1b\x21\xdc\x90\xcc\xee\xf4\x5a\x18\xd7\x63\x5b\xff\x42\x4a\x5b\x49\xfe\xf2\xdc\x2c\xd5\xfd\xc3\xb6\xbe\x7a\x1d\xfb\x54\x8f\x8e\xdf\x72\x67\x78\x5b\x7b\xde\x16\xc3\xcd\xe9\xc5\xe5\x06\x3a\x23\xf7\xb3\x0a\x0f\x4b\xb7\x4c\x1f\x64\xdc\xee\x49\xdf\x45\x93\xce\xf8\x5c\x13\x82\xd0\x77\xa8\x93\xb5\x37\xa7\x73\x38\x3d\x35\x2e\x7b\xc3\x33\xf3\x16\x3b\x64\xd0\x19\x16\xd8\x0e\x8d\x17\x76\xe4\x93\x93\x92\x68\x44\x79\x86\x11\xf3\xed\x48\x90\xf1\x35\x3c\x37\xca\xa7\x07\xf3\xd2\x24\x87\xe3\xe3\xf9\x01\x52\xef\x73\x11\x8a\xd4\xa8\x74\xc4\x9b\x14\x76\x73\x13\xdd\xef\xa3\xdd\x3e\x7a\xfe\xb6\xf7\x9d\x14\x79\x75\x3e\x5f\xef\xbf\xd4\xe9\xc4\xc0\x90\x50\x15\xd7\xc9\x29\x2d\x5d\x67\x7f\x19\x13\xcd\x89\xc1\x37\xac\x1e\x54\x8b\x25\x3e\xfd\x23\x7f\x58\x99\xd5\x3f\xb7\xed\x92\xec\xcd\x08\x00\x00"
+
+func dataDigitaloceanSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataDigitaloceanSimpleBuildTemplateJsonTpl,
+		"data/digitalocean-simple/build/template.json.tpl",
+	)
+}
+
+func dataDigitaloceanSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataDigitaloceanSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/digitalocean-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataDigitaloceanSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x6d\x52\x3d\x4f\xc3\x30\x10\xdd\xf3\x2b\x4e\x2e\x03\xa0\x92\x89\x95\x0d\x89\x91\x85\x0d\xa1\xe8\x5a\x5f\x5b\x43\xe2\xb3\xec\x73\xda\x10\xe5\xbf\x73\x75\x53\x4a\x25\xb2\xf9\x7d\xf9\xee\x39\x0b\x78\x21\x4f\x11\x85\x2c\xac\x06\x78\x15\xe1\x25\x58\x06\xcf\x02\x64\x9d\x40\x87\x3e\x63\xdb\x0e\x55\x15\x22\xf7\xce\x52\x04\x63\xdd\xd6\x09\xb6\xbc\x26\xf4\x06\xc6\x0a\xf4\x13\xfe\x22\x0f\x4f\x60\x6e\xc6\x1e\x63\x6d\xb9\x29\xc8\x64\xaa\xa9\xaa\x16\xf0\x4c\xa1\xe5\x01\x10\x12\x09\xf0\x06\x6c\xe4\xd0\x92\x24\xd8\x44\xee\x40\x76\x04\xab\xec\x5a\x81\xe4\x31\xa4\x1d\x4b\x0d\x6f\x8a\x61\x08\xb0\xc7\x04\xce\x27\xbd\xaf\x25\xab\x49\x7a\x44\x0f\x39\x28\x12\x55\x4f\xb1\x77\x6b\x3a\xce\x7e\x0e\xb1\x4b\x48\x0c\x3a\x7a\x51\x24\xd8\xef\x74\x30\x25\x07\x58\xb1\x06\x57\x91\x12\xe7\xa8\x9e\xab\x3d\x9a\x79\x22\x03\x66\x1c\xc1\x63\x47\x30\x4d\xe7\xe5\xd6\x9c\xbd\x5c\x96\x2b\xe3\xf8\x35\x35\x05\xd7\x15\x8f\x9a\x62\x79\xfa\xeb\x3e\x89\xc9\xf7\x2e\xb2\xef\xc8\x4b\x93\xf2\x66\xe3\x0e\xd3\xc3\xcd\x58\x9c\x1a\x64\xe9\x30\xfb\x5d\x87\x5b\xba\x2a\xb0\x20\x33\x1b\x69\xeb\xf8\xba\xdf\x13\x34\xf3\xc9\x7d\x5f\x9b\x8f\xc0\x99\x4b\xbb\xe6\x8b\x86\xa4\xfc\xfb\x2c\x98\xa1\xc6\xd9\xc9\x7c\x14\x51\xd6\x26\x1b\x8b\x82\x97\x94\x5f\xe8\xf4\x88\x9c\x25\x64\x01\xe3\xc2\xb9\x96\x1e\xdb\x3c\xdf\xfa\xc9\xce\xdf\x9a\xa5\xd1\x9f\xe7\x9f\x52\xeb\x4b\x29\xf5\x7d\xed\x42\xff\xd8\xa0\xb5\xfa\x10\xe9\xae\x64\xff\x00\xf6\xa9\x51\x01\x86\x02\x00\x00"
+
+func dataDigitaloceanSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataDigitaloceanSimpleDeployMainTfTpl,
+		"data/digitalocean-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataDigitaloceanSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataDigitaloceanSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/digitalocean-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataDigitaloceanSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x93\x41\x4f\xc3\x30\x0c\x85\xef\xfd\x15\xd6\x7a\x65\x48\x43\xe2\xc8\x61\xd2\x0e\xec\x02\x88\x89\x73\xe5\xa5\x6e\x6b\x2d\x75\xaa\x38\xd9\x18\x88\xff\x4e\xda\x81\x34\x75\xf4\x36\xa2\xde\x9e\xfd\x3d\x3f\x27\xcd\xe7\x57\x38\x59\x0e\x4b\x63\x48\x15\xd6\x52\xb9\x2c\xbf\x0a\x33\xdb\xa3\x67\xdc\x5a\x82\x59\xe9\x8a\xe0\x76\x24\x33\xf8\xcc\x20\x9d\x92\xd4\x78\xee\x02\x3b\x81\x07\x98\x2d\x5f\xd6\x30\xe8\x50\x39\x0f\x2b\xae\x39\xa0\x7d\x36\x84\x32\xcb\xbe\x46\x1c\x4f\x75\xea\x9a\x00\xbd\x0e\x22\x1c\x1a\xf2\x04\x87\xf4\xb1\xb5\xe0\x3a\xf2\x18\xe8\x76\x60\xe5\x57\x5a\xd7\x8a\x3a\xeb\x8e\xff\xb7\x2e\x6e\xb1\xa6\x89\x94\x1b\xc1\x4e\x1b\x17\xd2\xce\x92\xd4\xcf\x71\xb9\x26\xe5\x8f\xa9\xf6\x95\x77\x9d\xa5\x00\x43\xc9\x4f\x41\x85\xd1\x86\x5e\xbc\x5f\xdc\xb5\xdb\x11\x8e\x45\x03\x8a\xa1\xc2\xb8\x28\x61\x82\xfa\x14\xdb\x2d\x79\x70\x15\x94\x27\xbe\x9e\x8f\x37\x72\x59\x8c\x1c\x48\xf6\xec\x9d\xb4\x24\xa1\xd0\x58\x55\xfc\x3e\x15\x7d\x10\x87\x67\x12\x1a\x02\xc1\x96\xb4\xf7\xf4\xa4\x2e\xfa\xf4\x82\x81\x05\x50\xe0\x0c\x78\x61\x3e\xf2\x56\x6d\x8a\x1d\x1d\x0b\x2e\xa7\x3c\x37\x8f\x90\x0a\xa0\xf6\x28\x81\x4a\xc0\xd3\x9f\x92\xd2\xf5\x23\xfc\xa6\x1d\x51\xa3\x92\x2f\x4a\x0c\x38\x01\x7d\x4b\x3a\xf4\x7a\x3f\xfd\x39\xe7\x06\x34\x9a\x06\x50\x01\xc1\x58\x17\xcb\x39\x0b\xa7\xcb\x1a\x9a\xff\xcc\xf2\x0d\x66\x83\x38\x93\x02\x04\x00\x00"
 
-func dataCommonDevDepUpstartConfTplBytes() ([]byte, error) {
+func dataDigitaloceanSimpleDeployVariablesTfBytes() ([]byte, error) {
 	return bindataRead(
-		_dataCommonDevDepUpstartConfTpl,
-		"data/common/dev-dep/upstart.conf.tpl",
+		_dataDigitaloceanSimpleDeployVariablesTf,
+		"data/digitalocean-simple/deploy/variables.tf",
 	)
 }
 
-func dataCommonDevDepUpstartConfTpl() (*asset, error) {
-	bytes, err := dataCommonDevDepUpstartConfTplBytes()
+func dataDigitaloceanSimpleDeployVariablesTf() (*asset, error) {
+	bytes, err := dataDigitaloceanSimpleDeployVariablesTfBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/common/dev-dep/upstart.conf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "data/digitalocean-simple/deploy/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataGoogleSimpleBuildBuildGoShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x95\x56\x6d\x4f\xdc\x38\x10\xfe\x9e\x5f\x31\x04\x38\xb5\x12\x49\x8e\x5e\xe9\x07\x5a\xd0\x71\x65\x8f\xe3\xc3\x15\x04\xb4\x3a\x09\x21\xe4\x4d\x66\xb3\x2e\x89\x9d\xda\xce\xbe\x40\xf7\xbf\xdf\x8c\x9d\x5d\xc2\x42\x2b\x15\x21\xed\xae\x67\xe6\xf1\xcc\x33\x6f\xde\xdc\xc8\x86\x52\x65\x43\x61\xc7\xd1\x66\xb4\x09\x47\xad\xd3\x49\x89\x0a\x8d\x70\x58\xc0\x70\x0e\x67\xce\xe9\xd4\xcb\xae\xc6\xd2\x02\xfd\xbb\x31\xc2\xb0\x95\x55\x01\x36\x37\xb2\x71\x30\xd2\x06\x0a\x6c\x2a\x3d\x97\xaa\x04\x01\x27\x3a\x21\x40\x32\x6f\x8c\xfe\x8a\xb9\x4b\x23\x8b\x0e\x12\x8c\xa2\x87\x6d\x90\xa3\x60\x7c\x5b\xe8\xfc\x0e\x0d\x6c\x2f\x3c\x34\xc2\x71\xf8\x2d\x6b\x51\x22\x5f\xc3\x5a\x0e\xa4\x22\xc0\x5c\x2b\x27\x24\x39\x05\x53\xe9\xc6\xba\x75\x60\xe7\xb6\xd2\xe5\x0e\x58\xed\xdd\xa1\xa3\xa6\x75\x04\x44\x76\x85\xb4\xb9\x30\x05\x5d\x4f\x12\x83\x69\x44\x37\x5e\x43\x72\x09\x59\x81\x93\x8c\xac\xe0\xe6\x3d\x8b\x54\x04\xf4\xa7\xf1\xd5\x6b\x78\x80\xad\x3f\xe1\xcd\xe1\x6f\xbb\xf0\x1d\x48\xa1\xa4\x8b\x12\x07\x9a\x22\x87\xc3\x60\xa6\xda\xaa\x7a\x0f\x8b\x08\x2b\x8b\x6b\x76\x3d\x0d\x8f\xc1\x6a\x23\xc9\xa1\xb2\x32\xc7\xf7\x8b\x77\xb0\xa5\x2a\xc8\x6b\x36\xad\xbc\x29\xe6\x63\x0d\xf1\x35\x6b\xdf\x10\x4e\xcc\x6a\x4f\xc8\xd4\xa3\x51\x45\x04\x05\x36\xff\xe2\x23\x4e\x45\x77\xba\x0f\xe7\xc2\x73\xdb\x52\x8e\x04\x33\x73\xa2\x61\x64\x74\x1d\xb8\xeb\x4c\x0b\x69\x28\x57\xda\xcc\x9f\xb8\x5e\x41\x7c\xac\xa7\x8a\xed\x18\x91\x0c\x1f\x1e\x28\xd9\x93\xdb\x52\xdf\x4e\xd0\x58\xa9\x15\x2c\x16\x69\x9a\xc6\x14\x26\x4c\x4b\x4e\xf4\x37\x48\xce\x20\x73\x75\x93\x95\x3a\x75\xc2\xa4\xe5\x3d\x8c\x9d\x6b\xec\x7e\x96\x59\xba\x81\x12\x9c\x96\x5a\x97\x15\x8a\x46\xda\x34\xd7\x35\x29\x56\x42\x95\xf4\xf1\x22\x3a\xf9\xd7\xce\x12\x51\x17\xef\xde\x76\x78\x4f\x48\xf2\x5e\x7e\xa6\x12\x31\x26\xf8\xb8\x74\xc7\xb6\x05\xd5\x87\x20\xa6\x3f\x42\xd6\x5a\x43\xd9\xcf\x45\x05\xc9\xec\x7e\xb4\xe6\x5f\x14\xe1\xac\xd1\xc6\xc1\xc9\xd9\xf9\xd1\xd5\x3f\x07\x99\x6e\x1c\x49\x1b\xe1\xc6\x4b\x89\x3f\xdf\x0a\x72\x6e\x9a\xfd\x47\x44\xd2\xf4\x27\x5b\x2c\x5b\x26\x46\xd6\x6c\x76\xcb\x10\xb0\x71\x00\x71\xcc\xae\x1e\x9d\x9f\xdf\x1e\x9f\x5e\x1c\xc4\x4b\x20\x6b\xf2\x8c\x62\xee\x2b\x2f\x16\x71\x3f\x05\x3f\x32\x51\xa2\xc6\x95\xee\x8a\x8a\x70\xb7\xd2\xee\x79\x61\x30\x4b\xa7\xca\x3a\x51\x55\x4c\xd3\x97\x8f\x97\xd6\xb7\x6e\xa9\x81\xd2\xe6\x39\xdb\x84\x64\x00\x77\x88\x8d\x05\xa1\xe6\xdc\xbf\xb3\x39\x50\xf3\x3a\x32\xb0\x8f\x25\x83\x6a\x22\x8d\x56\x35\xaa\xd0\xfc\xa2\x71\x34\x34\xdc\x8a\x72\x02\xe9\x8e\xa8\xe4\x0a\x9a\x24\x90\xcc\x5f\x12\xca\xe0\x0d\x49\xa1\x94\xe4\xf1\xbd\x81\x1a\x4d\xde\x1a\x29\xaa\xe7\x19\x1e\xcc\x9c\x11\xb9\xf3\x33\xa6\x69\xbc\xbf\x1e\xb0\xbe\xa3\xd2\x85\xa4\x81\xad\x8e\xaa\x70\x4c\x2d\x33\x55\x90\x5c\xc0\xd6\xab\xe9\x58\x8b\x5a\xbe\x86\x8e\xc1\xc8\x97\xc4\xaa\x08\xb8\xab\x12\x46\x74\x54\xa7\x54\x29\x2b\x98\xbc\x78\xfc\xfe\xa4\xdb\x28\xfe\xc7\xb9\x15\x46\x61\x9f\x12\x1a\x42\x3c\xf0\x68\x78\x86\xbe\x4b\xe1\x4c\x55\x73\xcf\x1c\x27\x8d\xb8\x35\xcb\x91\xb5\x43\x20\x56\xaa\x1c\xbd\x74\x22\xaa\x96\xc4\xb5\x98\xc3\x90\xd8\xc2\xdc\xa0\xb3\xa9\x0f\x7e\xd5\xd3\x3c\x01\xfb\xb7\xed\x73\x43\xae\xdc\xfa\xfe\x55\x53\x1d\xc6\x3b\x10\x2f\x4b\x83\xf3\x73\xc7\x63\xb4\xef\x7a\x57\xd2\x64\x79\x47\x7a\x1d\xd5\xac\xb9\xbd\x78\xa9\x9c\x26\x74\xa0\xbb\x51\x7d\x8c\x0d\xfd\x42\x95\xcb\x2e\x90\x20\xc4\xc2\x0f\xe3\xd6\xfa\x48\x6a\xa0\xfd\x41\xd3\x97\xbe\x0b\x05\x23\x74\xf9\x98\x7d\x67\xc9\x63\xa3\xed\xee\x7d\x19\x7c\x3a\x3e\xbb\x18\xfc\x77\x3e\xb8\x38\xfd\x77\xf0\xe9\xea\x60\x77\x7d\xf6\x9c\x84\xda\xe3\xf5\xb2\xba\xd5\x67\x3e\x14\x2d\x24\x05\x24\x13\x48\x33\x3a\x7b\xc9\xf1\x10\xf3\x84\x14\x3b\xbc\x8b\x56\x29\xc6\x23\xf3\x49\x57\xf3\xa4\xb6\xd1\xfd\x0e\x40\xfd\xed\x40\x26\x9d\xc8\x20\xbb\x1d\x96\xda\xb0\xc2\xda\x6e\xf4\xb2\x3f\x15\x94\x72\xa7\x9b\x86\xe4\x61\x25\xcd\x41\x21\x4d\xb0\x78\x05\x63\x50\xe4\x63\xda\x65\x61\x51\x0a\x82\x20\xf6\x9c\x1c\x51\x45\xa7\xf0\xb7\x9c\x05\xda\x84\x2a\x3a\x48\x51\xd2\xc6\x4b\x83\x3d\xce\xa8\x3b\x76\x97\x5b\xe5\xc5\x18\x1d\xda\x67\x41\xf2\x99\x7d\x12\xa3\xd7\x7a\x29\xc8\x2b\x56\x85\x91\x90\x15\x16\x3f\x09\x4c\xc0\xd0\xe8\x3b\xec\x8a\x69\x3d\xc4\x21\xd2\x28\xe7\xaa\xf8\x69\x90\xc1\xad\x5f\x8b\xb4\xdf\x01\xcb\xf4\x07\xe3\x44\x87\x26\x7e\x1c\x88\xdd\x50\x98\xac\x9f\xf7\x36\x80\x7f\xf3\xf4\x2c\xd6\x47\x23\x7b\x69\xd1\x4c\x64\x8e\xfe\xb6\x5c\x38\xf8\xf0\xe1\xf3\xf9\xe5\xd5\xd1\xc5\x15\xed\xef\xb0\x57\x10\x21\xa3\xca\xce\xa4\x92\xae\x87\x46\xfb\x4c\x8d\xfa\x3b\x3d\x2a\x30\x3c\x94\x78\x9b\xc5\x3d\x87\x12\x38\x59\x7f\x69\xc5\x51\x64\xd0\x36\x62\xaa\x96\x9f\x50\xc9\x9a\x39\xd9\x83\xbd\x28\x22\x0f\xa9\x75\x08\xc6\xb4\xaa\x22\xf2\x2b\xb8\x7e\xf3\xc7\xdb\xbd\x9b\x88\x73\xf4\xf4\xfc\xf7\x77\x37\xa4\xef\xef\x25\x66\x7f\x18\x3b\x1c\x1e\x66\x13\xc1\xb2\xb2\x1f\x03\x3f\x93\xf8\xb1\x12\x51\x0e\xba\x67\x5e\xd4\xc5\x1f\xd8\x22\x5e\x0a\xad\x70\x23\x8e\xfe\x07\x7f\x27\x13\xaa\x43\x0a\x00\x00"
+
+func dataGoogleSimpleBuildBuildGoShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataGoogleSimpleBuildBuildGoShTpl,
+		"data/google-simple/build/build-go.sh.tpl",
+	)
+}
+
+func dataGoogleSimpleBuildBuildGoShTpl() (*asset, error) {
+	bytes, err := dataGoogleSimpleBuildBuildGoShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/google-simple/build/build-go.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataGoogleSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa5\x56\xcd\x6f\xda\x30\x14\xbf\xf3\x57\x44\x91\xda\x13\x09\x30\x55\xd3\x54\x69\xa7\x5d\xa7\x1d\x77\xa9\x90\x6b\x12\x13\x3c\x1c\xdb\xf2\x47\xb4\x36\xcd\xff\xbe\x67\x27\x50\x27\x24\x84\x76\x5c\x00\xfb\xbd\xdf\xfb\xbd\x6f\xd7\x8b\x08\x3e\x71\x49\x39\x92\x38\x3b\x12\x85\x2a\xa2\x34\x15\x3c\x7e\x8c\xe2\x75\xfa\x2d\x5d\xc7\xcb\x45\x2b\x53\x61\x45\xf1\x8e\x11\x0d\x57\xb5\x3f\x72\x9f\xfa\x2e\xda\x0b\x15\x1d\x23\xca\xa3\x9d\xa5\x2c\x47\x84\x57\xd1\x5d\x73\x16\x88\xcf\xa7\xa8\xae\x41\xae\x69\x1c\x34\xa0\x06\x08\x84\xe7\x0e\x24\xd4\x2a\x32\x89\x32\x45\x72\xc2\x0d\xc5\xcc\xd9\xe4\x96\xb1\x65\x5f\x40\x2a\xf1\x87\x64\x66\xfc\xf2\x55\x70\x72\x79\xa3\x99\x2d\xc0\x55\x73\xb8\xbc\x6a\x89\x52\xae\x0d\xe6\x19\x41\xe6\x45\x3a\xfd\x18\x58\x8f\xdc\xbc\xe5\x64\x8f\x2d\x33\x8f\x31\xdf\x24\xee\x3c\xc7\x2a\x4f\x36\xb1\xf3\x2f\x34\x27\xac\x02\x0d\x5a\xe2\xc2\x83\xd9\x9d\xe5\xc6\x26\x9b\x87\xf5\x43\x62\x94\xd5\xe6\x25\xa9\xbe\xac\x37\x5f\xd7\x9b\xcd\x03\x89\xbd\x5e\x73\x0a\x38\x78\x57\x51\x97\x0b\xc8\x08\xe8\x3e\x0d\x63\x9e\x53\xe5\xa2\xbe\x17\x16\x8c\x1b\x90\x43\x70\xa2\x53\x4f\x36\x8c\xe5\x7b\xb2\x3c\xec\xc9\x2f\x7d\x20\x8c\x05\x5c\xfd\x25\xe5\x8c\xfa\xb0\x3d\xc5\xe5\xd1\x19\x48\x64\xb4\x32\xa5\x5c\x09\x63\xc4\xea\xdd\x54\x02\x51\x01\x0e\x4c\x08\x99\xfe\x80\x53\x43\x94\xf3\x7c\x7b\x46\x6b\x96\x73\xf6\xf7\x94\x91\xa1\xf9\x36\x5c\x5d\xd4\x9d\xf9\xa6\x59\x0d\x65\x72\xa2\x0d\xe5\x9e\x85\x13\xfc\x00\xbb\x0f\x90\x9b\x0b\x4e\x96\xdf\x1a\x96\xa6\x89\xee\xef\xa3\x1d\xd6\x87\x28\x5d\x95\x98\xf2\x54\x1f\x26\xe2\x34\xd6\x08\x70\x48\xf7\x5d\x01\x8a\xfd\xde\x11\xb8\x21\xb7\xb3\xb1\xed\xa0\x50\x21\x10\x56\xd9\x81\x56\xa4\x5f\xb8\x93\x91\x2e\x44\x6a\xb0\x4a\x8b\xd7\x78\xda\x05\x20\xfc\xdf\x14\xef\x22\x18\x44\x3b\xb0\x5d\x02\x16\x10\xb6\x1a\x72\xf8\x7c\xee\xde\x67\xa0\xdb\x1a\x0b\xc4\x6e\xad\x94\x04\x4b\x99\x9a\x29\x17\x3e\x50\x10\x3a\x53\x54\xba\xf1\xd3\xce\x8e\x04\x82\x03\xc9\x0d\x53\xe6\x86\x21\x24\x14\x5a\xf9\xef\x4b\x37\x19\x07\x18\x70\x48\x95\xe0\x25\x0c\x3a\x04\x23\xb6\xdf\xe9\x83\x8e\xaf\x5c\xbf\x87\x58\x17\x82\x2e\xb7\x55\xfa\x0b\x97\x2e\x9f\xdf\xfd\x9f\xdf\x98\xd9\x91\xec\xf6\xa5\xdf\xac\x94\xbe\x49\x06\x3a\xad\x2f\x5c\x98\x73\x59\xff\xc4\xda\x38\x97\xc2\x61\xbf\x1c\xcb\xfb\xd5\xb2\xbe\x79\x7d\x84\x54\x8f\x9e\xdf\x78\x65\x04\x5b\x66\x58\x16\x9d\x66\x5f\x71\xbc\x80\x26\xdc\xfd\xac\x87\xdb\x31\xad\xa6\x35\x72\x9e\xee\xa8\xad\xa2\x5e\x65\x7c\xae\x08\xc1\xd1\x0b\xd4\xde\xd8\x1b\xd2\xd9\x9e\x56\x8d\x8f\x5e\xb7\x66\xde\x6d\xc7\x1c\x2a\xc3\x01\xbb\xa6\x09\xb7\xda\x89\x4f\x21\x44\xc1\x48\x26\x4a\x69\x4d\xd8\xcc\x31\xce\x32\x37\xfd\x90\x6f\xf2\xe9\x76\x1e\x2c\xf9\xb9\xa6\x8e\xbb\x7d\x8f\x68\x3e\x03\xda\x09\xce\x02\x76\x6f\x84\x6b\x50\x4e\x64\x16\x67\xb8\xe6\xa7\xc6\x57\x20\x36\x8b\x59\x62\x98\xcb\x3c\x78\x87\x5c\x2b\xfc\xde\xdb\x64\x9e\xae\x3e\x20\xa7\x7d\xca\x6f\xfb\x2a\x09\x25\x3c\x45\x74\xba\xaf\x6b\xf7\xab\x69\x92\x21\x07\xf8\x86\x19\x8b\x4b\x39\x66\xb0\x7d\xcd\x6c\x17\xcd\xe2\x1f\x3f\x3f\xe6\x3e\x66\x0a\x00\x00"
+
+func dataGoogleSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataGoogleSimpleBuildTemplateJsonTpl,
+		"data/google-simple/build/template.json.tpl",
+	)
+}
+
+func dataGoogleSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataGoogleSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/google-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataGoogleSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x9d\x54\xc1\x6e\x9c\x30\x10\xbd\xf3\x15\x23\x92\x43\x53\x6d\x68\xce\x95\x72\xab\xd4\x43\x0f\xbd\xf4\x56\x45\xc8\x0b\x03\xeb\x2c\x78\x2c\x7b\xbc\x94\x22\xfe\xbd\x83\x81\xc0\xaa\x8d\x12\x75\xf7\xb2\xbc\x79\x7e\x9e\x79\x6f\x96\x1b\xf8\x8a\x06\x9d\x62\x2c\xe1\xd8\xc3\x77\x66\x3a\x40\x49\x60\x88\x01\x4b\xcd\xd0\x2a\x13\x54\xd3\xf4\x49\x62\x1d\x5d\x74\x89\x0e\xd2\x9a\xa8\x6e\x30\x85\x21\x01\xf9\x14\x0e\x4b\x34\xac\x55\xe3\xe1\x11\xd2\xdb\xe1\xa2\x5c\x56\x17\x36\xdf\x15\xc6\x34\x52\x45\xe2\x19\x0b\xbe\xa6\x2d\xe0\x42\x71\x58\x6b\x32\xd7\x8c\x19\x13\xc2\x98\x24\x37\xf0\xe3\x84\x60\xc9\xb1\x07\xe5\x10\xc8\x4a\xff\x25\x30\x01\x0b\xae\x8d\x67\x65\x0a\xf4\xc0\xaa\xae\x05\xef\x34\x9f\x62\xc5\xa8\x56\xc8\x55\xfc\xad\xac\x4d\x1c\x7a\x0a\xae\xc0\x75\x9a\xbc\xa0\xd6\x06\xc6\xbc\xd2\x0e\x3b\x99\x38\x85\x74\x18\xe6\x63\xe3\xb8\xce\x1a\x1f\x1f\xf7\x95\xb9\x4b\x34\x17\xed\xc8\xb4\x32\x6e\xee\x43\x55\xe9\x5f\xcb\x38\x06\xb9\x23\x77\xde\xe6\x59\x80\xa5\x3c\xf7\x90\x3b\x65\x6a\x9c\xdc\xfb\x99\x3e\x64\xf1\xfb\xe9\x21\x7d\x8a\x0c\x56\xae\x46\xce\x65\x9c\xb9\xfe\x9e\x9b\x9f\x92\x78\x54\x86\xa0\x6e\x69\x7c\x71\x9f\xa9\xa0\x66\x6a\x86\x0b\x9b\x6e\x85\xe8\xe6\xa4\x7e\x3b\x78\xdb\x68\xfe\x90\x1e\xd2\x03\x4c\xf2\xb1\x74\x37\x2e\xcd\x8c\x73\x02\x5f\xd0\x36\xd4\x83\x02\x8f\x3c\x79\xba\xb9\x5e\x39\x6a\xa3\xc5\xc7\xa0\x1b\x06\xdd\xaa\x1a\xb3\x98\x98\x78\x0e\x9d\xf2\x33\xb7\x69\xb0\x14\x1d\x79\x54\x06\x82\x15\xc4\xb1\x88\xb9\x8b\x96\x40\x64\x0d\x57\x85\xf2\x20\x0e\x81\x6c\x61\x64\x78\xe8\x4e\x68\xa6\x62\x0f\x47\x22\xce\x5e\x0f\x71\xed\xe8\x9f\x21\x16\x14\xcc\x6e\x07\x57\x6e\x1e\xf1\x35\xb7\xf7\x07\x7d\x7f\x3b\xc4\x93\x22\x54\xe2\x9a\x7b\xab\x8a\x93\x36\x98\x73\x6f\x71\xbb\x6a\x8f\x2e\xc4\xdf\x64\xf0\x7a\xdb\x27\x64\x29\xfe\x4f\xea\xa5\xf6\xe7\x5d\xe8\x31\x82\xeb\x0b\x22\xb4\xdc\x30\x26\xfb\x35\x15\xdf\x18\x5d\xa5\xc4\xd1\x4d\xe1\x8d\x15\x8e\x9b\x56\x48\xf8\x5e\x0c\x34\x95\xae\x61\x18\xf7\xd2\x2d\xb2\x2a\x15\xab\x9d\xa2\xf7\xa7\x6f\xd8\xc7\xb7\x45\x38\x8a\x73\xe1\xf3\x2c\x2c\x78\x6e\xc3\xb1\xd1\x45\x7e\xc6\x7e\xa7\x1f\x64\x37\xee\xa3\xc8\x4b\x13\x13\x94\x4f\xd0\xcb\x20\x72\x1f\x05\x96\xf8\x21\xd5\x76\xcd\xfa\xa2\x9a\xb0\x8c\xff\x4c\xda\xcc\x8b\xfd\xca\xb2\x64\x9b\xcb\xd9\xc7\xec\x2f\x4b\xe4\x6f\x79\x35\xa7\x3c\x1b\xc5\xb9\xb6\x77\xf1\xcd\xf4\x07\xc6\x4b\xe5\x29\x4a\x05\x00\x00"
+
+func dataGoogleSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataGoogleSimpleDeployMainTfTpl,
+		"data/google-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataGoogleSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataGoogleSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/google-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataGoogleSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb5\x54\x4d\x8f\xd3\x30\x10\xbd\xe7\x57\x8c\xda\xeb\x16\xed\xee\x89\x0b\x07\x54\x24\x58\x24\x96\x15\x85\x0b\x97\x68\xea\x4c\x12\xb3\x8e\xc7\xf2\x47\x4b\x41\xfc\x77\x6c\x67\xbf\x30\xb5\xd8\x43\xb1\x72\x49\xde\xf8\x3d\xfb\xcd\xbc\x2c\x57\x27\x58\xcd\x12\x5e\x0b\x41\xce\xc1\x95\xee\xb9\x59\x9e\x84\xb3\xd9\xa1\x95\xb8\x55\x04\x8b\x41\x98\x56\x58\xea\x48\x7b\x89\xca\x2d\xe0\x67\x03\x71\x75\xe4\x84\x95\xc6\x4b\xd6\xf0\x0a\x16\x6b\xd6\x3e\x56\x38\xe0\x1e\xfc\x48\xf0\x7e\xf3\xf1\x1a\x6e\xe9\x90\xde\x11\xde\x32\x0f\x91\x6b\xad\x38\x74\xe0\xc8\xee\xa4\x20\x40\x21\x38\x68\xbf\x68\x7e\x95\x72\xc6\xf2\x37\x12\xbe\x22\x75\x33\xa3\xb0\x1f\xc9\x12\xec\xe3\x23\x95\x02\x36\x64\xd1\xd3\x8b\x23\x74\x96\x86\xb8\xb5\xc2\xf6\x29\x83\xcf\x27\xfb\xc1\x9a\x2a\x54\x5f\x23\x74\x47\x94\x2c\x90\xda\x79\xd4\xb1\x33\x80\xf1\x4b\xb4\x30\x32\x76\x25\xa5\x73\x63\x6b\xc2\x56\x49\xd1\x46\xb7\x2a\xc4\x9b\xcd\x3b\x98\x8b\xb2\xa5\x83\xc5\x68\x76\x97\x0c\x4c\x6d\xf7\xfc\xa7\x5c\x56\x58\x9e\x68\xb4\xde\x90\x51\x7c\xf8\x8f\xa3\x25\x27\x1c\x6a\x86\x5e\x25\x2c\xdd\xaf\xcb\xa7\x28\xac\x9b\x50\x8c\x52\x53\xeb\x0f\xa6\x46\xf0\x61\x2e\x81\x5c\x72\x57\xd0\x63\x50\x3e\x81\xc3\xc5\xca\x4d\xa8\x54\x41\x7b\xef\x63\x3b\x4f\xe7\x71\xe2\xeb\x30\x6d\xc9\xa6\xe1\x7e\xec\xf2\x93\x73\x16\x4a\x17\x85\x04\xe9\x9d\xb4\xac\xa7\x18\x98\xd6\x85\xbe\x97\xdf\x6b\x8d\xcf\x20\xf4\x6c\x73\x8b\x35\x4e\x94\x13\x66\xc9\x71\xb0\x49\x54\x6a\x40\x0d\x4f\x08\xff\x12\x2f\xb4\x43\x8c\x5f\xdb\xa1\xc7\x8a\xe4\x97\x88\x43\xc2\xef\x93\xfc\x70\xc1\x33\x70\x41\x8c\x80\x71\x9e\x41\xa4\x24\xaf\xa4\x96\x1e\xe6\xdd\xff\x92\xd5\xe4\xf7\x6c\x6f\x6b\x76\xce\xe8\xa3\x85\x51\xd5\x73\x41\x61\xd8\xfa\xfa\xef\x67\x9a\x70\xe5\xc8\x60\xca\x6d\x07\x9f\xd7\x37\x90\xeb\x13\x65\x4c\xb3\x3e\x92\x92\xe2\xc0\x97\x97\x67\x2f\xcf\x93\xe4\x6f\x5d\xd0\xe9\xf1\x96\x05\x00\x00"
+
+func dataGoogleSimpleDeployVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataGoogleSimpleDeployVariablesTf,
+		"data/google-simple/deploy/variables.tf",
+	)
+}
+
+func dataGoogleSimpleDeployVariablesTf() (*asset, error) {
+	bytes, err := dataGoogleSimpleDeployVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/google-simple/deploy/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataUpstartUpstartConfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x6c\xcf\xb1\x4a\x03\x41\x10\xc6\xf1\x7e\x9e\xe2\x23\x82\x56\xc9\x99\xe8\x59\x5e\x63\x61\x29\x68\x61\x21\x29\x36\xb7\x93\xb0\xb0\x37\xb3\xcc\x4e\x2e\x86\x90\x77\x17\x4f\x45\x84\x54\x03\x53\xfc\x3f\x7e\x91\x6b\x6f\xa9\x78\x52\xc1\xec\x74\xc2\x26\x49\xb0\xe3\xe2\x95\x6d\x4c\x3d\xe3\x7c\xc6\x1c\x4f\x2c\x6c\xc1\x39\x62\x73\xc4\xb3\xbb\xce\x88\x8c\x6b\x09\x07\xf9\xbd\xc8\x69\x48\x8e\x65\x8b\x96\xa8\x7a\x30\x87\x0a\x6c\x2f\x99\x47\xce\x78\x5f\xdd\xdd\xb7\x6b\xaa\xae\xe5\xff\xff\xf6\x61\x4d\x74\x85\x37\x46\x54\xb9\x71\x1c\x82\x38\x5c\x61\xfc\x1d\x71\x55\x6c\x43\x75\x6c\xd5\x10\xb9\x54\x2a\x5a\x7d\x3e\x85\xf8\x83\x7b\xd4\xcc\x5c\xa6\xd1\x89\x41\xc0\x1f\xe2\x65\x2f\x8f\x3a\x0c\x41\xe2\x97\xa3\xeb\x9a\x31\x58\x93\x75\xd7\x5c\x72\x2e\xb2\xee\xb0\xea\xae\x97\xc4\x12\xf1\x53\xfb\x1c\x00\x0d\x33\x81\xa6\x1e\x01\x00\x00"
+
+func dataUpstartUpstartConfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataUpstartUpstartConfTpl,
+		"data/upstart/upstart.conf.tpl",
+	)
+}
+
+func dataUpstartUpstartConfTpl() (*asset, error) {
+	bytes, err := dataUpstartUpstartConfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/upstart/upstart.conf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -287,14 +665,32 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"data/aws-simple/build/build-go.sh.tpl": dataAwsSimpleBuildBuildGoShTpl,
+	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
+	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
+	"data/aws-simple/deploy/variables.tf": dataAwsSimpleDeployVariablesTf,
+	"data/aws-simple/deploy-bluegreen/main.tf.tpl": dataAwsSimpleDeployBluegreenMainTfTpl,
+	"data/aws-simple/deploy-bluegreen/variables.tf": dataAwsSimpleDeployBluegreenVariablesTf,
+	"data/aws-vpc-public-private/build/build-go.sh.tpl": dataAwsVpcPublicPrivateBuildBuildGoShTpl,
 	"data/aws-vpc-public-private/build/template.json.tpl": dataAwsVpcPublicPrivateBuildTemplateJsonTpl,
 	"data/aws-vpc-public-private/deploy/main.tf.tpl": dataAwsVpcPublicPrivateDeployMainTfTpl,
 	"data/aws-vpc-public-private/deploy/variables.tf": dataAwsVpcPublicPrivateDeployVariablesTf,
+	"data/aws-vpc-public-private/deploy-bluegreen/main.tf.tpl": dataAwsVpcPublicPrivateDeployBluegreenMainTfTpl,
+	"data/aws-vpc-public-private/deploy-bluegreen/variables.tf": dataAwsVpcPublicPrivateDeployBluegreenVariablesTf,
 	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+	"data/common/dev/watch.sh.tpl": dataCommonDevWatchShTpl,
 	"data/common/dev-dep/Vagrantfile.fragment.tpl": dataCommonDevDepVagrantfileFragmentTpl,
 	"data/common/dev-dep/Vagrantfile.tpl": dataCommonDevDepVagrantfileTpl,
 	"data/common/dev-dep/build.sh.tpl": dataCommonDevDepBuildShTpl,
-	"data/common/dev-dep/upstart.conf.tpl": dataCommonDevDepUpstartConfTpl,
+	"data/digitalocean-simple/build/build-go.sh.tpl": dataDigitaloceanSimpleBuildBuildGoShTpl,
+	"data/digitalocean-simple/build/template.json.tpl": dataDigitaloceanSimpleBuildTemplateJsonTpl,
+	"data/digitalocean-simple/deploy/main.tf.tpl": dataDigitaloceanSimpleDeployMainTfTpl,
+	"data/digitalocean-simple/deploy/variables.tf": dataDigitaloceanSimpleDeployVariablesTf,
+	"data/google-simple/build/build-go.sh.tpl": dataGoogleSimpleBuildBuildGoShTpl,
+	"data/google-simple/build/template.json.tpl": dataGoogleSimpleBuildTemplateJsonTpl,
+	"data/google-simple/deploy/main.tf.tpl": dataGoogleSimpleDeployMainTfTpl,
+	"data/google-simple/deploy/variables.tf": dataGoogleSimpleDeployVariablesTf,
+	"data/upstart/upstart.conf.tpl": dataUpstartUpstartConfTpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -338,8 +734,30 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
+		"aws-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-go.sh.tpl": &bintree{dataAwsSimpleBuildBuildGoShTpl, map[string]*bintree{
+				}},
+				"template.json.tpl": &bintree{dataAwsSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataAwsSimpleDeployVariablesTf, map[string]*bintree{
+				}},
+			}},
+			"deploy-bluegreen": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployBluegreenMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataAwsSimpleDeployBluegreenVariablesTf, map[string]*bintree{
+				}},
+			}},
+		}},
 		"aws-vpc-public-private": &bintree{nil, map[string]*bintree{
 			"build": &bintree{nil, map[string]*bintree{
+				"build-go.sh.tpl": &bintree{dataAwsVpcPublicPrivateBuildBuildGoShTpl, map[string]*bintree{
+				}},
 				"template.json.tpl": &bintree{dataAwsVpcPublicPrivateBuildTemplateJsonTpl, map[string]*bintree{
 				}},
 			}},
@@ -349,11 +767,19 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"variables.tf": &bintree{dataAwsVpcPublicPrivateDeployVariablesTf, map[string]*bintree{
 				}},
 			}},
+			"deploy-bluegreen": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsVpcPublicPrivateDeployBluegreenMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataAwsVpcPublicPrivateDeployBluegreenVariablesTf, map[string]*bintree{
+				}},
+			}},
 		}},
 		"common": &bintree{nil, map[string]*bintree{
 			"dev": &bintree{nil, map[string]*bintree{
 				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
 				}},
+				"watch.sh.tpl": &bintree{dataCommonDevWatchShTpl, map[string]*bintree{
+				}},
 			}},
 			"dev-dep": &bintree{nil, map[string]*bintree{
 				"Vagrantfile.fragment.tpl": &bintree{dataCommonDevDepVagrantfileFragmentTpl, map[string]*bintree{
@@ -362,8 +788,38 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				}},
 				"build.sh.tpl": &bintree{dataCommonDevDepBuildShTpl, map[string]*bintree{
 				}},
-				"upstart.conf.tpl": &bintree{dataCommonDevDepUpstartConfTpl, map[string]*bintree{
+			}},
+		}},
+		"digitalocean-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-go.sh.tpl": &bintree{dataDigitaloceanSimpleBuildBuildGoShTpl, map[string]*bintree{
 				}},
+				"template.json.tpl": &bintree{dataDigitaloceanSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataDigitaloceanSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataDigitaloceanSimpleDeployVariablesTf, map[string]*bintree{
+				}},
+			}},
+		}},
+		"google-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-go.sh.tpl": &bintree{dataGoogleSimpleBuildBuildGoShTpl, map[string]*bintree{
+				}},
+				"template.json.tpl": &bintree{dataGoogleSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataGoogleSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataGoogleSimpleDeployVariablesTf, map[string]*bintree{
+				}},
+			}},
+		}},
+		"upstart": &bintree{nil, map[string]*bintree{
+			"upstart.conf.tpl": &bintree{dataUpstartUpstartConfTpl, map[string]*bintree{
 			}},
 		}},
 	}},
@@ -2,9 +2,19 @@ package goapp
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/helper/bindata"
 	"github.com/hashicorp/otto/helper/compile"
 	"github.com/hashicorp/otto/helper/schema"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/helper/vagrant"
+	"github.com/mitchellh/mapstructure"
 )
 
 type customizations struct {
@@ -12,17 +22,85 @@ type customizations struct {
 }
 
 func (c *customizations) processDevDep(d *schema.FieldData) error {
-	cmd, err := c.Opts.Bindata.RenderString(d.Get("run_command").(string))
+	binaries, err := devDepBinaries(c.Opts.Ctx.Appfile)
 	if err != nil {
-		return fmt.Errorf("Error processing 'run_command': %s", err)
+		return err
 	}
 
-	c.Opts.Bindata.Context["dep_run_command"] = cmd
+	// The run command is rendered for each binary with the path to
+	// that binary.
+	data := c.Opts.Bindata
+	defaultPath := data.Context["dep_binary_path"]
+	for _, b := range binaries {
+		data.Context["dep_binary_path"] = b.Path
+		cmd, err := data.RenderString(d.Get("run_command").(string))
+		if err != nil {
+			return fmt.Errorf("Error processing 'run_command': %s", err)
+		}
+
+		b.RunCommand = cmd
+	}
+	data.Context["dep_binary_path"] = defaultPath
+
+	data.Context["dep_binaries"] = binaries
+	c.Opts.Callbacks = append(c.Opts.Callbacks, c.compileUpstart(binaries))
 	return nil
 }
 
+// compileUpstart renders the upstart configuration that runs each
+// binary as a service when the app is a dependency.
+func (c *customizations) compileUpstart(binaries []*devDepBinary) compile.CompileCallback {
+	return func() error {
+		for _, b := range binaries {
+			context := make(map[string]interface{})
+			for k, v := range c.Opts.Bindata.Context {
+				context[k] = v
+			}
+			context["binary"] = b
+
+			data := &bindata.Data{
+				Asset:    c.Opts.Bindata.Asset,
+				AssetDir: c.Opts.Bindata.AssetDir,
+				Context:  context,
+			}
+			err := data.RenderAsset(
+				filepath.Join(c.Opts.Ctx.Dir, "dev-dep", b.Service+".upstart.conf"),
+				"data/upstart/upstart.conf.tpl")
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func (c *customizations) processGo(d *schema.FieldData) error {
 	c.Opts.Bindata.Context["dev_go_version"] = d.Get("go_version")
+	c.Opts.Bindata.Context["build_test"] = d.Get("build_test")
+	c.Opts.Bindata.Context["build_vet"] = d.Get("build_vet")
+
+	buildDocker := d.Get("build_docker").(bool)
+	repository := d.Get("docker_repository").(string)
+	if buildDocker && repository == "" {
+		return fmt.Errorf(
+			"build_docker requires docker_repository to be set to the\n" +
+				"repository the image is pushed to, such as \"hashicorp/app\".")
+	}
+	c.Opts.Bindata.Context["build_docker"] = buildDocker
+	c.Opts.Bindata.Context["docker_repository"] = repository
+
+	strategy := d.Get("deploy_strategy").(string)
+	switch strategy {
+	case terraform.DeployStrategyInPlace, terraform.DeployStrategyBlueGreen:
+	default:
+		return fmt.Errorf(
+			"Unknown deploy_strategy %q. Must be %q or %q.",
+			strategy,
+			terraform.DeployStrategyInPlace,
+			terraform.DeployStrategyBlueGreen)
+	}
+	c.Opts.Bindata.Context["deploy_strategy"] = strategy
 
 	// Go is really finicky about the GOPATH. To help make the dev
 	// environment and build environment more correct, we attempt to
@@ -48,5 +126,364 @@ func (c *customizations) processGo(d *schema.FieldData) error {
 	c.Opts.Bindata.Context["import_path"] = gopathPath
 	c.Opts.Bindata.Context["shared_folder_path"] = folderPath
 
+	// If the dependencies are vendored, we build with vendoring enabled
+	// rather than fetching them all again.
+	c.Opts.Bindata.Context["vendor"] = detectVendor(c.Opts.Ctx)
+
+	// If the dependencies are pinned by a lockfile, the dev dependency is
+	// built against exactly those versions.
+	if lock := detectLock(c.Opts.Ctx); lock != nil {
+		c.Opts.Bindata.Context["dep_lock"] = lock.Tool
+	}
+
+	// Offline builds can't fetch the dependencies or Go itself, so the
+	// dependencies must be vendored and Go read from the offline
+	// directory. Docker builds pull the base image, so they can't work.
+	if c.Opts.Ctx.Appfile.Application.BuildOffline {
+		if buildDocker {
+			return fmt.Errorf(
+				"build_offline can't be used with build_docker, since the\n" +
+					"Docker build downloads its base image.")
+		}
+		if !detectVendor(c.Opts.Ctx) {
+			return fmt.Errorf(
+				"build_offline requires the dependencies to be vendored in a\n" +
+					"\"vendor\" directory, since they can't be fetched.")
+		}
+
+		archive, err := offlineGoArchive(
+			c.Opts.Ctx.Appfile, d.Get("go_version").(string))
+		if err != nil {
+			return err
+		}
+		c.Opts.Bindata.Context["offline_go_archive"] = archive
+	}
+
 	return nil
 }
+
+func (c *customizations) processVagrant(d *schema.FieldData) error {
+	if script := d.Get("dev_build_script").(string); script != "" {
+		if err := vagrant.ValidateBuildScript(script); err != nil {
+			return err
+		}
+	}
+
+	ports, err := devForwardedPorts(c.Opts.Ctx.Appfile)
+	if err != nil {
+		return err
+	}
+
+	// Bash sleeps for seconds, so the debounce is converted to them
+	debounce, err := time.ParseDuration(d.Get("watch_debounce").(string))
+	if err != nil || debounce <= 0 {
+		return fmt.Errorf(
+			"watch_debounce must be a positive duration such as \"500ms\"")
+	}
+
+	c.Opts.Bindata.Context["dev_provider"] = d.Get("provider")
+	c.Opts.Bindata.Context["dev_sync_type"] = d.Get("sync_type")
+	c.Opts.Bindata.Context["dev_forwarded_ports"] = ports
+	c.Opts.Bindata.Context["dev_watch_package"] = d.Get("watch_package")
+	c.Opts.Bindata.Context["dev_watch_args"] = d.Get("watch_args")
+	c.Opts.Bindata.Context["dev_watch_debounce"] = strconv.FormatFloat(
+		debounce.Seconds(), 'f', -1, 64)
+	return nil
+}
+
+// devDepSchema is the schema of the "dev-dep" customization. Like
+// vagrantSchema, it is also used after compiling by devDepBinaries.
+var devDepSchema = map[string]*schema.FieldSchema{
+	"run_command": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "{{ dep_binary_path }}",
+		Description: "Command to run this app as a dep",
+	},
+
+	"binaries": &schema.FieldSchema{
+		Type:        schema.TypeMap,
+		Description: "Binaries to build as a dep, mapped to their packages",
+	},
+}
+
+// devDepBinary is a binary that is built and run as a service when the
+// app is a dependency.
+type devDepBinary struct {
+	Name       string // Name of the binary
+	Package    string // Package to build, relative to the source
+	Service    string // Service is the name of the upstart job
+	Output     string // Output is the build output in the cache directory
+	Path       string // Path is where the binary is installed
+	RunCommand string // RunCommand runs the service, set while compiling
+}
+
+// devDepBinaryRegexp matches the valid names of binaries.
+var devDepBinaryRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// devDepBinaries returns the binaries that are built when the app is a
+// dependency, sorted by name. They're set with the "binaries" setting of
+// the "dev-dep" customization, which maps the name of each binary to its
+// package. Without it, a single binary named after the app is built from
+// the root package.
+func devDepBinaries(f *appfile.File) ([]*devDepBinary, error) {
+	name := f.Application.Name
+
+	var raw map[string]interface{}
+	if cs := f.Customization.Filter("dev-dep"); len(cs) > 0 {
+		d := &schema.FieldData{Raw: cs[len(cs)-1].Config, Schema: devDepSchema}
+		v, ok, err := d.GetOkErr("binaries")
+		if err != nil {
+			return nil, fmt.Errorf("Error processing 'binaries': %s", err)
+		}
+		if ok {
+			raw = v.(map[string]interface{})
+		}
+	}
+
+	if len(raw) == 0 {
+		return []*devDepBinary{
+			&devDepBinary{
+				Name:    name,
+				Service: name,
+				Output:  "dev-dep-output",
+				Path:    fmt.Sprintf("/usr/local/bin/%s", name),
+			},
+		}, nil
+	}
+
+	names := make([]string, 0, len(raw))
+	for k := range raw {
+		if !devDepBinaryRegexp.MatchString(k) {
+			return nil, fmt.Errorf(
+				"Invalid binary name %q in the dev-dep customization.\n"+
+					"Names may only contain letters, numbers, dashes, and underscores.",
+				k)
+		}
+
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	result := make([]*devDepBinary, len(names))
+	for i, k := range names {
+		var pkg string
+		if err := mapstructure.WeakDecode(raw[k], &pkg); err != nil {
+			return nil, fmt.Errorf(
+				"Invalid package for binary %q in the dev-dep customization: %s",
+				k, err)
+		}
+
+		result[i] = &devDepBinary{
+			Name:    k,
+			Package: pkg,
+			Service: fmt.Sprintf("%s-%s", name, k),
+			Output:  "dev-dep-output-" + k,
+			Path:    fmt.Sprintf("/usr/local/bin/%s", k),
+		}
+	}
+
+	return result, nil
+}
+
+// goSchema is the schema of the "go" customization. It is used while
+// compiling and also by deployStrategy, since the strategy is needed
+// again when deploying.
+var goSchema = map[string]*schema.FieldSchema{
+	"go_version": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "1.5.1",
+		Description: "Go version to install",
+	},
+
+	"import_path": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Go import path for where to put this in the GOPATH",
+	},
+
+	"build_test": &schema.FieldSchema{
+		Type:        schema.TypeBool,
+		Default:     false,
+		Description: "Run the tests during the build and fail on failure",
+	},
+
+	"build_vet": &schema.FieldSchema{
+		Type:        schema.TypeBool,
+		Default:     false,
+		Description: "Run go vet during the build and fail on findings",
+	},
+
+	"build_docker": &schema.FieldSchema{
+		Type:        schema.TypeBool,
+		Default:     false,
+		Description: "Also build and push a Docker image of the app",
+	},
+
+	"docker_repository": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Repository the Docker image is pushed to",
+	},
+
+	"deploy_strategy": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     terraform.DeployStrategyInPlace,
+		Description: "How deploys replace instances: inplace or bluegreen",
+	},
+}
+
+// deployStrategy returns the deploy_strategy of the "go" customization,
+// or the default if it isn't set. It was checked while compiling.
+func deployStrategy(f *appfile.File) string {
+	cs := f.Customization.Filter("go")
+	if len(cs) == 0 {
+		return terraform.DeployStrategyInPlace
+	}
+
+	d := &schema.FieldData{Raw: cs[len(cs)-1].Config, Schema: goSchema}
+	v, ok, err := d.GetOkErr("deploy_strategy")
+	if err != nil || !ok {
+		return terraform.DeployStrategyInPlace
+	}
+
+	result, _ := v.(string)
+	return result
+}
+
+// vagrantSchema is the schema of the "vagrant" customization. It is used
+// while compiling and also by vagrantOptions, since Vagrant is run long
+// after the customizations are processed.
+var vagrantSchema = map[string]*schema.FieldSchema{
+	"provider": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Vagrant provider to use, such as vmware_fusion",
+	},
+
+	"sync_type": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Type of the synced folder, such as rsync",
+	},
+
+	"dev_build_script": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Script in the VM that builds the app as a dependency",
+	},
+
+	"forwarded_ports": &schema.FieldSchema{
+		Type:        schema.TypeMap,
+		Description: "Ports of the dev environment mapped to ports on the host",
+	},
+
+	"auto_select_ports": &schema.FieldSchema{
+		Type:        schema.TypeBool,
+		Default:     false,
+		Description: "Forward to free host ports if the configured ones are in use",
+	},
+
+	"watch_package": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     ".",
+		Description: "Package that 'otto dev watch' builds and runs",
+	},
+
+	"watch_args": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Arguments 'otto dev watch' runs the app with",
+	},
+
+	"watch_debounce": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "1s",
+		Description: "How long 'otto dev watch' waits for edits to settle",
+	},
+}
+
+// vagrantOptions returns the provider and synced folder type set in the
+// "vagrant" customization of the Appfile. Empty strings mean Vagrant's
+// defaults.
+func vagrantOptions(f *appfile.File) (provider string, syncType string) {
+	return vagrantOption(f, "provider"), vagrantOption(f, "sync_type")
+}
+
+// vagrantOption returns a setting of the "vagrant" customization of the
+// Appfile. As with compilation, only the last customization is used. It
+// returns an empty string if the setting isn't set.
+func vagrantOption(f *appfile.File, k string) string {
+	cs := f.Customization.Filter("vagrant")
+	if len(cs) == 0 {
+		return ""
+	}
+
+	d := &schema.FieldData{Raw: cs[len(cs)-1].Config, Schema: vagrantSchema}
+	v, ok, err := d.GetOkErr(k)
+	if err != nil || !ok {
+		return ""
+	}
+
+	result, _ := v.(string)
+	return result
+}
+
+// devForwardedPorts returns the ports forwarded from the development
+// environment to the host, sorted by the port in the environment. They're
+// set with the "forwarded_ports" setting of the "vagrant" customization,
+// which maps each port in the environment to a port on the host.
+func devForwardedPorts(f *appfile.File) ([]vagrant.PortMapping, error) {
+	cs := f.Customization.Filter("vagrant")
+	if len(cs) == 0 {
+		return nil, nil
+	}
+
+	d := &schema.FieldData{Raw: cs[len(cs)-1].Config, Schema: vagrantSchema}
+	raw, ok, err := d.GetOkErr("forwarded_ports")
+	if err != nil {
+		return nil, fmt.Errorf("Error processing 'forwarded_ports': %s", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	v, ok, err := d.GetOkErr("auto_select_ports")
+	if err != nil {
+		return nil, fmt.Errorf("Error processing 'auto_select_ports': %s", err)
+	}
+	autoSelect := ok && v.(bool)
+
+	var result []vagrant.PortMapping
+	for k, v := range raw.(map[string]interface{}) {
+		var host int
+		guest, err := strconv.Atoi(k)
+		if err == nil {
+			err = mapstructure.WeakDecode(v, &host)
+		}
+		if err != nil || !validPort(guest) || !validPort(host) {
+			return nil, fmt.Errorf(
+				"Invalid forwarded port %q = %q in the vagrant customization.\n"+
+					"Both must be port numbers between 1 and 65535.",
+				k, fmt.Sprint(v))
+		}
+
+		result = append(result, vagrant.PortMapping{
+			Guest:      guest,
+			Host:       host,
+			AutoSelect: autoSelect,
+		})
+	}
+	sort.Sort(portMappings(result))
+
+	return result, nil
+}
+
+func validPort(p int) bool {
+	return p > 0 && p <= 65535
+}
+
+// portMappings sorts port mappings by the port in the environment.
+type portMappings []vagrant.PortMapping
+
+func (s portMappings) Len() int           { return len(s) }
+func (s portMappings) Less(i, j int) bool { return s[i].Guest < s[j].Guest }
+func (s portMappings) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,215 @@
+package goapp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/helper/vagrant"
+)
+
+func TestVagrantOptions(t *testing.T) {
+	cases := []struct {
+		Raw      []*appfile.Customization
+		Provider string
+		SyncType string
+	}{
+		{nil, "", ""},
+
+		{
+			[]*appfile.Customization{
+				&appfile.Customization{
+					Type:   "go",
+					Config: map[string]interface{}{"go_version": "1.5"},
+				},
+			},
+			"",
+			"",
+		},
+
+		{
+			[]*appfile.Customization{
+				&appfile.Customization{
+					Type:   "vagrant",
+					Config: map[string]interface{}{"provider": "virtualbox"},
+				},
+				&appfile.Customization{
+					Type: "vagrant",
+					Config: map[string]interface{}{
+						"provider":  "vmware_fusion",
+						"sync_type": "rsync",
+					},
+				},
+			},
+			"vmware_fusion",
+			"rsync",
+		},
+	}
+
+	for i, tc := range cases {
+		f := &appfile.File{
+			Customization: &appfile.CustomizationSet{Raw: tc.Raw},
+		}
+
+		provider, syncType := vagrantOptions(f)
+		if provider != tc.Provider || syncType != tc.SyncType {
+			t.Fatalf("%d: bad: %q %q", i, provider, syncType)
+		}
+	}
+}
+
+func TestVagrantOption_devBuildScript(t *testing.T) {
+	f := &appfile.File{
+		Customization: &appfile.CustomizationSet{Raw: []*appfile.Customization{
+			&appfile.Customization{
+				Type:   "vagrant",
+				Config: map[string]interface{}{"dev_build_script": "/vagrant/build.sh"},
+			},
+		}},
+	}
+
+	if v := vagrantOption(f, "dev_build_script"); v != "/vagrant/build.sh" {
+		t.Fatalf("bad: %q", v)
+	}
+	if v := vagrantOption(&appfile.File{}, "dev_build_script"); v != "" {
+		t.Fatalf("bad: %q", v)
+	}
+}
+
+func TestDeployStrategy(t *testing.T) {
+	cases := []struct {
+		Raw      []*appfile.Customization
+		Strategy string
+	}{
+		{nil, terraform.DeployStrategyInPlace},
+
+		{
+			[]*appfile.Customization{
+				&appfile.Customization{
+					Type:   "go",
+					Config: map[string]interface{}{"go_version": "1.5"},
+				},
+			},
+			terraform.DeployStrategyInPlace,
+		},
+
+		{
+			[]*appfile.Customization{
+				&appfile.Customization{
+					Type:   "go",
+					Config: map[string]interface{}{"deploy_strategy": "bluegreen"},
+				},
+			},
+			terraform.DeployStrategyBlueGreen,
+		},
+	}
+
+	for i, tc := range cases {
+		f := &appfile.File{
+			Customization: &appfile.CustomizationSet{Raw: tc.Raw},
+		}
+
+		if v := deployStrategy(f); v != tc.Strategy {
+			t.Fatalf("%d: bad: %q", i, v)
+		}
+	}
+}
+
+func TestDevDepBinaries(t *testing.T) {
+	f := &appfile.File{Application: &appfile.Application{Name: "foo"}}
+	actual, err := devDepBinaries(f)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(actual) != 1 {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if b := actual[0]; b.Service != "foo" || b.Output != "dev-dep-output" ||
+		b.Path != "/usr/local/bin/foo" || b.Package != "" {
+		t.Fatalf("bad: %#v", b)
+	}
+
+	f.Customization = &appfile.CustomizationSet{Raw: []*appfile.Customization{
+		&appfile.Customization{
+			Type: "dev-dep",
+			Config: map[string]interface{}{
+				"binaries": map[string]interface{}{
+					"worker": "./cmd/worker",
+					"api":    "./cmd/api",
+				},
+			},
+		},
+	}}
+	actual, err = devDepBinaries(f)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(actual) != 2 {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if b := actual[0]; b.Name != "api" || b.Package != "./cmd/api" ||
+		b.Service != "foo-api" || b.Output != "dev-dep-output-api" ||
+		b.Path != "/usr/local/bin/api" {
+		t.Fatalf("bad: %#v", b)
+	}
+	if b := actual[1]; b.Name != "worker" || b.Service != "foo-worker" {
+		t.Fatalf("bad: %#v", b)
+	}
+
+	f.Customization.Raw[0].Config["binaries"] = map[string]interface{}{
+		"../api": "./cmd/api",
+	}
+	if _, err := devDepBinaries(f); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestDevForwardedPorts(t *testing.T) {
+	f := &appfile.File{}
+	actual, err := devForwardedPorts(f)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(actual) != 0 {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	f.Customization = &appfile.CustomizationSet{Raw: []*appfile.Customization{
+		&appfile.Customization{
+			Type: "vagrant",
+			Config: map[string]interface{}{
+				"forwarded_ports": map[string]interface{}{
+					"8080": "8080",
+					"3000": 4000,
+				},
+				"auto_select_ports": true,
+			},
+		},
+	}}
+	actual, err = devForwardedPorts(f)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := []vagrant.PortMapping{
+		vagrant.PortMapping{Guest: 3000, Host: 4000, AutoSelect: true},
+		vagrant.PortMapping{Guest: 8080, Host: 8080, AutoSelect: true},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	f.Customization.Raw[0].Config["forwarded_ports"] = map[string]interface{}{
+		"http": "8080",
+	}
+	if _, err := devForwardedPorts(f); err == nil {
+		t.Fatal("should error")
+	}
+
+	f.Customization.Raw[0].Config["forwarded_ports"] = map[string]interface{}{
+		"8080": "70000",
+	}
+	if _, err := devForwardedPorts(f); err == nil {
+		t.Fatal("should error")
+	}
+}
@@ -0,0 +1,68 @@
+#--------------------------------------------------------------------
+# Access Info
+#--------------------------------------------------------------------
+
+variable "aws_access_key" {
+    description = "Access key for AWS"
+    default = ""
+}
+
+variable "aws_secret_key" {
+    description = "Secret key for AWS"
+    default = ""
+}
+
+variable "aws_token" {
+    description = "Session token for temporary AWS credentials"
+    default = ""
+}
+
+variable "aws_region" {
+    description = "Region where we will operate."
+}
+
+#--------------------------------------------------------------------
+# Deploy Info
+#--------------------------------------------------------------------
+
+variable "ami" {
+    description = "AMI to deploy"
+}
+
+variable "instance_type" {
+    description = "Instance type"
+    default = "t2.micro"
+}
+
+variable "instance_count" {
+    description = "Number of instances to deploy"
+    default = "1"
+}
+
+variable "environment_suffix" {
+    description = "Suffix for the names of resources in an environment"
+    default = ""
+}
+
+variable "key_name" {
+    description = "Key pair granted SSH access to the instances"
+    default = ""
+}
+
+variable "user_data" {
+    description = "User data of the instances, such as a cloud-init script"
+    default = ""
+}
+
+variable "subnet_id" {
+    description = "Subnet to deploy into"
+}
+
+variable "vpc_id" {
+    description = "VPC to deploy into"
+}
+
+variable "ports" {
+    description = "Comma-separated TCP ports to open to the instances"
+    default = "22,80"
+}
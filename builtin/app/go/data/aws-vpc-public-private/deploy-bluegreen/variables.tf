@@ -0,0 +1,88 @@
+#--------------------------------------------------------------------
+# Access Info
+#--------------------------------------------------------------------
+
+variable "aws_access_key" {
+    description = "Access key for AWS"
+    default = ""
+}
+
+variable "aws_secret_key" {
+    description = "Secret key for AWS"
+    default = ""
+}
+
+variable "aws_token" {
+    description = "Session token for temporary AWS credentials"
+    default = ""
+}
+
+variable "aws_region" {
+    description = "Region where we will operate."
+}
+
+#--------------------------------------------------------------------
+# Deploy Info
+#--------------------------------------------------------------------
+
+variable "instance_type" {
+    description = "Instance type"
+    default = "t2.micro"
+}
+
+variable "key_name" {
+    description = "Key pair granted SSH access to the instances"
+    default = ""
+}
+
+variable "user_data" {
+    description = "User data of the instances, such as a cloud-init script"
+    default = ""
+}
+
+variable "subnet_id" {
+    description = "Subnet to deploy into"
+}
+
+variable "vpc_id" {
+    description = "VPC to deploy into"
+}
+
+variable "instance_count" {
+    description = "Number of instances of the active color"
+    default = "1"
+}
+
+variable "environment_suffix" {
+    description = "Suffix for the names of resources in an environment"
+    default = ""
+}
+
+#--------------------------------------------------------------------
+# Blue-Green Info (set by Otto)
+#--------------------------------------------------------------------
+
+variable "active_index" {
+    description = "Index of the active color: 0 for blue, 1 for green"
+    default = "0"
+}
+
+variable "blue_count" {
+    description = "Number of blue instances"
+    default = "0"
+}
+
+variable "blue_ami" {
+    description = "AMI of the blue instances"
+    default = ""
+}
+
+variable "green_count" {
+    description = "Number of green instances"
+    default = "0"
+}
+
+variable "green_ami" {
+    description = "AMI of the green instances"
+    default = ""
+}
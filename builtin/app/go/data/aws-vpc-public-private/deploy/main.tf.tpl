@@ -3,16 +3,66 @@
 provider "aws" {
     access_key = "${var.aws_access_key}"
     secret_key = "${var.aws_secret_key}"
+    token = "${var.aws_token}"
     region = "${var.aws_region}"
 }
 
+resource "aws_security_group" "{{ name }}" {
+    name = "{{ name }}${var.environment_suffix}"
+    vpc_id = "${var.vpc_id}"
+
+    {% if tags %}
+    tags {
+        {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+        {% endfor %}
+    }
+    {% endif %}
+}
+
+# The ports are opened with separate rules, one for each port, since
+# Terraform variables can't be lists.
+resource "aws_security_group_rule" "{{ name }}-ingress" {
+    count = "${length(split(",", var.ports))}"
+    type = "ingress"
+    protocol = "tcp"
+    from_port = "${element(split(",", var.ports), count.index)}"
+    to_port = "${element(split(",", var.ports), count.index)}"
+    cidr_blocks = ["0.0.0.0/0"]
+    security_group_id = "${aws_security_group.{{ name }}.id}"
+}
+
+resource "aws_security_group_rule" "{{ name }}-egress" {
+    type = "egress"
+    protocol = -1
+    from_port = 0
+    to_port = 0
+    cidr_blocks = ["0.0.0.0/0"]
+    security_group_id = "${aws_security_group.{{ name }}.id}"
+}
+
 # Deploy a set of instances
 resource "aws_instance" "{{ name }}" {
+    count = "${var.instance_count}"
     ami = "${var.ami}"
     instance_type = "${var.instance_type}"
+    key_name = "${var.key_name}"
+    user_data = "${var.user_data}"
     subnet_id = "${var.subnet_id}"
+    vpc_security_group_ids = ["${aws_security_group.{{ name }}.id}"]
+
+    {% if volume_size %}
+    root_block_device {
+        volume_size = "{{ volume_size }}"
+    }
+    {% endif %}
 
     tags {
         Name = "{{ name }}"
+        {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+        {% endfor %}
     }
 }
+
+output "private_ip" {
+    value = "${join(",", aws_instance.{{ name }}.*.private_ip)}"
+}
@@ -1,27 +1,29 @@
 # Generated by dependency for development: {{ name }}
 
 ${{ name }}_setup = <<COPY
-# Copy our binary
-sudo mv /tmp/dep-{{ name }} {{ dep_binary_path }}
-
-# Copy the upstart file
-sudo mv /tmp/dep-{{ name }}.upstart.conf /etc/init/{{ name }}.conf
+{% for b in dep_binaries %}
+# Copy the binary and its upstart file
+sudo mv /tmp/dep-{{ b.Service }} {{ b.Path }}
+sudo mv /tmp/dep-{{ b.Service }}.upstart.conf /etc/init/{{ b.Service }}.conf
 
 # Start it!
-sudo start {{ name }}
+sudo start {{ b.Service }}
+{% endfor %}
 COPY
 
 # Sync our own dep folder in there
 config.vm.synced_folder "{{ path.working }}", "{{ path.guest_working }}"
 
 # Copy files into a temp directory. The script will move them.
+{% for b in dep_binaries %}
 config.vm.provision "file",
-  source: "{{ path.cache }}/dev-dep-output",
-  destination: "/tmp/dep-{{ name }}"
+  source: "{{ path.cache }}/{{ b.Output }}",
+  destination: "/tmp/dep-{{ b.Service }}"
 
 config.vm.provision "file",
-  source: "{{ path.compiled }}/dev-dep/upstart.conf",
-  destination: "/tmp/dep-{{ name }}.upstart.conf"
+  source: "{{ path.compiled }}/dev-dep/{{ b.Service }}.upstart.conf",
+  destination: "/tmp/dep-{{ b.Service }}.upstart.conf"
+{% endfor %}
 
 config.vm.provision "shell",
   inline: ${{ name }}_setup
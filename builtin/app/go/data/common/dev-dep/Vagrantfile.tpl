@@ -5,8 +5,18 @@
 #
 # Do not hand-edit this file. To modify this, use the Appfile.
 
+{% if dev_provider %}
+# Default to the provider from the Appfile so that running Vagrant
+# directly uses the same provider as Otto.
+ENV["VAGRANT_DEFAULT_PROVIDER"] = "{{ dev_provider }}"
+{% endif %}
+
 Vagrant.configure("2") do |config|
   config.vm.box = "hashicorp/precise64"
+  {% if offline_box %}
+  # Building offline, so the box is added from the offline directory
+  config.vm.box_url = "file://{{ offline_box }}"
+  {% endif %}
 
   # Setup a synced folder from our working directory to /vagrant
   config.vm.synced_folder "{{ path.working }}", "{{ shared_folder_path }}",
@@ -27,24 +37,64 @@ Vagrant.configure("2") do |config|
   # Setup a synced folder from where the cache dir is
   config.vm.synced_folder "{{ path.cache }}", "/otto-cache"
 
+  {% if proxy_env %}
+  # Use the proxy Otto was compiled with for all downloads in the VM
+  config.vm.provision "shell", inline: $script_proxy
+  {% endif %}
+
+  {% if build_offline %}
+  # Go is installed from the offline directory instead of downloaded
+  config.vm.synced_folder "{{ offline_dir }}", "/otto-offline"
+  {% endif %}
+
   # Install Go build environment
   config.vm.provision "shell", inline: $script_golang
 end
 
+{% if proxy_env %}
+$script_proxy = <<SCRIPT
+set -e
+
+# Replace the proxy settings from any previous provision
+sed -i -e '/^http_proxy=/Id' -e '/^https_proxy=/Id' -e '/^no_proxy=/Id' /etc/environment
+{% for v in proxy_env %}
+echo '{{ v.Name }}={{ v.Value }}' >> /etc/environment
+echo '{{ v.Name|upper }}={{ v.Value }}' >> /etc/environment
+{% endfor %}
+
+# apt-get is run with sudo, which doesn't keep the environment
+cat > /etc/apt/apt.conf.d/95otto-proxy <<EOF
+{% for v in proxy_env %}{% if v.Name == "http_proxy" %}Acquire::http::Proxy "{{ v.Value }}";
+{% elif v.Name == "https_proxy" %}Acquire::https::Proxy "{{ v.Value }}";
+{% endif %}{% endfor %}EOF
+SCRIPT
+{% endif %}
+
 $script_golang = <<SCRIPT
 set -e
 
 oe() { $@ 2>&1 | logger -t otto > /dev/null; }
 ol() { echo "[otto] $@"; }
 
-# If we have Go, then do nothing
+# If we have the requested version of Go, then do nothing. If another
+# version is installed, replace it so the requested version is used.
 if command -v go >/dev/null 2>&1; then
-    ol "Go already installed! Otto won't install Go."
-    exit 0
+    if go version | grep -q "go{{ dev_go_version }} "; then
+        ol "Go {{ dev_go_version }} already installed! Otto won't install Go."
+        exit 0
+    fi
+
+    ol "Replacing installed $(go version | cut -d' ' -f3) with Go {{ dev_go_version }}..."
+    oe sudo rm -rf /usr/local/go
 fi
 
+{% if build_offline %}
+ol "Copying Go {{ dev_go_version }} from the offline directory..."
+oe cp /otto-offline/go{{ dev_go_version }}.linux-amd64.tar.gz /home/vagrant/go.tar.gz
+{% else %}
 ol "Downloading Go {{ dev_go_version }}..."
 oe wget -q -O /home/vagrant/go.tar.gz https://storage.googleapis.com/golang/go{{ dev_go_version }}.linux-amd64.tar.gz
+{% endif %}
 
 ol "Untarring Go..."
 oe sudo tar -C /usr/local -xzf /home/vagrant/go.tar.gz
@@ -58,10 +108,12 @@ ol "Setting up PATH..."
 echo 'export PATH=/opt/gopath/bin:/usr/local/go/bin:$PATH' >> /home/vagrant/.bashrc
 echo 'export GOPATH=/opt/gopath' >> /home/vagrant/.bashrc
 
+{% if not build_offline %}
 ol "Installing VCSs for go get..."
 oe sudo apt-get update -y
 oe sudo apt-get install -y git bzr mercurial
 
 ol "Configuring Go to use SSH instead of HTTP..."
 git config --global url."git@github.com:".insteadOf "https://github.com/"
+{% endif %}
 SCRIPT
@@ -18,11 +18,35 @@ export PS1="> "
 # Go into our working directory
 cd {{ shared_folder_path }}
 
+{% if vendor %}
+# Dependencies are vendored, so use them rather than fetching them
+export GO15VENDOREXPERIMENT=1
+{% elif dep_lock == "dep" %}
+# Install exactly the dependencies pinned by Gopkg.lock
+ol "Installing locked dependencies with dep..."
+go get -v github.com/golang/dep/cmd/dep
+dep ensure -vendor-only
+export GO15VENDOREXPERIMENT=1
+{% elif dep_lock == "glide" %}
+# Install exactly the dependencies pinned by glide.lock
+ol "Installing locked dependencies with glide..."
+go get -v github.com/Masterminds/glide
+glide install
+export GO15VENDOREXPERIMENT=1
+{% elif dep_lock == "godep" %}
+# Restore exactly the dependencies pinned by Godeps.json into the GOPATH
+ol "Restoring locked dependencies with godep..."
+go get -v github.com/tools/godep
+godep restore
+{% else %}
 # Get all the dependencies
 ol "Getting dependencies..."
 go get -v ./...
+{% endif %}
 
 # Build the project and write the output into our shared directory
 # with the compiled directory so that we can easily extract it.
-ol "Building..."
-go build -o "/otto-cache/dev-dep-output"
+{% for b in dep_binaries %}
+ol "Building {{ b.Name }}..."
+go build -o "/otto-cache/{{ b.Output }}"{% if b.Package %} "{{ b.Package }}"{% endif %}
+{% endfor %}
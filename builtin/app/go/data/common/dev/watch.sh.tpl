@@ -0,0 +1,73 @@
+#!/bin/bash
+#
+# Auto-generated by Otto.
+#
+# This is the script run by 'otto dev watch'. It rebuilds and restarts
+# the application whenever a Go file changes. The files are polled since
+# file system events don't work with most synced folders.
+
+ol() { echo "[otto] $@"; }
+
+# Set this to fake the bashrc on some platforms that we're interactive.
+export PS1="> "
+. /home/vagrant/.bashrc
+
+cd {{ shared_folder_path }}
+
+bin=/home/vagrant/.otto-watch/{{ name }}
+mkdir -p $(dirname $bin)
+pid=""
+
+# snapshot changes whenever a Go file is added, removed, or modified
+snapshot() {
+    find . -name '*.go' -printf '%p %T@\n' 2>/dev/null | sort | md5sum
+}
+
+stop() {
+    if [ -n "$pid" ]; then
+        kill $pid 2>/dev/null
+        wait $pid 2>/dev/null
+        pid=""
+    fi
+}
+
+restart() {
+    stop
+
+    ol "Building {{ name }}..."
+    if ! go build -o "$bin" {{ dev_watch_package }}; then
+        ol "Build failed. Waiting for changes..."
+        return
+    fi
+
+    ol "Starting {{ name }}..."
+    "$bin" {{ dev_watch_args|safe }} &
+    pid=$!
+}
+
+trap 'stop; exit 0' INT TERM
+
+last=$(snapshot)
+restart
+while true; do
+    sleep 1
+    current=$(snapshot)
+    if [ "$current" == "$last" ]; then
+        continue
+    fi
+
+    # Wait for the edits to settle so that saving many files at once
+    # only rebuilds once.
+    while true; do
+        sleep {{ dev_watch_debounce }}
+        next=$(snapshot)
+        if [ "$next" == "$current" ]; then
+            break
+        fi
+        current=$next
+    done
+
+    last=$current
+    ol "Change detected."
+    restart
+done
@@ -0,0 +1,106 @@
+#!/bin/bash
+#
+# Auto-generated by Otto.
+#
+# This is the build script for deploying a Go-based project.
+set -e
+
+{% if build_docker %}
+# The Docker image is built in a container without syslog, so the output
+# is discarded there.
+if [ -S /dev/log ]; then
+    oe() { $@ 2>&1 | logger -t otto > /dev/null; }
+else
+    oe() { $@ > /dev/null 2>&1; }
+fi
+{% else %}
+oe() { $@ 2>&1 | logger -t otto > /dev/null; }
+{% endif %}
+ol() { echo "[otto] $@"; }
+
+{% if build_offline %}
+# Building offline: Packer uploaded Go from the offline directory
+{% else %}
+ol "Downloading Go {{ dev_go_version }}..."
+oe wget -q -O /tmp/go.tar.gz https://storage.googleapis.com/golang/go{{ dev_go_version }}.linux-amd64.tar.gz
+{% endif %}
+
+ol "Untarring Go..."
+oe sudo tar -C /usr/local -xzf /tmp/go.tar.gz
+
+export GOPATH=/opt/gopath
+export PATH=$GOPATH/bin:/usr/local/go/bin:$PATH
+
+{% if import_path != "" %}
+APP_DIR="$GOPATH/src/{{ import_path }}"
+{% else %}
+APP_DIR="$GOPATH/src/{{ name }}"
+{% endif %}
+
+{% if not build_offline %}
+ol "Installing VCSs for go get..."
+# -E keeps any proxy settings from the environment for apt-get
+oe sudo -E apt-get update -y
+oe sudo -E apt-get install -y git bzr mercurial
+{% endif %}
+
+ol "Extracting app..."
+sudo mkdir -p $APP_DIR
+sudo chown -R $(whoami) $GOPATH
+tar -xzf /tmp/otto-app.tgz -C $APP_DIR
+cd $APP_DIR
+
+{% if build_env %}
+# The build environment is set by Packer. Only the names are output,
+# since the values may be secrets.
+ol "Building with environment: {{ build_env|join:", " }}"
+{% for k in build_env %}export {{ k }}
+{% endfor %}
+{% endif %}
+
+{% if vendor %}
+# Dependencies are vendored, so use them rather than fetching them
+export GO15VENDOREXPERIMENT=1
+{% else %}
+ol "Getting dependencies..."
+go get -d -v ./...
+{% endif %}
+
+{% if build_vet %}
+ol "Running go vet..."
+if ! go vet ./...; then
+    ol "go vet reported problems! The build was stopped so they never"
+    ol "reach a deployable artifact. Fix them and build again."
+    exit 1
+fi
+{% endif %}
+
+{% if build_test %}
+ol "Running tests..."
+if ! go test ./...; then
+    ol "Tests failed! The build was stopped so a broken build never"
+    ol "becomes a deployable artifact. Fix the tests and build again."
+    exit 1
+fi
+{% endif %}
+
+ol "Building..."
+go build -o /tmp/{{ name }}
+sudo mv /tmp/{{ name }} /usr/local/bin/{{ name }}
+
+ol "Installing the service..."
+cat <<UPSTART | sudo tee /etc/init/{{ name }}.conf > /dev/null
+description "{{ name }} - Generated by Otto"
+
+respawn
+respawn limit 15 5
+
+start on runlevel [2345]
+stop on runlevel [06]
+
+script
+  /usr/local/bin/{{ name }} >>/var/log/{{ name }}.log 2>&1
+end script
+UPSTART
+
+ol "...done!"
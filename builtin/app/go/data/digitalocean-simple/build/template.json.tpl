@@ -0,0 +1,69 @@
+{
+    "min_packer_version": "0.8.0",
+
+    "variables": {
+        {% for k in build_env %}
+        "build_env_{{ k }}": "",
+        {% endfor %}
+        "do_token": null,
+        "do_region": null,
+        "slug_path": null
+    },
+
+    "provisioners": [
+        {% for dir in foundation_dirs.build %}
+        {
+            "type": "shell",
+            "inline": ["mkdir -p /tmp/otto/foundation-{{ forloop.Counter }}"]
+        },
+        {
+            "type": "file",
+            "source": "{{ dir }}/",
+            "destination": "/tmp/otto/foundation-{{ forloop.Counter }}"
+        },
+        {
+            "type": "shell",
+            "inline": ["cd /tmp/otto/foundation-{{ forloop.Counter}} && bash ./main.sh"]
+        },
+        {% endfor %}
+        {% if build_offline %}
+        {
+            "type": "file",
+            "source": "{{ offline_go_archive }}",
+            "destination": "/tmp/go.tar.gz"
+        },
+        {% endif %}
+        {
+            "type": "file",
+            "source": "{% verbatim %}{{ user `slug_path` }}{% endverbatim %}",
+            "destination": "/tmp/otto-app.tgz"
+        },
+        {
+            "type": "shell",
+            "script": "build-go.sh"{% if build_env or proxy_env %},
+            "environment_vars": [
+                {% for v in proxy_env %}
+                "{{ v.Name }}={{ v.Value }}",
+                "{{ v.Name|upper }}={{ v.Value }}"{% if not forloop.Last or build_env %},{% endif %}
+                {% endfor %}
+                {% for k in build_env %}
+                "{{ k }}={% verbatim %}{{ user `build_env_{% endverbatim %}{{ k }}{% verbatim %}` }}{% endverbatim %}"{% if not forloop.Last %},{% endif %}
+                {% endfor %}
+            ]{% endif %}
+        }{% if provision_script %},
+        {
+            "type": "shell",
+            "script": "{{ provision_script }}"
+        }{% endif %}
+    ],
+
+    "builders": [{
+        "name": "otto",
+        "type": "digitalocean",
+        "api_token": "{% verbatim %}{{ user `do_token` }}{% endverbatim %}",
+        "region": "{% verbatim %}{{ user `do_region` }}{% endverbatim %}",
+        "image": "ubuntu-14-04-x64",
+        "size": "512mb",
+        "snapshot_name": "{{name}}-{% verbatim %}{{timestamp}}{% endverbatim %}"
+    }]
+}
@@ -0,0 +1,43 @@
+#--------------------------------------------------------------------
+# Access Info
+#--------------------------------------------------------------------
+
+variable "do_token" {
+    description = "API token for DigitalOcean"
+}
+
+variable "do_region" {
+    description = "Region where we will operate."
+}
+
+#--------------------------------------------------------------------
+# Deploy Info
+#--------------------------------------------------------------------
+
+variable "do_image" {
+    description = "Snapshot to deploy"
+}
+
+variable "do_size" {
+    description = "Droplet size"
+    default = "512mb"
+}
+
+variable "instance_count" {
+    description = "Number of droplets to deploy"
+    default = "1"
+}
+
+variable "environment_suffix" {
+    description = "Suffix for the names of resources in an environment"
+    default = ""
+}
+
+variable "ssh_key_id" {
+    description = "SSH key granted access to the droplets"
+}
+
+variable "user_data" {
+    description = "User data of the droplets, such as a cloud-init script"
+    default = ""
+}
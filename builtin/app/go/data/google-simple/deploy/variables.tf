@@ -0,0 +1,60 @@
+#--------------------------------------------------------------------
+# Access Info
+#--------------------------------------------------------------------
+
+variable "gcp_credentials" {
+    description = "Contents of the JSON key of a Google Cloud service account"
+}
+
+variable "gcp_project" {
+    description = "Project where we will operate."
+}
+
+variable "gcp_region" {
+    description = "Region where we will operate."
+}
+
+variable "gcp_zone" {
+    description = "Zone where the instances are created."
+}
+
+variable "ssh_public_key" {
+    description = "SSH public key granted access to the instances"
+}
+
+#--------------------------------------------------------------------
+# Deploy Info
+#--------------------------------------------------------------------
+
+variable "gcp_image" {
+    description = "Image to deploy"
+}
+
+variable "machine_type" {
+    description = "Machine type"
+    default = "g1-small"
+}
+
+variable "instance_count" {
+    description = "Number of instances to deploy"
+    default = "1"
+}
+
+variable "environment_suffix" {
+    description = "Suffix for the names of resources in an environment"
+    default = ""
+}
+
+variable "user_data" {
+    description = "User data of the instances, such as a cloud-init script"
+    default = ""
+}
+
+variable "network" {
+    description = "Network to deploy into"
+}
+
+variable "ports" {
+    description = "Comma-separated TCP ports to open to the instances"
+    default = "22,80"
+}
@@ -0,0 +1,14 @@
+description "{{ binary.Service }} - Generated by Otto"
+
+respawn
+respawn limit 15 5
+
+start on runlevel [2345]
+stop on runlevel [06]
+
+# We don't want to restart too fast for deps
+post-stop exec sleep 5
+
+script
+  {{ binary.RunCommand }} >>/var/log/{{ binary.Service }}.log 2>&1
+end script
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/ui"
 )
 
 // detectImportPath will try to automatically determine the import path
@@ -17,18 +18,18 @@ import (
 func detectImportPath(ctx *app.Context) (string, error) {
 	gopath := os.Getenv("GOPATH")
 	if gopath == "" {
-		ctx.Ui.Message(
-			"Warning! GOPATH not set. Otto will be unable to automatically\n" +
-				"setup your application GOPATH for development and builds. While Otto\n" +
-				"sets up a development for you, your folder structure outside of Otto\n" +
-				"should still represent a proper Go environment. If you do this, then\n" +
-				"the development and build process function a lot smoother.\n\n" +
-				"For simple Go applications, this may not be necessary.\n\n" +
+		ui.Info(ctx.Ui,
+			"Warning! GOPATH not set. Otto will be unable to automatically\n"+
+				"setup your application GOPATH for development and builds. While Otto\n"+
+				"sets up a development for you, your folder structure outside of Otto\n"+
+				"should still represent a proper Go environment. If you do this, then\n"+
+				"the development and build process function a lot smoother.\n\n"+
+				"For simple Go applications, this may not be necessary.\n\n"+
 				"This is just an informational message. This is not a bug.")
 		return "", nil
 	}
 
-	dir := filepath.Dir(ctx.Appfile.Path)
+	dir := ctx.Appfile.SourceDir()
 
 	// If the directory to our Appfile is a symlink, resolve that symlink
 	// through. This makes this heuristic work for local dependencies.
@@ -47,20 +48,53 @@ func detectImportPath(ctx *app.Context) (string, error) {
 	// The directory has to be prefixed with the gopath
 	gopath = filepath.Join(gopath, "src")
 	if !strings.HasPrefix(dir, gopath) {
-		ctx.Ui.Message(
-			"Warning! It looks like your application is not within your set\n" +
-				"GOPATH. Otto will be unable to automatically setup the proper\n" +
-				"GOPATH structure within your development and build environments.\n\n" +
-				"To fix this, please put your application into the proper GOPATH\n" +
+		ui.Warn(ctx.Ui,
+			"Warning! It looks like your application is not within your set\n"+
+				"GOPATH. Otto will be unable to automatically setup the proper\n"+
+				"GOPATH structure within your development and build environments.\n\n"+
+				"To fix this, please put your application into the proper GOPATH\n"+
 				"location as according to standard Go development practices.")
 		return "", nil
 	}
 
 	detected := dir[len(gopath)+1:]
-	ctx.Ui.Message(fmt.Sprintf(
+	ui.Info(ctx.Ui, fmt.Sprintf(
 		"Detected import path: %s\n\n"+
 			"Otto will use this import path to automatically setup your dev\n"+
 			"and build environments in the proper directories.",
 		detected))
 	return detected, nil
 }
+
+// goLock is a lockfile of a Go dependency management tool, which pins
+// the versions of the dependencies of the application.
+type goLock struct {
+	Tool string // Tool is the tool that installs the lock, i.e. "glide"
+	Path string // Path is relative to the source directory of the app
+}
+
+// goLocks are the lockfiles that are detected, in order of preference.
+var goLocks = []*goLock{
+	{Tool: "dep", Path: "Gopkg.lock"},
+	{Tool: "glide", Path: "glide.lock"},
+	{Tool: "godep", Path: filepath.Join("Godeps", "Godeps.json")},
+}
+
+// detectLock returns the lockfile of the Go application under
+// development, or nil if it doesn't have one.
+func detectLock(ctx *app.Context) *goLock {
+	for _, l := range goLocks {
+		if _, err := os.Stat(filepath.Join(ctx.Appfile.SourceDir(), l.Path)); err == nil {
+			return l
+		}
+	}
+
+	return nil
+}
+
+// detectVendor returns true if the Go application under development
+// vendors its dependencies in a "vendor" directory.
+func detectVendor(ctx *app.Context) bool {
+	fi, err := os.Stat(filepath.Join(ctx.Appfile.SourceDir(), "vendor"))
+	return err == nil && fi.IsDir()
+}
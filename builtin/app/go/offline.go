@@ -0,0 +1,31 @@
+package goapp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/otto/appfile"
+)
+
+// goArchiveName returns the name of the archive of a Go release for the
+// Linux machines that apps are built and developed on.
+func goArchiveName(version string) string {
+	return fmt.Sprintf("go%s.linux-amd64.tar.gz", version)
+}
+
+// offlineGoArchive returns the path to the archive of the given Go
+// version in the offline directory of the Appfile, which is installed
+// instead of downloading Go when building offline.
+func offlineGoArchive(f *appfile.File, version string) (string, error) {
+	name := goArchiveName(version)
+	path := filepath.Join(f.OfflineDir(), name)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf(
+			"build_offline requires the Go %s archive at %s.\n"+
+				"Download it from https://storage.googleapis.com/golang/%s\n"+
+				"and compile again.", version, path, name)
+	}
+
+	return path, nil
+}
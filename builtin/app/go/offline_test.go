@@ -0,0 +1,40 @@
+package goapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/otto/appfile"
+)
+
+func TestOfflineGoArchive(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	f := &appfile.File{Path: filepath.Join(td, "Appfile")}
+	if _, err := offlineGoArchive(f, "1.5.1"); err == nil {
+		t.Fatal("should error")
+	}
+
+	dir := filepath.Join(td, ".otto", "offline")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := filepath.Join(dir, "go1.5.1.linux-amd64.tar.gz")
+	if err := ioutil.WriteFile(expected, nil, 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual, err := offlineGoArchive(f, "1.5.1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual != expected {
+		t.Fatalf("bad: %s", actual)
+	}
+}
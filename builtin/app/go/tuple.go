@@ -9,4 +9,10 @@ import (
 var Tuples = app.TupleSlice([]app.Tuple{
 	{"go", "aws", "simple"},
 	{"go", "aws", "vpc-public-private"},
+	{"go", "digitalocean", "simple"},
+	{"go", "google", "simple"},
 })
+
+func init() {
+	app.Register("go", app.StructFactory(new(App)), Tuples...)
+}
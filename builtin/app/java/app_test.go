@@ -0,0 +1,25 @@
+package javaapp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+)
+
+func TestApp_impl(t *testing.T) {
+	var _ app.App = new(App)
+	var _ app.DeployReporter = new(App)
+	var _ app.AppVerify = new(App)
+}
+
+func TestApp_registered(t *testing.T) {
+	if app.Get("java") == nil {
+		t.Fatal("java app should be registered")
+	}
+
+	for _, tuple := range Tuples {
+		if app.Registered().Lookup(tuple) == nil {
+			t.Fatalf("tuple should be registered: %s", tuple)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+// Code generated by go-bindata.
+// sources:
+// data/aws-simple/build/build-java.sh.tpl
+// data/aws-simple/build/template.json.tpl
+// data/aws-simple/deploy/main.tf.tpl
+// data/aws-vpc-public-private/build/build-java.sh.tpl
+// data/aws-vpc-public-private/build/template.json.tpl
+// data/aws-vpc-public-private/deploy/main.tf.tpl
+// data/common/dev/Vagrantfile.tpl
+// DO NOT EDIT!
+
+package javaapp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataAwsSimpleBuildBuildJavaShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdf\x6f\xdb\x38\x12\x7e\xe7\x5f\x31\x55\x8c\xcb\xee\x5d\x68\xb5\x05\x0e\x58\xb4\xab\x62\x9d\xc6\xd7\x4b\xd0\x75\x0e\x4a\xda\x3e\xe4\x82\x80\x11\x47\x32\x13\x8a\x24\xc8\x91\x63\xd7\xf1\xff\xbe\x20\x65\x3b\x76\xd2\x5d\xec\x93\x8d\x6f\x7e\x7e\x1f\x87\x43\x1d\xbc\xca\x6f\x95\xc9\x6f\x45\x98\x32\x16\x90\x80\x5b\x30\xb6\x33\xeb\xbf\xe8\x3d\xce\x55\xfa\xeb\x94\xc3\x5a\x28\xbd\x86\xc9\x8b\x0a\x19\x43\xef\xad\xff\xe9\x67\x58\x32\x00\xd0\xb6\x12\x1a\x82\xed\x7c\x85\xb5\xd2\x58\x0c\xde\x3c\xc1\x5a\x19\x34\xb6\x18\xbc\x8d\x10\x56\x53\x0b\xd9\xb8\x2c\xcf\x4b\x10\x04\x83\xe5\x53\xd0\xea\xdd\x60\xd9\xfb\xae\xde\xc3\x67\x11\x08\xb4\x6d\xc2\xbb\x2c\x86\x35\x1e\x1d\x58\x22\x0b\xf9\x4c\xf8\x5c\xdb\x26\x0f\x8b\xa0\x6d\x03\x8f\x40\xa9\x37\x03\x6f\x5f\xb3\x15\x23\x2f\x1c\x1c\xa6\xe6\x20\x1b\x2c\x8f\x47\x17\xff\xbd\xb9\x38\xff\x52\x7e\x1c\xaf\xb2\x08\x7c\x3e\x9d\x8c\x27\xe7\xab\xec\x10\xc6\x65\xc9\x98\xc5\x48\x01\xb2\xc1\x6f\x19\xbc\xfd\xf0\x8f\x37\xf0\x18\x8b\x36\xe8\x81\x53\x5f\xef\x03\xe4\x12\x67\xb9\xe9\xb4\x7e\x0f\x2b\x66\x75\x0a\xe8\x69\x5c\x45\x8f\x6b\x18\xfc\x96\x45\x13\x3b\x80\x4a\xdb\x4e\xf2\xca\x9a\x5a\x35\x50\x09\x03\xca\x10\xfa\x1a\x3d\xc2\x83\xa2\x29\x08\x47\x50\xd9\xb6\x15\x46\x06\x50\x35\x28\x3a\x0c\x10\x48\x69\x0d\xca\x80\xf3\xb6\xf1\x18\x02\xb3\x1a\xb2\x6f\x42\x91\x32\x0d\xd4\xd6\xef\xa7\x25\x1b\x53\x38\x8d\x84\xc3\xe1\x30\x63\x9d\x21\xa5\xe1\xea\x0a\x78\xbd\x16\x47\xdd\xe6\x29\x22\x57\x26\x90\x30\x15\xe6\xb7\xd6\x12\xaf\x95\x51\x61\x8a\x12\xae\xaf\xdf\x83\xb4\x0c\x20\x68\x44\x07\xaf\x87\xff\x66\xd2\x9a\x78\xa6\x73\x67\x3d\xc1\xd9\xe8\xeb\xe8\xe6\xeb\xb8\xbc\x38\x3d\x9f\x14\xd9\x72\x09\x77\x62\x26\x6e\x66\xe8\x83\xb2\x06\x56\xab\x6c\xe3\x78\x32\x3e\x3e\x1d\x4d\x6e\xfe\x53\x9e\x4f\x2e\xc7\x93\x93\xc2\x58\x93\x08\x8b\x8a\xd4\x0c\x59\xe2\x31\x92\x32\xd2\x88\xcc\x3d\x3a\x1b\x14\x59\xaf\x30\x80\x30\x12\x3a\x27\x45\x24\x99\x78\x58\x84\xd0\x49\x1b\x3d\x79\x83\xd4\x1b\xf1\x05\x9c\x38\x69\x0d\x7c\x01\xc1\xd6\xf4\x20\x3c\x72\xe7\xad\x43\x4f\x0a\x03\x8f\xea\x5a\xf3\x14\x25\x25\x8f\x91\xdb\xd2\x8b\x18\xe8\x9c\x78\x67\x1d\x9a\x3b\x79\xcf\x7d\xee\x9c\xf8\xb3\xe2\x89\xc1\x69\x5f\x31\xb2\x38\x13\x33\x01\x83\xe5\xae\x40\xab\x1f\x36\xbf\xd3\x65\xa3\x08\x36\xc5\x9e\x85\xf2\x3b\x79\xdf\xd7\x18\xcf\x29\xa9\x96\x94\x72\x29\x65\x7b\x2f\x95\x07\xee\x20\xa7\xd6\xe5\x71\xd2\xf8\x6d\xa7\xb4\x64\x24\x3c\x7c\x9f\xd7\x3b\x78\x0c\xa1\xe6\x3b\xf0\x8f\x2f\x9c\x2b\xf9\x02\x62\x07\x70\x1c\xff\xf4\x23\x49\x53\x84\x4f\x5e\x48\x8d\xf0\xe0\x85\x73\xe8\xc1\x7a\xf8\x5d\xcc\xd0\x6c\x01\x55\x27\x37\xe7\xed\x1d\x56\x04\x53\x11\xc0\x1a\x3c\x62\x07\x10\x2c\xd0\x54\x50\x32\x6f\x06\xc4\xf6\xde\xa9\x18\x90\xb5\x1a\x5a\x41\xd5\x14\xc3\x6e\x92\x21\x53\x35\xa4\x99\x4d\x7e\xc3\xa6\x6f\xe1\xfa\x7d\x74\x32\x0c\x60\x63\xee\x0d\x0f\x3b\x16\x80\x6a\xda\x5a\x09\xff\x9a\x6f\x8c\x09\xfc\x54\x8e\x4e\x3e\x8f\x8b\x6c\x98\xaf\xd1\xb8\x38\x50\x07\x4c\xd6\x67\x07\xd9\x33\x4e\x3a\x27\xeb\x5f\x1c\x5f\xf2\xdc\xab\xd0\x43\x31\xb2\x56\x8c\xf5\xb9\x93\xa2\x31\x73\xe4\x28\x9c\xeb\xc5\xdd\x2b\x63\x11\x06\x7d\x0a\xe0\xdc\x58\x2e\x05\xb6\xd6\xac\x75\xe2\x73\x20\x0c\xc4\x00\xce\x46\xe5\xcd\xc9\x69\x59\x64\xc9\x10\x6f\x73\xc8\x18\xea\x8d\x1e\xce\xb6\xc3\x79\xab\x7f\xa0\x54\x3b\x33\x7f\x22\x53\xb4\x24\xe4\xf7\xd1\xd7\xf1\x24\x4a\x14\x91\xbf\xd2\x27\x9d\xff\xdf\x91\xa7\x8d\x8e\xbb\xb9\xdb\x99\xf9\x3b\xca\xec\x16\x88\xc2\xa4\x68\xe0\xc7\xe0\x44\x75\x2f\x1a\x04\x7e\x12\xee\x95\xbb\xc4\x40\x61\x57\x14\x12\xbe\x41\xca\xd8\xba\xf1\x58\x60\x62\xb7\xa2\x58\xbf\x3f\x4e\xb5\xed\x8c\x3c\xda\x56\xae\x84\x39\x24\xb8\xed\x67\x93\x5e\x25\x01\xe2\x23\xf7\x86\xc5\x76\x0f\xe0\x72\x8a\x50\x8b\xb8\x00\x4b\x50\xfd\xb8\xea\x58\x2f\xf4\x50\x8a\x3a\x82\xa0\x4c\x85\xa0\xa2\x0c\x95\xee\x24\x06\xc0\x19\xfa\x05\x3b\x00\x89\x0e\x8d\x44\x53\x2d\x86\x70\x91\xde\xb5\xa3\xb4\x3b\xa5\xad\x8e\xd2\xc2\x8b\x19\x3b\x13\xa6\x42\xa2\x04\xeb\x55\xa3\x8c\xd0\x31\x77\x00\xe1\x11\x22\x63\x87\x72\xc8\xce\x46\x65\x31\xf8\x49\x07\xe0\x17\x69\xdd\x24\xf2\xab\xfc\x9f\xc3\x3b\xe1\xe1\xed\x87\xed\x8b\x04\xff\x67\x00\x8f\xfd\xeb\xc8\x67\xc0\x11\x0e\x79\xff\xa0\x86\xe8\x3a\x38\xec\xa1\x75\x0f\x3b\x50\xbe\x29\xce\x0f\xd7\x39\xa6\x28\x24\x70\x03\x6f\xe0\xf1\x11\xc8\x77\xf8\xf3\xfa\x86\x7e\x8f\xcf\xe6\xd9\xa8\x5c\x65\x3b\xc3\xb5\xd6\x3d\xaa\xf2\x20\x42\xaf\x0c\x28\xb3\xd3\xec\x73\x75\x9f\x0d\x58\x9f\x72\x7f\x77\x4a\xd9\x85\xf8\xf2\xf2\xb0\x08\x84\x2d\x70\xde\x78\xdb\xb9\xfe\xb6\x54\x1e\x05\x21\x9f\xda\x16\x61\xb3\xf4\x58\x8a\x7b\x5a\x94\xc1\xcf\xf2\x7d\x5b\xe5\x9e\xba\xdf\x33\xe7\x71\x67\xde\x09\xbf\x76\x9b\xda\x07\x03\xbc\xdc\x66\x7e\xf7\x2c\x99\x6f\x81\xfb\xfa\xe5\x2a\x8d\xac\x3e\xa6\x77\xb9\xf3\xbb\x33\x4e\x16\x02\x09\x4f\x10\x2f\xb8\xb5\x94\x88\x56\x82\xe0\xd7\x5f\xbf\x4c\x4e\x2f\xe1\xb1\xa7\x4c\x88\x90\x23\x55\x79\xcf\x58\xae\x7f\x9f\xb6\x7a\x40\x3f\x53\x15\xee\x7e\x85\xb0\x03\xf8\x84\x06\xbd\x20\x94\x70\xbb\x80\x73\x22\xcb\xae\xbe\x18\x45\xd7\xec\x04\x43\xe5\x95\x23\x65\x4d\xb1\x5c\x82\x11\x2d\xc2\x6a\xc5\x46\x35\xa1\x2f\x0c\xd2\x83\xf5\xf7\xc3\xfe\x0a\x31\x76\x75\xd1\x27\xbf\x66\x5f\x02\xfa\x62\xcb\xf5\x9b\xf5\xf7\xca\x34\x27\xca\x63\x15\x5f\xcc\x62\x5f\x8a\xb1\x99\x29\x6f\x4d\x8b\x86\x8a\xff\x9d\x97\x97\xc5\x2f\xaf\x7f\x79\xcd\xc6\x73\xac\x2e\x22\xe3\x22\xef\x82\x4f\x9f\x96\x71\xea\x80\xc7\x89\xfd\xb1\xf2\x25\x26\x89\x0a\xa1\x1f\xc4\x22\x30\x76\xb5\x1e\x8f\x6b\xf6\x4d\x18\x42\x79\xbc\x28\xda\x4e\x93\xe2\x71\x2a\x36\x5d\x47\xf5\xb6\x13\xd3\xab\x55\x91\x06\x34\xe2\x56\x23\x3c\xd7\xad\x3f\xa0\xe1\x70\x18\xbf\x6f\x5e\x65\xec\x8f\x01\x00\x1b\x7a\xc0\x41\xf5\x0a\x00\x00"
+
+func dataAwsSimpleBuildBuildJavaShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildBuildJavaShTpl,
+		"data/aws-simple/build/build-java.sh.tpl",
+	)
+}
+
+func dataAwsSimpleBuildBuildJavaShTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildBuildJavaShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/build-java.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\x08\x06\xda\x53\xe3\x74\xed\x0e\x5d\x81\x9e\x06\xec\xb2\x9d\x77\x29\x02\x57\xb1\x95\x44\x8b\x2c\x1b\x7a\x78\x68\x33\xff\xf7\x51\x96\x1f\x92\x9f\xc9\xb0\x5e\x9a\x88\xe4\x47\xf2\x13\x45\x32\xe7\x15\x82\xbf\x20\xa5\x3c\xca\x71\x7c\x22\x22\x2a\x88\x90\x34\xe3\xc1\x33\x0a\xee\xc3\xa7\xf0\x3e\xb8\x5b\x59\x9d\x02\x0b\x8a\x77\x8c\x48\x10\x9d\xab\x23\x84\xce\x37\x68\x9f\x09\x74\x42\x94\xa3\x9d\xa6\x2c\x89\x08\x2f\xd0\x4d\x59\x8b\x83\xf6\x2c\x3a\x9f\x41\xab\x2c\x0d\x2c\x20\xb6\xd6\x84\x27\x06\xa0\xb3\xc0\xbf\x65\x84\xe3\x98\x48\x19\x9d\xc8\xbb\xa7\x5e\xc9\x24\x89\x05\x51\xe3\x32\x95\x9d\x08\xf7\x8f\xa5\x3c\x1a\xdd\x88\xe3\x94\x0c\x25\xb9\xa0\x05\x56\xa4\xd2\xd8\x53\x46\x86\x90\x82\x1c\x2c\x19\x5c\x33\xd6\xd9\x32\x7d\x00\xbe\xd4\xb1\x2f\xb0\xf9\x5a\x23\xd9\xf3\x77\xc4\x82\x98\xd4\x32\xcd\x55\x4f\x66\xcd\x28\x97\x0a\xf3\x98\x44\xea\x3d\xaf\x42\x01\xce\x46\x24\x7f\x12\xb2\xc7\x9a\xa9\xe7\x20\x7e\x0c\x19\x16\x07\x12\x18\x62\x3b\x47\x99\x16\xa0\x8a\x53\x5a\x63\x74\x07\x9d\x29\x7c\x59\x3f\x90\xfd\xe7\xa7\xc7\xc7\x2f\xbe\x79\x91\xc7\x11\x4d\x7a\xb1\xeb\x1d\x07\xd2\x07\xc7\x79\xa6\x0c\x87\x31\x99\x3a\x8f\xb0\x56\x19\x7c\xcc\x12\x1d\xab\x4a\xa9\xd2\x29\x9b\x9a\x02\x49\x41\x4d\xb9\x41\xd1\x81\xf8\xd5\x2f\xab\x84\x0a\x53\x58\x7b\x60\x2c\xc1\x0a\xb4\x22\x38\x91\x61\xc5\x49\x57\x32\x4d\x2d\x02\x5c\x43\x9c\x3c\x12\xc6\xda\x78\x40\x40\x39\xa3\xdc\x88\x5e\x83\xf4\x64\x60\xd7\x39\xda\xa8\x34\xdf\x64\x4a\x65\x9b\xce\xc1\x1a\xe8\x02\xcf\x2c\xcb\xf2\xf0\xab\xb9\x28\x22\x0c\x39\xdb\x1a\xa9\xbc\x9b\xf6\x59\xd5\x8f\xe3\xd2\xb2\x5e\x5f\x81\x71\x59\x96\x1b\x57\x9e\x10\xa9\x28\xaf\xbc\x1a\xa5\x2b\xa2\xb9\x20\x98\x39\x02\xe2\xe4\xd2\xd4\xcb\x12\xdd\xde\xa2\x1d\x96\x47\x14\x6e\x52\x4c\x79\x28\x8f\x23\x5c\x0c\x5f\xf1\x75\xf4\xdc\x20\xe8\x39\x3b\x08\x22\x05\x04\x88\x42\x4b\xc8\xf3\xad\x7d\x63\x6f\x90\xb3\xf5\xe1\xa8\x5d\xc2\xe4\x1a\xe7\x79\xa8\x0e\x1f\xff\x44\x98\x8c\x05\xcd\xab\x92\xad\xca\x6d\xfd\x0b\x17\xd8\xa4\x0f\x91\xd0\xbd\xd7\xeb\x1c\x23\x38\xa0\x22\xe3\x29\xe1\x2a\x82\x76\xe9\x96\xf4\x05\xdd\xb2\x82\xa8\xfb\xe4\xcb\x04\x2b\x4e\x43\xed\x53\x52\x5b\xfa\x86\xe3\xe4\xd9\x24\x78\xa6\xda\x1b\xff\x81\xa5\x32\xb9\x58\x5d\x10\x7a\x51\x8d\x5c\x31\x42\xdb\xa1\x6e\x69\x81\xdb\x57\x1d\x59\x16\x1d\x92\xae\xa3\x1e\x52\x1a\x60\x39\x0f\xa0\x1f\xc0\xb6\x69\x2b\x15\x4b\x75\x4b\xa9\x49\xaf\x8f\x5a\xea\x41\x6a\x38\x6b\x5a\x56\x33\x1f\x4c\xdd\x0c\xd9\xf9\x06\x6d\xc7\x64\xb1\x6e\x3a\x72\xf5\x10\x1d\xf7\x5d\xef\xeb\xf7\x6e\xfb\x64\xbb\x99\xe2\x8d\xb6\x89\x4b\xf6\x67\xe0\x7c\xfd\x07\xfe\x40\x9c\x41\xec\x14\x17\x10\xdb\x31\x3a\x03\x56\xe9\x2c\xe0\xb4\xb3\x73\x0e\xc8\x2a\x2d\xe5\xe8\x8f\xb4\x89\x86\xd1\x2a\xcd\xa3\x39\x2f\x18\xcc\x5e\x5e\xe0\x52\x52\xfc\x01\x0d\x90\xec\x64\xe0\x6c\x22\xdd\x20\x9c\x70\x68\x15\x96\x42\x77\x47\xe7\x54\xe4\x8d\xce\x02\xd6\x70\x41\x98\x6b\x12\x9e\xf6\x52\x94\xde\x24\x9f\x0a\xb3\x55\xba\x18\x6d\x30\xff\x17\xa1\x3d\x8b\x25\x3f\xb0\xc2\x19\xfb\xe6\xf1\xea\x1d\x8c\x2d\x3d\x58\xfe\x72\x4c\x45\xbb\x00\x4e\x05\xe0\xec\x89\x17\x78\x1d\x5b\x1c\x67\x90\xfb\xea\x8b\x05\xda\xeb\xab\x66\x5d\x73\xb7\xca\xd9\x7b\xaf\xf5\x16\xb2\x30\x88\xc6\x6a\x0e\xcf\x5f\x59\xff\xcb\xab\xb2\x6a\x45\xc6\x74\x4a\x22\x49\x3f\x88\x93\x24\xc3\x9a\xc7\xc7\x68\xc7\xb2\xf8\x14\x25\xa4\x80\x6a\xb0\x1d\xdc\x1d\xf5\xe6\xb4\xbd\xcc\x0d\x7c\xdf\xc8\x04\x7f\x72\xc7\x87\x03\x6e\x7e\xa9\x9c\x3d\x6f\x65\x39\xd4\x6c\x5e\xd3\x21\x7f\xf0\xd7\x0a\x46\xe0\xce\x60\xf2\xc0\x2a\x94\x76\x0b\x86\x12\x9a\x34\x13\x68\x3b\x7d\x67\x36\x53\x85\x0f\xd2\x49\x51\x68\x40\x83\x23\xe7\x27\x54\xbb\x16\x28\x33\x9b\x6a\x7d\x33\x3e\x54\xf8\x9d\xbc\xd7\x3f\x99\xaa\xaf\x3f\x31\xd3\x26\x83\x2b\xc7\xf7\xc8\xf0\x2e\x67\xc3\x1e\x2b\xbe\xf6\xf9\x9c\xcd\xa7\xb2\x9c\x9e\x91\x68\x6a\x46\xa2\x7e\x95\xc1\x7f\xd8\xdc\x70\x9a\x8f\xd5\xd5\xca\xd9\x27\x16\x32\x75\x13\xdc\xae\x56\xe5\xea\x2f\xb1\x91\xf4\xd0\xd5\x0e\x00\x00"
+
+func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildTemplateJsonTpl,
+		"data/aws-simple/build/template.json.tpl",
+	)
+}
+
+func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x04\xb7\xbd\x0c\xab\xe3\xae\x18\x50\x14\xd8\x61\xa7\x1d\x06\x6c\x3b\xed\x32\x14\x82\x62\xcb\xa9\x50\x5b\xf2\x24\xda\x5b\x9a\xf9\xdf\x47\x89\x51\x6c\x39\x41\xb7\xc3\x30\xe7\x12\x3d\x52\x24\xf5\x1e\xc9\x0b\xf6\x41\x6a\x69\x05\xc8\x8a\x6d\x76\xec\x33\x80\x79\xcd\x2a\xc3\xb4\x01\x26\x2b\x05\xac\x15\xba\x17\x4d\xb3\x5b\xad\x06\x61\x95\xd8\x34\x92\x65\x4a\xd7\x56\x70\x55\x65\x6c\x3f\xce\x60\xf1\xc3\x71\x51\x96\xd2\x39\xfe\x24\x77\x68\x64\x95\xac\x45\xdf\x00\x7b\xc7\xb2\x8c\x2d\x5d\x9d\x2c\xad\x84\xbf\x72\x05\xf3\x24\xf5\x1f\xbd\xac\xdc\x2a\xa3\x17\x45\x61\x78\xae\x45\x2b\x17\x70\xef\xa4\xe5\x95\x00\x71\x26\xea\x3c\x6c\xab\x16\x17\x95\x76\x20\x74\x29\x39\xec\x3a\xb9\xb8\xbc\xdf\xb3\xc4\xfc\xeb\x60\xbb\xcf\xe0\x4d\xde\xaa\xd2\x1a\x0c\x3f\xa6\x85\x1f\x2f\x94\xa6\xd7\xb0\x08\x78\x93\xfa\x4a\x3d\x28\x6b\x74\x2b\x35\x70\xd7\xd7\xb5\xfa\xf9\x22\x27\xae\xdf\x68\x24\xb8\xeb\x37\x8d\x2a\x17\xcf\x18\xba\x92\x97\xaa\xb2\x67\xe0\x83\xae\xab\xce\x9a\x41\x55\xd2\x06\x72\x11\x5a\x31\x36\xa9\xeb\xb3\x5d\xee\xf1\x62\x9e\xaa\x3e\x66\xe8\x36\x29\x9b\xba\x4d\x78\x70\x0b\xaa\xa6\x1e\x01\x0a\x46\x12\x93\xf9\x2f\xf1\x20\x1c\x5d\xb0\x42\x2b\x9d\xe9\x6d\x39\xf5\x53\x6f\x15\xec\xf8\xd6\x9a\xbe\xcb\x10\xec\x3a\x2a\xdb\xeb\x4f\x71\x50\xa1\x70\x18\xc7\x6b\x0a\x19\x5b\x79\xa4\xe3\x29\xc3\xa1\x18\xa2\x65\x2a\x84\xce\x68\x42\x9b\xd2\x5b\xac\xc3\x85\x44\x8c\x21\x69\x60\x4a\xd3\x50\xdd\xd7\x37\x01\xac\xad\x69\x79\x67\x2c\x04\xb0\x08\x18\x98\x88\x4c\x98\x17\x84\x6f\x1a\x53\x3e\x39\xc4\xbe\x65\x45\x1e\x7e\xeb\x22\x7b\x40\xfb\xe8\xb3\xc9\xff\x99\x6c\x7f\xc5\x54\xcd\x40\x6c\x1d\xbb\x1a\xbd\x60\xfe\x1f\xa5\x46\x53\x6d\x2c\x03\x7c\x7e\x74\xf0\xe4\x42\xfe\x11\x45\xf7\x3d\x4e\x64\x43\xfe\x55\x34\xbd\xe7\x3b\x8b\xd7\xa4\xae\xfc\xcd\x10\x70\x5c\x45\x08\xf3\x20\x72\xa2\x69\x9c\x8e\xb9\x9a\x61\x50\x58\xfc\x8e\x9a\xa4\x83\x14\xf2\xe1\xf4\x32\x76\xea\x89\x70\x30\x27\xb3\x7a\x26\x90\x87\xa9\x9f\x69\x90\xb0\x03\x92\x38\xc9\x7c\x05\xc7\xb8\x6c\x16\x09\x23\x1c\x7c\x8e\x9b\x27\xf5\x39\xc2\xd4\x55\xbe\xc3\xd2\x86\xc6\xf4\x24\xd4\xe5\xfe\xb4\xdb\x73\x64\x27\xf7\x1d\xf9\x30\xe9\x36\x98\xa6\x6f\x25\x77\xea\x59\x12\xdb\xd6\x18\x20\xc5\x79\x25\x07\x85\x1c\x93\x96\x73\x47\x92\x6d\x8e\x90\x74\x4b\xa5\xd2\x6e\xf8\xe4\xdf\x9c\x8c\x57\xf6\x4f\xbb\x04\xf3\x99\x1e\xba\x1e\x70\x71\xdb\x86\xda\x60\x08\x57\x30\xc0\x23\x40\x77\xbf\x5e\x13\x2d\x51\xbc\x40\x48\x91\x93\x36\xbc\xd2\x6e\xbc\xbf\x2b\xee\x8a\x75\x36\x8f\xa5\xba\x45\xa8\x97\x62\xa8\x8e\x96\x0e\x91\x5b\x99\x56\xe0\xa3\xae\xe6\xcb\x93\xb0\xc5\x46\x25\x90\x3f\x1b\x2d\x8f\x9b\xf5\x82\x7d\x31\x0a\x7b\x18\x1e\x65\x0c\x64\xea\x70\xc2\x8c\x98\x4b\x80\x5f\x7d\x82\x1c\x62\x35\x6e\x31\x1a\x28\x3b\xc8\xb7\xb7\xb8\x10\x4b\x63\x31\xf0\x94\x1e\x5f\x74\x48\x37\xb5\x57\x5a\x46\xe0\x39\x36\xea\xc2\x87\x56\xb3\x9f\x08\xb2\xbd\x0f\x67\x68\x62\xbb\xde\x16\x05\xed\x67\x9f\x76\xde\x91\x09\x6d\xaf\xe6\xb4\x3d\xcc\x49\x9f\x97\xb9\x20\x3e\x7d\xd2\xa1\x9e\xbc\xfe\x5e\xd1\xbe\x9f\xf7\xdf\x6f\x65\x86\xf3\x86\xb8\x08\x00\x00"
+
+func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployMainTfTpl,
+		"data/aws-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateBuildBuildJavaShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdf\x6f\xdb\x38\x12\x7e\xe7\x5f\x31\x55\x8c\xcb\xee\x5d\x68\xb5\x05\x0e\x58\xb4\xab\x62\x9d\xc6\xd7\x4b\xd0\x75\x0e\x4a\xda\x3e\xe4\x82\x80\x11\x47\x32\x13\x8a\x24\xc8\x91\x63\xd7\xf1\xff\xbe\x20\x65\x3b\x76\xd2\x5d\xec\x93\x8d\x6f\x7e\x7e\x1f\x87\x43\x1d\xbc\xca\x6f\x95\xc9\x6f\x45\x98\x32\x16\x90\x80\x5b\x30\xb6\x33\xeb\xbf\xe8\x3d\xce\x55\xfa\xeb\x94\xc3\x5a\x28\xbd\x86\xc9\x8b\x0a\x19\x43\xef\xad\xff\xe9\x67\x58\x32\x00\xd0\xb6\x12\x1a\x82\xed\x7c\x85\xb5\xd2\x58\x0c\xde\x3c\xc1\x5a\x19\x34\xb6\x18\xbc\x8d\x10\x56\x53\x0b\xd9\xb8\x2c\xcf\x4b\x10\x04\x83\xe5\x53\xd0\xea\xdd\x60\xd9\xfb\xae\xde\xc3\x67\x11\x08\xb4\x6d\xc2\xbb\x2c\x86\x35\x1e\x1d\x58\x22\x0b\xf9\x4c\xf8\x5c\xdb\x26\x0f\x8b\xa0\x6d\x03\x8f\x40\xa9\x37\x03\x6f\x5f\xb3\x15\x23\x2f\x1c\x1c\xa6\xe6\x20\x1b\x2c\x8f\x47\x17\xff\xbd\xb9\x38\xff\x52\x7e\x1c\xaf\xb2\x08\x7c\x3e\x9d\x8c\x27\xe7\xab\xec\x10\xc6\x65\xc9\x98\xc5\x48\x01\xb2\xc1\x6f\x19\xbc\xfd\xf0\x8f\x37\xf0\x18\x8b\x36\xe8\x81\x53\x5f\xef\x03\xe4\x12\x67\xb9\xe9\xb4\x7e\x0f\x2b\x66\x75\x0a\xe8\x69\x5c\x45\x8f\x6b\x18\xfc\x96\x45\x13\x3b\x80\x4a\xdb\x4e\xf2\xca\x9a\x5a\x35\x50\x09\x03\xca\x10\xfa\x1a\x3d\xc2\x83\xa2\x29\x08\x47\x50\xd9\xb6\x15\x46\x06\x50\x35\x28\x3a\x0c\x10\x48\x69\x0d\xca\x80\xf3\xb6\xf1\x18\x02\xb3\x1a\xb2\x6f\x42\x91\x32\x0d\xd4\xd6\xef\xa7\x25\x1b\x53\x38\x8d\x84\xc3\xe1\x30\x63\x9d\x21\xa5\xe1\xea\x0a\x78\xbd\x16\x47\xdd\xe6\x29\x22\x57\x26\x90\x30\x15\xe6\xb7\xd6\x12\xaf\x95\x51\x61\x8a\x12\xae\xaf\xdf\x83\xb4\x0c\x20\x68\x44\x07\xaf\x87\xff\x66\xd2\x9a\x78\xa6\x73\x67\x3d\xc1\xd9\xe8\xeb\xe8\xe6\xeb\xb8\xbc\x38\x3d\x9f\x14\xd9\x72\x09\x77\x62\x26\x6e\x66\xe8\x83\xb2\x06\x56\xab\x6c\xe3\x78\x32\x3e\x3e\x1d\x4d\x6e\xfe\x53\x9e\x4f\x2e\xc7\x93\x93\xc2\x58\x93\x08\x8b\x8a\xd4\x0c\x59\xe2\x31\x92\x32\xd2\x88\xcc\x3d\x3a\x1b\x14\x59\xaf\x30\x80\x30\x12\x3a\x27\x45\x24\x99\x78\x58\x84\xd0\x49\x1b\x3d\x79\x83\xd4\x1b\xf1\x05\x9c\x38\x69\x0d\x7c\x01\xc1\xd6\xf4\x20\x3c\x72\xe7\xad\x43\x4f\x0a\x03\x8f\xea\x5a\xf3\x14\x25\x25\x8f\x91\xdb\xd2\x8b\x18\xe8\x9c\x78\x67\x1d\x9a\x3b\x79\xcf\x7d\xee\x9c\xf8\xb3\xe2\x89\xc1\x69\x5f\x31\xb2\x38\x13\x33\x01\x83\xe5\xae\x40\xab\x1f\x36\xbf\xd3\x65\xa3\x08\x36\xc5\x9e\x85\xf2\x3b\x79\xdf\xd7\x18\xcf\x29\xa9\x96\x94\x72\x29\x65\x7b\x2f\x95\x07\xee\x20\xa7\xd6\xe5\x71\xd2\xf8\x6d\xa7\xb4\x64\x24\x3c\x7c\x9f\xd7\x3b\x78\x0c\xa1\xe6\x3b\xf0\x8f\x2f\x9c\x2b\xf9\x02\x62\x07\x70\x1c\xff\xf4\x23\x49\x53\x84\x4f\x5e\x48\x8d\xf0\xe0\x85\x73\xe8\xc1\x7a\xf8\x5d\xcc\xd0\x6c\x01\x55\x27\x37\xe7\xed\x1d\x56\x04\x53\x11\xc0\x1a\x3c\x62\x07\x10\x2c\xd0\x54\x50\x32\x6f\x06\xc4\xf6\xde\xa9\x18\x90\xb5\x1a\x5a\x41\xd5\x14\xc3\x6e\x92\x21\x53\x35\xa4\x99\x4d\x7e\xc3\xa6\x6f\xe1\xfa\x7d\x74\x32\x0c\x60\x63\xee\x0d\x0f\x3b\x16\x80\x6a\xda\x5a\x09\xff\x9a\x6f\x8c\x09\xfc\x54\x8e\x4e\x3e\x8f\x8b\x6c\x98\xaf\xd1\xb8\x38\x50\x07\x4c\xd6\x67\x07\xd9\x33\x4e\x3a\x27\xeb\x5f\x1c\x5f\xf2\xdc\xab\xd0\x43\x31\xb2\x56\x8c\xf5\xb9\x93\xa2\x31\x73\xe4\x28\x9c\xeb\xc5\xdd\x2b\x63\x11\x06\x7d\x0a\xe0\xdc\x58\x2e\x05\xb6\xd6\xac\x75\xe2\x73\x20\x0c\xc4\x00\xce\x46\xe5\xcd\xc9\x69\x59\x64\xc9\x10\x6f\x73\xc8\x18\xea\x8d\x1e\xce\xb6\xc3\x79\xab\x7f\xa0\x54\x3b\x33\x7f\x22\x53\xb4\x24\xe4\xf7\xd1\xd7\xf1\x24\x4a\x14\x91\xbf\xd2\x27\x9d\xff\xdf\x91\xa7\x8d\x8e\xbb\xb9\xdb\x99\xf9\x3b\xca\xec\x16\x88\xc2\xa4\x68\xe0\xc7\xe0\x44\x75\x2f\x1a\x04\x7e\x12\xee\x95\xbb\xc4\x40\x61\x57\x14\x12\xbe\x41\xca\xd8\xba\xf1\x58\x60\x62\xb7\xa2\x58\xbf\x3f\x4e\xb5\xed\x8c\x3c\xda\x56\xae\x84\x39\x24\xb8\xed\x67\x93\x5e\x25\x01\xe2\x23\xf7\x86\xc5\x76\x0f\xe0\x72\x8a\x50\x8b\xb8\x00\x4b\x50\xfd\xb8\xea\x58\x2f\xf4\x50\x8a\x3a\x82\xa0\x4c\x85\xa0\xa2\x0c\x95\xee\x24\x06\xc0\x19\xfa\x05\x3b\x00\x89\x0e\x8d\x44\x53\x2d\x86\x70\x91\xde\xb5\xa3\xb4\x3b\xa5\xad\x8e\xd2\xc2\x8b\x19\x3b\x13\xa6\x42\xa2\x04\xeb\x55\xa3\x8c\xd0\x31\x77\x00\xe1\x11\x22\x63\x87\x72\xc8\xce\x46\x65\x31\xf8\x49\x07\xe0\x17\x69\xdd\x24\xf2\xab\xfc\x9f\xc3\x3b\xe1\xe1\xed\x87\xed\x8b\x04\xff\x67\x00\x8f\xfd\xeb\xc8\x67\xc0\x11\x0e\x79\xff\xa0\x86\xe8\x3a\x38\xec\xa1\x75\x0f\x3b\x50\xbe\x29\xce\x0f\xd7\x39\xa6\x28\x24\x70\x03\x6f\xe0\xf1\x11\xc8\x77\xf8\xf3\xfa\x86\x7e\x8f\xcf\xe6\xd9\xa8\x5c\x65\x3b\xc3\xb5\xd6\x3d\xaa\xf2\x20\x42\xaf\x0c\x28\xb3\xd3\xec\x73\x75\x9f\x0d\x58\x9f\x72\x7f\x77\x4a\xd9\x85\xf8\xf2\xf2\xb0\x08\x84\x2d\x70\xde\x78\xdb\xb9\xfe\xb6\x54\x1e\x05\x21\x9f\xda\x16\x61\xb3\xf4\x58\x8a\x7b\x5a\x94\xc1\xcf\xf2\x7d\x5b\xe5\x9e\xba\xdf\x33\xe7\x71\x67\xde\x09\xbf\x76\x9b\xda\x07\x03\xbc\xdc\x66\x7e\xf7\x2c\x99\x6f\x81\xfb\xfa\xe5\x2a\x8d\xac\x3e\xa6\x77\xb9\xf3\xbb\x33\x4e\x16\x02\x09\x4f\x10\x2f\xb8\xb5\x94\x88\x56\x82\xe0\xd7\x5f\xbf\x4c\x4e\x2f\xe1\xb1\xa7\x4c\x88\x90\x23\x55\x79\xcf\x58\xae\x7f\x9f\xb6\x7a\x40\x3f\x53\x15\xee\x7e\x85\xb0\x03\xf8\x84\x06\xbd\x20\x94\x70\xbb\x80\x73\x22\xcb\xae\xbe\x18\x45\xd7\xec\x04\x43\xe5\x95\x23\x65\x4d\xb1\x5c\x82\x11\x2d\xc2\x6a\xc5\x46\x35\xa1\x2f\x0c\xd2\x83\xf5\xf7\xc3\xfe\x0a\x31\x76\x75\xd1\x27\xbf\x66\x5f\x02\xfa\x62\xcb\xf5\x9b\xf5\xf7\xca\x34\x27\xca\x63\x15\x5f\xcc\x62\x5f\x8a\xb1\x99\x29\x6f\x4d\x8b\x86\x8a\xff\x9d\x97\x97\xc5\x2f\xaf\x7f\x79\xcd\xc6\x73\xac\x2e\x22\xe3\x22\xef\x82\x4f\x9f\x96\x71\xea\x80\xc7\x89\xfd\xb1\xf2\x25\x26\x89\x0a\xa1\x1f\xc4\x22\x30\x76\xb5\x1e\x8f\x6b\xf6\x4d\x18\x42\x79\xbc\x28\xda\x4e\x93\xe2\x71\x2a\x36\x5d\x47\xf5\xb6\x13\xd3\xab\x55\x91\x06\x34\xe2\x56\x23\x3c\xd7\xad\x3f\xa0\xe1\x70\x18\xbf\x6f\x5e\x65\xec\x8f\x01\x00\x1b\x7a\xc0\x41\xf5\x0a\x00\x00"
+
+func dataAwsVpcPublicPrivateBuildBuildJavaShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateBuildBuildJavaShTpl,
+		"data/aws-vpc-public-private/build/build-java.sh.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateBuildBuildJavaShTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateBuildBuildJavaShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/build/build-java.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\x08\x06\xda\x53\xe3\x74\xed\x0e\x5d\x81\x9e\x06\xec\xb2\x9d\x77\x29\x02\x57\xb1\x95\x44\x8b\x2c\x1b\x7a\x78\x68\x33\xff\xf7\x51\x96\x1f\x92\x9f\xc9\xb0\x5e\x9a\x88\xe4\x47\xf2\x13\x45\x32\xe7\x15\x82\xbf\x20\xa5\x3c\xca\x71\x7c\x22\x22\x2a\x88\x90\x34\xe3\xc1\x33\x0a\xee\xc3\xa7\xf0\x3e\xb8\x5b\x59\x9d\x02\x0b\x8a\x77\x8c\x48\x10\x9d\xab\x23\x84\xce\x37\x68\x9f\x09\x74\x42\x94\xa3\x9d\xa6\x2c\x89\x08\x2f\xd0\x4d\x59\x8b\x83\xf6\x2c\x3a\x9f\x41\xab\x2c\x0d\x2c\x20\xb6\xd6\x84\x27\x06\xa0\xb3\xc0\xbf\x65\x84\xe3\x98\x48\x19\x9d\xc8\xbb\xa7\x5e\xc9\x24\x89\x05\x51\xe3\x32\x95\x9d\x08\xf7\x8f\xa5\x3c\x1a\xdd\x88\xe3\x94\x0c\x25\xb9\xa0\x05\x56\xa4\xd2\xd8\x53\x46\x86\x90\x82\x1c\x2c\x19\x5c\x33\xd6\xd9\x32\x7d\x00\xbe\xd4\xb1\x2f\xb0\xf9\x5a\x23\xd9\xf3\x77\xc4\x82\x98\xd4\x32\xcd\x55\x4f\x66\xcd\x28\x97\x0a\xf3\x98\x44\xea\x3d\xaf\x42\x01\xce\x46\x24\x7f\x12\xb2\xc7\x9a\xa9\xe7\x20\x7e\x0c\x19\x16\x07\x12\x18\x62\x3b\x47\x99\x16\xa0\x8a\x53\x5a\x63\x74\x07\x9d\x29\x7c\x59\x3f\x90\xfd\xe7\xa7\xc7\xc7\x2f\xbe\x79\x91\xc7\x11\x4d\x7a\xb1\xeb\x1d\x07\xd2\x07\xc7\x79\xa6\x0c\x87\x31\x99\x3a\x8f\xb0\x56\x19\x7c\xcc\x12\x1d\xab\x4a\xa9\xd2\x29\x9b\x9a\x02\x49\x41\x4d\xb9\x41\xd1\x81\xf8\xd5\x2f\xab\x84\x0a\x53\x58\x7b\x60\x2c\xc1\x0a\xb4\x22\x38\x91\x61\xc5\x49\x57\x32\x4d\x2d\x02\x5c\x43\x9c\x3c\x12\xc6\xda\x78\x40\x40\x39\xa3\xdc\x88\x5e\x83\xf4\x64\x60\xd7\x39\xda\xa8\x34\xdf\x64\x4a\x65\x9b\xce\xc1\x1a\xe8\x02\xcf\x2c\xcb\xf2\xf0\xab\xb9\x28\x22\x0c\x39\xdb\x1a\xa9\xbc\x9b\xf6\x59\xd5\x8f\xe3\xd2\xb2\x5e\x5f\x81\x71\x59\x96\x1b\x57\x9e\x10\xa9\x28\xaf\xbc\x1a\xa5\x2b\xa2\xb9\x20\x98\x39\x02\xe2\xe4\xd2\xd4\xcb\x12\xdd\xde\xa2\x1d\x96\x47\x14\x6e\x52\x4c\x79\x28\x8f\x23\x5c\x0c\x5f\xf1\x75\xf4\xdc\x20\xe8\x39\x3b\x08\x22\x05\x04\x88\x42\x4b\xc8\xf3\xad\x7d\x63\x6f\x90\xb3\xf5\xe1\xa8\x5d\xc2\xe4\x1a\xe7\x79\xa8\x0e\x1f\xff\x44\x98\x8c\x05\xcd\xab\x92\xad\xca\x6d\xfd\x0b\x17\xd8\xa4\x0f\x91\xd0\xbd\xd7\xeb\x1c\x23\x38\xa0\x22\xe3\x29\xe1\x2a\x82\x76\xe9\x96\xf4\x05\xdd\xb2\x82\xa8\xfb\xe4\xcb\x04\x2b\x4e\x43\xed\x53\x52\x5b\xfa\x86\xe3\xe4\xd9\x24\x78\xa6\xda\x1b\xff\x81\xa5\x32\xb9\x58\x5d\x10\x7a\x51\x8d\x5c\x31\x42\xdb\xa1\x6e\x69\x81\xdb\x57\x1d\x59\x16\x1d\x92\xae\xa3\x1e\x52\x1a\x60\x39\x0f\xa0\x1f\xc0\xb6\x69\x2b\x15\x4b\x75\x4b\xa9\x49\xaf\x8f\x5a\xea\x41\x6a\x38\x6b\x5a\x56\x33\x1f\x4c\xdd\x0c\xd9\xf9\x06\x6d\xc7\x64\xb1\x6e\x3a\x72\xf5\x10\x1d\xf7\x5d\xef\xeb\xf7\x6e\xfb\x64\xbb\x99\xe2\x8d\xb6\x89\x4b\xf6\x67\xe0\x7c\xfd\x07\xfe\x40\x9c\x41\xec\x14\x17\x10\xdb\x31\x3a\x03\x56\xe9\x2c\xe0\xb4\xb3\x73\x0e\xc8\x2a\x2d\xe5\xe8\x8f\xb4\x89\x86\xd1\x2a\xcd\xa3\x39\x2f\x18\xcc\x5e\x5e\xe0\x52\x52\xfc\x01\x0d\x90\xec\x64\xe0\x6c\x22\xdd\x20\x9c\x70\x68\x15\x96\x42\x77\x47\xe7\x54\xe4\x8d\xce\x02\xd6\x70\x41\x98\x6b\x12\x9e\xf6\x52\x94\xde\x24\x9f\x0a\xb3\x55\xba\x18\x6d\x30\xff\x17\xa1\x3d\x8b\x25\x3f\xb0\xc2\x19\xfb\xe6\xf1\xea\x1d\x8c\x2d\x3d\x58\xfe\x72\x4c\x45\xbb\x00\x4e\x05\xe0\xec\x89\x17\x78\x1d\x5b\x1c\x67\x90\xfb\xea\x8b\x05\xda\xeb\xab\x66\x5d\x73\xb7\xca\xd9\x7b\xaf\xf5\x16\xb2\x30\x88\xc6\x6a\x0e\xcf\x5f\x59\xff\xcb\xab\xb2\x6a\x45\xc6\x74\x4a\x22\x49\x3f\x88\x93\x24\xc3\x9a\xc7\xc7\x68\xc7\xb2\xf8\x14\x25\xa4\x80\x6a\xb0\x1d\xdc\x1d\xf5\xe6\xb4\xbd\xcc\x0d\x7c\xdf\xc8\x04\x7f\x72\xc7\x87\x03\x6e\x7e\xa9\x9c\x3d\x6f\x65\x39\xd4\x6c\x5e\xd3\x21\x7f\xf0\xd7\x0a\x46\xe0\xce\x60\xf2\xc0\x2a\x94\x76\x0b\x86\x12\x9a\x34\x13\x68\x3b\x7d\x67\x36\x53\x85\x0f\xd2\x49\x51\x68\x40\x83\x23\xe7\x27\x54\xbb\x16\x28\x33\x9b\x6a\x7d\x33\x3e\x54\xf8\x9d\xbc\xd7\x3f\x99\xaa\xaf\x3f\x31\xd3\x26\x83\x2b\xc7\xf7\xc8\xf0\x2e\x67\xc3\x1e\x2b\xbe\xf6\xf9\x9c\xcd\xa7\xb2\x9c\x9e\x91\x68\x6a\x46\xa2\x7e\x95\xc1\x7f\xd8\xdc\x70\x9a\x8f\xd5\xd5\xca\xd9\x27\x16\x32\x75\x13\xdc\xae\x56\xe5\xea\x2f\xb1\x91\xf4\xd0\xd5\x0e\x00\x00"
+
+func dataAwsVpcPublicPrivateBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateBuildTemplateJsonTpl,
+		"data/aws-vpc-public-private/build/template.json.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc5\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\xb4\xd9\x4b\x91\xd5\x3a\x0d\x0a\x04\x01\x7a\x28\xda\xa2\x87\xa2\x49\x50\x04\xbd\x14\x85\x40\x4b\xd4\x2e\xb1\x14\xa9\x92\x23\xa7\x8e\xab\xff\xde\x21\x47\xb4\x44\xd9\xd9\x04\x6d\xd0\x78\x2f\xeb\x37\x9f\x9e\xf7\x48\xce\x15\xfc\x24\x8d\x74\x02\x65\x03\xbb\x03\xbc\x46\xb4\x4f\xa1\xb1\x60\x2c\x82\x6c\x14\x42\x27\xcc\x20\xb4\x3e\x6c\x36\x7b\xe1\x94\xd8\x69\x09\x85\x32\xad\x13\x95\x6a\x0a\x38\x8e\x0b\x58\xbc\xf3\x95\xa8\x6b\xe9\x7d\xf5\x20\x0f\x64\x84\x46\xb6\x62\xd0\x08\xdf\x42\x51\xc0\xda\xd5\xcb\xda\x49\xfc\x24\x57\xb4\x0f\xd2\x7c\xd4\xcb\xc9\x3b\x65\xcd\xaa\x29\x4a\x5f\x19\xd1\xc9\x15\x3c\x78\xe9\xaa\x46\xa0\xb8\x90\x75\x99\xb6\x53\xab\x40\x65\x3c\x0a\x53\xcb\x0a\x0f\xbd\x5c\x05\x1f\x8f\x90\x99\xff\x9e\x6c\x2f\x0b\xfc\xba\xec\x54\xed\x2c\xa5\x1f\xf3\xc6\x4f\x01\xb5\x1d\x0c\xae\x12\x3e\xcb\x7d\xa5\xd9\x2b\x67\x4d\x27\x0d\x56\x7e\x68\x5b\xf5\xd7\xa3\x33\xe9\x9d\xda\x13\xb1\xe4\xba\x33\x34\xe8\x33\xbe\xfa\x61\xa7\x55\xfd\x41\xf3\xbe\xaf\xab\x5a\x35\xee\x02\x3c\xf9\x6e\x7a\x67\xf7\xaa\x91\x2e\xce\x9f\xa0\x0d\xc0\x2c\x80\xd0\xd0\x93\x23\x05\x96\xb9\x30\xc6\x82\xdc\x66\xf2\x73\xb7\x19\x8f\x6e\x91\xf8\xdc\x23\x42\xd1\xc8\x7c\x43\xf8\x64\x1e\x8c\x93\x0b\x75\xe8\xa4\xb7\x83\xab\x67\xc9\x0d\x4e\xe1\xa1\xba\x73\x76\xe8\x0b\x9a\xa8\xde\x71\xdb\x41\x22\x13\x85\xf1\xdf\x71\xbc\x21\xdb\x0d\x27\x4d\x7a\x1f\xf9\xeb\x39\x0d\xb1\x1d\x1e\xcc\xdc\x0a\x7f\x27\x13\xd9\xe4\x1d\x35\xe2\x63\x25\x00\x9a\x1a\xda\xda\x6a\x6e\xfc\xe6\x59\x04\x5b\x67\xbb\xaa\xb7\x0e\x23\xb8\x8d\x18\xda\x84\xcc\x58\x60\xa4\xda\x69\x5b\x3f\x78\xc2\x7e\x2f\xb6\x65\xfc\xbb\xdd\x16\x7f\x90\x7d\x0c\xc5\x94\xf9\x70\xb5\x02\xeb\xbe\xb8\x50\xf0\xc5\xa5\x8a\x2f\x3e\xb9\xe4\xf1\x1a\x54\x0b\x28\xee\x3c\x5c\x8f\x81\xb7\xf0\x1f\xd7\x27\x53\x6b\x1d\x20\xb5\x95\x1c\xc2\x94\xb1\xfc\x99\xb8\x0f\xa7\x81\xa7\x8e\xe5\x6f\x42\x0f\x61\xf0\x45\x0a\x93\xa6\x09\x91\x31\xe1\xb8\x49\x10\xd5\x21\xe4\xe3\xd4\x8a\xbe\x5f\x50\x0b\x2b\x72\x3f\x17\xb1\x8f\xcd\xfa\x3f\x32\x3b\x17\x0b\x96\x71\x1a\xf6\xff\xac\xa5\x2f\x4f\x6c\x3c\xa2\x67\x6c\x9e\x3e\xff\x9e\x56\xbe\xf7\xfc\x22\x53\x9a\xf9\xfa\x62\xe4\xd9\xe7\x0a\x4b\x1c\x9d\x6b\xaf\xa4\x8e\xcb\x14\x94\xae\x77\x9f\x15\x09\x41\xc9\x52\xd2\x4f\x2b\xbf\x9a\x02\x28\xe2\x0a\xde\xde\x4b\x20\x10\xb4\xf2\x28\x8d\x07\xba\xe3\x90\xa0\x48\x1f\xcd\xfa\xc9\x9b\xd7\xbf\xbe\x7d\x0a\xef\xee\x55\x7d\x0f\xca\xd3\x29\x8d\xe7\x94\xbd\xe9\x2e\x66\x76\xf4\x6e\xe6\x1b\xf2\xf3\x1c\x4c\x0b\xd9\xac\xee\x85\xd3\x83\x94\x5d\x04\x53\xe8\x6c\x4c\x09\xe6\xd0\x2f\xa6\x97\x2b\xf8\x41\xf6\xda\x1e\x40\x10\x47\x08\xb6\x9d\xa7\xbe\xd2\x52\xc2\x97\x82\x8a\xef\xee\x52\x4e\x49\x43\xcb\x77\x39\xf6\x42\xcb\x40\x2e\xbc\xe9\xc9\xe9\x54\x34\x67\x4f\xff\x85\x44\x01\x5e\x08\x2f\x5c\x29\x59\x9e\xb3\xe7\x3a\x3a\xa7\xfd\x65\x55\x34\xc1\xd1\xe7\xb4\xcc\xe4\x3e\x27\x98\xaf\xaa\x70\x93\xe4\x4a\xa5\x1a\x8f\xc8\x38\xe8\xf2\xa4\x4a\xe6\x75\x6f\xf5\xd0\x51\x87\xea\xbd\x64\x36\x9c\xb5\xc8\x37\x48\xd5\xc8\xbd\xa2\x39\x33\xd7\x4b\x47\xa6\x75\x89\x30\xb5\x6b\x26\x73\xb5\xbc\x3a\x7b\x90\x8b\xcf\xaa\x22\xaa\x67\x07\xec\x07\xa4\x5d\xd0\x69\x96\xc2\x3e\x86\x50\x82\x7b\xc4\xfe\xe5\xed\x2d\x8f\x25\x1c\xe7\x30\x8b\xc6\x78\x1e\xf9\x6d\xdc\x2c\x78\x22\x8d\xed\x04\x75\x72\xbd\xdc\x90\x18\x5b\xad\x4d\x0c\x56\xef\xad\x91\xa7\xf5\xe9\x0a\xde\x58\x45\xe2\x0b\xa7\x7b\x4a\x44\xe2\x45\x3e\xfe\x74\x03\x09\x0c\xfb\x8d\x60\x07\x6d\x05\xad\xe8\x42\x07\x2d\xb9\x95\xae\x89\x2f\x94\xdf\x3c\xa7\xcd\xa7\xb6\x8e\x92\xcf\x2d\xd0\xaf\x9a\x4a\xce\xba\xc8\x5b\x89\x03\x4a\x0a\x5b\xf9\xf0\x0e\x16\xe4\xcc\xb6\xef\x5f\x7d\xf7\xcb\x8f\x11\x43\x9d\xb4\xf6\x7c\xbb\xe5\x65\x2c\x94\x5e\xca\xe9\x6c\x6e\xa4\xa4\xc5\xd4\x97\x2d\x9e\x06\xcf\x91\xf9\xcf\x99\x7a\x29\xdb\x3f\x1b\x5e\xea\x96\xa2\xf9\x07\x11\xff\xa8\x9a\xc0\x0c\x00\x00"
+
+func dataAwsVpcPublicPrivateDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateDeployMainTfTpl,
+		"data/aws-vpc-public-private/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\x5d\x6f\x1b\xb7\x12\x7d\xe7\xaf\x38\x5d\x29\x69\x02\x58\xab\x26\xb8\xb8\x0f\x4a\x1d\xc4\x4d\xdd\xc6\x45\x6a\x17\xb2\x9b\x97\x20\x50\xa9\xe5\xec\x2e\x63\x8a\x43\x90\x5c\xc9\x82\xac\xff\x7e\x41\xee\xca\x1f\x49\x6e\xee\x7d\x31\xb4\xc3\x99\x73\x66\xce\x70\x86\x1e\xe1\x77\xb2\xe4\x65\x24\x85\xe5\x16\x17\x31\xf2\x11\x14\xc3\x72\x04\x29\x1d\x7f\x10\x23\x31\xc2\x55\xab\x03\x74\x40\x6c\x09\x1f\x64\xe3\xa5\x8d\xb5\x36\x84\xe6\xcb\x58\xd4\xec\xb3\x97\xa2\x35\x19\x76\x2b\xb2\x11\x5c\x8b\x11\x62\x82\x90\xce\x19\x5d\xc9\xa8\xd9\x4e\x03\xf9\xb5\xae\xa8\xc4\x59\x44\x68\xb9\x33\x2a\x93\x2e\x09\xad\xb4\x6a\x92\xc8\x49\x95\xb8\x62\xac\x58\xe9\x7a\x9b\x60\xc5\xe8\x21\xfd\x11\xba\x40\x99\xed\xc4\xb9\x64\x28\x85\x18\x8e\xcb\x8a\x6d\xad\x9b\xce\xd3\xb3\xe2\x65\xf1\x3c\x55\x74\xdb\x9b\x6e\x05\x30\xc2\xdf\xcb\xce\xc6\x0e\x2f\xfe\x5d\xfe\xf4\xaf\xa3\x0c\x11\xe4\x8a\xe0\xc9\x90\xcc\x98\x32\x62\xd9\x69\xa3\x02\xa4\x4f\xd5\x38\xc3\x5b\x52\x60\x2b\x80\x1e\xa8\x5c\xaf\xca\x25\xdf\xe0\x18\xc5\x92\x6c\xe4\x69\x97\x31\x27\x19\xb3\x10\x99\xe6\x1d\x87\x08\xb6\x66\x0b\x4b\x71\xc3\xfe\xfa\x51\xf4\x60\x43\xe1\xbc\x5e\xcb\x48\x8b\xc1\x50\x1c\x41\xbb\x19\x8a\xdd\x2e\xc9\xb8\xd0\x6e\x21\x95\xf2\x14\x02\xf6\xfb\x01\xf8\x92\x62\xe7\x20\x11\xb6\xb6\x22\x85\x9a\x8d\x22\x8f\xda\xf3\x0a\xdc\x79\x24\x14\x6d\x1b\x28\xed\xa9\x8a\xec\xb7\x88\x8c\xe9\xba\xd7\xe6\x51\x0e\x3d\xc0\x62\x00\x48\x94\x4e\xc6\xb6\x3c\x00\xec\xf7\xc5\x11\x8a\x43\x64\x71\x24\x00\x80\x37\x96\xfc\x0c\xc5\x9d\x15\x8d\xe7\xce\x3d\xb0\xf4\x49\x9e\x5a\xb9\x34\x84\xcb\xcb\x77\x90\x0d\xd9\x98\x2e\xc7\x46\x7a\x95\x80\x03\xa3\xa1\x18\xd3\xcf\xa1\xfa\x24\x32\x59\x45\xb6\xd2\x14\x72\x05\xe1\x3e\xd3\x10\xda\x72\x88\x5e\xf4\x58\xc7\x88\xbe\xa3\x9e\xe8\x37\xee\xac\xca\xb7\x0a\x87\xbe\xf7\x5f\xcf\x74\x0d\x69\xb7\xcf\x05\xb0\x7b\x92\xe8\x93\x22\xd0\x16\xf5\x5d\xc4\x42\x69\x1f\x4a\x45\x6b\x3c\xd9\x0b\xe4\xf3\x63\x14\x53\x8e\x91\xa7\xf7\x5e\x93\xdd\x2e\x85\x1b\x66\x57\xbe\xe5\xce\x46\xf2\xb9\x19\xdf\x97\x32\x81\x65\x05\x95\xf6\x8f\x5c\x9d\xe7\xb5\x0e\x29\xc3\x22\xb4\x64\x4c\xea\xb8\x35\xda\xd2\x0c\x45\xa5\x30\xda\x29\xed\xf7\x78\xfa\x14\x4b\x19\xda\xe1\x73\xba\x92\xda\x96\xa1\x2d\xfa\x62\xc8\xaa\x54\xcf\x93\x7d\x2f\xc1\x7b\x96\x0a\xd2\x98\xdc\xfe\xda\xcb\x26\x4d\x5e\x40\x4b\x9e\x72\xdd\xd2\x6e\x1f\x09\x5c\xde\x4b\x72\xf0\x4e\xba\xa4\xfb\x76\x1f\x9d\x15\x49\x95\x0f\x96\x5b\x4f\x52\x61\xbf\xff\x66\x06\x67\x36\xc4\x94\xc0\x1f\x72\x2d\xfb\xd9\x01\xd9\xb5\xf6\x6c\x53\xe8\xff\x5b\xfe\x38\x54\x5e\xbb\xb8\xf8\x2c\xd7\x52\x90\x55\x42\x3c\xb4\xe0\x18\x3f\xff\x7c\xf9\x76\x7e\xf6\xd7\x95\x08\x14\x31\x61\x58\xee\xec\xf0\x93\xbc\xa7\x1b\x9d\x7f\x3a\xed\xa8\x96\xda\x0c\xe6\xe8\x65\x45\x42\x90\xf7\xec\x9f\x3d\xc7\x4e\x00\x30\x5c\x49\x83\xc0\x9d\xaf\x28\x2d\x8f\xe3\xf1\x8b\x7b\x73\x4a\xc6\xf2\xf1\xf8\x65\x32\x51\xd5\x32\x8a\xd3\xf9\xfc\x62\x0e\x19\x31\xde\xdd\x07\xed\x67\xe3\x5d\xef\xbb\x7f\x85\xf7\x32\x44\x18\x6e\xc2\x2c\xf5\x08\x8d\x27\x07\x8e\xfd\xe4\xf9\xa9\xe1\x66\x1a\xb6\xc1\x70\x83\x5b\xc4\x9c\x9b\xc5\xcb\x9f\xc4\x5e\x44\x2f\x1d\x7e\xcc\xc9\xa1\x18\xef\x7e\x39\xb9\x7c\xb7\xb8\xbc\xf8\x7b\xfe\xf6\x74\x5f\x24\xc3\xfb\xb3\xf3\xd3\xf3\x8b\x7d\xf1\x23\x4e\xe7\x73\x21\x46\x19\x74\x42\x37\x54\xcd\x90\xfe\x76\x91\x50\xf1\x6a\x25\xad\xc2\x46\xc7\x16\xdc\x45\xd7\xe5\x54\x9a\xb4\x9a\xbb\x98\x37\xab\xd2\xc1\x19\xb9\x25\x25\x98\x92\x08\x18\xbf\xc1\xcb\xd7\x4f\x5f\xe0\xb6\xf7\xf4\x98\xc4\x3e\xdf\xd7\x98\x2a\x5a\x4f\x6d\x67\xcc\x2b\xec\xef\x18\x0d\x37\xb3\x03\xb6\x84\xf3\x54\xeb\x1b\x52\x58\x51\x08\xb2\x21\xc1\x26\xa3\xf6\x6a\x7d\x4c\x11\x9f\x30\x7e\x53\x0c\x08\x7f\xca\x6b\x82\x8e\x08\xdc\x6f\xd7\x7f\x86\x5d\x81\x10\xda\x7f\xd0\x30\x85\x61\x5b\x99\xbc\xac\xd2\x4a\xae\xd8\x27\x43\xb2\x8b\x1e\xb5\x52\x77\x5b\xac\xc0\xeb\xd7\x98\xb6\xbc\xa2\x83\x65\x5a\xa6\x69\xf1\x95\x10\x74\xe3\xd8\x47\xfc\x7a\xfa\xcb\xd9\xc9\xf9\xe2\xb7\xf9\xc5\xf9\xd5\xe9\xf9\xaf\xc7\x96\xad\x4e\x93\x2b\xab\xa8\xd7\x24\x04\x1b\x14\x27\x2a\xaf\x23\xe9\x22\x3c\x39\x0e\x3a\xb2\x4f\xfb\x27\x69\xd9\xb9\x34\xfc\xb6\x29\xcb\xb2\x10\x4c\x08\x9d\xe2\xe4\x39\x69\x28\xf6\x87\xf4\x95\x59\x0f\x53\x30\xd9\x22\x70\x1d\x37\xd2\xd3\xc4\x79\x76\xe4\xa3\xa6\x30\x49\x6d\x62\x7b\x1f\xa5\xd4\x24\x45\xde\x51\x6f\x53\xa0\x73\x72\xc6\x8e\xec\x67\x75\x3d\xf1\x53\xe7\xe4\x7f\x23\x3f\x14\xfa\xc7\xc9\x87\x93\xc5\x87\xd3\xf9\xe5\xd9\xc5\xf9\x71\x5a\x3c\x69\x56\x16\x6b\xf2\x79\xc6\xf2\xa3\x91\x6a\x1d\x26\x34\xd5\x9b\x87\x74\xbc\x7b\x18\xb8\xff\x66\x99\x0f\xea\x69\x74\xc4\x21\xad\x2f\x42\x27\x9f\xd5\x75\x6a\xf2\xc0\x90\xbb\xd7\xaf\x80\xc8\xdc\x7f\x3a\xcf\x9f\x53\x33\xbb\x40\xe1\x08\x9d\x35\xe9\x3d\xd3\x11\xad\x0c\x90\xd8\x78\xe9\x1c\xf9\x7c\x35\xc4\x08\x8a\x37\xd6\xb0\x54\xfd\x7f\x1c\x5e\x37\x6d\xc4\xa1\x1c\x1d\x03\x99\xba\x14\xba\xc6\x47\x4c\xea\xbb\x0b\x31\xcd\x84\x65\xe3\xa5\x32\x84\x4f\x69\x7b\x7e\xc4\x0f\x8f\x3c\xfa\xb3\x0d\x3e\xbd\x4a\xb8\x56\x00\x5f\xc8\xf2\x7b\x76\xc8\x42\x00\xdf\x93\x22\xfb\x89\x5a\x8b\xaf\xd3\x70\xbc\x2a\x6f\x56\xe6\xdb\x19\xac\xd6\xf6\x3b\xf4\x7f\xca\x35\xd9\xff\xcd\xbe\x4a\x6e\x99\x3c\xc5\xbf\x1d\x5e\xbc\x9c\xbf\x8e\x88\x9c\x34\xce\xaf\x6e\x8a\x21\xa9\xc0\x35\xde\x5d\x5d\xfd\x85\xc0\xd8\x10\x2a\x69\xfb\xf7\x78\x32\xbc\xa8\x77\x2f\x70\xba\x86\x90\x5d\x6c\x0f\x57\x21\xb5\xbc\x5f\xdb\x98\x4c\x1a\xc3\x4b\x69\xd0\x79\x53\x16\x8d\x8e\x6f\x1a\x1d\xdb\x6e\x59\x56\xbc\x9a\x15\xe5\x40\x75\x51\xa3\x68\x63\x74\x61\x36\x9d\xde\x9f\x4f\x0b\x31\x2c\xed\xff\x0c\x00\x5f\x45\xaf\x7e\x6f\x0a\x00\x00"
+
+func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevVagrantfileTpl,
+		"data/common/dev/Vagrantfile.tpl",
+	)
+}
+
+func dataCommonDevVagrantfileTpl() (*asset, error) {
+	bytes, err := dataCommonDevVagrantfileTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev/Vagrantfile.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/aws-simple/build/build-java.sh.tpl": dataAwsSimpleBuildBuildJavaShTpl,
+	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
+	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
+	"data/aws-vpc-public-private/build/build-java.sh.tpl": dataAwsVpcPublicPrivateBuildBuildJavaShTpl,
+	"data/aws-vpc-public-private/build/template.json.tpl": dataAwsVpcPublicPrivateBuildTemplateJsonTpl,
+	"data/aws-vpc-public-private/deploy/main.tf.tpl": dataAwsVpcPublicPrivateDeployMainTfTpl,
+	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"aws-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-java.sh.tpl": &bintree{dataAwsSimpleBuildBuildJavaShTpl, map[string]*bintree{
+				}},
+				"template.json.tpl": &bintree{dataAwsSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+		"aws-vpc-public-private": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-java.sh.tpl": &bintree{dataAwsVpcPublicPrivateBuildBuildJavaShTpl, map[string]*bintree{
+				}},
+				"template.json.tpl": &bintree{dataAwsVpcPublicPrivateBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsVpcPublicPrivateDeployMainTfTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+		"common": &bintree{nil, map[string]*bintree{
+			"dev": &bintree{nil, map[string]*bintree{
+				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
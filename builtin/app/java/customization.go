@@ -0,0 +1,15 @@
+package javaapp
+
+import (
+	"github.com/hashicorp/otto/helper/compile"
+	"github.com/hashicorp/otto/helper/schema"
+)
+
+type customizations struct {
+	Opts *compile.AppOptions
+}
+
+func (c *customizations) processJava(d *schema.FieldData) error {
+	c.Opts.Bindata.Context["java_version"] = d.Get("java_version")
+	return nil
+}
@@ -0,0 +1,108 @@
+#!/bin/bash
+
+set -o nounset -o errexit -o pipefail -o errtrace
+
+error() {
+   local sourcefile=$1
+   local lineno=$2
+   echo "ERROR at ${sourcefile}:${lineno}; Last logs:"
+   grep otto /var/log/syslog | tail -n 20
+}
+trap 'error "${BASH_SOURCE}" "${LINENO}"' ERR
+
+oe() { "$@" 2>&1 | logger -t otto > /dev/null; }
+ol() { echo "[otto] $@"; }
+
+# cloud-config can interfere with apt commands if it's still in progress
+ol "Waiting for cloud-config to complete..."
+until [[ -f /var/lib/cloud/instance/boot-finished ]]; do
+  sleep 0.5
+done
+
+export JAVA_VERSION="{{ java_version }}"
+export DEBIAN_FRONTEND=noninteractive
+
+ol "Adding apt repositories and updating..."
+oe sudo apt-get update
+oe sudo apt-get install -y software-properties-common
+oe sudo add-apt-repository -y ppa:openjdk-r/ppa
+oe sudo apt-get update
+
+ol "Installing Java ${JAVA_VERSION}..."
+oe sudo apt-get install -y git openjdk-${JAVA_VERSION}-jdk
+
+ol "Extracting app..."
+mkdir -p /tmp/otto-build
+tar zxf /tmp/otto-app.tgz -C /tmp/otto-build
+cd /tmp/otto-build
+
+# Build with the Gradle wrapper or Maven wrapper if the project has one,
+# so that the version of the build tool matches the project.
+if [ -f build.gradle ]; then
+  if [ -f gradlew ]; then
+    chmod +x gradlew
+    GRADLE="./gradlew"
+  else
+    ol "Installing Gradle..."
+    oe sudo apt-get install -y gradle
+    GRADLE="gradle"
+  fi
+
+  ol "Building the app with Gradle..."
+  oe $GRADLE --no-daemon build -x test
+  JAR_DIR="build/libs"
+elif [ -f pom.xml ]; then
+  if [ -f mvnw ]; then
+    chmod +x mvnw
+    MAVEN="./mvnw"
+  else
+    ol "Installing Maven..."
+    oe sudo apt-get install -y maven
+    MAVEN="mvn"
+  fi
+
+  ol "Building the app with Maven..."
+  oe $MAVEN -B package -DskipTests
+  JAR_DIR="target"
+else
+  ol "No pom.xml or build.gradle found, the app can't be built!"
+  exit 1
+fi
+
+# The fat JAR is the largest JAR built, since it includes every
+# dependency. Source, javadoc, and the unshaded original JARs are skipped.
+JAR=$(ls -S ${JAR_DIR}/*.jar 2>/dev/null \
+  | grep -v -e '-sources.jar$' -e '-javadoc.jar$' -e '/original-' \
+  | head -n 1 || true)
+if [ -z "${JAR}" ]; then
+  ol "No JAR was built in ${JAR_DIR}!"
+  exit 1
+fi
+
+ol "Installing ${JAR}..."
+oe sudo adduser --system --group --no-create-home otto-app
+sudo mkdir -p /srv/otto-app
+sudo cp "${JAR}" /srv/otto-app/app.jar
+sudo chown -R otto-app: /srv/otto-app
+rm -rf /tmp/otto-build
+
+ol "Configuring the app to start on boot..."
+cat <<UNIT | sudo tee /etc/systemd/system/otto-app.service > /dev/null
+# Generated by Otto
+[Unit]
+Description={{ name }}
+After=network.target
+
+[Service]
+User=otto-app
+WorkingDirectory=/srv/otto-app
+Environment=PORT=8080
+ExecStart=/usr/bin/java -jar /srv/otto-app/app.jar
+Restart=always
+
+[Install]
+WantedBy=multi-user.target
+UNIT
+oe sudo systemctl enable otto-app.service
+
+ol "...done!"
@@ -0,0 +1,84 @@
+# Generated by Otto, do not edit!
+#
+# This is the Vagrantfile generated by Otto for the development of
+# this application/service. It should not be hand-edited. To modify the
+# Vagrantfile, use the Appfile.
+
+Vagrant.configure("2") do |config|
+  # Ubuntu 16.04, the same release that builds are deployed on
+  config.vm.box = "bento/ubuntu-16.04"
+
+  # Host only network
+  config.vm.network "private_network", ip: "{{ dev_ip_address }}"
+
+  # Setup a synced folder from our working directory to /vagrant
+  config.vm.synced_folder "{{ path.working }}", "/vagrant",
+    owner: "vagrant", group: "vagrant"
+
+  # Enable SSH agent forwarding so getting private dependencies works
+  config.ssh.forward_agent = true
+
+  # Foundation configuration (if any)
+  {% for dir in foundation_dirs.dev %}
+  dir = "/otto/foundation-{{ forloop.Counter }}"
+  config.vm.synced_folder "{{ dir }}", dir
+  config.vm.provision "shell", inline: "cd #{dir} && bash #{dir}/main.sh"
+  {% endfor %}
+
+  # Load all our fragments here for any dependencies.
+  {% for fragment in dev_fragments %}
+  {{ fragment|read }}
+  {% endfor %}
+
+  # Install Java build environment
+  config.vm.provision "shell", inline: $script_java
+end
+
+$script_java = <<SCRIPT
+set -o nounset -o errexit -o pipefail -o errtrace
+
+error() {
+   local sourcefile=$1
+   local lineno=$2
+   echo "ERROR at ${sourcefile}:${lineno}; Last logs:"
+   grep otto /var/log/syslog | tail -n 20
+}
+trap 'error "${BASH_SOURCE}" "${LINENO}"' ERR
+
+# otto-exec: execute command with output logged but not displayed
+oe() { $@ 2>&1 | logger -t otto > /dev/null; }
+
+# otto-log: output a prefixed message
+ol() { echo "[otto] $@"; }
+
+# Make it so that `vagrant ssh` goes directly to the correct dir
+echo "cd /vagrant" >> /home/vagrant/.bashrc
+
+export DEBIAN_FRONTEND=noninteractive
+
+ol "Adding apt repositories and updating..."
+oe sudo apt-get update
+oe sudo apt-get install -y software-properties-common
+oe sudo add-apt-repository -y ppa:openjdk-r/ppa
+oe sudo apt-get update
+
+export JAVA_VERSION="{{ java_version }}"
+
+ol "Installing Java ${JAVA_VERSION}..."
+oe sudo apt-get install -y git openjdk-${JAVA_VERSION}-jdk
+
+# Install the build tool the project uses, unless it has a wrapper that
+# downloads the right version itself.
+if [ -f /vagrant/build.gradle ] && [ ! -f /vagrant/gradlew ]; then
+  ol "Installing Gradle..."
+  oe sudo apt-get install -y gradle
+fi
+
+if [ -f /vagrant/pom.xml ] && [ ! -f /vagrant/mvnw ]; then
+  ol "Installing Maven..."
+  oe sudo apt-get install -y maven
+fi
+
+ol "Configuring Git to use SSH instead of HTTP so we can agent-forward private repo auth..."
+oe git config --global url."git@github.com:".insteadOf "https://github.com/"
+SCRIPT
@@ -0,0 +1,16 @@
+package javaapp
+
+import (
+	"github.com/hashicorp/otto/app"
+)
+
+// Tuples is the list of tuples that this built-in app implementation knows
+// that it can support.
+var Tuples = app.TupleSlice([]app.Tuple{
+	{"java", "aws", "simple"},
+	{"java", "aws", "vpc-public-private"},
+})
+
+func init() {
+	app.Register("java", app.StructFactory(new(App)), Tuples...)
+}
@@ -1,6 +1,8 @@
 package nodeapp
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/otto/app"
@@ -25,8 +27,11 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 		Bindata: &bindata.Data{
 			Asset:    Asset,
 			AssetDir: AssetDir,
-			Context:  map[string]interface{}{},
+			Context: map[string]interface{}{
+				"dep_app_path": fmt.Sprintf("/opt/%s", ctx.Application.Name),
+			},
 		},
+		Builders: true,
 		Customizations: []*compile.Customization{
 			&compile.Customization{
 				Type:     "node",
@@ -39,6 +44,18 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 					},
 				},
 			},
+
+			&compile.Customization{
+				Type:     "dev-dep",
+				Callback: custom.processDevDep,
+				Schema: map[string]*schema.FieldSchema{
+					"run_command": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "npm start",
+						Description: "Command to run this app as a dep",
+					},
+				},
+			},
 		},
 	}
 
@@ -63,6 +80,22 @@ func (a *App) Deploy(ctx *app.Context) error {
 	}).Route(ctx)
 }
 
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and Terraform configuration.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	return terraform.Verify(ctx, &terraform.DeployOptions{})
+}
+
 func (a *App) Dev(ctx *app.Context) error {
 	return vagrant.Dev(&vagrant.DevOptions{
 		Instructions: strings.TrimSpace(devInstructions),
@@ -70,7 +103,11 @@ func (a *App) Dev(ctx *app.Context) error {
 }
 
 func (a *App) DevDep(dst, src *app.Context) (*app.DevDep, error) {
-	return vagrant.DevDep(dst, src, &vagrant.DevDepOptions{})
+	return vagrant.DevDep(dst, src, &vagrant.DevDepOptions{
+		Dir:    filepath.Join(src.Dir, "dev-dep"),
+		Script: vagrant.DefaultBuildScript,
+		Files:  []string{"dev-dep-output.tgz"},
+	})
 }
 
 const devInstructions = `
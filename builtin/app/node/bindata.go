@@ -4,6 +4,10 @@
 // data/aws-simple/build/template.json.tpl
 // data/aws-simple/deploy/main.tf.tpl
 // data/common/dev/Vagrantfile.tpl
+// data/common/dev-dep/Vagrantfile.fragment.tpl
+// data/common/dev-dep/Vagrantfile.tpl
+// data/common/dev-dep/build.sh.tpl
+// data/common/dev-dep/upstart.conf.tpl
 // DO NOT EDIT!
 
 package nodeapp
@@ -91,7 +95,7 @@ func dataAwsSimpleBuildBuildNodeShTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x5d\x6f\x9b\x30\x14\x7d\xe7\x57\x58\x48\xe9\x53\x80\x6c\xad\xa6\x69\xaf\xfb\x19\x55\x44\x0d\xb8\xe1\x2a\xb6\xb1\x7c\x4d\xa6\x16\xf9\xbf\xef\x9a\xef\x4c\x0d\xcd\x9a\x17\x23\x9f\xc3\x39\xc7\x87\xeb\x74\x11\xa3\x5f\xac\x40\xe7\x86\x97\x67\x61\xf3\x8b\xb0\x08\x8d\x8e\x7f\xb1\xf8\x90\xfe\x4c\x0f\xf1\x3e\x1a\x38\x17\x6e\x81\x17\x52\x20\x41\xc3\x6b\xb4\xc9\xff\x60\xce\xcb\x52\x20\xe6\x67\xf1\x46\x88\x6e\xa5\xdc\xaf\x51\x14\xa5\x15\xee\x16\x6a\xc5\x69\x30\xbb\x42\x50\xb6\x27\xca\xe3\xea\x11\xe8\xf7\xfd\x14\xc4\xd8\xe6\x02\x21\x23\x25\x25\xc2\xf3\xf8\x56\xb7\x63\xaf\x8d\x65\x15\x58\x06\x9a\x1e\x5b\x5d\x71\x47\xac\x9c\x76\x30\x2d\x5a\x90\x15\xdb\xf9\x89\x3c\xae\x24\xe7\xde\x8c\x08\xa7\xc5\x5a\x48\x19\xef\x17\x00\xb4\x04\x1d\xa0\xe7\x58\x9d\x83\x6c\x62\x58\xe6\x94\xc9\x1a\xe7\x9a\x6c\x31\x48\xba\x2e\x38\xcb\xa6\x31\xe9\x6f\xda\x75\xc2\x32\xef\xe3\xe3\xa8\xe4\xf7\xb7\x3d\x5f\x41\x8a\xb5\x25\x36\xad\x2d\x7b\x84\x34\x83\xa5\xf7\xd9\x1a\xaf\x04\x3a\xd0\xbd\x6b\x20\xfd\x47\x9a\x3b\xc2\x6c\x15\x50\x56\xf7\x1e\xdd\x7b\xf6\xf0\xc0\x0a\x8e\x35\x4b\x33\xc5\x41\xa7\x58\x7f\xd0\xc5\x8e\x09\x5d\x85\xef\xb5\xf5\x49\x36\xea\xd9\x31\x1a\xd4\x82\x42\x28\x52\xa0\x14\x2d\xd2\x39\x5f\xe6\xc1\x79\xa1\x33\x0f\x1e\x2b\xda\x3d\x4d\x26\xdc\x98\xd4\x9d\xde\xbf\x54\x18\x96\x16\x8c\x0b\x50\x3f\x6e\x89\x6e\x2a\x11\x8e\x3f\x69\xf5\xeb\x71\x9a\xe3\x9e\x33\xce\xf0\x7c\xa1\x34\x57\xbd\x76\xc8\x32\x4b\xcf\x8e\x5c\xf1\x77\x6a\x5d\x14\xb8\x60\x57\xd7\xef\x56\x31\xd7\xf7\x74\xbb\x9d\xf8\xea\xca\x6e\x29\x2e\xc4\x4f\x14\xe7\x6b\xbe\xa5\x36\x90\x3e\xcb\xd6\x8f\x40\xce\x15\x0c\x7d\x40\xf2\xfd\xdb\x8f\xc7\x43\xf5\xf4\xb4\x70\x40\xa3\xe3\x9a\x58\x53\x6d\xe5\x63\x2a\xb9\x3d\x89\x95\x0c\xd6\x79\x70\x9e\xea\x6e\x0b\x1a\xde\x76\x55\xaa\x82\x7c\xc2\xba\x2e\x3c\xd1\x5c\xff\x9b\x9d\x56\x9a\x22\xae\xcc\x47\x89\x87\xff\xac\x63\x14\xf9\xe8\x6f\x00\x00\x00\xff\xff\xa2\x67\x64\x41\x65\x05\x00\x00"
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\x08\x06\xda\xd3\xe2\xf4\x85\x61\x28\xd0\xd3\x80\x5d\xb6\xf3\x2e\x45\xe0\x29\xb6\x92\x08\x91\x25\x43\x8f\x0c\x6d\xe6\xff\x3e\xca\xf2\x43\xf2\x33\x1d\xd6\x4b\x13\x91\xfc\x48\x7e\xa2\x48\xe6\xb2\x42\xf0\x17\xe5\x94\x27\x05\x4e\x4f\x44\x26\x67\x22\x15\x15\x3c\x7a\x46\xd1\x5d\xfc\x25\xbe\x8b\x3e\xad\x9c\xce\x19\x4b\x8a\x77\x8c\x28\x10\x5d\xaa\x23\x84\x2e\x37\x68\x2f\x24\x3a\x21\xca\xd1\xce\x50\x96\x25\x84\x9f\xd1\x4d\x59\x8b\xa3\xf6\x2c\xb9\x5c\x40\xab\x2c\x2d\x2c\x20\xb6\xd6\x84\x67\x16\xa0\xb3\xc0\xbf\x55\x82\xd3\x94\x28\x95\x9c\xc8\x5b\xa0\x5e\xc9\x14\x49\x25\xd1\xe3\x32\x2d\x4e\x84\x87\xc7\x4a\x1d\xad\x6e\xc2\x71\x4e\x86\x92\x42\xd2\x33\xd6\xa4\xd2\xd8\x53\x46\x86\x90\x92\x1c\x1c\x19\xdc\x30\xd6\xd9\x32\x73\x00\xbe\xf4\xb1\x2f\x70\xf9\x3a\x23\xd5\xf3\x77\xc4\x92\xd8\xd4\x84\xe1\xba\x27\x73\x66\x94\x2b\x8d\x79\x4a\x12\xfd\x56\x54\xa1\x00\x67\x23\x92\x3f\x19\xd9\x63\xc3\xf4\x73\x94\x3e\xc6\x0c\xcb\x03\x89\x2c\xb1\x9d\x23\x61\x24\xa8\xe2\x9c\xd6\x18\xdd\x41\x67\x0a\x5f\xd6\x0f\xf7\x9f\x1f\xef\xb2\xa7\xa7\xd0\xfc\x5c\xa4\x09\xcd\x7a\xb1\x9b\x1d\x07\xd2\x07\xc7\x85\xd0\x96\xc3\x94\x4c\x9d\x27\xd8\x68\x01\x1f\x45\x66\x52\x5d\x29\x55\x3a\x65\x53\x53\x20\x39\x53\x5b\x6e\x50\x74\x20\x7e\x0d\xcb\x2a\xa3\xd2\x16\xd6\x1e\x18\xcb\xb0\x06\xad\x04\x4e\x54\x5c\x71\xd2\x95\x4c\x53\x8b\x00\xd7\x10\xa7\x8e\x84\xb1\x36\x1e\x10\x50\xce\x28\xb7\xa2\xd7\x28\x3f\x59\xd8\x75\x81\x36\x3a\x2f\x36\x42\x6b\xb1\xe9\x1c\xac\x81\x2e\xf0\xcc\x84\x28\xe2\xaf\xf6\xa2\x88\xb4\xe4\x6c\x6b\xa4\xf2\xd3\xb4\xcf\xaa\x7e\x3c\x97\x8e\xf5\xfa\x0a\xac\xcb\xb2\xdc\xf8\xf2\x8c\x28\x4d\x79\xe5\xd5\x2a\x7d\x20\x9a\x2b\x82\x99\x23\x20\xcd\xae\x4d\xbd\x2c\xd1\xed\x2d\xda\x61\x75\x44\xf1\x26\xc7\x94\xc7\xea\x38\xc2\xc5\xf0\x15\x7f\x8c\x9e\x1b\x04\x3d\x67\x07\x41\xe4\x80\x00\x51\x18\x05\x79\xfe\x6a\xdf\xd8\x2f\xc8\xd9\xf9\xf0\xd4\xae\x61\x72\x8d\x8b\x22\xd6\x87\xf7\x7f\x22\x4c\xa5\x92\x16\x55\xc9\x56\xe5\xb6\xe6\x22\x23\x36\x7d\x88\x84\xee\x83\x5e\xe7\x19\xc1\x01\x95\x82\xe7\x84\xeb\x04\xda\xa5\x5f\xd2\x57\x74\xcb\x0a\xa2\xee\x93\x2f\x13\xac\x78\x0d\xb5\x4f\x49\x6d\x19\x1a\x8e\x93\xe7\x92\xe0\x42\xb7\x37\xfe\x03\x2b\x6d\x73\x71\xba\x20\x0c\xa2\x1a\xb9\x62\x84\xb6\x43\xdd\xd2\x01\xb7\xaf\x3a\x71\x2c\x7a\x24\x7d\x8c\x7a\x48\x69\x80\xe5\x3d\x80\x7e\x00\xdb\xa6\xad\x54\x2c\xd5\x2d\xa5\x26\xbd\x3e\x6a\xa9\x07\xa9\xe5\xac\x69\x59\xcd\x7c\xb0\x75\x33\x64\xe7\x1b\xb4\x1d\x9b\xc5\xba\xe9\xc8\xd5\x43\xf4\xdc\x77\xbd\xaf\xdf\xbb\xdd\x93\xed\x66\x4a\x30\xda\x26\x2e\x39\x9c\x81\xf3\xf5\x1f\x85\x03\x71\x06\xb1\x53\x5c\x40\x6c\xc7\xe8\x0c\x58\xa5\xb3\x80\xd3\xce\xce\x39\x20\xa7\xb4\x94\x63\x38\xd2\x26\x1a\x46\xab\x34\x8f\xe6\xbd\x60\x30\x7b\x79\x81\x4b\xc9\xf1\x3b\x34\x40\xb2\x53\x91\xb7\x89\x74\x83\x70\xc2\xa1\x53\x58\x0a\xdd\x1f\x9d\x53\x91\x37\x3a\x0b\x58\xc3\x05\x61\xae\x49\x04\xda\x4b\x51\x06\x93\x7c\x2a\xcc\x56\xe9\x6a\xb4\xc1\xfc\x5f\x84\x0e\x2c\x96\xfc\xc0\x0a\x67\xed\x9b\xc7\x6b\x76\x30\xb6\xcc\x60\xf9\x2b\x30\x95\xed\x02\x38\x15\x80\xb7\x27\x5e\xe1\x75\x6c\x71\x9c\x41\xee\xab\x2f\x16\x68\xaf\xaf\xda\x75\xcd\xdf\x2a\x67\xef\xbd\xd6\x5b\xc8\xc2\x22\x5a\xab\x39\xbc\x70\x65\xfd\x2f\xaf\xca\xa9\x9d\x05\x33\x39\x49\x14\x7d\x27\x5e\x92\x0c\x1b\x9e\x1e\x93\x1d\x13\xe9\x29\xc9\xc8\x19\xaa\xc1\x75\x70\x7f\xd4\xdb\xd3\xf6\x32\x37\xf0\x7d\xa3\x32\x7c\xef\x8f\x0f\x0f\xdc\xfe\x52\xb9\x04\xde\xca\x72\xa8\xd9\xbc\xa6\x43\xf1\x10\xae\x15\x8c\xc0\x9d\xc1\xe4\x81\x55\x28\xef\x16\x0c\x2d\x0d\x69\x26\xd0\x76\xfa\xce\x5c\xa6\x1a\x1f\x94\x97\xa2\x34\x80\x06\x47\xde\x4f\xa8\x76\x2d\xd0\x76\x36\xd5\xfa\x76\x7c\xe8\xf8\x3b\x79\xab\x7f\x32\x55\x5f\x7f\x62\x66\x6c\x06\x1f\x1c\xdf\x23\xc3\xbb\x9c\x0d\x7b\xac\xf8\xda\xe7\x73\xb1\x9f\xca\x72\x7a\x46\xa2\xa9\x19\x89\xfa\x55\x06\xff\x61\x73\xc3\x79\x31\x56\x57\x2b\x6f\x9f\x58\xc8\xd4\x4f\x70\xbb\x5a\x95\xab\xbf\x59\x5c\xd9\xa0\xd5\x0e\x00\x00"
 
 func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +115,7 @@ func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x53\xc1\x8e\xdc\x2a\x10\xbc\xcf\x57\xb4\xd8\x3d\xbe\xf5\xcc\xcb\x31\x52\x6e\x91\x72\x4b\x3e\x20\x5a\x21\x8c\x99\x09\x5a\x0c\x08\x9a\x89\x2c\xcb\xff\x9e\x06\x62\x31\x38\x9b\x6b\x3c\x27\xaa\x8b\x2e\xba\xba\xe6\x09\xbe\x28\xab\x82\x40\x35\xc1\xb8\xc0\x37\x44\xf7\x1f\x4c\x0e\xac\x43\x50\x93\x46\x98\x85\x4d\xc2\x98\xe5\x74\xba\x8b\xa0\xc5\x68\x14\x30\x6d\xaf\x41\x70\x3d\x31\x58\xb7\x07\x58\xfc\x8c\x5c\x48\xa9\x62\xe4\x6f\x6a\x79\xa7\x18\x95\x0c\x0a\xff\x52\x0c\xea\xa6\x9d\x3d\x14\x88\xca\xad\x98\x55\x81\x1f\x2f\xcc\xfa\xc0\xd4\x36\xa2\xb0\x52\x71\x5c\x7c\xa6\xc3\xa4\xae\x22\x19\x84\x4f\xc0\xf0\xc3\x30\x6b\x19\x1c\x83\xc7\x1b\x31\x8d\x96\x5e\xe3\xd3\x68\xb4\x3c\x74\xbb\x7b\xc9\xa5\x9e\xc2\x3b\xf0\xef\xb1\x4f\x3e\xb8\xbb\x9e\x54\x28\xaf\x27\xe8\x04\xd0\x86\xcf\xaa\xcf\x2b\x5d\x1c\x7a\x53\x36\x46\xb4\x66\x43\x4f\x6b\x78\xa1\x55\x43\x20\x7f\x1d\xad\xe2\x44\xa1\x47\x04\x15\x5d\x0a\xb2\xf9\x9b\x82\xc6\x85\xdf\x82\x4b\x9e\x11\xe8\x7d\x7d\x59\xf6\xb0\xf6\x59\xd7\x7a\xd8\xb6\x97\xda\x72\x5f\x66\xd1\xac\x03\x36\xbd\x7a\xa6\x12\xd5\xb4\xbd\x91\x5c\x2c\xfd\x00\x68\x7c\x74\xd2\x99\xfa\xbc\x97\xff\x0b\x78\x0d\x6e\xe6\xde\x05\x2c\xe0\xa5\x60\xe8\x76\xa4\x61\xd9\x5a\x3e\x1a\x27\xdf\x22\x61\xdf\xd9\x65\x28\xbf\xf3\x85\xbd\x52\x7d\xcb\x6a\xea\x9f\x89\x91\xdc\x13\x7c\x56\xde\xb8\x05\x04\x2d\x07\xc1\x5d\x61\x8f\x53\x3c\x58\xbc\xe3\x8f\xe6\x52\x18\xa1\x7d\x6d\x57\xb3\x2e\x9e\x76\xc9\x6c\xe5\x0e\xae\xb9\xa8\x81\x24\xff\xbb\x3e\x5d\x4e\x0b\x71\xff\x57\x1c\x04\x77\xb8\xae\x2b\xaf\xae\x0f\x04\x75\xae\x0e\x3c\xaf\x7f\xa6\x65\xa0\x71\x86\xbc\xea\xd7\x7c\x19\xc5\x8d\xbc\x87\xaf\x59\xa4\x0b\x0d\xab\x86\xb9\x84\x3e\x21\xb0\x14\x4c\xf5\xe0\x2e\x4c\x2a\xd4\x1f\x88\xfe\xe3\xf9\x5c\x25\xf6\x19\x4b\xf3\x3a\x00\x9f\x6c\xdc\xce\x39\xbc\xbf\x02\x00\x00\xff\xff\x05\xad\xee\xc5\x7b\x04\x00\x00"
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x55\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x08\xb7\xbd\x0c\xab\x93\xae\xd8\xa5\xc0\x0e\x03\x06\xec\x30\x60\xdb\x69\x97\xa1\x10\x14\x5b\x4e\x85\xda\x92\x27\xd1\xde\xd2\xcc\xff\x3e\x4a\x8c\x63\xcb\xed\xba\x1d\x86\x25\x97\xe8\x91\x22\x29\xbe\x47\xe6\x0c\xde\x2b\xa3\x9c\x44\x55\xc2\x76\x0f\x9f\x10\xed\x4b\x28\x2d\x18\x8b\xa0\x4a\x8d\xd0\x48\xd3\xc9\xba\xde\xaf\x56\xbd\x74\x5a\x6e\x6b\x05\x99\x36\x95\x93\x42\x97\x19\x1c\x86\x19\x2c\xbf\x7b\x21\x8b\x42\x79\x2f\xee\xd5\x9e\x8c\x50\xaa\x4a\x76\x35\xc2\x1b\xc8\x32\x58\xba\x7a\x55\x38\x85\x7f\xe5\x8a\xf6\x5e\x99\x3f\x7a\x39\xb5\xd3\xd6\x2c\x8a\xa2\xf0\xc2\xc8\x46\x2d\xe0\xce\x2b\x27\x4a\x89\xf2\x89\xa8\xf3\xb0\x8d\x5e\x5c\xd4\xc6\xa3\x34\x85\x12\xb8\x6f\xd5\xe2\xf2\xe1\x00\x89\xf9\xe7\xd1\x76\x93\xe1\xab\xbc\xd1\x85\xb3\x14\x7e\x48\x0b\x3f\x5d\x28\x6c\x67\x70\x11\xf0\x2a\xf5\x55\xa6\xd7\xce\x9a\x46\x19\x14\xbe\xab\x2a\xfd\xe3\xd9\x9e\xf8\x6e\x6b\xa8\xc1\x6d\xb7\xad\x75\xb1\x78\x46\xdf\x16\xa2\xd0\xa5\x7b\x02\x3e\xf2\xba\x6a\x9d\xed\x75\xa9\x5c\x6c\x2e\x41\x2b\x80\x89\xdd\x90\xed\xfc\x40\x17\xf3\x94\xf5\x21\x23\xb7\x89\xd9\xd4\x6d\xc2\xa3\x5b\x64\x35\xf5\x88\x50\x34\x32\x99\x10\x3e\x89\x07\xe3\xe4\x42\x15\x3a\xe5\x6d\xe7\x8a\x49\x4f\x9d\xd3\xb8\x17\x3b\x67\xbb\x36\x23\xb0\x6d\xb9\xec\xc0\x3f\xc7\x21\x86\xe2\x61\x18\x2e\x39\xe4\x28\xe5\x81\x8f\x8f\x3b\x1c\x8b\xe1\xb6\x4c\x85\xf0\x99\x4c\x64\xd3\x66\x47\x75\xf8\x98\x08\x80\x9a\x86\xb6\xb0\x35\xd7\x7d\x79\x15\xc1\xca\xd9\x46\xb4\xd6\x61\x04\x37\x11\x43\x3b\x22\x13\x16\x08\x11\xdb\xda\x16\xf7\x9e\xb0\xaf\xd9\x26\x8f\xdf\xf5\x26\xbb\x25\xfb\x10\xb2\xa9\xff\x99\xec\x70\x01\xba\x02\x94\x3b\x0f\x17\x43\x20\x2c\xfc\xe2\xd4\x64\xaa\xac\x03\xa4\xe7\x8f\x0e\xa1\xb9\x98\x7f\x20\xd2\x83\xc6\xb9\xd9\x98\x7f\x91\x75\x17\xfa\x9d\x8d\xd7\x94\x29\xc3\xcd\x18\x70\x58\x8d\x10\xe5\x21\x84\xb2\x9e\xc1\x3b\xd5\xd6\x76\x0f\x92\x64\x84\x60\xab\xd3\x48\xf9\x05\xdf\x23\x3e\x67\x3a\x0e\x11\x8c\x9f\x13\x5f\xe9\x90\xc5\x5a\x68\xb2\x01\x1e\x7b\x12\x1c\xcd\xc9\x1c\x3f\x11\x28\xc0\xac\x75\x1e\x32\x52\x47\x12\x27\x99\xbd\xe8\x38\x2e\xa2\x45\xc2\x11\x8e\x3e\xa7\xad\x94\xfa\x9c\x60\x56\x5c\x50\x5f\x2a\x76\x4a\xcf\x24\x9e\x1f\x1e\x4f\x42\x4e\xdd\xc9\x83\x5a\x6f\x27\x4e\x7b\x5b\x77\x8d\x12\x5e\x3f\x28\x66\xc2\x59\x8b\xac\x06\x51\xaa\x5e\x53\x8f\x99\xe7\xb9\x23\x53\x3a\x47\x98\xd6\x25\x8b\xa9\x52\x3e\x86\x37\x27\xa3\x97\xfd\x53\x05\x51\x3e\xdb\x61\xdb\x21\x2d\x75\x57\xb3\x0c\xfa\x78\x85\x02\xdc\x21\xb6\x37\xeb\x35\xb7\x65\x24\x2f\x36\x64\x93\x33\x37\xa2\x34\x7e\x58\x67\xf3\x30\xba\x5d\x44\x79\xee\xba\x6e\x79\x17\x71\x5f\x4b\xdb\x48\x7a\xcf\xc5\x7c\xa7\x32\xb6\x58\xb4\x0c\x8a\x07\x6b\xd4\x69\xe1\x9e\xc1\x67\xab\x49\xbe\x78\xa7\xc6\x40\x24\xff\x70\xa2\x8c\x94\x4b\x62\xd8\x88\x92\x1d\x7e\x37\x15\xc4\x38\xaa\xd7\xd7\xb4\x27\x0b\xeb\x28\xf0\x94\x9e\x5e\x74\x4c\x37\x29\x2b\x2d\x23\xb6\x78\xd4\xe8\xc2\x87\x37\x76\x18\x06\xb6\xbd\x8d\x67\xac\x47\xa5\x5e\x6f\x36\xbc\xb6\x43\xda\xb9\x18\x93\xb6\xbd\x98\xb7\xed\x76\xde\xf4\x79\x99\x8b\xc6\xa7\x4f\x3a\xd6\x93\x57\xdf\x4a\xfe\x1b\x98\x4b\xef\x17\x74\xa9\xd1\xcb\xcf\x08\x00\x00"
 
 func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,6 +155,86 @@ func dataCommonDevVagrantfileTpl() (*asset, error) {
 	return a, nil
 }
 
+var _dataCommonDevDepVagrantfileFragmentTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa4\x54\xc1\x8e\xe4\x34\x10\xbd\xfb\x2b\xde\x66\x76\x06\x90\x36\xb1\x40\x88\x43\xef\xee\x08\x69\x04\x88\x0b\x8b\x04\x17\x4e\x2d\x4f\x5c\x49\xcc\x3a\x2e\x63\x57\x32\xd3\xd3\xe4\xdf\x91\xd3\xbd\x33\x8d\x7a\x07\x2d\xda\x5b\x9c\x7a\xf5\xde\xab\xe7\x92\x2f\xf0\x13\x05\x4a\x46\xc8\xe2\x76\x07\x4b\x91\x82\xa5\xd0\xee\xd0\x71\x82\xa5\x99\x3c\xc7\x91\x82\x6c\xb0\xdf\x23\x98\x91\xb0\x2c\x4a\xbd\x7c\x3a\x6c\x33\xc9\x14\xf1\x16\x6f\xde\xdc\xbc\xfb\xf5\x0f\x95\x49\x50\x93\x52\x4c\x5f\x7e\x85\x3d\x5e\x7e\x8f\x6f\xae\xaf\xbe\xc6\xdf\xf0\xdc\xf7\x94\x50\x0b\x58\x84\x71\x0d\x6d\x69\xd6\x61\xf2\xfe\x35\x16\xa5\x2e\xf0\x73\xc8\x62\xbc\xc7\x2f\x6c\x09\xae\xc3\x1d\xc1\x72\xf8\x42\x30\x98\x99\xe0\x04\xc6\x27\x32\x76\xa7\x5c\x87\x17\x68\x79\x1c\x4d\xb0\xa8\x67\x84\xd2\x70\xfd\x48\xb7\x0a\xbe\x86\x0c\x14\x14\xc0\x84\xbb\xbe\x78\xfa\x0b\xf5\x3b\x68\x19\xa3\x2e\xf8\x46\x4c\x6a\xfa\x07\x0c\x22\x31\x6f\xf4\xfa\xef\xcf\xdc\x70\xea\xb5\x75\x59\xf4\x5c\x26\x64\x4b\xdb\x99\x52\x76\x1c\xb0\x2c\x2b\xa6\xfe\x48\xa1\xf6\x2e\x4c\xf7\xf5\xfd\x77\xdf\x1e\x59\x0f\xb2\x79\xb2\x0c\x31\x09\xf5\x0d\x34\x47\x41\x7d\xff\xd0\x9d\x39\x38\xc1\xfa\x80\x3a\xaf\xd0\x4f\x90\xd2\xb7\x2e\xac\x30\xe8\x29\x27\xed\xb9\x35\xfe\xf1\xdf\xe7\x91\xc6\xf1\x8c\x33\x8e\xaa\x73\xe5\x92\x7e\xb8\x97\x64\x5a\x01\x4f\x09\xd1\xb4\xef\x4d\x4f\x6a\xd5\x49\x23\xea\xd4\x95\x2d\xb1\x14\xb7\x26\xc6\x6d\x34\x32\x94\x6d\x59\xcb\xe3\x7b\xeb\x12\xea\xf8\x2c\xe0\x18\xd4\x79\xf9\x24\x36\x4b\xb1\x7e\xda\xbc\x46\xfa\x87\x62\xe9\x86\xe3\xae\xdc\x36\xa6\x98\xc5\x24\x41\xe7\xfc\xd1\xd4\x38\x7f\xb4\xf1\x08\x6c\x5a\x0e\x1d\x34\x49\xab\x5d\x70\xa2\x4f\x10\xa5\x52\xb8\x7f\x5b\x09\x9d\xbc\x38\xf0\x1d\xf8\x9f\x70\x6a\x5d\xf9\x0f\x1e\x8a\x6e\x86\x0b\xc2\x30\x10\x1a\x23\xac\x4b\xd4\x0a\xa7\x5d\x83\xdf\x07\x42\x6e\x93\x8b\x82\x3b\xe7\x3d\x46\x9e\xa9\xb8\x1e\x1b\x55\xc4\x5c\xdf\xcc\x63\x13\x13\xcf\x6e\xbd\x95\xaa\x90\x55\xaf\x14\x90\x79\x4a\x2d\x6d\x50\xed\xf7\x28\x91\x34\xad\x69\x87\x22\x5e\x16\xbe\x2e\x93\xf1\x24\x71\x92\x12\xc7\xda\x60\x29\x8b\x0b\x46\x1c\x87\x0d\xaa\x67\x82\xab\xd4\xff\x96\xe5\x31\x3a\x4f\xf6\x44\x59\x9f\x06\xf9\x89\xda\xff\x6a\x79\xc6\x44\x1e\xc8\xfb\x95\xcf\x05\xef\x02\x6d\x70\xf6\xe0\x94\xd0\x7f\xe4\x29\xd8\x55\x0b\x07\x9a\x29\x1d\x4e\xc7\xb7\xab\x6c\x92\xda\x5f\x1e\x8e\x2e\xc1\x95\xca\x87\x96\xad\x75\x29\x37\x96\xe6\xad\xa5\x88\xcb\x45\x15\xc4\x5b\x54\x9a\x45\x58\x3f\xe1\x4e\xbc\x97\xcf\x8e\x93\x67\x8e\xcd\x0d\x4f\x41\x28\x61\x59\xaa\x93\x19\xf2\x2e\xb4\x64\xb7\x1d\x7b\x4b\x69\x8d\xae\xb0\x2e\x4b\xf5\xaa\x7c\xfc\xe7\xb0\x8f\xa3\x56\xad\xc5\xc5\xde\xba\xb4\xe0\xea\x0a\xb7\x26\x0f\xc7\xa3\x1e\x8d\x0b\x4d\x1e\xaa\x32\x13\x05\x5b\xc6\xba\x5c\xd4\x3f\x03\x00\x7b\xec\x4a\x52\xc3\x05\x00\x00"
+
+func dataCommonDevDepVagrantfileFragmentTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevDepVagrantfileFragmentTpl,
+		"data/common/dev-dep/Vagrantfile.fragment.tpl",
+	)
+}
+
+func dataCommonDevDepVagrantfileFragmentTpl() (*asset, error) {
+	bytes, err := dataCommonDevDepVagrantfileFragmentTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev-dep/Vagrantfile.fragment.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevDepVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xa4\x94\x41\x6f\xdc\x36\x10\x85\xef\xfc\x15\xaf\xb2\x51\x24\x80\x25\xa1\x41\x90\x83\x1b\x07\x71\x63\xb7\xf1\xc5\x0e\x62\xb7\x3d\x14\x85\x41\x8b\xb3\x12\x1b\x2e\x87\x25\x47\xbb\xde\xac\xf7\xbf\x17\xa4\x76\x9d\xb8\x6e\x51\x03\xbd\x09\xa3\x99\x37\xdf\xcc\x3c\x69\x0f\x3f\x91\xa7\xa8\x85\x0c\x6e\x56\xb8\x10\xe1\x03\x18\x86\x67\x01\x19\x2b\xdf\xa8\x3d\xb5\x87\xab\xc1\x26\xd8\x04\x19\x08\xbf\xe8\x3e\x6a\x2f\x33\xeb\x08\xfd\xdf\x6b\x31\xe3\x88\x9b\xd1\x3a\x63\x7d\x9f\xd3\xd5\x1e\x82\xee\x3e\xe9\x9e\x20\x83\x96\x2c\x32\x26\x32\xd0\x09\x1a\x86\x02\x79\x43\xbe\x5b\x95\x3a\x43\x0b\x72\x1c\xe6\xe4\xa5\x29\x6d\x4f\x26\x8e\x41\x7b\x53\x67\x18\x48\xe6\xc8\x9d\x1b\x5c\x31\xe6\x6c\xec\x6c\x55\x82\x07\x59\xb5\xe0\x1d\x87\x50\x12\x94\xda\x82\x36\x1d\xfb\x99\xed\xc7\x48\xcf\xaa\x17\xd5\xf3\x3c\xdc\xdd\x14\xba\x53\xc0\xf4\xd4\x2c\xe6\xcd\x0d\xdf\xe2\x08\xd5\xa0\xd3\x60\x3b\x8e\xa1\x0d\x91\x3a\x9b\xe8\xd5\xcb\x4a\x29\x60\x0f\x97\x24\x63\x80\x46\x5a\xf9\x8e\x0c\x66\xec\x0c\x45\xcc\x22\xcf\xc1\x63\xc4\x92\xe3\xa7\x3c\xb4\xb1\x91\x3a\xe1\xb8\x82\x30\xda\xc5\x04\xf1\xa0\xd3\x24\x70\xbd\x15\xa8\xd6\x6b\x04\x2d\x43\xb3\x13\xd8\x6c\xaa\x03\x54\xbb\xca\xea\x40\x01\x00\x2f\x3d\xc5\x43\x54\xf7\x51\xf4\x91\xc7\xf0\x55\x64\x82\x3c\xf5\xfa\xc6\x11\x2e\x2f\xdf\x43\xf7\xe4\x25\x2f\x76\xa9\x63\x39\x47\x62\xf4\x24\x92\x1f\x43\xb4\x0b\x2d\xf4\xe5\x02\x96\x52\x99\x20\x7d\x21\x4d\x69\x68\xb6\xd5\xd7\x93\xd6\x11\x24\x8e\xf4\x9f\xdb\x58\x0e\x14\xa9\xec\xa4\xe3\x79\xb0\x8e\x0c\x8c\x16\x5d\x0c\xc4\xa5\xb8\x65\x11\x6e\xf0\x2b\xe5\x6b\x94\xa3\x0a\x43\x77\x1d\xa5\xc9\x63\xc5\x41\x48\x5d\xb4\x41\x9a\xa7\xec\xee\xbe\xd1\x66\xd3\x1a\x5a\xd4\x86\x42\x59\x62\xee\x53\x3d\x11\x38\x37\xee\x74\x37\x50\x3e\x21\x6c\x7a\x52\xdf\x92\xbf\xd9\xdc\x37\xab\x4b\x64\xdb\xf2\xcc\x27\xd1\xce\xe1\x9c\xcd\x6e\x26\xf2\x0b\x1b\xd9\x67\x8f\x3f\xd0\x0f\x91\x17\x36\x59\xf6\xa8\xd2\x40\xce\x55\x07\xb0\xde\x59\x4f\x87\xd8\x9f\xf6\x70\xed\xd9\x90\x22\x6f\x94\xfa\x3a\x82\x23\xbc\x7e\x7d\xf9\xee\xe3\xd9\x87\x2b\x95\x48\x50\x93\x52\x4c\xcf\x9e\x63\x8d\xfd\xb7\x78\xf1\xe6\xdb\xef\x70\x07\xc7\x7d\x4f\x11\xb5\x20\x33\xe2\x0d\xf2\x92\x5a\x3f\x3a\xf7\x3d\x36\x8a\x5d\x49\xa7\x6e\x60\x54\xbf\xe5\x8c\xdf\xb1\xff\xb6\xca\xaf\x14\xdd\x06\x8e\x82\x93\xd3\x1f\xce\x8e\xcf\xaf\x7f\xfc\x78\x71\x7e\x75\x7a\x7e\x72\xe4\xd9\x5b\x2f\x14\x75\x27\x76\x41\x8a\x09\x69\x34\x0c\x1d\xa4\xee\x49\x30\x06\x93\xdd\x55\xaf\x94\x62\x87\xea\x84\x97\xde\xb1\x2e\x26\x2c\xbb\x58\xaf\x91\xd9\xaf\x17\x14\xcb\xcc\x9b\x4d\xd3\x34\x55\x96\x59\xe6\xf2\xfa\x4f\xd4\x17\x68\x07\x9e\xd3\xee\x33\x68\x73\x7e\x23\x3a\x36\xfd\x67\x0c\x22\x21\x1d\xb6\x25\xf6\x47\x6a\x38\xf6\xad\xb1\x49\xda\xc5\x63\xdd\x92\x53\xff\xc3\x8b\xda\x59\x3f\xde\xd6\xb7\xaf\x5e\x6e\x55\x27\xd4\x9f\xbd\xe8\x18\x77\xa0\x3b\xaa\x32\x9c\xe8\x88\xfa\x1d\x5a\x0e\x82\xfa\xf6\xf3\xec\xdf\x01\x27\xa9\xcb\xed\xb7\x36\x06\x7c\x38\xbe\x7a\xff\x40\xcb\x79\xd4\xa9\x48\x3d\x01\xb0\xbd\xb1\xbe\xa4\xa1\x1d\x53\x6c\x1d\x77\xda\xdd\xc7\xfe\x8f\x64\x98\x3f\x52\x0c\xf3\x09\x7e\x6b\xdd\xcc\x5f\x8c\x5b\x53\x4a\xe4\xc5\x6a\x57\x7e\xd5\x5e\xe7\xbb\xef\x7e\xed\xe9\xc1\x6c\x3b\x13\xd8\x49\x02\xf5\xea\x91\x44\x6f\x45\x6d\x3d\xfb\xd7\x00\xc5\xc8\xfe\x4d\x81\x06\x00\x00"
+
+func dataCommonDevDepVagrantfileTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevDepVagrantfileTpl,
+		"data/common/dev-dep/Vagrantfile.tpl",
+	)
+}
+
+func dataCommonDevDepVagrantfileTpl() (*asset, error) {
+	bytes, err := dataCommonDevDepVagrantfileTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev-dep/Vagrantfile.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevDepBuildShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x74\x91\xc1\x8e\xd4\x30\x10\x44\xef\xfe\x8a\x62\x06\x09\x38\xd8\xf9\x00\x2e\x20\x4e\x5c\x00\x21\x6e\x08\xa1\x8e\xdd\x49\x9a\x4d\xdc\x96\xdd\xd9\x25\x8b\xf8\x77\x94\x0c\x23\x01\x23\x6e\x6e\x97\x55\x5d\xaf\x7c\x7e\xd2\xf5\x92\xbb\x9e\xda\xe4\xce\xee\x8c\xd7\xab\xa9\x1f\x39\x73\x25\xe3\x84\x7e\xc3\x7b\x33\x0d\x87\xf6\x69\x92\x06\x69\xb0\x89\xd1\xaf\x32\x27\xb4\x58\xa5\x18\x06\xad\x20\xbc\xd3\xc4\xbe\xa7\xc6\x09\xa5\xea\x37\x8e\x86\x75\x1f\xa8\x81\x90\xb8\x70\x4e\x9c\xe3\x16\x5c\x63\x83\x67\xe7\x74\x7e\xfe\x02\x3f\xc0\x71\x52\x9c\x3e\xab\x99\x7e\xc1\xd3\x57\xa7\x97\xf8\xe9\xdc\x19\x6f\xb4\x6c\xc7\x2a\x2a\x05\xba\x1a\x74\x38\xc6\xb6\xe5\xc8\x09\x83\xce\x89\x2b\x9a\x42\x72\x33\x9a\x67\xc9\x23\x92\x72\xcb\xcf\x0c\x8b\x26\x19\x36\x88\x39\x9d\x71\xda\x9d\x76\x95\x4a\x99\x25\x92\x89\xe6\x10\xc2\xc9\xd5\x05\xbe\x0e\xe8\x6c\x29\xdd\xbe\xdd\x1f\x50\x6e\xb9\x4b\x52\xe1\xcb\x8d\x10\x0b\xfc\x47\x74\xf7\x34\x56\xca\xd6\x85\x7f\x1f\x74\xff\x71\xec\xc2\x7e\xbe\xbd\xfd\x6d\x74\x23\x64\x4d\xfc\x75\xd1\xb4\xce\xdc\x5c\x4c\x37\x39\x0e\xa8\xb7\x7f\x50\x5f\xbb\x15\x6e\x07\x58\x2e\xcb\xb5\x15\x78\x5f\xaa\xa6\x35\xee\xd4\x7b\xad\x1f\x28\xde\xd1\xc8\xd7\x66\xaf\x85\xe0\x41\x6c\x82\x58\xfb\xcb\x0d\x92\x4d\xa1\x6b\x45\x9b\xa8\x72\x42\xa4\x38\xb1\x3b\x23\x49\xe5\x68\x5a\xb7\xfd\x03\x6c\x22\xc3\x03\x23\x52\x06\x53\x93\x79\x03\x7f\xb7\x4a\xd1\x20\x16\x8e\xb4\x97\xad\x92\xc7\x23\x9f\x51\x85\x8f\x8f\x03\x2e\x54\x87\x69\x97\xf8\xde\x27\x2e\x5e\x57\x2b\xab\x05\x1b\x1f\x11\xdc\xaf\x01\x00\x2d\xd8\x33\xf9\x9f\x02\x00\x00"
+
+func dataCommonDevDepBuildShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevDepBuildShTpl,
+		"data/common/dev-dep/build.sh.tpl",
+	)
+}
+
+func dataCommonDevDepBuildShTpl() (*asset, error) {
+	bytes, err := dataCommonDevDepBuildShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev-dep/build.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevDepUpstartConfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x54\x8f\x31\x4b\x03\x41\x10\x85\xfb\xf9\x15\x8f\x08\x5a\xc5\x98\xe8\x59\x5e\x6b\x69\x69\x21\xe1\x58\x6f\x27\xc9\xc2\xde\xcc\x32\x3b\x49\x94\xe3\xfe\xbb\x9c\x31\x88\xd5\xc0\xbc\xc7\xf7\xf8\x22\xd7\xde\x52\xf1\xa4\x82\xc5\x38\x42\xc2\xc0\x98\x26\x2c\xf1\xc2\xc2\x16\x9c\x23\x3e\xbe\xf0\xea\xae\x0b\x22\xe3\x5a\xc2\x59\xae\x17\x39\x0d\xc9\xb1\x6e\xd0\x10\x55\x0f\xe6\x50\x81\x1d\x25\xf3\x89\x33\xde\x37\x8f\x4f\xcd\x96\xaa\x6b\xf9\xff\x7f\x78\xde\x12\xdd\xe0\x8d\x11\x55\xee\x1c\xe7\x20\x0e\x57\x18\x5f\x20\xae\x8a\x5d\xa8\x8e\x9d\x1a\x22\x97\x4a\x45\xab\x2f\x7f\x40\xfc\xc9\x3d\x6a\x66\x2e\xf3\x68\x7f\x88\xc9\x30\x8e\x73\xab\x0b\xa5\x74\x25\xf8\x01\xd3\x44\x74\xd1\x22\x5c\x43\x3b\x4a\xd7\xeb\x30\x04\x89\xb3\x5f\xdb\xae\x4e\xc1\x56\x59\xf7\xab\x3f\xeb\xfb\xac\x7b\x6c\xda\xdb\x35\xb1\x44\xfc\x12\xbe\x07\x00\x89\xb0\xc2\xe2\x22\x01\x00\x00"
+
+func dataCommonDevDepUpstartConfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevDepUpstartConfTpl,
+		"data/common/dev-dep/upstart.conf.tpl",
+	)
+}
+
+func dataCommonDevDepUpstartConfTpl() (*asset, error) {
+	bytes, err := dataCommonDevDepUpstartConfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev-dep/upstart.conf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -207,6 +291,10 @@ var _bindata = map[string]func() (*asset, error){
 	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
 	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
 	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+	"data/common/dev-dep/Vagrantfile.fragment.tpl": dataCommonDevDepVagrantfileFragmentTpl,
+	"data/common/dev-dep/Vagrantfile.tpl": dataCommonDevDepVagrantfileTpl,
+	"data/common/dev-dep/build.sh.tpl": dataCommonDevDepBuildShTpl,
+	"data/common/dev-dep/upstart.conf.tpl": dataCommonDevDepUpstartConfTpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -267,6 +355,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
 				}},
 			}},
+			"dev-dep": &bintree{nil, map[string]*bintree{
+				"Vagrantfile.fragment.tpl": &bintree{dataCommonDevDepVagrantfileFragmentTpl, map[string]*bintree{
+				}},
+				"Vagrantfile.tpl": &bintree{dataCommonDevDepVagrantfileTpl, map[string]*bintree{
+				}},
+				"build.sh.tpl": &bintree{dataCommonDevDepBuildShTpl, map[string]*bintree{
+				}},
+				"upstart.conf.tpl": &bintree{dataCommonDevDepUpstartConfTpl, map[string]*bintree{
+				}},
+			}},
 		}},
 	}},
 }}
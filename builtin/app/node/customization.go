@@ -1,6 +1,8 @@
 package nodeapp
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/otto/helper/compile"
 	"github.com/hashicorp/otto/helper/schema"
 )
@@ -10,6 +12,16 @@ type customizations struct {
 }
 
 func (c *customizations) processDev(d *schema.FieldData) error {
-	c.Opts.Bindata.Context["dev_node_version"] = d.Get("node_version")
+	c.Opts.Bindata.Context["node_version"] = d.Get("node_version")
+	return nil
+}
+
+func (c *customizations) processDevDep(d *schema.FieldData) error {
+	cmd, err := c.Opts.Bindata.RenderString(d.Get("run_command").(string))
+	if err != nil {
+		return fmt.Errorf("Error processing 'run_command': %s", err)
+	}
+
+	c.Opts.Bindata.Context["dep_run_command"] = cmd
 	return nil
 }
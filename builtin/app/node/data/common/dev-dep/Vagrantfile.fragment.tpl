@@ -0,0 +1,45 @@
+# Generated by dependency for development: {{ name }}
+
+${{ name }}_setup = <<COPY
+set -e
+
+oe() { $@ 2>&1 | logger -t otto > /dev/null; }
+
+# Install Node if we don't have it already
+if ! command -v node >/dev/null 2>&1; then
+  oe wget -q -O /tmp/node.tar.gz https://nodejs.org/dist/v{{ node_version }}/node-v{{ node_version }}-linux-x64.tar.gz
+  oe sudo tar -C /opt -xzf /tmp/node.tar.gz
+  oe sudo ln -s /opt/node-v{{ node_version }}-linux-x64/bin/node /usr/local/bin/node
+  oe sudo ln -s /opt/node-v{{ node_version }}-linux-x64/bin/npm /usr/local/bin/npm
+fi
+
+# Extract our package
+sudo rm -rf {{ dep_app_path }}
+sudo mkdir -p {{ dep_app_path }}
+sudo tar -C {{ dep_app_path }} -xzf /tmp/dep-{{ name }}.tgz
+
+# Copy the upstart file
+sudo mv /tmp/dep-{{ name }}.upstart.conf /etc/init/{{ name }}.conf
+
+# Start it!
+sudo start {{ name }}
+COPY
+
+# Copy files into a temp directory. The script will move them.
+config.vm.provision "file",
+  source: "{{ path.cache }}/dev-dep-output.tgz",
+  destination: "/tmp/dep-{{ name }}.tgz"
+
+config.vm.provision "file",
+  source: "{{ path.compiled }}/dev-dep/upstart.conf",
+  destination: "/tmp/dep-{{ name }}.upstart.conf"
+
+config.vm.provision "shell",
+  inline: ${{ name }}_setup
+
+# Foundation configuration for dev dep
+{% for dir in foundation_dirs.dev_dep %}
+dir = "/otto/foundation-{{ name }}-{{ forloop.Counter }}"
+config.vm.synced_folder "{{ dir }}", dir
+config.vm.provision "shell", inline: "cd #{dir} && bash #{dir}/main.sh"
+{% endfor %}
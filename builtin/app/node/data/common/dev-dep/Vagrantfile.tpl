@@ -0,0 +1,50 @@
+# Generated by Otto, do not edit!
+#
+# This is the Vagrantfile generated by Otto for building the
+# package that is used as a dependency for development.
+#
+# Do not hand-edit this file. To modify this, use the Appfile.
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "hashicorp/precise64"
+
+  # Setup a synced folder from our working directory to /vagrant
+  config.vm.synced_folder "{{ path.working }}", "/vagrant",
+    owner: "vagrant", group: "vagrant"
+
+  # Enable SSH agent forwarding so getting private dependencies works
+  config.ssh.forward_agent = true
+
+  # Setup a synced folder from where our compiled data is to
+  # /otto. We do this to access the build script.
+  config.vm.synced_folder "{{ path.compiled }}/dev-dep", "/otto"
+
+  # Setup a synced folder from where the cache dir is
+  config.vm.synced_folder "{{ path.cache }}", "/otto-cache"
+
+  # Install Node build environment
+  config.vm.provision "shell", inline: $script_node
+end
+
+$script_node = <<SCRIPT
+set -e
+
+oe() { $@ 2>&1 | logger -t otto > /dev/null; }
+ol() { echo "[otto] $@"; }
+
+export DEBIAN_FRONTEND=noninteractive
+oe sudo apt-get update -y
+
+ol "Downloading Node {{ node_version }}..."
+oe wget -q -O /home/vagrant/node.tar.gz https://nodejs.org/dist/v{{ node_version }}/node-v{{ node_version }}-linux-x64.tar.gz
+
+ol "Untarring Node..."
+oe sudo tar -C /opt -xzf /home/vagrant/node.tar.gz
+
+ol "Setting up PATH..."
+oe sudo ln -s /opt/node-v{{ node_version }}-linux-x64/bin/node /usr/local/bin/node
+oe sudo ln -s /opt/node-v{{ node_version }}-linux-x64/bin/npm /usr/local/bin/npm
+
+ol "Installing build-essential for native packages..."
+oe sudo apt-get install -y build-essential git
+SCRIPT
@@ -0,0 +1,24 @@
+#!/bin/bash
+#
+# Auto-generated by Otto.
+#
+# This is the build script for a Node-based project used as a dependency.
+set -e
+
+ol() { echo "[otto] $@"; }
+
+# Copy the app out of the synced folder so installing doesn't modify it
+ol "Copying application..."
+rm -rf /tmp/otto-build
+mkdir -p /tmp/otto-build
+cp -R /vagrant/. /tmp/otto-build/
+rm -rf /tmp/otto-build/.otto /tmp/otto-build/.vagrant /tmp/otto-build/node_modules
+cd /tmp/otto-build
+
+ol "Installing dependencies..."
+npm install --production
+
+# Package the application with its dependencies into our shared cache
+# directory so that we can easily extract it.
+ol "Packaging..."
+tar -czf /otto-cache/dev-dep-output.tgz .
@@ -9,6 +9,8 @@ stop on runlevel [06]
 # We don't want to restart too fast for deps
 post-stop exec sleep 5
 
+chdir {{ dep_app_path }}
+
 script
   {{ dep_run_command }} >>/var/log/{{ name }}.log 2>&1
 end script
@@ -25,6 +25,7 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 			AssetDir: AssetDir,
 			Context:  map[string]interface{}{},
 		},
+		Builders: true,
 	}
 
 	return compile.App(&opts)
@@ -48,6 +49,22 @@ func (a *App) Deploy(ctx *app.Context) error {
 	}).Route(ctx)
 }
 
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and Terraform configuration.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	return terraform.Verify(ctx, &terraform.DeployOptions{})
+}
+
 func (a *App) Dev(ctx *app.Context) error {
 	return vagrant.Dev(&vagrant.DevOptions{
 		Instructions: strings.TrimSpace(devInstructions),
@@ -66,18 +83,10 @@ to the development environment automatically.
 
 To run and view your application, run 'otto dev ssh' to enter the
 development environment. You'll be placed directly into the working
-directory where you can run "composer", "php", etc.
+directory where you can run "composer", "php", etc. If your app has a
+composer.json, its dependencies were installed with "composer install".
 
 You can access the environment from this machine using the IP address above.
 For example, if you start your app with 'php -S 0.0.0.0:5000', then you can
 access it using the above IP at port 5000.
 `
-
-const buildErr = `
-Build isn't supported yet for PHP!
-
-Early versions of Otto are focusing on creating a fantastic development
-experience. Because of this, build/deploy are still lacking for many
-application types. These will be fixed very soon in upcoming versions of
-Otto. Sorry!
-`
@@ -71,7 +71,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataAwsSimpleBuildBuildPhpSh = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x55\xdd\x6e\x1b\x37\x13\xbd\xdf\xa7\x98\x28\xc6\x97\xaf\x45\x28\x2a\x06\x5c\x14\xf2\x0f\xaa\xc8\x4a\x6d\x20\x90\x0c\xa9\x4d\x2f\x5c\x23\xa0\x96\xb3\x2b\xc6\xbb\x24\x43\x72\x25\xcb\x8e\xde\xbd\x43\xae\x2c\x5b\x2e\xea\x2b\x71\xc9\xf9\x39\x73\xe6\xcc\xe8\xed\x1b\x3e\x57\x9a\xcf\x85\x5f\x64\x99\xc7\x00\xcc\x80\x36\x8d\xde\x1e\xd1\x39\xbc\x53\xe9\x68\x95\xc5\x42\xa8\x6a\x7b\x1d\x9c\xc8\x31\xcb\xe8\x64\xdc\xff\x7f\x82\x87\x0c\x00\x2a\x93\x8b\x0a\xbc\x69\x5c\x8e\x85\xaa\xf0\xf4\xe0\xc3\xd3\x75\xa5\x34\x6a\x73\x7a\x70\x18\xaf\x30\x5f\x18\xe8\x8c\xa6\xd3\xc9\x14\x44\x80\x83\x87\x27\xa7\x4d\xff\xe0\xa1\xb5\xdd\x1c\xc3\x67\xe1\x03\xf9\x97\xbe\xdf\x89\x6e\xa5\x43\x0b\x26\x04\x03\x7c\x29\x1c\xa7\x07\xee\xd7\x9e\x7e\xe0\x07\x84\x84\x4d\xc3\x61\x2f\xdb\x64\x84\xce\xc2\xbb\x04\x0e\x3a\x07\x0f\x1f\x07\xb3\x8b\xaf\xb3\xc9\x9f\xd3\xe1\x68\xd3\x89\x17\x9f\x2f\xc7\xa3\xf1\x64\xd3\x79\x07\x84\x21\xcb\x0c\xc6\x12\xe8\xe1\xb7\x0e\x1c\x9e\xfd\xef\x03\x85\xa3\xa0\x25\x3a\x60\xa1\xcd\x77\x06\x5c\xe2\x92\xeb\xa6\xaa\x8e\x61\x93\x99\x2a\x39\xb4\x65\x5c\x47\x8b\x1b\x20\xe7\xf8\x94\xbd\x85\xbc\x32\x8d\x64\xb9\xd1\x85\x2a\x21\x17\x1a\x94\x0e\xe8\x0a\x74\x08\x2b\x15\x16\x20\x6c\x80\xdc\xd4\xb5\xd0\xd2\x83\x2a\x40\x85\x77\x1e\x7c\x50\x55\x45\x96\x60\x9d\xa1\x3a\xbd\xa7\x24\xd0\xf9\x4b\xa8\xa0\x74\x09\x05\x15\xb2\x17\x96\x30\x51\x08\x5b\x61\xc0\x6e\xb7\xdb\xc9\x1a\x4d\xfe\x70\x7d\x0d\xac\xd8\x92\xa3\xe6\x3c\x79\x70\xa5\x7d\x10\x3a\x47\x3e\x37\x26\xb0\x42\x69\xe5\x17\x28\xe1\xe6\xe6\x18\xa4\x21\x5a\x7d\x85\x44\x6b\xaf\x7b\x94\x49\xa3\xa9\xa7\x31\xef\x40\xca\x98\x36\x22\x25\xce\x8d\x57\xc1\x38\x85\x1e\x08\x32\x34\x56\x8a\x08\x2a\xe5\xc5\x3b\x6b\x5c\x80\xf3\xd1\xc7\xcb\xc1\xf8\xeb\xa7\xe9\x64\xfc\xc7\x68\x7c\x7e\xaa\x8d\x4e\x45\x8b\x3c\xa8\x25\x12\xc1\xe0\x1b\x69\x62\x3c\x56\x92\xb4\x52\x08\x04\xb6\xfe\xd7\x4b\x02\x4b\x44\xb0\x35\x09\xa9\x08\x2b\xe1\x90\x11\x23\x16\x5d\xa0\xfc\x2c\xd2\x66\xf4\x93\x97\x94\x2c\x7a\xee\x30\xae\xa3\xa3\xb5\xa2\x6f\xb4\x74\xf8\x8d\xdb\x85\x3d\x62\x47\xdd\x5f\xa8\x2b\x33\xc4\xda\x47\xde\xe6\x48\x35\x7d\x6f\x94\x23\x12\xe8\xd3\x3a\x5c\xa2\x0e\xd0\x69\xb4\x68\xc2\x82\x8e\x2a\x27\x70\x12\xac\xc8\x6f\x45\x89\xbe\x43\xce\x49\x4a\x1e\x4c\x43\x7a\x28\x5e\x82\xed\xee\x55\x71\x8b\xeb\x6d\x7d\xaf\x94\x9d\x48\xbe\x6c\xdd\x23\xd1\x57\x17\x57\xf0\x65\x38\xf3\xef\x61\x40\x69\x17\xf8\x3e\x31\x6d\x08\x8e\xdb\xe1\x48\x7c\xbf\xc2\x97\x48\x9e\x87\x10\x6b\xa6\x71\x9b\x6f\xbf\x59\x6d\x24\x4b\x77\x7f\x53\xb3\xe7\xf7\x0e\x4a\x9a\xe8\x1a\x5d\xde\x38\x45\x83\x39\x6f\x54\x25\x19\x09\x2e\x56\x4e\xdf\xd1\x8a\x9e\xda\x43\xe2\xaf\xce\xdd\x9a\x84\xd0\x9e\xd7\xfe\x7b\xd5\x1e\x0b\x5b\xb7\x87\x52\xb6\xbf\x0e\x85\x8c\xa3\xdb\x7e\xd9\x92\x2c\xdb\x42\x47\x77\x21\x29\x21\x29\xca\xa6\x3a\x52\x11\xf5\xad\x54\x34\x63\x16\xb8\x77\x4b\x1e\xc7\x88\xba\x69\xdb\xb7\x20\x1c\xdc\xdf\x91\x98\x43\x6d\x77\x4f\xdd\x50\xde\x03\x1b\xbe\xb0\x4f\x39\x66\x18\x52\x02\x52\x4a\xad\xbc\x57\x46\xef\x13\x46\xa3\xba\xd2\xc0\xa6\xb0\x5a\xad\x18\xb5\x41\xf4\x5f\x46\xa1\x49\x6c\xc7\xe7\xf9\x35\x8f\x53\x66\x3c\xba\xee\x37\x6f\x34\xd0\xcc\x44\x89\x10\x33\x2f\x1a\x38\x7c\x34\x8b\x39\x89\x40\x99\x80\x3f\x52\xc9\xfc\x0c\x16\x21\x58\xdf\xe7\x9c\xba\xb6\x8b\x69\x5c\xc9\xb7\x1d\xa4\x46\xff\x88\xc4\xc5\xd0\x5b\xc8\xf5\x12\x76\x96\x76\x41\x7c\xf0\xc6\xc7\xad\x47\xeb\x34\x6d\xed\xc7\xc7\x6c\x0b\x67\xda\x68\x1d\xb1\x3c\xde\xef\xf4\xd9\x62\x7a\x0c\xcb\x9a\x1d\x07\xff\x15\xf1\x49\x56\x6c\x65\xdc\x2d\x05\x65\xb1\x53\x7b\xcc\xd0\x9b\x36\x8c\xb6\x62\x7b\xd8\x8d\x3b\x8d\x67\xa1\xb2\xb6\x29\xc3\xb4\xac\x48\x67\xa9\xf3\x51\x8e\x7b\x3d\x71\x35\x70\x62\x83\x6f\x95\xca\x69\x84\x69\xc6\x51\x8b\x79\x85\x92\xf7\x7a\x3d\x0a\x5f\x88\xa6\x0a\xdd\xb8\xf5\xb2\x8c\xe6\x12\x4e\x4e\x06\x57\x83\xe1\xc5\x68\x38\x19\x7f\x22\xc6\x5a\xad\x20\xbe\x16\x68\xa7\x9e\x18\xe5\xf9\x2a\xa7\xc1\xfe\x1d\x35\xc1\x8e\xe3\x3e\x5f\xc3\x84\x0c\xb3\x93\x2f\xca\x85\x46\x54\x17\x86\xfe\x7b\x7e\xee\xff\xda\x3b\x23\xee\xce\x4d\xde\xd4\x34\x20\x53\x5a\xa1\x2f\x75\x03\x70\x72\x4e\xcb\x24\x4f\xeb\x67\x5f\x3c\xd1\x15\x60\xda\x6e\x1b\x88\x84\x96\x4e\x10\x51\x32\x3a\xf1\x9d\xd7\x59\x76\xc2\x9f\x65\x3d\xcb\x9e\x4a\xdc\x12\x49\xb4\xc5\xe5\xfc\xa6\x93\xfd\x13\x00\x00\xff\xff\x89\xa6\x02\xdd\xb2\x07\x00\x00"
+var _dataAwsSimpleBuildBuildPhpSh = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x7c\x55\x6f\x6f\x13\xb9\x13\x7e\xef\x4f\x31\xa4\xd1\xaf\xbf\x93\xd8\x6c\x41\x70\x3a\xb5\x94\xa3\x94\x14\x2a\x41\x5a\xa5\xdc\x1f\x09\xaa\xc8\x59\xcf\xee\x9a\x7a\x6d\x77\x3c\x9b\x26\xb4\xb9\xcf\x7e\xb2\x37\x49\x49\x39\x78\x93\x78\xed\x99\x67\x9e\x79\x66\x3c\xde\x79\x94\x4f\xb5\xcd\xa7\x32\xd4\x42\x04\x64\xc8\x1c\x58\xd7\xda\xd5\x12\x89\x70\xae\xd3\xd2\x6b\x8f\xa5\xd4\x66\xb5\xcd\x24\x0b\x14\x02\x89\x1c\xfd\xff\x17\xb8\x15\x00\x60\x5c\x21\x0d\x04\xd7\x52\x81\xa5\x36\x78\xd8\x7f\x72\xbf\x6d\xb4\x45\xeb\x0e\xfb\x4f\xe3\x16\x16\xb5\x83\xde\x70\x3c\x3e\x1b\x83\x64\xe8\xdf\xde\x3b\x2d\xf7\xfb\xb7\x9d\xed\xf2\x00\xde\xcb\xc0\x60\x5c\x15\xf6\x7b\xd1\xad\x22\xf4\xe0\x98\x1d\xe4\x33\x49\xb9\x71\x55\x1e\x16\xc1\xb8\x0a\xee\x80\x13\x37\x0b\x4f\xf7\xc4\x52\x30\x49\x0f\xbb\x89\x1c\xf4\xfa\xb7\xaf\x8f\x2e\xde\x4d\x2e\xce\xfe\x18\x1f\x0f\x97\xbd\xb8\xf1\xfe\x74\x34\x1c\x9d\x2d\x7b\xbb\x30\x1c\x8f\x85\x70\x18\x53\x80\x5e\xff\x55\x0f\x9e\xbe\xfc\xdf\x13\xb8\x8b\x41\x2b\x24\xc8\xb8\x8b\xf7\x12\x72\x85\xb3\xdc\xb6\xc6\x1c\xc0\x52\x38\x93\x1c\xba\x34\x3e\x45\x8b\x4b\xe8\xbf\xea\xc5\x23\xb1\x03\x85\x71\xad\xca\x0a\x67\x4b\x5d\x41\x21\x2d\x68\xcb\x48\x25\x12\xc2\x8d\xe6\x1a\xa4\x67\x28\x5c\xd3\x48\xab\x02\xe8\x12\x34\xef\x06\x08\xac\x8d\x01\x6d\xc1\x93\xab\x08\x43\x10\xce\x40\xef\x2f\xa9\x59\xdb\x0a\x4a\x47\xdb\xb0\xec\x22\x84\x37\xc8\x38\x18\x0c\x7a\xa2\xb5\xac\x0d\x7c\xfa\x04\x59\xb9\x12\x47\x4f\xf3\xe4\x91\x6b\x1b\x58\xda\x02\xf3\xa9\x73\x9c\x95\xda\xea\x50\xa3\x82\xcb\xcb\x03\x50\x4e\x00\x04\x83\xe8\x61\x6f\xf0\x5c\x28\x67\x51\xa4\xb8\x47\x4a\xc5\xb0\x91\x29\xa1\x77\x41\xb3\x23\x8d\x01\xa4\x55\xd0\x7a\x25\x23\xa9\x14\x17\xe7\xde\x11\xc3\x9b\xe1\xeb\xd3\xa3\xd1\xe4\x64\x7c\x36\xfa\x38\x1c\xbd\x39\xb4\xce\xa6\xa4\x65\xc1\x7a\x86\xc2\x21\x84\x56\xb9\x88\x97\x55\xc8\x1d\x04\x42\xb6\xf8\xee\x24\x91\x35\x06\xb2\x05\x04\x57\xf2\x8d\x24\xcc\x3c\x39\x8f\xc4\x1a\x43\x16\x65\x73\xf6\xde\x4b\xa9\x2c\x7a\x6e\x38\x2e\xa2\xa3\xf7\x72\xdf\x59\x45\xf8\x25\xf7\xb5\x7f\x9e\x3d\x1f\xfc\x2a\x76\xe0\x02\xb1\x09\xc0\x0e\xa6\x08\x84\xd7\xad\x26\x54\xf1\xd3\x13\xce\xd0\x32\xf4\x5a\x2b\x5b\xae\xd1\xb2\x2e\x24\xa3\x02\x2f\x8b\x2b\x59\x61\xe8\x89\x1d\x48\xad\x14\xc0\xb5\x0c\xae\x7c\x48\x76\xb0\x95\xc5\x15\x2e\x56\xf9\xfd\x24\xed\x24\xf2\x69\xe7\x1e\x85\x3e\x7f\x77\x0e\x7f\x1e\x5f\x84\xc7\x60\x2b\x6d\xe7\x8f\x93\xd0\x8e\x6b\xa4\x0d\x8d\x24\xf7\x4f\xe4\x4a\x8e\x90\x12\x2e\x8c\x86\xcf\x02\x60\xfa\x95\xa0\xd2\x0c\x0d\x52\xd1\x92\x96\x06\xa6\xad\x36\x2a\xc3\x10\x62\x9a\xd2\x24\xab\xa2\xa5\x6e\x91\x7c\x9b\x82\x16\x9e\x57\xeb\x45\xb8\x36\xdd\xb2\xf4\x4d\xb7\xa8\x54\xf7\x4f\x28\x55\xbc\xa7\xdd\x97\xaf\xc2\xb5\xe9\xb2\x1a\xce\x39\x95\x3d\xb5\x8f\x4f\xac\x13\xe5\xe6\x4a\x69\x82\xcc\x43\x1e\x68\x96\xc7\x3b\x93\x49\xef\xbb\x33\x96\x04\x5f\xe7\x25\xe4\xdc\xf8\xcd\xd1\x80\xab\xaf\x90\x1d\x3f\xb0\x4f\x31\x2e\x90\x53\x00\x8f\xd4\xe8\x10\xb4\xb3\xdb\xf2\x14\xb5\xbb\xb1\x90\x8d\xe1\xe6\xe6\x26\x53\x92\xe5\xfe\x43\x14\x5d\x42\x77\x57\xbe\xdd\xce\xe3\x95\x72\x01\x69\xf0\x25\x38\x0b\x97\x07\x10\xfb\x41\x00\x3c\xa8\xd6\xf1\xda\x2c\xc6\x04\x28\x54\x22\xbe\x96\x32\x0b\x17\x50\x33\xfb\xb0\x9f\xe7\x15\xf2\x06\xd3\x51\x95\xaf\xea\x85\x04\x77\x51\xb8\x08\xbd\xa2\xdc\xcc\x60\x63\xe9\x6b\x49\x90\xb7\x21\x8e\xb8\x42\x9a\x34\xa2\xd7\x87\x62\x45\x67\xdc\x5a\x1b\xb9\xac\xf7\x37\xcd\xd8\x71\x5a\xc3\x66\xed\x46\x83\x1f\x21\xde\x37\x51\x76\xe3\xe8\x4a\xdb\x2a\x8b\x95\xda\x52\x06\xb2\xcc\xba\x4c\xe1\xac\x5b\x6c\xee\xb6\xb3\xa2\xd4\xa2\x2b\xca\x71\x9a\x4c\x2d\x45\x56\x5d\x33\xc6\x26\x3e\x7f\x77\x9e\x9d\x9c\x7f\xd8\x2a\x0f\x35\x90\x23\x17\x79\xb2\xca\x83\x66\x0c\x19\x5a\x39\x35\xa8\x72\x85\xa5\x6c\x0d\xc7\x39\xfa\xb1\x46\xa8\x91\x50\xb9\x02\x74\x80\xeb\xd6\xc5\x6b\x19\x1c\x70\x2d\x39\x96\x66\x15\x66\x26\x49\x47\xe7\x00\x92\xd0\xee\x32\xe0\xdc\x4b\xab\x50\x89\x42\x32\xbc\x78\xb1\x3b\x7a\x7b\x3a\xfa\xfb\xf8\x6c\x74\xb2\x0b\x77\x1d\x03\x46\xfc\x31\x85\x4d\x03\xc6\x59\xfb\xed\xe8\x17\x3b\xf0\x16\x2d\x52\x1a\x0f\xd3\x05\x9c\x31\x3b\x11\x90\x66\x48\xe9\x05\x34\x3a\x30\x5a\xf8\x6d\xef\x40\x00\x90\x73\xbc\x2d\x62\xdc\xd5\x56\xe1\xbc\xfb\x1d\xf8\xda\xaf\x56\x35\x37\xe6\x20\x56\x36\x56\x27\xaa\x0a\x79\x02\x04\x60\x5a\x4c\xe2\xb3\x18\xa0\xdf\x92\x4e\x3f\x39\xe4\x1b\xff\xdf\xfb\xd7\x2d\xd2\x62\x12\x38\xca\x1e\x03\x2c\xb7\x60\xfe\x81\xcf\xd1\xac\xff\xdf\x68\x87\xcf\xf6\x9e\x1d\xa4\x83\x52\x06\x2e\x2a\x3d\xf1\x32\x04\x68\xad\x9e\xef\xa7\x47\x84\x5a\x9b\xaf\xaf\xff\x20\xb8\xe2\x6a\xdb\xfa\x41\x32\x0f\xa1\x48\x36\x70\x71\x3c\x3e\x3d\xff\x38\x39\x39\x7d\x3f\x1c\x1d\x7d\x18\x42\x5f\xb9\xa2\x6d\xd0\xf2\x24\xea\xd3\x5f\x1b\x87\x82\xb4\xe7\x89\x95\x0d\x76\x28\xda\x16\xa6\x55\xb8\x8d\x16\xba\x04\x97\x62\x53\x50\xb1\xe9\xa9\x58\x06\x5d\xe0\xfd\xb4\x22\x0c\x2c\x89\xbf\x33\xe8\x7a\x66\x7d\x9a\x3a\x77\x30\x18\xc4\xa7\xef\x51\x4f\xfc\x3b\x00\x4b\x25\xf9\x3d\x10\x09\x00\x00"
 
 func dataAwsSimpleBuildBuildPhpShBytes() ([]byte, error) {
 	return bindataRead(
@@ -91,7 +91,7 @@ func dataAwsSimpleBuildBuildPhpSh() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\x5d\x6f\x9b\x30\x14\x7d\xe7\x57\x58\x48\xe9\x53\x80\x6c\xad\xa6\x69\xaf\xfb\x19\x55\x44\x0d\xb8\xe1\x2a\xb6\xb1\x7c\x4d\xa6\x16\xf9\xbf\xef\x9a\xef\x4c\x0d\xcd\x9a\x17\x23\x9f\xc3\x39\xc7\x87\xeb\x74\x11\xa3\x5f\xac\x40\xe7\x86\x97\x67\x61\xf3\x8b\xb0\x08\x8d\x8e\x7f\xb1\xf8\x90\xfe\x4c\x0f\xf1\x3e\x1a\x38\x17\x6e\x81\x17\x52\x20\x41\xc3\x6b\xb4\xc9\xff\x60\xce\xcb\x52\x20\xe6\x67\xf1\x46\x88\x6e\xa5\xdc\xaf\x51\x14\xa5\x15\xee\x16\x6a\xc5\x69\x30\xbb\x42\x50\xb6\x27\xca\xe3\xea\x11\xe8\xf7\xfd\x14\xc4\xd8\xe6\x02\x21\x23\x25\x25\xc2\xf3\xf8\x56\xb7\x63\xaf\x8d\x65\x15\x58\x06\x9a\x1e\x5b\x5d\x71\x47\xac\x9c\x76\x30\x2d\x5a\x90\x15\xdb\xf9\x89\x3c\xae\x24\xe7\xde\x8c\x08\xa7\xc5\x5a\x48\x19\xef\x17\x00\xb4\x04\x1d\xa0\xe7\x58\x9d\x83\x6c\x62\x58\xe6\x94\xc9\x1a\xe7\x9a\x6c\x31\x48\xba\x2e\x38\xcb\xa6\x31\xe9\x6f\xda\x75\xc2\x32\xef\xe3\xe3\xa8\xe4\xf7\xb7\x3d\x5f\x41\x8a\xb5\x25\x36\xad\x2d\x7b\x84\x34\x83\xa5\xf7\xd9\x1a\xaf\x04\x3a\xd0\xbd\x6b\x20\xfd\x47\x9a\x3b\xc2\x6c\x15\x50\x56\xf7\x1e\xdd\x7b\xf6\xf0\xc0\x0a\x8e\x35\x4b\x33\xc5\x41\xa7\x58\x7f\xd0\xc5\x8e\x09\x5d\x85\xef\xb5\xf5\x49\x36\xea\xd9\x31\x1a\xd4\x82\x42\x28\x52\xa0\x14\x2d\xd2\x39\x5f\xe6\xc1\x79\xa1\x33\x0f\x1e\x2b\xda\x3d\x4d\x26\xdc\x98\xd4\x9d\xde\xbf\x54\x18\x96\x16\x8c\x0b\x50\x3f\x6e\x89\xa9\x4d\x38\xfd\x24\xd5\xaf\xc7\x69\x8c\x7b\xca\x38\xc2\xf3\x7d\xd2\x5c\xf5\xd2\x21\xca\xac\x3c\x1b\x72\xc5\xdf\xa9\x74\x51\xe0\x82\x5d\xdd\xbe\x5b\xbd\x5c\x5f\xd3\xed\x72\xe2\xab\x1b\xbb\xa5\xb8\x10\x3f\x51\x9c\x6f\xf9\x96\xda\x40\xfa\x2c\x5b\x3f\x01\x39\x57\x30\xf4\x01\xc9\xf7\x6f\x3f\x1e\x0f\xd5\xd3\xd3\xc2\x01\x8d\x8e\x6b\x62\x4d\xb5\x95\x8f\xa9\xe4\xf6\x24\x56\x32\x58\xe7\xc1\x79\xaa\xbb\x2d\x68\x76\xdb\x55\xa9\x0a\xf2\x09\xeb\xba\xf0\x44\x63\xfd\x6f\x76\x5a\x69\x88\xb8\x32\x1f\x25\x1e\xfe\xb2\x8e\x51\xe4\xa3\xbf\x01\x00\x00\xff\xff\x4f\xd6\x14\x86\x64\x05\x00\x00"
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\xc9\x6e\xdb\x30\x10\xbd\xfb\x2b\x08\x01\xc9\x29\x96\xb3\xa1\x28\x02\xe4\x54\xa0\x97\xf6\xdc\x4b\x60\x28\xb4\x44\x5b\x84\x29\x4a\xe0\xe2\x22\x71\xf5\xef\x1d\x8a\x5a\x48\xad\x76\xd1\x5c\x62\x73\xde\x6c\x8f\xc3\x99\xf1\x79\x85\xe0\x2f\xc8\x28\x8f\x0a\x1c\x1f\x89\x88\x4e\x44\x48\x9a\xf3\xe0\x05\x05\xf7\xe1\xd7\xf0\x3e\xb8\x5b\x59\xcc\x09\x0b\x8a\x77\x8c\x48\x10\x9d\xab\x23\x84\xce\x37\x68\x9f\x0b\x74\x44\x94\xa3\x9d\xa6\x2c\x89\x08\x3f\xa1\x9b\xb2\x16\x07\xed\x59\x74\x3e\x03\xaa\x2c\x8d\x59\xb0\xd8\x6a\x13\x9e\x18\x03\x9d\x06\xfe\x2d\x23\x1c\xc7\x44\xca\xe8\x48\x3e\x3c\x78\x25\x93\x24\x16\x44\x8d\xcb\x54\x7e\x24\xdc\x3f\x96\x32\x35\xd8\x88\xe3\x8c\x0c\x25\x85\xa0\x27\xac\x48\x85\xd8\x53\x46\x86\x26\x05\x39\x58\x32\xb8\x66\xac\xd3\x65\xfa\x00\x7c\xa9\xb4\x2f\xb0\xf9\x5a\x25\xd9\xf3\x97\x62\x41\x4c\x6a\xb9\xe6\xaa\x27\xb3\x6a\x94\x4b\x85\x79\x4c\x22\xf5\x51\x54\xa1\x00\x67\x23\x92\x3f\x09\xd9\x63\xcd\xd4\x4b\x10\x3f\x85\x0c\x8b\x03\x09\x0c\xb1\x9d\xa3\x5c\x0b\x80\xe2\x8c\xd6\x36\xba\x83\x4e\x15\xbe\xac\x1f\x1f\xbe\x3c\xdd\x27\xcf\xcf\xbe\xfa\xa9\x88\x23\x9a\xf4\x62\xd7\x3b\x0e\xa4\x0f\x8e\x8b\x5c\x19\x0e\x63\x32\x75\x1e\x61\xad\x72\xf8\x98\x27\x3a\x56\x15\xa8\xc2\x94\x4d\x4d\x81\xe4\x44\x4d\xb9\x41\xd1\x81\xf8\xcd\x2f\xab\x84\x0a\x53\x58\x7b\x60\x2c\xc1\x0a\x50\x11\x9c\xc8\xb0\xe2\xa4\x2b\x99\xa6\x16\xc1\x5c\x43\x9c\x4c\x09\x63\x6d\x3c\x20\xa0\x9c\x51\x6e\x44\x6f\x41\x76\x34\x66\xd7\x05\xda\xa8\xac\xd8\xe4\x4a\xe5\x9b\xce\xc1\x1a\xe8\x02\xcf\x2c\xcf\x8b\xf0\x9b\xb9\x28\x22\x0c\x39\xdb\xda\x52\x79\x37\xed\xb3\xaa\x1f\xc7\xa5\x65\xbd\xbe\x02\xe3\xb2\x2c\x37\xae\x3c\x21\x52\x51\x5e\x79\x35\xa0\x2b\xa2\xb9\x20\x98\x39\x02\xe2\xe4\xd2\xd4\xcb\x12\xdd\xde\xa2\x1d\x96\x29\x0a\x37\x19\xa6\x3c\x94\xe9\x08\x17\xc3\x57\x7c\x1d\x3d\x37\x08\x7a\xce\x0e\x82\xc8\xc0\x02\x44\xa1\x25\xe4\xf9\xde\xbe\xb1\x77\xc8\xd9\xfa\x70\x60\x97\x30\xb9\xc6\x45\x11\xaa\xc3\xe7\x3f\x11\x26\x63\x41\x8b\xaa\x64\xab\x72\x5b\x17\x69\x61\xb2\x87\x40\xe8\xde\x6b\x75\x8e\x0e\x1c\x50\x91\xf3\x8c\x70\x15\x41\xb7\x74\x2b\xfa\x82\x66\x59\x99\xa8\xdb\xe4\xeb\x04\x29\x4e\x3f\xed\x33\x52\x6b\xfa\x8a\xe3\xdc\xd9\x24\x78\xae\xda\x0b\xff\x89\xa5\x32\xb9\x58\x2c\x08\xbd\xa8\x46\x6e\x18\xa1\xed\x10\x5b\x5a\xc3\xed\xa3\x8e\x2c\x89\x0e\x49\xd7\x31\x0f\x29\x0d\x6c\x39\xf5\xdf\x0f\x60\xdb\x74\x95\x8a\xa5\xba\xa3\xd4\xa4\xd7\x47\x2d\xf5\x20\x35\x9c\x35\x1d\xab\x19\x0f\xa6\x6c\x86\xec\x7c\x87\xae\x63\xb2\x58\x37\x0d\xb9\x7a\x87\x8e\xfb\xae\xf5\xf5\x5b\xb7\x7d\xb1\xdd\x48\xf1\x26\xdb\xc4\x25\xfb\x23\x70\xbe\xfc\x03\x7f\x1e\xce\x58\xec\x80\x0b\x16\xdb\x29\x3a\x63\xac\xc2\x2c\xd8\x69\x47\xe7\x9c\x21\x0b\x5a\xca\xd1\x9f\x68\x13\xfd\xa2\x05\xcd\x5b\x73\x5e\x30\xa8\xbd\xbe\xc2\xa5\x64\xf8\x13\xfa\x1f\xd9\xc9\xc0\x59\x44\xba\x39\x38\xe1\xd0\x02\x96\x42\x77\x27\xe7\x54\xe4\x0d\x66\xc1\xd6\x70\x3f\x98\x6b\x12\x1e\x7a\x29\x4a\x6f\x90\x4f\x85\xd9\x82\x2e\xb6\x36\x18\xff\x8b\xa6\x3d\x8d\x25\x3f\xb0\xc1\x19\xfd\xe6\xf1\xea\x1d\x4c\x2d\x3d\xd8\xfd\x0a\x4c\x45\xbb\xff\x4d\x05\xe0\xac\x89\x17\x78\x1d\xdb\x1b\x67\x2c\xf7\xe1\x8b\x05\xda\xeb\xab\x66\x5b\x73\x97\xca\xd9\x7b\xaf\x71\x0b\x59\x18\x8b\x46\x6b\xce\x9e\xbf\xb1\xfe\x97\x57\x65\x61\xa7\x9c\xe9\x8c\x44\x92\x7e\x12\x27\x49\x86\x35\x8f\xd3\x68\xc7\xf2\xf8\x18\x25\xe4\x04\xd5\x60\x3b\xb8\x3b\xe9\xcd\x69\x7b\x99\x1b\xf8\xbe\x91\x09\x7e\x70\xc7\x87\x63\xdc\xfc\x50\x39\x7b\xde\xca\x72\x88\x6c\x5e\xd3\xa1\x78\xf4\xb7\x0a\x46\xe0\xce\x60\xf2\xc0\x26\x94\x75\xfb\x85\x12\x9a\x34\x13\x68\x3b\x7d\x67\x36\x53\x85\x0f\xd2\x49\x51\x68\xb0\x06\x47\xce\x2f\xa8\x76\x2d\x50\x66\x36\xd5\x78\x33\x3e\x54\xf8\x83\x7c\xd4\xbf\x98\xaa\xaf\xbf\x30\xd3\x26\x83\x2b\xc7\xf7\xc8\xf0\x2e\x67\xc3\x1e\x2b\xbe\xf6\xf9\x9c\xcd\xa7\xb2\x9c\x9e\x91\x68\x6a\x46\xa2\x7e\x95\xc1\x7f\x58\xdc\x70\x56\x8c\xd5\xd5\xca\xd9\x27\x16\x32\x75\x13\xdc\xae\x56\xe5\xea\x2f\x26\xb1\xc1\x2e\xd4\x0e\x00\x00"
 
 func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +111,7 @@ func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x52\xc1\x8e\xdb\x20\x10\xbd\xe7\x2b\x46\x74\x8f\x5d\x27\xed\xb1\x52\xcf\xbd\xb5\x1f\x50\xad\x10\xc6\x24\x45\x8b\x01\xc1\x90\xca\xb2\xfc\xef\x1d\xa0\x16\xc1\xbb\xbd\x36\x39\xf9\xcd\x63\xde\xcc\x9b\xf7\x01\xbe\x29\xab\x82\x40\x35\xc1\xb8\xc0\x0f\x44\xf7\x11\x26\x07\xd6\x21\xa8\x49\x23\xcc\xc2\x26\x61\xcc\x72\x3a\xdd\x45\xd0\x62\x34\x0a\x98\xb6\xd7\x20\xb8\x9e\x18\xac\xdb\x03\x2c\x7e\x47\x2e\xa4\x54\x31\xf2\x57\xb5\xbc\x53\x8c\x4a\x06\x85\xff\x28\x06\x75\xd3\xce\x1e\x0a\x44\xe5\x56\xcc\xaa\xc0\x8f\x0f\x66\x7d\x60\x6a\x1b\x51\x58\xa9\x38\x2e\x3e\xd3\x61\x52\x57\x91\x0c\xc2\x57\x60\xf8\x79\x98\xb5\x0c\x8e\xc1\xe3\x8b\x98\x46\x4b\xd3\xf8\x34\x1a\x2d\x0f\xdd\xee\x5e\x72\xa9\xa7\xf0\x0e\xfc\x77\xed\x93\x0f\xee\xae\x27\x15\xca\xf4\x04\x9d\x00\xda\xf2\x59\xf5\x69\xa5\x87\x43\x6f\xca\xc6\x88\xd6\x6c\xe8\x69\x0d\x2f\xb4\x6a\x08\xe4\x5f\x47\xab\x38\x51\x68\x88\xa0\xa2\x4b\x41\x36\x7f\x53\xd0\xb8\xf0\x5b\x70\xc9\x33\x02\xbd\xaf\x93\x65\x0f\x6b\x9f\x75\xad\x1f\xdb\xf6\x5c\x5b\xee\xc7\x2c\x9a\x75\xc1\xa6\x57\xbf\xa9\x44\x35\x6d\x6f\x24\x17\x4b\x3f\x00\x5a\x1f\x9d\x74\xa6\x8e\xf7\xfc\xa9\x80\xd7\xe0\x66\xee\x5d\xc0\x02\x5e\x0a\x86\x6e\x47\x1a\x96\xad\xe5\xa3\x71\xf2\x35\x12\xf6\x93\x5d\x86\xf2\x3f\x5f\xd8\x0b\xd5\xb7\xac\xa6\xfe\x9b\xd8\x1b\x1b\xf7\x28\x3d\x1a\x48\x81\x83\xf6\x6b\xf7\x98\x75\xf1\xad\x4b\x5f\x2b\x77\x70\xbd\x7d\x0d\x1d\x79\xdc\xf5\xe9\xb2\x58\x88\x7b\xf2\x0f\x82\x3b\x5c\x4f\x92\xcf\xd3\x1f\x9d\x3a\xd7\x2d\x9f\xd6\xb7\x89\x18\x68\x9d\x21\x9f\xf3\x25\x3f\x46\x71\x23\x7f\xe1\x7b\x16\xe9\x82\xc1\xaa\x29\x2e\xa1\x4f\x08\x2c\x05\x53\x3d\xb8\x0b\x93\x0a\xf5\x17\xa2\xff\x72\x3e\x57\x89\x7d\xc7\xd2\xbc\x2e\xc0\x27\x1b\xb7\x73\x0e\xe8\x9f\x00\x00\x00\xff\xff\x5f\x73\x79\x4b\x5f\x04\x00\x00"
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x04\xb7\xbd\x0c\xab\x93\xae\xd8\xa5\xc0\x0e\x3b\xed\x30\x60\xdb\x69\x97\xa1\x10\x14\x5b\x4e\x85\xda\x92\x27\xd1\xde\xd2\xcc\xff\x3e\x4a\x8c\x62\x4b\x29\xba\x1d\x86\x39\x97\xe8\x91\x22\xa9\xf7\x48\x5e\xb0\x0f\x52\x4b\x2b\x40\xd6\x6c\xbb\x67\x9f\x01\xcc\x6b\x56\x1b\xa6\x0d\x30\x59\x2b\x60\x9d\xd0\x83\x68\xdb\xfd\x6a\x35\x0a\xab\xc4\xb6\x95\xac\x50\xba\xb1\x82\xab\xba\x60\x87\x69\x01\x8b\x1f\x8e\x8b\xaa\x92\xce\xf1\x47\xb9\x47\x23\xab\x65\x23\x86\x16\xd8\x3b\x56\x14\x2c\x77\x75\xb2\xb2\x12\xfe\xca\x15\xcc\xa3\xd4\x7f\xf4\xb2\x72\xa7\x8c\xce\x8a\xc2\xf0\x5c\x8b\x4e\x66\xf0\xe0\xa4\xe5\xb5\x00\xf1\x4c\xd4\x65\xd8\x4e\x65\x17\x95\x76\x20\x74\x25\x39\xec\x7b\x99\x5d\x3e\x1c\x58\x62\xfe\x75\xb4\xdd\x15\xf0\xa6\xec\x54\x65\x0d\x86\x9f\xd2\xc2\x4f\x17\x2a\x33\x68\xc8\x02\xde\xa4\xbe\x52\x8f\xca\x1a\xdd\x49\x0d\xdc\x0d\x4d\xa3\x7e\xbe\xc8\x89\x1b\xb6\x1a\x09\xee\x87\x6d\xab\xaa\xec\x19\x63\x5f\xf1\x4a\xd5\xf6\x19\xf8\xa8\xeb\xaa\xb7\x66\x54\xb5\xb4\x81\x5c\x84\x56\x8c\xcd\xea\xfa\x6c\x97\x07\xbc\x58\xa6\xaa\x4f\x05\xba\xcd\xca\xa6\x6e\x33\x1e\xdc\x82\xaa\xa9\x47\x80\x82\x91\xc4\x64\xfe\x4b\x3c\x08\x47\x17\xac\xd0\x4a\x67\x06\x5b\xcd\xfd\x34\x58\x05\x7b\xbe\xb3\x66\xe8\x0b\x04\xfb\x9e\xca\xf6\xfa\x53\x1c\x54\x28\x1c\xa6\xe9\x9a\x42\xc6\x56\x9e\xe8\x78\xce\x70\x28\x86\x68\x99\x0b\xa1\x33\x9a\xd0\xa6\xf4\x0e\xeb\x70\x21\x11\x63\x48\x1a\x98\xca\xb4\x54\xf7\xf5\x4d\x00\x1b\x6b\x3a\xde\x1b\x0b\x01\xdc\x04\x0c\x4c\x44\x66\xcc\x0b\xc2\xb7\xad\xa9\x1e\x1d\x62\xdf\x8a\x4d\x19\x7e\xeb\x4d\x71\x8f\xf6\xc9\x67\x93\xff\x33\xd9\xe1\x8a\xa9\x86\x81\xd8\x39\x76\x35\x79\xc1\xfc\x3f\x4a\x8d\xa6\xc6\x58\x06\xf8\xfc\xe8\xe0\xc9\x85\xf2\x23\x8a\xee\x7b\x9c\xc8\x86\xf2\xab\x68\x07\xcf\x77\x11\xaf\x49\x5d\xfb\x9b\x21\xe0\xb4\x8a\x10\xe6\x41\xe4\x4c\xd3\x38\x1d\x4b\x35\xc3\xa0\xb0\xf8\x9d\x34\x49\x07\x29\xe4\xc3\xe9\x65\xec\xdc\x13\xe1\x60\x4e\x66\xf5\x99\x40\x1e\xa6\x7e\xa6\x41\xc2\x0e\x48\xe2\x24\xf3\x15\x1c\xe3\xb2\xc9\x12\x46\x38\xf8\x9c\x36\x4f\xea\x73\x82\xa9\xab\x7c\x87\xa5\x0d\x8d\xe9\x49\xa8\xcb\xc3\x79\xb7\x97\xc8\x4e\xe9\x3b\xf2\x7e\xd6\x6d\x34\xed\xd0\x49\xee\xd4\x93\x24\xb6\xad\x31\x40\x8a\xf3\x5a\x8e\x0a\x39\x26\x2d\x97\x8e\x24\xdb\x12\x21\xe9\x72\xa5\xd2\x6e\xf8\xe4\xdf\x9c\x8c\x57\xf1\x4f\xbb\x04\xf3\x99\x01\xfa\x01\x70\x71\xdb\x96\xda\x60\x0c\x57\x30\xc0\x03\x40\x7f\xb7\x5e\x13\x2d\x51\xbc\x40\xc8\xa6\x24\x6d\x78\xad\xdd\xb4\x2e\x96\x61\x54\x9f\x45\x79\xe9\xba\xea\x69\xdf\x10\xaf\xb5\xe9\x04\xbe\xe7\x6a\xb9\x37\x09\xcb\x96\x29\x81\xfc\xc9\x68\x79\x5a\xaa\x17\xec\x8b\x51\xd8\xbe\xf0\x20\x63\x20\xd3\x84\x13\x66\xc4\x5c\x02\xfc\xd6\x13\xe4\x10\xab\x71\xd9\x54\xa0\xe2\x20\xdf\xde\xe2\x2e\xac\x8c\xc5\xc0\x73\x7a\x7c\xd1\x31\xdd\xdc\x59\x69\x19\x81\xe2\xd8\xa3\x99\x0f\x6d\x65\x3f\x0c\x64\x7b\x1f\xce\xd0\xc6\x4e\xbd\xdd\x6c\x68\x35\xfb\xb4\xcb\x66\x4c\x68\x7b\xb5\xa4\xed\x7e\x49\xfa\xb2\xcc\x8c\xf8\xf4\x49\xc7\x7a\xca\xe6\x7b\x4d\xab\x7e\xd9\x7a\xbf\x01\x36\x54\x39\x30\xb3\x08\x00\x00"
 
 func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,7 +131,7 @@ func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x55\xdb\x6e\xdc\x36\x10\x7d\xd7\x57\x4c\x64\xe7\x06\x58\x52\x63\x34\x79\x70\x62\xa3\x8e\xe3\xd4\x06\x52\x3b\xf0\x3a\x7d\x69\x8b\x0d\x57\xe2\x4a\xac\x25\x92\x21\xa9\x4d\xb6\xeb\xfd\xf7\x9e\xa1\xb4\xbe\x24\x41\x5a\xc0\xc6\x52\xc3\xb9\x9e\x99\x39\xdc\xa2\x5f\xa5\x96\x4e\x04\x59\xd1\x6c\x49\xe7\x21\x98\x1d\xaa\x0c\x69\x13\x48\x56\x2a\x3c\x48\xb6\x92\x2d\xba\x6c\x94\x27\xfc\x85\x46\xd2\xef\xa2\x76\x42\x87\xb9\x6a\x25\xd5\x5f\xdb\xd2\xdc\xb8\xa8\x55\xc9\x85\x6c\x8d\xed\xa4\x0e\x64\xe6\x70\x11\xd8\x85\xb0\xb6\x55\xa5\x08\xca\xe8\xc2\x4b\xb7\x50\xa5\xcc\xe9\x34\x90\x6f\x4c\xdf\x56\x31\xe8\x4c\x52\x23\x74\x95\x71\x70\x59\xe5\x74\x69\xa8\x33\x95\x9a\x2f\xd9\x2d\xfc\xdc\x09\xbf\x43\xbd\x97\x31\xda\xa1\xb5\x2c\xc8\x93\x64\xbc\xce\x4b\xa3\xe7\xaa\xee\x9d\x7c\x92\xee\xa6\x4f\xb9\xa2\xeb\x41\x74\x9d\x10\x0d\xa7\x7c\xd1\xe5\x33\xf3\x85\xf6\x29\x6d\x84\x6f\x54\x69\x9c\x2d\xac\x93\xa5\xf2\xf2\xc5\xcf\x69\x02\xc5\x2d\x3a\x31\x1e\x05\xe8\x76\x49\x5a\x86\xcf\xc6\x5d\xdd\x33\x1f\x65\x94\x5a\xa7\x16\xc0\x61\x3a\x0a\xd2\x1d\x52\x76\x8f\xd2\xd5\x8a\x81\x98\x2a\x3b\x15\x55\xe5\xa4\xf7\xb4\x5e\x8f\x8e\x27\x32\xf4\x96\x04\xf9\xa5\x2e\x81\xdf\xdc\xb4\x95\x74\x34\x77\xa6\x23\xd3\x3b\x62\x2f\x4a\xd7\x54\x29\x24\x14\x8c\x43\xf9\x86\x8a\xc5\x50\xdd\xbd\x1c\x06\x07\xd3\xd1\x01\x87\xb4\x22\x34\xf9\xc6\x01\x02\xee\x50\xba\xb1\x4c\x77\x60\x4b\x64\x3e\xa3\x6f\xc8\xef\x46\x4a\xb5\x33\xbd\xbd\x23\x19\x92\x3c\xd6\x62\x86\x36\x4f\x26\x27\x24\x6a\x6e\x25\xda\xfb\x59\xb8\x8a\x1d\x7b\x83\xf6\x87\xc0\xc7\xb1\x7a\xd4\x6a\xa5\xae\xa4\x2e\x95\xf4\xb1\x02\x7f\x9b\xa9\xf7\x4d\x3e\x5a\x4f\x07\x5f\xfb\x14\x5c\x2f\x87\x40\x6f\x4d\xaf\xab\x38\x17\xb4\xe9\xdc\xf0\xf5\x44\xcd\x49\xe8\xe5\x53\x68\xad\x1e\xc6\xe9\x02\x22\xa4\x34\x8e\x1b\x8b\x29\x24\x3e\x07\xce\xf4\x70\x0d\x35\xbe\x47\x4b\x0b\x83\x71\x2c\x6e\xb5\x32\x00\x03\xf3\xd6\x18\x9b\x1f\x41\x1a\x00\x16\x37\xe3\xc7\x50\xb2\xb3\x88\x20\x0e\xf7\x54\xad\x33\x0b\xe5\x39\xc3\xd4\x37\xb2\x6d\xb9\xe3\xba\x55\x5a\x02\xc3\xb2\xa2\xad\x15\x0c\xd6\xf4\xe8\x11\xcd\x30\x5a\xe3\x67\xd1\x09\xa5\x73\xdf\xa4\x43\x31\x80\x8a\xeb\x41\xd2\x11\x82\x77\x46\x54\x24\xda\x36\xb6\x7f\xee\x44\xcd\xbb\xe3\xa9\x91\x4e\xc6\xba\x81\xc2\x3d\x80\xf3\x5b\x48\x36\xda\x8c\x0b\xcf\xdb\xad\x75\x44\x84\x2b\x1f\x25\xd7\x4e\x22\xca\x7a\xfd\xdd\x0c\x4e\xb5\x0f\x9c\xc0\xac\x57\x58\x46\xa9\x17\xca\x19\xcd\x56\xff\xb7\xf2\x6d\x5f\x3a\x65\xc3\x14\x6b\x9e\xc0\x77\x92\xdc\x11\xa0\x27\xaf\x5e\x4d\x8e\x2e\x4e\xdf\x5f\x26\x5b\x0f\x8a\x99\xd2\x05\x43\x93\x24\x5e\x06\xca\x98\x70\x7a\x3d\x1e\xa5\x73\xf2\x8b\x8a\x47\xab\xac\x9c\x0b\xd5\x8e\xe2\xe0\x44\x89\x89\xc1\xc9\xb8\x27\x4f\x69\xc5\xc3\xdc\x9a\x52\xb4\x98\xc6\xde\x95\x92\x49\x60\x7f\xfb\xd9\xad\x98\xf3\xd2\x66\x7f\x7b\x97\x45\xb2\x6c\x0c\xa5\xc7\x17\x17\xe7\x17\x24\x02\x6d\xaf\x6e\x8d\xd6\x7b\xdb\xab\x41\x77\xfd\x92\xde\x09\xec\x7c\x6b\x6a\xbf\xc7\x9d\xc2\x6a\x48\x4b\x3c\x4d\xbc\x7f\xae\xc0\x45\xe1\x97\x1e\x3f\x74\x4d\x21\xe6\xa6\x69\xf7\xa7\x64\x9d\x20\x3b\x4b\x8f\x63\x72\x94\x6e\xaf\x5e\x1f\x4e\x4e\xa6\x93\xf3\x0f\x17\x47\xc7\xeb\x94\x05\xef\x4e\xcf\x8e\xcf\xce\xd7\xe9\x63\x42\x0e\x49\x62\x24\x97\x80\x8b\x5f\x52\xda\x3d\x78\xf4\x0c\xee\xe0\xb4\xc6\xe4\x65\x61\x88\x77\x40\x05\xda\x59\xe8\xbe\x6d\x5f\xd2\x3a\x31\x6d\x34\x18\xca\xf8\x83\x35\xfe\x22\x18\xf3\x15\x88\xf1\x37\x71\x25\x09\xa8\x61\x2f\x43\x83\xf2\x3e\x8e\xab\x4c\xd8\xbc\x8f\x54\x1b\xac\xe4\x40\x26\x6d\xe4\x12\xa6\x4d\x30\x1e\x0b\xe2\x70\x0f\x5e\x31\xba\x37\x54\x41\x07\x88\xdf\x98\x4e\x6e\x24\x45\xce\x1d\x73\x25\x47\x3b\x1a\xb7\x94\xd7\x9f\xe9\x21\x8e\x21\x70\x43\xf6\x28\x42\xe9\x04\x7b\xfb\x60\x80\x2e\xfd\xe0\xe5\x9b\xb3\x09\x3a\x9c\x52\x21\x43\x59\x20\x21\xfe\xaf\xa6\xc3\x50\xd1\xc1\x9d\x2a\x91\x96\x4e\x36\xad\xba\x63\x78\x4d\xbe\x07\x89\x07\x29\x29\x13\xff\xe5\x06\x0e\x8c\x1c\x0c\xc6\x57\x86\x41\x20\x10\x70\x10\x2e\x24\x73\x05\xf0\x5b\x4a\x0f\xab\x48\x64\xc2\x06\x5c\x59\xe3\x15\x78\x96\x99\x0b\xcf\x0f\xf5\x96\x69\x43\xd7\x79\x9e\xa7\x89\xfc\x62\x8d\x0b\xf4\xe6\xf8\xf5\xe9\xe1\xd9\xf4\xed\xc5\xf9\xd9\xe5\xf1\xd9\x9b\x7d\x6d\xb4\x62\x1e\x11\x65\x50\x0b\x99\x6c\x42\xc2\x5f\x06\x66\x1c\x5c\x20\xdd\xe5\x37\x37\x6a\x5c\xb4\x6c\x49\x76\x19\x1a\x90\x93\x37\xf3\x00\x6a\x94\x19\xb6\xcb\x4a\x17\x38\x8d\xef\xc8\xb2\xd2\x74\x1d\x36\x8f\x1d\x61\xda\xb4\xe7\xbc\xb2\x26\x04\xeb\x6f\x83\x54\x55\xc6\xf7\x37\x25\x2d\x63\x1c\x2b\xf6\x8c\xc6\x1b\xf4\x77\x61\x1b\xfb\x3c\x7b\x9e\xbf\x48\xf8\x11\x92\x9d\xe7\x69\xc0\xab\xeb\xe4\xa7\x1e\xf3\x51\xf1\x27\x5e\xc1\x05\xf3\x49\xda\x6b\xd1\x73\x4b\x02\xbf\xda\xb8\xb3\xa2\xbc\x02\x7b\xfb\x14\xc6\x71\xd0\x3d\xe8\x8a\x5f\xf8\xaf\x6b\xcb\xef\x15\x7d\x25\x97\x23\x1c\x3f\x40\x29\xf6\x64\xe4\x20\xee\xcb\xfb\x93\xf7\xb1\x15\xbe\xb7\x5c\x66\x7c\x68\xc6\xf0\xb1\x2b\x3f\x42\x15\x25\xd2\x9f\x18\x83\xd9\x3f\x8e\x6a\x2c\x45\x27\x5d\x89\x61\x15\x23\xb9\x65\x78\x8a\xb9\x28\x7c\xb3\x16\xae\x86\x43\x84\xa6\x2b\xdd\x12\x23\x31\x9c\x97\xfe\x53\x3b\x1c\xe7\xb6\x1b\x0e\x75\x35\xfc\x32\x95\x32\x67\x0c\x5f\xb6\x86\xe6\x37\x35\x1c\x99\x0e\x5d\x90\x2e\x26\xcc\xcb\x15\x3a\x9b\xc4\x70\x99\x9f\x50\x6c\xdc\x5e\x51\x20\xfb\x72\xa3\x68\x5c\x5d\x8c\x95\x60\x97\xae\xd9\xf9\x4d\xa5\xdd\x82\x6e\xf4\x6c\x23\x1c\x15\xbd\x67\x42\x02\xd3\x45\x42\xdd\x5c\x26\x23\xcf\xfe\x1b\x00\x00\xff\xff\xb9\x3a\x40\xb6\xdd\x09\x00\x00"
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdf\x73\xdb\x36\x12\x7e\xc7\x5f\xf1\x85\x72\xd2\x64\xc6\x24\xaf\x99\x4b\x1f\xdc\xda\xd3\xd4\x71\xcf\x9e\xc9\xd9\x19\x39\xbd\x97\x5e\x47\x85\x89\x25\x89\x86\xc2\x22\x00\xa8\x44\x27\xeb\x7f\xbf\x59\x92\x92\xec\xb6\x97\xeb\x83\xc7\xcb\x0f\xfb\xeb\xdb\xc5\x2e\x34\xc3\x3f\xc8\x51\xd0\x89\x0c\xee\xd6\xb8\x49\x89\x8f\x61\x18\x8e\x13\xc8\xd8\xf4\x44\xcd\xd4\x0c\xef\x5b\x1b\x61\x23\x52\x4b\xf8\x97\x6e\x82\x76\xa9\xb6\x1d\xa1\xf9\xbd\x2d\x6a\x0e\x83\x96\xa1\x15\x75\xec\x97\xe4\x12\xb8\x56\x33\x24\x71\xa1\xbd\xef\x6c\xa5\x93\x65\x57\x46\x0a\x2b\x5b\x51\x81\xab\x84\xd8\x72\xdf\x99\x21\xe8\x1d\xa1\xd5\xce\xe4\x12\x9c\x4c\x81\xf7\x8c\x25\x1b\x5b\xaf\xc5\xad\x9a\x3d\x0c\x7f\x8c\x3e\xd2\x10\xed\xb5\xf7\x02\x14\x4a\x4d\xc7\x45\xc5\xae\xb6\x4d\x1f\xe8\x79\xf6\x32\x7b\x21\x8c\xee\x47\xe8\x5e\x01\xa3\x54\xac\x96\xc5\x1d\x7f\xc6\x29\xb2\x56\xc7\xd6\x56\x1c\x7c\xe9\x03\x55\x36\xd2\x37\x7f\xcf\x94\x02\x66\xb8\xe4\x98\xc0\xae\x5b\xc3\x51\xfa\xc4\xe1\xc3\x23\xf3\x09\x43\xe6\x83\x5d\xe9\x44\x8b\x09\xc8\x8e\x61\xfd\x09\xb2\xcd\x46\x0a\xb1\xb0\x7e\xa1\x8d\x09\x14\x23\xb6\xdb\xc9\xf1\x2d\xa5\xde\x43\x23\xae\x5d\x45\x06\x35\x77\x86\x02\xea\xc0\x4b\x70\x1f\x20\x5e\xac\x6b\x60\x6c\xa0\x2a\x71\x58\x23\x31\xca\xd5\xc8\xee\x51\x0e\xa3\x83\xc5\xe4\x40\x42\x7a\x9d\xda\x62\xe7\x60\xbb\xcd\x8e\x91\xed\x2c\xb3\x63\x05\x00\xfc\xc9\x51\x38\x41\xb6\x47\xd1\x04\xee\xfd\x03\x64\x4c\xf2\xc2\xe9\xbb\x8e\x70\x7b\x7b\x09\xdd\x48\x2b\x6b\x0e\x9f\x74\x30\xe2\x38\x32\x1a\x4a\x49\xc4\x89\x3d\x0c\x79\x72\x86\x5c\x65\x29\x0e\x0c\xe2\x21\xd3\x18\xdb\x62\xb2\x5e\x8c\xbe\x4e\x91\x42\x4f\x63\xa0\x1f\xb9\x77\x66\xb8\x17\xd8\x75\x6e\xfc\x7a\x6e\x6b\x68\xb7\x7e\xa1\x80\xcd\x53\x09\x2f\x15\x81\x75\xa8\xf7\x16\x0b\x63\x43\x2c\x0c\xad\xf0\x74\xab\x30\x9c\x9f\x22\x2b\x39\x25\x2e\x0f\x5a\xf9\x66\x23\xe6\x1d\xb3\x2f\xce\xb9\x77\x89\xc2\xd0\x8c\x2f\x97\x52\x9c\x0d\x15\x34\x36\x3c\x52\xf5\x81\x57\x36\x4a\x86\x59\x6c\xa9\xeb\xa4\xe3\xae\xb3\x8e\x4e\x90\x55\x06\xb3\x8d\xb1\x61\x8b\x67\xcf\x70\xa7\x63\x3b\x7d\x96\x4b\x6d\x5d\x11\xdb\x6c\x24\x43\xce\x08\x9f\xa7\xdb\xb1\x04\x6f\x59\x1b\xe8\xae\x1b\xda\x5f\x07\xdd\xc8\xec\x44\xb4\x14\x68\xe0\xad\xdd\xfa\x51\x81\x8b\x43\x49\x76\xda\x52\x17\xb9\x6f\x07\xeb\xa1\x22\xc2\x7c\x42\xee\x03\x69\x83\xed\xf6\x4f\x33\xb8\x72\x31\x49\x02\x77\xbd\xed\x0c\xc8\xad\x6c\x60\x27\x56\x7f\x95\xf9\x51\xac\x82\xf5\x69\xa1\xbd\x57\xe4\x8c\x52\x0f\x00\x9c\xe2\xbb\xef\x6e\xcf\xe7\x57\xef\xde\xab\xd9\x93\xf2\xce\xba\x52\x4a\xa3\x54\xa4\x84\x9c\xe1\xb8\x77\x93\x48\x21\xd0\x67\x3b\x88\xde\x7a\xaa\xb5\xed\x26\x38\x05\x5d\x91\x52\x14\x02\x87\xe7\x2f\xb0\x51\x00\x3a\xae\x74\x87\xc8\x7d\xa8\x48\x96\xc0\xe9\xd1\xd7\x07\x58\xf2\x72\x7c\x7a\xf4\x52\x20\xaa\x5a\x46\x76\x31\x9f\xdf\xcc\xa1\x13\x8e\x36\x07\xa3\xed\xc9\xd1\x66\xd4\xdd\x7e\x8b\xb7\x3a\x26\x74\xdc\xc4\x13\xe9\x14\x9a\x40\x1e\x9c\xc6\xf9\x0b\x65\xc7\x4d\x19\xd7\xb1\xe3\x06\xf7\x48\x43\x6e\x0e\x2f\xff\xa6\xb6\x2a\x05\xed\xf1\xd5\x90\x1c\xb2\xa3\xcd\x0f\xaf\x6f\x2f\x17\xb7\x37\x3f\xcd\xcf\x2f\xb6\x99\x00\x6f\xaf\xae\x2f\xae\x6f\xb6\xd9\x57\xb8\x98\xcf\x95\x62\x12\x0a\xc8\x8e\xbe\xcf\xf0\xf2\xec\xd9\xd7\xb8\x97\xa0\x0d\x05\xe4\x69\x8c\x77\x86\xd2\xd0\xaa\x74\x7d\xd7\x7d\x8b\xad\xe2\x6e\x30\x18\x69\xfc\x2c\x1a\xbf\xe0\xe8\xfb\x4c\x8e\xd4\x0c\xff\xd4\x1f\x08\x36\x21\x32\x52\xab\x13\x7e\x9d\x46\x19\x31\xb6\xbf\xa2\x61\x8a\xd3\x32\xe9\x86\x5d\x22\x6b\xb3\xe2\x20\x80\xe0\x6a\xf4\x5a\x99\xfd\x92\xc9\x70\x76\x86\xb2\xe5\x25\xed\x90\xb2\x90\x8e\x85\x4a\xa2\x9d\x4f\x53\x2a\xe3\x2f\xeb\x61\xb8\x86\x3a\x26\x0a\x42\xc2\x3a\x65\x6b\x3c\x19\x4b\x97\xfd\x14\xe9\xcd\xf5\x2d\x1c\x67\x28\x29\x55\x65\x8c\xad\xfc\x99\xc5\x78\xa9\x70\xf6\x80\x65\x6a\xc9\xa9\x5d\xab\x1e\x18\xde\x23\xf6\x86\x91\x88\x90\xeb\xff\xe7\x46\x01\x4c\xa3\xc1\xf4\xca\x48\x11\x10\x28\x26\x1d\x92\xaa\xad\x52\xdc\x21\x7b\x6d\x86\x45\xa6\x7d\x42\x20\xcf\xd1\x26\x0e\xb2\xb9\xb4\x33\xe8\xbd\xac\x0d\xd7\x14\x45\x91\x29\xfa\xec\x39\x24\xbc\xb9\xf8\xe1\xea\xf5\xf5\xe2\xc7\xf9\xcd\xf5\xfb\x8b\xeb\x37\xa7\x8e\x9d\x75\x89\x82\xae\x92\x5d\x91\xda\x85\xd4\x3e\xe5\x0d\xa5\xd1\x05\x21\x5f\xff\xe1\xc4\x4e\x83\x96\xaf\xe1\xd7\xa9\x65\x97\x47\xae\xd3\x27\x1d\x28\xf7\x81\x3d\x85\x24\x69\xfc\x09\x96\x57\xbc\x5c\xb2\x1b\x1c\xa5\xa0\x5d\x94\xbc\xf2\x36\x25\x1f\x0f\x41\x8c\xc9\xe5\x7c\x4f\x69\x3d\xc4\xf1\xfa\x84\x9d\x09\xf4\x5b\xe9\x5b\xff\x2a\x7f\x55\x7c\xa3\xe4\x11\xa2\x65\x44\x62\x79\x75\x03\x7d\xec\x6d\x20\x23\x9f\x3e\xd0\x8a\x5c\x42\xd6\x3b\xdd\x4b\x4b\x92\xbc\xda\x64\xe0\x75\xf5\x41\x37\x14\x33\x35\xc3\x70\xd1\x23\xb8\x97\x17\xfe\xf7\xdc\x8a\x47\xa4\x3f\xd0\x7a\x2a\xc7\x17\xaa\x34\xf4\x64\xda\x41\xd2\x97\x77\x97\xef\x86\x56\xc4\xde\x0b\x4d\x81\x76\xe1\x87\xae\x7c\xa9\xaa\xad\x7f\x85\x7f\x2b\xe0\xee\x3f\x01\x8d\x4d\x58\x52\xa8\xfa\x60\xf5\xb4\xdc\x72\x8a\x51\x48\xe9\x6e\xd0\xaa\xfa\x30\x0a\x43\x69\x96\x55\x58\xfb\x34\xc9\xeb\xf8\xb1\x1b\xc5\xda\x2f\x47\xa1\x31\xe3\x7f\x59\xa5\xb2\x33\xc6\x2f\xdf\xc4\x8f\xdd\x1f\x38\x9c\xf3\xd2\x73\xa4\x30\x24\x2c\xc3\x95\x96\x5e\x0d\xe1\xf2\x78\x8b\xa1\x71\x27\x65\xd9\x50\xaa\x76\x8a\x1c\x9a\x72\x62\x42\x01\xf7\xe2\x7c\xcf\x74\xb9\xc2\x5e\xcf\xb7\x3a\xa0\xec\xa3\x2c\xa4\x4a\x77\xc3\x42\xdd\x1d\x2a\x99\xbe\x9f\x91\xd7\xfb\x61\xde\x1f\x15\xbf\x45\x76\xf8\x65\x3f\x68\x92\xef\xbc\x77\x4e\x92\xdd\xe9\xec\x7b\x28\x49\x1f\x46\x29\xef\x31\x79\x43\x7e\xf9\xbf\x42\x1f\xba\x90\x4f\xbf\x40\x72\x79\x43\xcb\xbd\x65\xee\x38\xdf\x4f\x0d\x3b\x19\xc6\xe9\x55\xf8\xef\x00\xac\x18\xef\xb5\x8b\x0a\x00\x00"
 
 func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
 	return bindataRead(
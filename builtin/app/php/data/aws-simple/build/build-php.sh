@@ -29,8 +29,8 @@ oe sudo add-apt-repository -y ppa:ondrej/php5-5.6
 oe sudo apt-key update
 oe sudo apt-get update -y
 
-ol "Installing PHP VCSs, Apache, and other packages..."
-oe sudo apt-get install -y apache2 php5 libapache2-mod-php5 \
+ol "Installing PHP VCSs, nginx, and other packages..."
+oe sudo apt-get install -y nginx php5-cli \
   bzr git mercurial build-essential \
   curl \
   php5-mcrypt php5-mysql php5-fpm php5-gd php5-readline php5-pgsql
@@ -53,19 +53,32 @@ if [ -f /srv/otto-app/composer.json ]; then
 fi
 
 
-ol "Configuring apache..."
-oe sudo rm /etc/apache2/sites-enabled/000-default.conf
+ol "Configuring nginx and PHP-FPM..."
+oe sudo rm /etc/nginx/sites-enabled/default
 
-cat <<APACHECONF | sudo tee /etc/apache2/sites-enabled/otto-app.conf > /dev/null
+# The heredoc is quoted so that the nginx variables aren't expanded
+cat <<'NGINXCONF' | sudo tee /etc/nginx/sites-enabled/otto-app.conf > /dev/null
 # Generated by Otto
-<VirtualHost *:80>
-  DocumentRoot /srv/otto-app
-
-  <Directory /srv/otto-app/>
-    Require all granted
-  </Directory>
-</VirtualHost>
-APACHECONF
+server {
+  listen 80;
+  root /srv/otto-app;
+  index index.php index.html;
+
+  location / {
+    try_files $uri $uri/ /index.php?$query_string;
+  }
+
+  location ~ \.php$ {
+    try_files $uri =404;
+    fastcgi_pass unix:/var/run/php5-fpm.sock;
+    fastcgi_index index.php;
+    fastcgi_param SCRIPT_FILENAME $document_root$fastcgi_script_name;
+    include fastcgi_params;
+  }
+}
+NGINXCONF
 
+oe sudo service php5-fpm restart
+oe sudo service nginx restart
 
 ol "...done!"
@@ -2,10 +2,24 @@
     "min_packer_version": "0.8.0",
 
     "variables": {
-      "aws_access_key": null,
-      "aws_secret_key": null,
+      {% for k in build_env %}
+      "build_env_{{ k }}": "",
+      {% endfor %}
+      "aws_access_key": "",
+      "aws_secret_key": "",
+      "aws_token": "",
+      "ssh_key_name": "",
+      "ssh_private_key_file": "",
       "aws_region": null,
-      "slug_path": null
+      "slug_path": null,
+      "build_regions": "",
+      "share_accounts": "",
+      "build_instance_type": "{{ build_instance_type|default:"c3.large" }}",
+      "source_ami": "{{ source_ami|default:"ami-21630d44" }}",
+      "vpc_id": "",
+      "subnet_id": "",
+      "spot_price": "",
+      "spot_price_auto_product": ""
     },
 
     "provisioners": [
@@ -31,20 +45,56 @@
       },
       {
         "type": "shell",
-        "script": "build-php.sh"
-      }
+        "script": "build-php.sh"{% if build_env %},
+        "environment_vars": [
+          {% for k in build_env %}
+          "{{ k }}={% verbatim %}{{ user `build_env_{% endverbatim %}{{ k }}{% verbatim %}` }}{% endverbatim %}"{% if not forloop.Last %},{% endif %}
+          {% endfor %}
+        ]{% endif %}
+      }{% if provision_script %},
+      {
+        "type": "shell",
+        "script": "{{ provision_script }}"
+      }{% endif %}
     ],
 
-    "builders": [{
-      "name": "otto",
-      "type": "amazon-ebs",
+    "builders": [{% for builder in builders %}{
+      "name": "otto{% if not forloop.First %}-{{ builder }}{% endif %}",
+      "type": "{{ builder }}",
       "access_key": "{% verbatim %}{{ user `aws_access_key` }}{% endverbatim %}",
       "secret_key": "{% verbatim %}{{ user `aws_secret_key` }}{% endverbatim %}",
+      "token": "{% verbatim %}{{ user `aws_token` }}{% endverbatim %}",
       "region": "{% verbatim %}{{ user `aws_region` }}{% endverbatim %}",
-      "source_ami": "ami-21630d44",
-      "instance_type": "c3.large",
+      "source_ami": "{% verbatim %}{{ user `source_ami` }}{% endverbatim %}",
+      {% if builder == "amazon-ebs" %}
+      "vpc_id": "{% verbatim %}{{ user `vpc_id` }}{% endverbatim %}",
+      "subnet_id": "{% verbatim %}{{ user `subnet_id` }}{% endverbatim %}",
+      "instance_type": "{% verbatim %}{{ user `build_instance_type` }}{% endverbatim %}",
+      "spot_price": "{% verbatim %}{{ user `spot_price` }}{% endverbatim %}",
+      "spot_price_auto_product": "{% verbatim %}{{ user `spot_price_auto_product` }}{% endverbatim %}",
       "ssh_username": "ubuntu",
-      "ami_name": "{{name}} {% verbatim %}{{timestamp}}{% endverbatim %}"
-    }]
+      "ssh_keypair_name": "{% verbatim %}{{ user `ssh_key_name` }}{% endverbatim %}",
+      "ssh_private_key_file": "{% verbatim %}{{ user `ssh_private_key_file` }}{% endverbatim %}",
+      {% endif %}
+      "ami_regions": "{% verbatim %}{{ user `build_regions` }}{% endverbatim %}",
+      "ami_users": "{% verbatim %}{{ user `share_accounts` }}{% endverbatim %}",
+      {% if builder == "amazon-ebs" %}
+      {% if volume_size %}
+      "launch_block_devices": [{
+        "device_name": "/dev/sda1",
+        "volume_size": {{ volume_size }},
+        "volume_type": "gp2",
+        "delete_on_termination": true
+      }],
+      {% endif %}
+      {% if tags %}
+      "run_tags": {
+        {% for t in tags %}"{{ t.Key }}": "{{ t.Value }}"{% if not forloop.Last %},{% endif %}
+        {% endfor %}
+      },
+      {% endif %}
+      {% endif %}
+      "ami_name": "{{name}}{% if not forloop.First %} {{ builder }}{% endif %} {% verbatim %}{{timestamp}}{% endverbatim %}"
+    }{% if not forloop.Last %},{% endif %}{% endfor %}]
 
 }
@@ -78,4 +78,9 @@ ol "Installing Composer..."
 cd /tmp
 curl -sS https://getcomposer.org/installer | php
 oe sudo mv composer.phar /usr/local/bin/composer
+
+if [ -f /vagrant/composer.json ]; then
+  ol "Running composer install..."
+  oe sudo -u vagrant -H /usr/local/bin/composer install --working-dir /vagrant --no-interaction
+fi
 SCRIPT
@@ -0,0 +1,124 @@
+package pythonapp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/helper/compile"
+	"github.com/hashicorp/otto/helper/packer"
+	"github.com/hashicorp/otto/helper/schema"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/helper/vagrant"
+)
+
+//go:generate go-bindata -pkg=pythonapp -nomemcopy -nometadata ./data/...
+
+// App is an implementation of app.App
+type App struct{}
+
+func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
+	// If the app has a requirements.txt, the dev and build environments
+	// will install the dependencies from it.
+	_, err := os.Stat(filepath.Join(
+		ctx.Appfile.SourceDir(), "requirements.txt"))
+	requirements := err == nil
+
+	var opts compile.AppOptions
+	custom := &customizations{Opts: &opts}
+	opts = compile.AppOptions{
+		Ctx: ctx,
+		Bindata: &bindata.Data{
+			Asset:    Asset,
+			AssetDir: AssetDir,
+			Context: map[string]interface{}{
+				"requirements": requirements,
+			},
+		},
+		Builders: true,
+		Customizations: []*compile.Customization{
+			&compile.Customization{
+				Type:     "python",
+				Callback: custom.processPython,
+				Schema: map[string]*schema.FieldSchema{
+					"python_version": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "2.7",
+						Description: "Python version to install",
+					},
+
+					"run_command": &schema.FieldSchema{
+						Type:        schema.TypeString,
+						Default:     "python app.py",
+						Description: "Command to run the app when deployed",
+					},
+				},
+			},
+		},
+	}
+
+	return compile.App(&opts)
+}
+
+func (a *App) Build(ctx *app.Context) error {
+	return packer.Build(ctx, &packer.BuildOptions{
+		InfraOutputMap: map[string]string{
+			"region": "aws_region",
+		},
+	})
+}
+
+func (a *App) Deploy(ctx *app.Context) error {
+	return terraform.Deploy(&terraform.DeployOptions{
+		InfraOutputMap: map[string]string{
+			"region":         "aws_region",
+			"subnet-private": "private_subnet_id",
+			"subnet-public":  "public_subnet_id",
+		},
+	}).Route(ctx)
+}
+
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and Terraform configuration.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	return terraform.Verify(ctx, &terraform.DeployOptions{})
+}
+
+func (a *App) Dev(ctx *app.Context) error {
+	return vagrant.Dev(&vagrant.DevOptions{
+		Instructions: strings.TrimSpace(devInstructions),
+	}).Route(ctx)
+}
+
+func (a *App) DevDep(dst, src *app.Context) (*app.DevDep, error) {
+	return vagrant.DevDep(dst, src, &vagrant.DevDepOptions{})
+}
+
+const devInstructions = `
+A development environment has been created for writing a Python app.
+
+Python is pre-installed along with a virtualenv that is activated
+automatically. If your app has a requirements.txt, the dependencies have
+been installed into it. To work on your project, edit files locally on your
+own machine. The file changes will be synced to the development environment.
+
+When you're ready to run your project, run 'otto dev ssh' to enter the
+development environment. You'll be placed directly into the working
+directory where you can run "pip", "python", etc.
+
+You can access the environment from this machine using the IP address above.
+For example, if you start your app on 0.0.0.0:5000, then you can access it
+using the above IP at port 5000.
+`
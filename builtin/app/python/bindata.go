@@ -0,0 +1,320 @@
+// Code generated by go-bindata.
+// sources:
+// data/aws-simple/build/build-python.sh.tpl
+// data/aws-simple/build/template.json.tpl
+// data/aws-simple/deploy/main.tf.tpl
+// data/common/dev/Vagrantfile.tpl
+// DO NOT EDIT!
+
+package pythonapp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataAwsSimpleBuildBuildPythonShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x7c\x55\xdf\x53\x1b\xb7\x13\x7f\xd7\x5f\xb1\xb9\x38\xdf\x7c\xfb\x20\x1f\xa1\x4d\xa7\x03\x81\x09\x21\x6e\xc3\x4c\x06\x33\x86\x4c\x1f\x28\xc3\xc8\xa7\xbd\xb3\x06\x59\x52\x57\x7b\xc6\xc6\xf8\x7f\xef\x48\xe7\xc1\xb9\xd0\xf2\xa6\xdb\xd5\xee\xe7\x87\xf6\xa4\xd7\xaf\xca\xa9\x71\xe5\x54\xc5\x99\x10\x11\x19\xa4\x07\xe7\x5b\xb7\x5d\x22\x11\x2e\x4d\x5e\x06\x13\xb0\x56\xc6\x6e\xc3\x4c\xaa\x42\x21\x90\xc8\xd3\xff\x7f\x82\xb5\x00\x00\xeb\x2b\x65\x21\xfa\x96\x2a\xac\x8d\xc5\xa3\xc1\xbb\x5d\xd8\x1a\x87\xce\x1f\x0d\xf6\x53\x08\xab\x99\x87\x62\x34\x99\x8c\x27\xa0\x18\x06\xeb\x5d\xd1\xe6\x60\xb0\xee\xf6\x6e\x0e\xe1\xab\x8a\x0c\xd6\x37\xf1\xa0\x48\x65\x0d\x61\x00\xcf\xec\xa1\x5c\x28\x2a\xad\x6f\xca\xb8\x8a\xd6\x37\xf0\x08\x9c\xb9\x39\xd8\xdf\x13\x1b\xc1\xa4\x02\xbc\xcd\xe4\xa0\x18\xac\x3f\x9d\x5c\x7e\xb9\xbd\x1c\x7f\x9b\x9c\x8e\x36\x45\x0a\x7c\x3d\x3b\x1f\x9d\x8f\x37\xc5\x5b\x18\x4d\x26\x42\x78\x4c\x12\xa0\x18\x7c\x2c\x60\xff\xf8\x7f\xef\xe0\x31\x81\x36\x48\x20\xb9\xc3\x3b\x86\x52\xe3\xa2\x74\xad\xb5\x87\xb0\x11\xde\xe6\x82\x4e\xc6\x75\xda\x71\x03\x83\x8f\x45\x4a\x89\xd7\x50\x59\xdf\x6a\x59\x79\x57\x9b\x06\x2a\xe5\xc0\x38\x46\xaa\x91\x10\xee\x0d\xcf\x40\x05\x86\xca\xcf\xe7\xca\xe9\x08\xa6\x06\xc3\x6f\x23\x44\x36\xd6\x82\x71\x10\xc8\x37\x84\x31\x0a\x6f\xa1\xf8\x53\x19\x36\xae\x81\xda\x53\xbf\x2d\xfb\xd4\x22\x58\x64\x1c\x0e\x87\x85\x68\x1d\x1b\x0b\xd7\xd7\x20\xeb\xad\x39\x66\x5a\xe6\x8a\xd2\xb8\xc8\xca\x55\x58\x4e\xbd\x67\x59\x1b\x67\xe2\x0c\x35\xdc\xdc\x1c\x82\xf6\x02\x20\x5a\xc4\x00\x7b\xc3\xf7\x42\x7b\x87\x22\xe3\x9e\x68\x9d\x60\x13\x53\xc2\xe0\xa3\x61\x4f\x06\x23\x28\xa7\xa1\x0d\x5a\x25\x52\x19\x17\x97\xc1\x13\xc3\xe7\xd1\xa7\xb3\x93\xf3\xdb\xdf\x27\xe3\xf3\xab\xd1\xf9\xe7\x23\xe7\x5d\x16\xad\x2a\x36\x0b\x14\x1e\x21\xb6\xda\xa7\x7e\xb2\x41\xee\x5a\x20\xc8\xd5\xb3\x4c\x26\x6b\x2d\xc8\x15\x44\x5f\xf3\xbd\x22\x94\x81\x7c\x40\x62\x83\x51\x26\xdb\xbc\xdb\x55\x69\x2d\x53\xe5\x13\xc7\x55\x2a\x0c\x41\x1d\xd4\x77\xd4\x5a\x5b\x6a\x54\x3a\x3a\x75\x87\xf1\x05\x0e\x59\xf1\x59\x07\x9c\x54\x5f\xac\x78\xe6\x1d\xac\xd7\x10\xf2\xea\x76\x81\x14\x8d\x77\xb0\xd9\x64\x03\x62\x1b\x92\xe8\xb4\x35\xa8\xea\x4e\x35\x18\xb3\x17\x2f\x68\xe9\x1a\xfd\x6b\xc7\xff\x4e\x49\x8d\x0b\xf8\x4b\xc0\x36\x23\x17\x86\xb8\x55\x16\xdd\x02\xa6\x0f\x04\x8d\x61\x98\x23\x55\x2d\x19\x65\x61\xda\x1a\xab\x25\xc6\x88\x8e\x8d\xb2\x9d\xa8\xd1\x92\xf3\x11\xe4\xa3\x0c\x99\x64\x66\x38\xbf\xd3\x86\x40\x06\x28\x23\x2d\xca\x34\xbf\x52\x85\xd0\xe5\x58\x11\x3c\x2c\x6b\x28\x79\x1e\x9e\x52\x43\x6e\x1e\x40\x9e\xfe\xb0\xbf\x3f\x2a\xc1\x9a\x4a\x71\xa2\xde\x46\xa4\xbe\x23\x5a\xa7\x18\x48\xa9\x4d\x54\x53\x8b\x5a\x06\x15\xe3\xbd\x27\x0d\x52\x36\x58\xf9\x08\x45\x01\xfd\xc6\xa7\x84\x79\xce\x60\xa7\xbb\xd7\x74\x17\x4e\x4a\x5e\x70\x78\x47\x7a\x81\x6e\x21\xc4\xfa\x4d\xfa\xeb\x08\xff\x6e\x0d\xe1\x1c\x1d\x47\x78\xb3\xf9\x71\x08\xbe\x4f\xf7\x60\xfb\xdd\xf2\xf5\x19\x4c\xd8\x1d\x36\xf5\x4d\x2a\x7b\x8d\x78\xc9\x09\x1e\x9d\x36\x75\x02\xcd\xa8\x97\xc8\xdd\x30\x21\xcd\x4d\x4c\xac\xfb\x88\xd5\xcc\xdf\x3b\x90\x93\x27\x7f\x0e\xfa\x10\xcf\x04\x66\xf7\xf2\x3d\xd1\x52\x6a\xdc\x86\xc8\x8a\x38\x37\xad\x14\xc3\x87\x0f\xdf\x2e\x2e\xaf\x4e\x26\x57\xf0\xd8\x21\x30\x22\x94\xc8\x55\x69\x9c\xe1\xdd\xa1\xa7\xbb\xe6\xfb\xab\x4f\xbc\x86\x3f\xd0\x21\x29\x46\x0d\xd3\x15\x8c\x99\xbd\xd0\x18\x2b\x32\x21\x1f\x7c\xb1\x5e\x83\x53\x73\x84\xcd\xa6\x10\x22\x83\x82\x77\x40\xad\xb3\xb8\x40\x0b\xd7\xfb\x3f\xff\xf2\xfe\x46\x44\xf6\xa1\x1f\xdf\xfb\xf5\x46\x08\xc2\x18\xd4\xbd\x13\xa2\x9a\xa5\xf1\xec\xcf\x5a\x3a\xe6\x8b\x93\xab\x2f\x47\xcf\xfd\x3f\x28\xdb\x98\x5e\x83\x4a\xd9\xdd\x67\x5e\x4c\x8d\xeb\x0a\xc7\x93\xab\xa3\xdf\xf6\x84\xc0\x25\x56\xe9\xc7\xa6\xd6\xdd\x6e\xaf\xe0\x34\x21\xc7\xc7\x4f\x0f\xca\x93\xf8\xf4\xac\xa4\xc7\x40\x6c\xbd\xea\x6c\x1d\x0e\x87\xe9\x96\x7c\x55\x88\x7f\x06\x00\xc0\x3f\xc5\x8e\x3b\x07\x00\x00"
+
+func dataAwsSimpleBuildBuildPythonShTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildBuildPythonShTpl,
+		"data/aws-simple/build/build-python.sh.tpl",
+	)
+}
+
+func dataAwsSimpleBuildBuildPythonShTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildBuildPythonShTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/build-python.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\x1b\x39\x0c\xbe\xfb\x57\x08\x03\x24\xa7\x7a\x9c\xb4\x45\xb1\x08\x90\x53\x81\xbd\xec\x9e\xf7\x52\x18\x53\x79\x46\xb6\x05\x6b\xa4\x81\x1e\x5e\x24\xde\xf9\xef\xa5\xa4\x79\x48\xf3\x74\x16\xcd\x25\xb6\x48\x7e\x24\x3f\x51\x24\x7d\xdb\x20\xf8\x4b\x4a\xca\xb3\x0a\xe7\x17\x22\xb3\x2b\x91\x8a\x0a\x9e\xbc\xa0\xe4\x29\xfd\x23\x7d\x4a\x3e\x6d\xbc\xce\x15\x4b\x8a\x0f\x8c\x28\x10\xdd\xdc\x11\x42\xb7\x07\x74\x14\x12\x5d\x10\xe5\xe8\x60\x28\x2b\x32\xc2\xaf\xe8\xa1\x6e\xc4\x49\x77\x96\xdd\x6e\xa0\x55\xd7\x16\x16\x10\x3b\x6b\xc2\x0b\x0b\xd0\x5b\xe0\x7f\x55\x86\xf3\x9c\x28\x95\x5d\xc8\x5b\xa4\xee\x64\x8a\xe4\x92\xe8\x69\x99\x16\x17\xc2\xe3\x63\xa5\xce\x56\x37\xe3\xb8\x24\x63\x49\x25\xe9\x15\x6b\xe2\x34\x8e\x94\x91\x31\xa4\x24\x27\x4f\x06\x37\x8c\xf5\xb6\xcc\x9c\x80\x2f\x7d\x1e\x0a\x7c\xbe\xde\x48\x0d\xfc\x9d\xb1\x24\x36\x35\x61\xb8\x1e\xc8\xbc\x19\xe5\x4a\x63\x9e\x93\x4c\xbf\x55\x2e\x14\xe0\x6c\x42\xf2\x5f\x41\x8e\xd8\x30\xfd\x92\xe4\x5f\x52\x86\xe5\x89\x24\x96\xd8\xde\x91\x30\x12\x54\x71\x49\x1b\x8c\xfe\xa0\x37\x85\x2f\xdb\xcf\xcf\xdf\xbe\x3c\x15\x5f\xbf\xc6\xe6\xd7\x2a\xcf\x68\x31\x88\xdd\x1c\x38\x90\x3e\x3a\xae\x84\xb6\x1c\xe6\x64\xee\x3c\xc3\x46\x0b\xf8\x28\x0a\x93\x6b\xa7\xe4\x74\xea\xb6\xa6\x40\x72\xa5\xb6\xdc\xa0\xe8\x40\xfc\x23\x2e\xab\x82\x4a\x5b\x58\x47\x60\xac\xc0\x1a\xb4\x32\x38\x51\xa9\xe3\xa4\x2f\x99\xb6\x16\x01\xae\x25\x4e\x9d\x09\x63\x5d\x3c\x20\xa0\x9c\x51\x6e\x45\x3f\x92\xf2\x62\x61\xb7\x15\xda\xe9\xb2\xda\x09\xad\xc5\xae\x77\xb0\x05\xba\xc0\x33\x13\xa2\x4a\xbf\xdb\x8b\x22\xd2\x92\xb3\x6f\x90\xea\x4f\xf3\x3e\x5d\xfd\x04\x2e\x3d\xeb\xcd\x15\x58\x97\x75\xbd\x0b\xe5\x05\x51\x9a\x72\xe7\xd5\x2a\x7d\x20\x9a\x3b\x82\x59\x22\x20\x2f\xee\x4d\xbd\xae\xd1\xe3\x23\x3a\x60\x75\x46\xe9\xae\xc4\x94\xa7\xea\x3c\xc1\xc5\xf8\x15\x7f\x8c\x9e\x07\x04\x3d\xe7\x00\x41\x94\x80\x00\x51\x18\x05\x79\xfe\xec\xde\xd8\x4f\xc8\xd9\xfb\x08\xd4\xee\x61\x72\x8b\xab\x2a\xd5\xa7\xf7\xff\x45\x98\xca\x25\xad\x5c\xc9\xba\x72\xdb\x56\x6f\xfa\x2c\x1c\x01\x10\x0b\x3d\x46\xdd\x2e\x30\x83\x03\x2a\x05\x2f\x09\xd7\x19\x34\xcc\xb0\xa8\xef\xe8\x97\x0e\xa2\xe9\x94\xaf\x33\xbc\x04\x2d\x75\x48\x4a\x63\x19\x1b\x4e\xd3\xe7\x93\xe0\x42\x77\x77\xfe\x37\x56\xda\xe6\xe2\x75\x41\x18\x45\x35\x71\xc9\x08\xed\xc7\xba\xb5\x07\xee\xde\x75\xe6\x79\x0c\x48\xfa\x18\xf9\x90\xd2\x08\x2b\x78\x02\xc3\x00\xf6\x6d\x63\x71\x2c\x35\x4d\xa5\x21\xbd\x39\xea\xa8\x07\xa9\xe5\xac\x6d\x5a\xed\x84\xb0\x95\x33\x66\xe7\x4f\x68\x3c\x36\x8b\x6d\xdb\x93\xdd\x53\x0c\xdc\xf7\xdd\x6f\xd8\xbd\xfd\xa3\xed\xa7\x4a\x34\xdc\x66\x2e\x39\x9e\x82\xcb\x2f\x20\x89\x47\xe2\x02\x62\xaf\xb8\x82\xd8\x0d\xd2\x05\x30\xa7\xb3\x82\xd3\x4d\xcf\x25\x20\xaf\xb4\x96\x63\x3c\xd4\x66\x5a\x46\xa7\xb4\x8c\x16\xbc\x60\x30\x7b\x7d\x85\x4b\x29\xf1\x3b\xb4\x40\x72\x50\x49\xb0\x8b\xf4\xa3\x70\xc6\xa1\x57\x58\x0b\x3d\x1c\x9e\x73\x91\xb7\x3a\x2b\x58\xe3\x15\x61\xa9\x49\x44\xda\x6b\x51\x46\xb3\x7c\x2e\xcc\x4e\xe9\x6e\xb4\xd1\x06\xb0\x0a\x1d\x59\xac\xf9\x81\x25\xce\xda\xb7\x8f\xd7\x1c\x60\x70\x99\xd1\xfa\x57\x61\x2a\xbb\x15\x70\x2e\x80\x60\x53\xbc\xc3\xeb\xd4\xea\xb8\x80\x3c\x54\x5f\x2d\xd0\x41\x5f\xb5\x0b\x5b\xb8\x57\x2e\xde\x7b\xa3\xb7\x92\x85\x45\xb4\x56\x4b\x78\xf1\xd2\xfa\x5b\x5e\x95\x57\xbb\x0a\x66\x4a\x92\x29\xfa\x4e\x82\x24\x19\x36\x3c\x3f\x67\x07\x26\xf2\x4b\x56\x90\x2b\x54\x83\xef\xe0\xe1\xb0\xb7\xa7\xdd\x65\xee\xe0\xfb\x4e\x15\xf8\x39\x1c\x1f\x01\xb8\xfd\xad\x72\x8b\xbc\xd5\xf5\x58\xb3\x7d\x4d\xa7\xea\x73\xbc\x58\x30\x02\x77\x06\x93\x07\x96\xa1\xb2\x5f\x31\xb4\x34\xa4\x9d\x40\xfb\xf9\x3b\xf3\x99\x6a\x7c\x52\x41\x8a\xd2\x00\x1a\x1c\x05\x3f\xa2\xba\xb5\x40\xdb\xd9\xd4\xe8\xdb\xf1\xa1\xd3\xbf\xc8\x5b\xf3\xa3\xc9\x7d\xfd\x07\x33\x63\x33\xf8\xe0\xf8\x9e\x18\xde\xf5\x62\xd8\x53\xc5\xd7\x3d\x9f\x9b\xfd\x54\xd7\xf3\x33\x12\xcd\xcd\x48\x34\xac\x32\xf8\x0f\xbb\x1b\x2e\xab\xa9\xba\xda\x04\xfb\xc4\x4a\xa6\x61\x82\xfb\xcd\xa6\xde\xfc\x02\x20\xcb\xaf\x1f\xd7\x0e\x00\x00"
+
+func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleBuildTemplateJsonTpl,
+		"data/aws-simple/build/template.json.tpl",
+	)
+}
+
+func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleBuildTemplateJsonTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/build/template.json.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x04\xb7\xbd\x0c\xab\x93\xae\xd8\xa5\xc0\x0e\x3b\xed\x30\x60\xdb\x69\x97\xa1\x10\x14\x5b\x4e\x85\xda\x92\x27\xd1\xde\xd2\xcc\xff\x3e\x4a\x8c\x62\x4b\x29\xba\x1d\x86\x39\x97\xe8\x91\x22\xa9\xf7\x48\x5e\xb0\x0f\x52\x4b\x2b\x40\xd6\x6c\xbb\x67\x9f\x01\xcc\x6b\x56\x1b\xa6\x0d\x30\x59\x2b\x60\x9d\xd0\x83\x68\xdb\xfd\x6a\x35\x0a\xab\xc4\xb6\x95\xac\x50\xba\xb1\x82\xab\xba\x60\x87\x69\x01\x8b\x1f\x8e\x8b\xaa\x92\xce\xf1\x47\xb9\x47\x23\xab\x65\x23\x86\x16\xd8\x3b\x56\x14\x2c\x77\x75\xb2\xb2\x12\xfe\xca\x15\xcc\xa3\xd4\x7f\xf4\xb2\x72\xa7\x8c\xce\x8a\xc2\xf0\x5c\x8b\x4e\x66\xf0\xe0\xa4\xe5\xb5\x00\xf1\x4c\xd4\x65\xd8\x4e\x65\x17\x95\x76\x20\x74\x25\x39\xec\x7b\x99\x5d\x3e\x1c\x58\x62\xfe\x75\xb4\xdd\x15\xf0\xa6\xec\x54\x65\x0d\x86\x9f\xd2\xc2\x4f\x17\x2a\x33\x68\xc8\x02\xde\xa4\xbe\x52\x8f\xca\x1a\xdd\x49\x0d\xdc\x0d\x4d\xa3\x7e\xbe\xc8\x89\x1b\xb6\x1a\x09\xee\x87\x6d\xab\xaa\xec\x19\x63\x5f\xf1\x4a\xd5\xf6\x19\xf8\xa8\xeb\xaa\xb7\x66\x54\xb5\xb4\x81\x5c\x84\x56\x8c\xcd\xea\xfa\x6c\x97\x07\xbc\x58\xa6\xaa\x4f\x05\xba\xcd\xca\xa6\x6e\x33\x1e\xdc\x82\xaa\xa9\x47\x80\x82\x91\xc4\x64\xfe\x4b\x3c\x08\x47\x17\xac\xd0\x4a\x67\x06\x5b\xcd\xfd\x34\x58\x05\x7b\xbe\xb3\x66\xe8\x0b\x04\xfb\x9e\xca\xf6\xfa\x53\x1c\x54\x28\x1c\xa6\xe9\x9a\x42\xc6\x56\x9e\xe8\x78\xce\x70\x28\x86\x68\x99\x0b\xa1\x33\x9a\xd0\xa6\xf4\x0e\xeb\x70\x21\x11\x63\x48\x1a\x98\xca\xb4\x54\xf7\xf5\x4d\x00\x1b\x6b\x3a\xde\x1b\x0b\x01\xdc\x04\x0c\x4c\x44\x66\xcc\x0b\xc2\xb7\xad\xa9\x1e\x1d\x62\xdf\x8a\x4d\x19\x7e\xeb\x4d\x71\x8f\xf6\xc9\x67\x93\xff\x33\xd9\xe1\x8a\xa9\x86\x81\xd8\x39\x76\x35\x79\xc1\xfc\x3f\x4a\x8d\xa6\xc6\x58\x06\xf8\xfc\xe8\xe0\xc9\x85\xf2\x23\x8a\xee\x7b\x9c\xc8\x86\xf2\xab\x68\x07\xcf\x77\x11\xaf\x49\x5d\xfb\x9b\x21\xe0\xb4\x8a\x10\xe6\x41\xe4\x4c\xd3\x38\x1d\x4b\x35\xc3\xa0\xb0\xf8\x9d\x34\x49\x07\x29\xe4\xc3\xe9\x65\xec\xdc\x13\xe1\x60\x4e\x66\xf5\x99\x40\x1e\xa6\x7e\xa6\x41\xc2\x0e\x48\xe2\x24\xf3\x15\x1c\xe3\xb2\xc9\x12\x46\x38\xf8\x9c\x36\x4f\xea\x73\x82\xa9\xab\x7c\x87\xa5\x0d\x8d\xe9\x49\xa8\xcb\xc3\x79\xb7\x97\xc8\x4e\xe9\x3b\xf2\x7e\xd6\x6d\x34\xed\xd0\x49\xee\xd4\x93\x24\xb6\xad\x31\x40\x8a\xf3\x5a\x8e\x0a\x39\x26\x2d\x97\x8e\x24\xdb\x12\x21\xe9\x72\xa5\xd2\x6e\xf8\xe4\xdf\x9c\x8c\x57\xf1\x4f\xbb\x04\xf3\x99\x01\xfa\x01\x70\x71\xdb\x96\xda\x60\x0c\x57\x30\xc0\x03\x40\x7f\xb7\x5e\x13\x2d\x51\xbc\x40\xc8\xa6\x24\x6d\x78\xad\xdd\xb4\x2e\x96\x61\x54\x9f\x45\x79\xe9\xba\xea\x69\xdf\x10\xaf\xb5\xe9\x04\xbe\xe7\x6a\xb9\x37\x09\xcb\x96\x29\x81\xfc\xc9\x68\x79\x5a\xaa\x17\xec\x8b\x51\xd8\xbe\xf0\x20\x63\x20\xd3\x84\x13\x66\xc4\x5c\x02\xfc\xd6\x13\xe4\x10\xab\x71\xd9\x54\xa0\xe2\x20\xdf\xde\xe2\x2e\xac\x8c\xc5\xc0\x73\x7a\x7c\xd1\x31\xdd\xdc\x59\x69\x19\x81\xe2\xd8\xa3\x99\x0f\x6d\x65\x3f\x0c\x64\x7b\x1f\xce\xd0\xc6\x4e\xbd\xdd\x6c\x68\x35\xfb\xb4\xcb\x66\x4c\x68\x7b\xb5\xa4\xed\x7e\x49\xfa\xb2\xcc\x8c\xf8\xf4\x49\xc7\x7a\xca\xe6\x7b\x4d\xab\x7e\xd9\x7a\xbf\x01\x36\x54\x39\x30\xb3\x08\x00\x00"
+
+func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployMainTfTpl,
+		"data/aws-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x55\x51\x6f\xdc\xb8\x11\x7e\xe7\xaf\xf8\xa2\x75\x72\x09\x60\x49\xbd\xa0\xe8\x83\xef\x6c\x5c\xce\xf1\x35\x06\x52\xfb\x60\xe7\xfa\xd2\x16\x7b\xb4\x38\x92\x08\x6b\x39\xec\x90\xda\x78\xbb\xd6\x7f\x2f\x28\x69\xbd\xf6\x35\x71\xfa\xb0\x00\x35\x9c\xf9\xe6\x9b\x6f\x38\xb3\x0b\xfc\x95\x1c\x89\x8e\x64\x70\xb3\xc1\x65\x8c\x7c\x08\xc3\x70\x1c\x41\xc6\xc6\x17\x6a\xa1\x16\xf8\xd4\xda\x00\x1b\x10\x5b\xc2\xdf\x75\x23\xda\xc5\xda\x76\x84\xe6\x8f\xb1\xa8\x59\x46\x2f\x43\x6b\xea\xd8\xaf\xc8\x45\x70\xad\x16\x88\x09\x42\x7b\xdf\xd9\x4a\x47\xcb\xae\x0c\x24\x6b\x5b\x51\x81\xf3\x88\xd0\x72\xdf\x99\x31\xe9\x0d\xa1\xd5\xce\xe4\x29\x39\x99\x02\x9f\x18\x2b\x36\xb6\xde\x24\x58\xb5\x78\x9c\xfe\x10\x7d\xa0\x31\xdb\x3b\xef\x93\xa1\x50\x6a\xbe\x2e\x2a\x76\xb5\x6d\x7a\xa1\xd7\xd9\xdb\xec\x4d\xaa\xe8\x7e\x32\xdd\x2b\x60\x3a\x15\xeb\x55\x71\xc3\x77\x38\x46\xd6\xea\xd0\xda\x8a\xc5\x97\x5e\xa8\xb2\x81\xfe\xf2\xe7\x4c\x29\x60\x81\x0f\x1c\x22\xd8\x75\x1b\x38\x8a\x9f\x59\x6e\x9f\x84\xcf\x36\x64\x5e\xec\x5a\x47\x5a\xce\x86\xec\x10\xd6\x1f\x21\xdb\x6e\x93\x10\x4b\xeb\x97\xda\x18\xa1\x10\x30\x0c\x33\xf0\x35\xc5\xde\x43\x23\x6c\x5c\x45\x06\x35\x77\x86\x04\xb5\xf0\x0a\xdc\x0b\x12\x8a\x75\x0d\x8c\x15\xaa\x22\xcb\x06\x91\x51\xae\xa7\xea\x9e\x70\x98\x00\x96\x33\x40\x4a\xe9\x75\x6c\x8b\x1d\xc0\x30\x64\x87\xc8\x76\x91\xd9\xa1\x02\x00\xfe\xec\x48\x8e\x90\x3d\x58\xd1\x08\xf7\xfe\x91\x65\x22\x79\xe6\xf4\x4d\x47\xb8\xbe\xfe\x00\xdd\xa4\x56\xd6\x2c\x9f\xb5\x98\x04\x1c\x18\x0d\xc5\x98\x8e\x73\xf5\x30\xe4\xc9\x19\x72\x95\xa5\x30\x56\x10\xf6\x4c\x43\x68\x8b\x39\x7a\x39\x61\x1d\x23\x4a\x4f\x53\xa2\x5f\xb8\x77\x66\x7c\x17\xd8\x75\x6e\xfa\x7a\x6d\x6b\x68\xb7\x79\xa3\x80\xed\xcb\x94\x3e\x29\x02\xeb\x50\x3f\x44\x2c\x8d\x95\x50\x18\x5a\xe3\xe5\xa0\x30\xde\x1f\x23\x2b\x39\x46\x2e\xf7\x5e\xf9\x76\x9b\xc2\x3b\x66\x5f\x9c\x72\xef\x22\xc9\xd8\x8c\xe7\xa5\x4c\x60\xa3\x82\xc6\xca\x13\x57\x2f\xbc\xb6\x21\x31\xcc\x42\x4b\x5d\x97\x3a\xee\x3a\xeb\xe8\x08\x59\x65\xb0\xd8\x1a\x2b\x03\x5e\xbd\xc2\x8d\x0e\xed\xfc\x59\xae\xb4\x75\x45\x68\xb3\xa9\x18\x72\x26\xd5\xf3\x72\x98\x24\xf8\xc8\xda\x40\x77\xdd\xd8\xfe\x5a\x74\x93\x66\x27\xa0\x25\xa1\xb1\x6e\xed\x36\x4f\x04\x2e\xf6\x92\xec\xbc\x61\xdd\xf8\xde\xf6\xd1\xa3\x22\xa9\xf2\xd9\x72\x2f\xa4\x0d\x86\xe1\x8b\x0c\xce\x5d\x88\x89\xc0\x4d\x6f\x3b\x03\x72\x6b\x2b\xec\x52\xd4\xff\x5b\xf9\x41\xa8\xc4\xfa\xb8\xd4\xde\x2b\x72\x46\xa9\x47\x06\x1c\xe3\xc7\x1f\xaf\x4f\xaf\xce\x7f\xfd\xa4\x16\x2f\xca\x1b\xeb\xca\x24\x8d\x52\x81\x22\x72\x86\xe3\xde\xcd\x47\x12\xa1\x3b\x3b\x1e\xbd\xf5\x54\x6b\xdb\xcd\xe6\x28\xba\x22\xa5\x48\x84\xe5\xf5\x1b\x6c\x15\x80\x8e\x2b\xdd\x21\x70\x2f\x15\xa5\x25\x70\x7c\xf0\xfd\xde\x9c\x78\x39\x3e\x3e\x78\x9b\x4c\x54\xb5\x8c\xec\xec\xea\xea\xf2\x0a\x3a\xe2\x60\xbb\x0f\x1a\x8e\x0e\xb6\x93\xef\xf0\x03\x3e\xea\x10\xd1\x71\x13\x8e\x52\xa7\xd0\x08\x79\x70\x9c\xe6\x4f\xca\x8e\x9b\x32\x6c\x42\xc7\x0d\xee\x11\x47\x6e\x0e\x6f\xff\xa4\x06\x15\x45\x7b\x7c\x37\x92\x43\x76\xb0\xfd\xf9\xdd\xf5\x87\xe5\xf5\xe5\x6f\x57\xa7\x67\x43\x96\x0c\x1f\xcf\x2f\xce\x2e\x2e\x87\xec\x3b\x9c\x5d\x5d\x29\xc5\x94\x4a\x40\x76\xf0\x53\x86\xb7\x27\xaf\xbe\xc7\x7d\x4a\xda\x90\x20\x8f\x53\xbe\x13\x94\x86\xd6\xa5\xeb\xbb\xee\x07\x0c\x8a\xbb\x31\x60\x2a\xe3\x1f\xc9\xe3\x5f\x38\xf8\x29\x4b\x57\x6a\x81\xbf\xe9\x5b\x82\x8d\x08\x8c\xd8\xea\x88\xdf\xe7\x51\x46\x08\xed\xef\x68\x98\xc2\xbc\x4c\xba\x71\x97\xa4\xb5\x59\xb1\x24\x43\xb2\xab\x09\xb5\x32\x0f\x4b\x26\xc3\xc9\x09\xca\x96\x57\xb4\xb3\x94\x45\xea\x98\x54\x29\xdb\xe9\x3c\xa5\x69\xfc\xd3\x7a\x18\x9f\xa1\x0e\x91\x24\x15\x61\x9d\xb2\x35\x5e\x4c\xd2\x65\xbf\x05\x7a\x7f\x71\x0d\xc7\x19\x4a\x8a\x55\x19\x42\x9b\x7e\x66\x39\x3d\x2a\x9c\x3c\xaa\x32\xb6\xe4\xd4\xae\x55\x8f\x02\xef\x11\x7a\xc3\x88\x44\xc8\xf5\xb7\x60\x14\xc0\x34\x05\xcc\xff\x32\x49\x04\x08\x85\xa8\x25\xaa\xda\x2a\xc5\x1d\xb2\x77\x66\x5c\x64\xda\x47\x08\x79\x0e\x36\xb2\x58\x0a\xd0\xce\xa0\xf7\x69\x6d\xb8\xa6\x28\x8a\x4c\xd1\x9d\x67\x89\x78\x7f\xf6\xf3\xf9\xbb\x8b\xe5\x2f\x57\x97\x17\x9f\xce\x2e\xde\x1f\x3b\x76\xd6\x45\x12\x5d\x45\xbb\x26\xb5\x4b\xa9\x7d\xcc\x1b\x8a\x13\x04\x21\xdf\xfc\xcf\x8d\x9d\x07\x2d\xdf\xc0\x6f\x62\xcb\x2e\x0f\x5c\xc7\xcf\x5a\x28\xf7\xc2\x9e\x24\x26\x1a\x5f\xb0\xe5\x15\xaf\x56\xec\xf6\x80\xc6\xe4\x09\xf4\x81\xfe\x66\xc4\xf4\xfa\xa8\xbe\x95\xbe\xeb\x4a\x43\xda\x04\xa7\x6f\x29\x3c\x43\x6f\x14\x63\x1e\xfe\x24\xc8\xaf\x23\xa7\xb4\x36\x26\x76\xcb\x35\xc9\x38\xf0\xc3\x30\x6a\x13\x7a\x9f\xf4\x48\xae\x5e\x57\xb7\xba\xa1\x30\xca\xf4\xcd\x32\xbf\x88\xf8\xf5\xab\x3c\xed\xf4\x7f\x2a\xec\x44\x5a\x5b\x89\xbd\xee\xc8\xad\x71\xf3\x1f\x41\x63\x23\x56\x24\x55\x2f\x56\xcf\x4b\x2b\xa7\x10\xc8\xc5\xf4\x5d\xf5\xd2\x4d\x95\x9d\x0a\x8d\xad\xc4\x3e\xfe\x09\xdd\xbc\xc7\x6e\x54\x1e\x65\xc8\xfd\x73\xa4\x9f\xce\xc5\x3e\x6c\x1e\xa3\xe2\xab\x0e\xe3\xe2\x1b\x1f\x8c\x8e\xf4\xdc\x88\x6d\x5f\xc2\xd6\x10\xfa\x77\x6f\x85\x1e\x96\xf9\x1f\x3a\xf5\xf8\xfa\x6b\x35\x3d\xcb\xc4\x5b\xbf\x6f\x93\x3c\xcc\x7e\xf9\x04\x38\xde\x45\x35\xfd\x5b\xd8\x3a\x91\x98\xb7\xf8\x7f\x07\x00\x55\x54\x7f\x41\x3b\x0a\x00\x00"
+
+func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevVagrantfileTpl,
+		"data/common/dev/Vagrantfile.tpl",
+	)
+}
+
+func dataCommonDevVagrantfileTpl() (*asset, error) {
+	bytes, err := dataCommonDevVagrantfileTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev/Vagrantfile.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/aws-simple/build/build-python.sh.tpl": dataAwsSimpleBuildBuildPythonShTpl,
+	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
+	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
+	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"aws-simple": &bintree{nil, map[string]*bintree{
+			"build": &bintree{nil, map[string]*bintree{
+				"build-python.sh.tpl": &bintree{dataAwsSimpleBuildBuildPythonShTpl, map[string]*bintree{
+				}},
+				"template.json.tpl": &bintree{dataAwsSimpleBuildTemplateJsonTpl, map[string]*bintree{
+				}},
+			}},
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+		"common": &bintree{nil, map[string]*bintree{
+			"dev": &bintree{nil, map[string]*bintree{
+				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,16 @@
+package pythonapp
+
+import (
+	"github.com/hashicorp/otto/helper/compile"
+	"github.com/hashicorp/otto/helper/schema"
+)
+
+type customizations struct {
+	Opts *compile.AppOptions
+}
+
+func (c *customizations) processPython(d *schema.FieldData) error {
+	c.Opts.Bindata.Context["python_version"] = d.Get("python_version")
+	c.Opts.Bindata.Context["run_command"] = d.Get("run_command")
+	return nil
+}
@@ -0,0 +1,68 @@
+#!/bin/bash
+
+set -o nounset -o errexit -o pipefail -o errtrace
+
+error() {
+   local sourcefile=$1
+   local lineno=$2
+   echo "ERROR at ${sourcefile}:${lineno}; Last logs:"
+   grep otto /var/log/syslog | tail -n 20
+}
+trap 'error "${BASH_SOURCE}" "${LINENO}"' ERR
+
+oe() { "$@" 2>&1 | logger -t otto > /dev/null; }
+ol() { echo "[otto] $@"; }
+
+# cloud-config can interfere with apt commands if it's still in progress
+ol "Waiting for cloud-config to complete..."
+until [[ -f /var/lib/cloud/instance/boot-finished ]]; do
+  sleep 0.5
+done
+
+ol "Adding apt repositories and updating..."
+export DEBIAN_FRONTEND=noninteractive
+oe sudo apt-get update -y
+oe sudo apt-get install -y software-properties-common
+oe sudo add-apt-repository -y ppa:fkrull/deadsnakes
+oe sudo apt-get update -y
+
+ol "Installing Python {{ python_version }} and supporting packages..."
+oe sudo apt-get install -y python{{ python_version }} python{{ python_version }}-dev \
+  python-virtualenv bzr git mercurial build-essential
+
+ol "Extracting app..."
+sudo mkdir -p /srv/otto-app
+sudo tar zxf /tmp/otto-app.tgz -C /srv/otto-app
+
+ol "Adding application user..."
+oe sudo adduser --disabled-password --gecos "" otto-app
+
+ol "Creating virtualenv..."
+oe sudo virtualenv -p python{{ python_version }} /srv/otto-venv
+
+{% if requirements %}
+ol "Installing requirements..."
+oe sudo /srv/otto-venv/bin/pip install -r /srv/otto-app/requirements.txt
+{% endif %}
+
+ol "Setting permissions..."
+oe sudo chown -R otto-app: /srv/otto-app /srv/otto-venv
+
+ol "Configuring upstart..."
+cat <<UPSTART | sudo tee /etc/init/otto-app.conf > /dev/null
+# Generated by Otto
+description "{{ name }}"
+
+start on runlevel [2345]
+stop on runlevel [06]
+
+respawn
+
+chdir /srv/otto-app
+env PATH=/srv/otto-venv/bin:/usr/local/bin:/usr/bin:/bin
+env PORT=80
+
+exec {{ run_command }} >>/var/log/otto-app.log 2>&1
+UPSTART
+
+ol "...done!"
@@ -27,6 +27,7 @@ func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
 			AssetDir: AssetDir,
 			Context:  map[string]interface{}{},
 		},
+		Builders: true,
 		Customizations: []*compile.Customization{
 			&compile.Customization{
 				Type:     "ruby",
@@ -63,6 +64,22 @@ func (a *App) Deploy(ctx *app.Context) error {
 	}).Route(ctx)
 }
 
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+// Verify implements app.AppVerify by validating the compiled Packer
+// template and Terraform configuration.
+func (a *App) Verify(ctx *app.Context) error {
+	if err := packer.Verify(ctx, &packer.BuildOptions{}); err != nil {
+		return err
+	}
+
+	return terraform.Verify(ctx, &terraform.DeployOptions{})
+}
+
 func (a *App) Dev(ctx *app.Context) error {
 	return vagrant.Dev(&vagrant.DevOptions{
 		Instructions: strings.TrimSpace(devInstructions),
@@ -77,8 +94,10 @@ const devInstructions = `
 A development environment has been created for writing a generic
 Ruby-based app.
 
-Ruby is pre-installed. To work on your project, edit files locally on your
-own machine. The file changes will be synced to the development environment.
+Ruby and Bundler are pre-installed, and if your app has a Gemfile, its
+gems were installed with 'bundle install'. To work on your project, edit
+files locally on your own machine. The file changes will be synced to the
+development environment.
 
 When you're ready to build your project, run 'otto dev ssh' to enter
 the development environment. You'll be placed directly into the working
@@ -8,4 +8,6 @@ import (
 
 func TestApp_impl(t *testing.T) {
 	var _ app.App = new(App)
+	var _ app.DeployReporter = new(App)
+	var _ app.AppVerify = new(App)
 }
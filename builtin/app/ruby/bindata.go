@@ -3,7 +3,7 @@
 // data/aws-simple/build/build-ruby.sh.tpl
 // data/aws-simple/build/template.json.tpl
 // data/aws-simple/deploy/main.tf.tpl
-// data/aws-vpc-public-private/build/build-ruby.sh
+// data/aws-vpc-public-private/build/build-ruby.sh.tpl
 // data/aws-vpc-public-private/build/template.json.tpl
 // data/aws-vpc-public-private/deploy/main.tf.tpl
 // data/common/dev/Vagrantfile.tpl
@@ -94,7 +94,7 @@ func dataAwsSimpleBuildBuildRubyShTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\xcd\x8e\x9b\x30\x18\xbc\xf3\x14\x16\x52\xf6\x14\x20\xed\xae\xaa\xaa\xd7\x3e\xc6\x2a\x62\x0d\x78\x83\x15\xdb\x58\xfe\xec\x54\x59\xe4\x77\xef\x67\xfe\xa9\x36\x24\xdd\x5c\x8c\x3c\xc3\xcc\x78\xf8\x9c\x36\x22\xf8\x8b\x25\x57\xb9\xa6\xe5\x99\x99\xfc\xc2\x0c\xf0\x46\xc5\xbf\x48\x7c\x48\x7f\xa6\x87\x78\x1f\xf5\x9c\x0b\x35\x9c\x16\x82\x01\x42\xfd\x6b\xb8\x49\xff\x40\x4e\xcb\x92\x01\xe4\x67\x76\x45\x44\x39\x21\xf6\x4b\x14\x58\x69\x98\xbd\x85\x1a\x76\xea\xcd\x56\x08\x08\x77\xc2\x3c\xb6\x1e\x80\x6e\xdf\x8f\x41\xb4\x69\x2e\x3c\x64\xc4\xa4\x48\x78\x1d\xde\x6a\x77\xe4\xbd\x31\xa4\xe2\x86\x70\x85\x8f\x4e\x55\xd4\x22\x2b\xc7\x1d\x48\x0b\xc7\x45\x45\x76\x7e\x24\x0f\x2b\xca\xd9\xab\x66\xe1\xb4\x50\x33\x21\xe2\xfd\x0c\x70\x25\xb8\x0a\xd0\x6b\x2c\xcf\x41\x36\xd1\x24\xb3\x52\x67\x8d\xb5\x4d\x36\x1b\x24\x6d\x1b\x9c\x45\xd3\xe8\xf4\x37\xee\x5a\x66\x88\xf7\xf1\x71\x50\xf2\xfb\xdb\x9e\xef\x5c\xb0\xa5\x25\x34\xce\x94\x1d\x82\x9a\xc1\xd2\xfb\x6c\x89\x57\x0c\x2c\x57\x9d\x6b\x20\xfd\x47\x9a\x07\xc2\x6c\x15\x50\x56\x8f\x1e\xdd\x7b\xf2\xf4\x44\x0a\x0a\x35\x49\x33\x49\xb9\x4a\xa1\xfe\xa4\x8b\x1d\x61\xaa\x0a\xdf\x6b\xeb\x93\x6c\xd4\xb3\x23\x38\xa8\x05\x86\x90\xa8\x80\x29\x1c\xe0\x39\xdf\xa6\xc1\x79\xc3\x33\xf7\x1e\x0b\xda\x23\x4d\x26\x54\xeb\xd4\x9e\x3e\xbe\x54\x18\x94\x86\x6b\x1b\xa0\x6e\xdc\x12\xe3\x8a\x6b\x38\xfe\xa8\xd5\xad\xc7\x71\x8e\x3b\xce\x30\xc3\xd3\x85\x52\x54\x76\xda\x21\xcb\x24\x3d\x39\x52\x49\x3f\xb0\x75\x56\xc0\x8c\xad\xae\xdf\xad\x62\xd6\xf7\x74\xbb\x9d\x78\x75\x65\xb7\x14\x67\xe2\x1d\xc5\xe9\x9a\x6f\xa9\xf5\xa4\x7b\xd9\xba\x11\xc8\xa9\xe4\x7d\x1f\x3c\xf9\xfe\xed\xc7\xf3\xa1\x7a\x79\x99\x39\x5c\x81\xa5\x0a\x59\x63\x6d\xe5\x73\x2a\xa8\x39\xb1\x85\x0c\xd4\x79\x70\x1e\xeb\x76\x05\x0e\xaf\x5b\x94\x2a\x79\x3e\x62\x6d\x1b\x9e\x70\xae\xff\xcd\x8e\x2b\x4e\x11\x95\xfa\xb3\xc4\xfd\x7f\xd6\x31\x8a\x7c\xf4\x37\x00\x00\xff\xff\x16\x08\x5f\x5b\x65\x05\x00\x00"
+var _dataAwsSimpleBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\x08\x06\xda\x53\xe3\xf4\x85\x61\x28\xd0\xd3\x80\x5d\xb6\xf3\x2e\x45\xe0\xca\xb6\x92\x08\x91\x65\x43\x8f\x0c\x6d\xe6\xff\x3e\xca\xf2\x43\xf2\x33\x19\xd6\x4b\x13\x91\xfc\x48\x7e\xa2\x48\xe6\xbc\x42\xf0\x17\x64\x94\x47\x05\x4e\x8e\x44\x44\x27\x22\x24\xcd\x79\xf0\x82\x82\xfb\xf0\x6b\x78\x1f\xdc\xad\xac\xce\x09\x0b\x8a\x63\x46\x24\x88\xce\xd5\x11\x42\xe7\x1b\xb4\xcb\x05\x3a\x22\xca\x51\xac\x29\x4b\x23\xc2\x4f\xe8\xa6\xac\xc5\x41\x7b\x16\x9d\xcf\xa0\x55\x96\x06\x16\x10\x5b\x6b\xc2\x53\x03\xd0\x59\xe0\xdf\x32\xc2\x49\x42\xa4\x8c\x8e\xe4\xc3\x53\xaf\x64\x92\x24\x82\xa8\x71\x99\xca\x8f\x84\xfb\xc7\x52\x1e\x8c\x6e\xc4\x71\x46\x86\x92\x42\xd0\x13\x56\xa4\xd2\xd8\x51\x46\x86\x90\x82\xec\x2d\x19\x5c\x33\xd6\xd9\x32\xbd\x07\xbe\xd4\xa1\x2f\xb0\xf9\x5a\x23\xd9\xf3\x77\xc0\x82\x98\xd4\x72\xcd\x55\x4f\x66\xcd\x28\x97\x0a\xf3\x84\x44\xea\xa3\xa8\x42\x01\xce\x46\x24\x7f\x52\xb2\xc3\x9a\xa9\x97\x20\x79\x0a\x19\x16\x7b\x12\x18\x62\x3b\x47\xb9\x16\xa0\x8a\x33\x5a\x63\x74\x07\x9d\x29\x7c\x59\x3f\x3e\x7c\x79\xba\x4f\x9f\x9f\x7d\xf3\x53\x91\x44\x34\xed\xc5\xae\x63\x0e\xa4\x0f\x8e\x8b\x5c\x19\x0e\x13\x32\x75\x1e\x61\xad\x72\xf8\x98\xa7\x3a\x51\x95\x52\xa5\x53\x36\x35\x05\x92\x13\x35\xe5\x06\x45\x07\xe2\x37\xbf\xac\x52\x2a\x4c\x61\xed\x80\xb1\x14\x2b\xd0\x8a\xe0\x44\x86\x15\x27\x5d\xc9\x34\xb5\x08\x70\x0d\x71\xf2\x40\x18\x6b\xe3\x01\x01\xe5\x8c\x72\x23\x7a\x0b\xb2\xa3\x81\x5d\x17\x68\xa3\xb2\x62\x93\x2b\x95\x6f\x3a\x07\x6b\xa0\x0b\x3c\xb3\x3c\x2f\xc2\x6f\xe6\xa2\x88\x30\xe4\x6c\x6b\xa4\xf2\x6e\xda\x67\x55\x3f\x8e\x4b\xcb\x7a\x7d\x05\xc6\x65\x59\x6e\x5c\x79\x4a\xa4\xa2\xbc\xf2\x6a\x94\xae\x88\xe6\x82\x60\xe6\x08\x48\xd2\x4b\x53\x2f\x4b\x74\x7b\x8b\x62\x2c\x0f\x28\xdc\x64\x98\xf2\x50\x1e\x46\xb8\x18\xbe\xe2\xeb\xe8\xb9\x41\xd0\x73\x62\x08\x22\x03\x04\x88\x42\x4b\xc8\xf3\xbd\x7d\x63\xef\x90\xb3\xf5\xe1\xa8\x5d\xc2\xe4\x1a\x17\x45\xa8\xf6\x9f\xff\x44\x98\x4c\x04\x2d\xaa\x92\xad\xca\x6d\x2d\x74\xfc\x61\xd2\x87\x48\xe8\xce\xeb\x75\x8e\x11\x1c\x50\x91\xf3\x8c\x70\x15\x41\xbb\x74\x4b\xfa\x82\x6e\x59\x41\xd4\x7d\xf2\x75\x82\x15\xa7\xa1\xf6\x29\xa9\x2d\x7d\xc3\x71\xf2\x6c\x12\x3c\x57\xed\x8d\xff\xc4\x52\x99\x5c\xac\x2e\x08\xbd\xa8\x46\xae\x18\xa1\xed\x50\xb7\xb4\xc0\xed\xab\x8e\x2c\x8b\x0e\x49\xd7\x51\x0f\x29\x0d\xb0\x9c\x07\xd0\x0f\x60\xdb\xb4\x95\x8a\xa5\xba\xa5\xd4\xa4\xd7\x47\x2d\xf5\x20\x35\x9c\x35\x2d\xab\x99\x0f\xa6\x6e\x86\xec\x7c\x87\xb6\x63\xb2\x58\x37\x1d\xb9\x7a\x88\x8e\xfb\xae\xf7\xf5\x7b\xb7\x7d\xb2\xdd\x4c\xf1\x46\xdb\xc4\x25\xfb\x33\x70\xbe\xfe\x03\x7f\x20\xce\x20\x76\x8a\x0b\x88\xed\x18\x9d\x01\xab\x74\x16\x70\xda\xd9\x39\x07\x64\x95\x96\x72\xf4\x47\xda\x44\xc3\x68\x95\xe6\xd1\x9c\x17\x0c\x66\xaf\xaf\x70\x29\x19\xfe\x84\x06\x48\x62\x19\x38\x9b\x48\x37\x08\x27\x1c\x5a\x85\xa5\xd0\xdd\xd1\x39\x15\x79\xa3\xb3\x80\x35\x5c\x10\xe6\x9a\x84\xa7\xbd\x14\xa5\x37\xc9\xa7\xc2\x6c\x95\x2e\x46\x1b\xcc\xff\x45\x68\xcf\x62\xc9\x0f\xac\x70\xc6\xbe\x79\xbc\x3a\x86\xb1\xa5\x07\xcb\x5f\x81\xa9\x68\x17\xc0\xa9\x00\x9c\x3d\xf1\x02\xaf\x63\x8b\xe3\x0c\x72\x5f\x7d\xb1\x40\x7b\x7d\xd5\xac\x6b\xee\x56\x39\x7b\xef\xb5\xde\x42\x16\x06\xd1\x58\xcd\xe1\xf9\x2b\xeb\x7f\x79\x55\x56\xed\x94\x33\x9d\x91\x48\xd2\x4f\xe2\x24\xc9\xb0\xe6\xc9\x21\x8a\x59\x9e\x1c\xa3\x94\x9c\xa0\x1a\x6c\x07\x77\x47\xbd\x39\x6d\x2f\x73\x03\xdf\x37\x32\xc5\x0f\xee\xf8\x70\xc0\xcd\x2f\x95\xb3\xe7\xad\x2c\x87\x9a\xcd\x6b\xda\x17\x8f\xfe\x5a\xc1\x08\xdc\x19\x4c\x1e\x58\x85\xb2\x6e\xc1\x50\x42\x93\x66\x02\x6d\xa7\xef\xcc\x66\xaa\xf0\x5e\x3a\x29\x0a\x0d\x68\x70\xe4\xfc\x84\x6a\xd7\x02\x65\x66\x53\xad\x6f\xc6\x87\x0a\x7f\x90\x8f\xfa\x27\x53\xf5\xf5\x17\x66\xda\x64\x70\xe5\xf8\x1e\x19\xde\xe5\x6c\xd8\x63\xc5\xd7\x3e\x9f\xb3\xf9\x54\x96\xd3\x33\x12\x4d\xcd\x48\xd4\xaf\x32\xf8\x0f\x9b\x1b\xce\x8a\xb1\xba\x5a\x39\xfb\xc4\x42\xa6\x6e\x82\xdb\xd5\xaa\x5c\xfd\x05\x05\x2e\x25\x4a\xd5\x0e\x00\x00"
 
 func dataAwsSimpleBuildTemplateJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -114,7 +114,7 @@ func dataAwsSimpleBuildTemplateJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x52\xc1\x8e\xdb\x20\x10\xbd\xe7\x2b\x46\x74\x8f\x5d\x27\xed\xb1\x52\xcf\xbd\xb5\x1f\x50\xad\x10\xc6\x24\x45\x8b\x01\xc1\x90\xca\xb2\xfc\xef\x1d\xa0\x16\xc1\xbb\xbd\x36\x39\xf9\xcd\x63\xde\xcc\x9b\xf7\x01\xbe\x29\xab\x82\x40\x35\xc1\xb8\xc0\x0f\x44\xf7\x11\x26\x07\xd6\x21\xa8\x49\x23\xcc\xc2\x26\x61\xcc\x72\x3a\xdd\x45\xd0\x62\x34\x0a\x98\xb6\xd7\x20\xb8\x9e\x18\xac\xdb\x03\x2c\x7e\x47\x2e\xa4\x54\x31\xf2\x57\xb5\xbc\x53\x8c\x4a\x06\x85\xff\x28\x06\x75\xd3\xce\x1e\x0a\x44\xe5\x56\xcc\xaa\xc0\x8f\x0f\x66\x7d\x60\x6a\x1b\x51\x58\xa9\x38\x2e\x3e\xd3\x61\x52\x57\x91\x0c\xc2\x57\x60\xf8\x79\x98\xb5\x0c\x8e\xc1\xe3\x8b\x98\x46\x4b\xd3\xf8\x34\x1a\x2d\x0f\xdd\xee\x5e\x72\xa9\xa7\xf0\x0e\xfc\x77\xed\x93\x0f\xee\xae\x27\x15\xca\xf4\x04\x9d\x00\xda\xf2\x59\xf5\x69\xa5\x87\x43\x6f\xca\xc6\x88\xd6\x6c\xe8\x69\x0d\x2f\xb4\x6a\x08\xe4\x5f\x47\xab\x38\x51\x68\x88\xa0\xa2\x4b\x41\x36\x7f\x53\xd0\xb8\xf0\x5b\x70\xc9\x33\x02\xbd\xaf\x93\x65\x0f\x6b\x9f\x75\xad\x1f\xdb\xf6\x5c\x5b\xee\xc7\x2c\x9a\x75\xc1\xa6\x57\xbf\xa9\x44\x35\x6d\x6f\x24\x17\x4b\x3f\x00\x5a\x1f\x9d\x74\xa6\x8e\xf7\xfc\xa9\x80\xd7\xe0\x66\xee\x5d\xc0\x02\x5e\x0a\x86\x6e\x47\x1a\x96\xad\xe5\xa3\x71\xf2\x35\x12\xf6\x93\x5d\x86\xf2\x3f\x5f\xd8\x0b\xd5\xb7\xac\xa6\xfe\x9b\xd8\x1b\x1b\xf7\x28\x3d\x1a\x48\x81\x83\xf6\x6b\xf7\x98\x75\xf1\xad\x4b\x5f\x2b\x77\x70\xbd\x7d\x0d\x1d\x79\xdc\xf5\xe9\xb2\x58\x88\x7b\xf2\x0f\x82\x3b\x5c\x4f\x92\xcf\xd3\x1f\x9d\x3a\xd7\x2d\x9f\xd6\xb7\x89\x18\x68\x9d\x21\x9f\xf3\x25\x3f\x46\x71\x23\x7f\xe1\x7b\x16\xe9\x82\xc1\xaa\x29\x2e\xa1\x4f\x08\x2c\x05\x53\x3d\xb8\x0b\x93\x0a\xf5\x17\xa2\xff\x72\x3e\x57\x89\x7d\xc7\xd2\xbc\x2e\xc0\x27\x1b\xb7\x73\x0e\xe8\x9f\x00\x00\x00\xff\xff\x5f\x73\x79\x4b\x5f\x04\x00\x00"
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xbd\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x04\xb7\xbd\x0c\xab\x93\xae\xd8\xa5\xc0\x0e\x3b\xed\x30\x60\xdb\x69\x97\xa1\x10\x14\x5b\x4e\x85\xda\x92\x27\xd1\xde\xd2\xcc\xff\x3e\x4a\x8c\x62\x4b\x29\xba\x1d\x86\x39\x97\xe8\x91\x22\xa9\xf7\x48\x5e\xb0\x0f\x52\x4b\x2b\x40\xd6\x6c\xbb\x67\x9f\x01\xcc\x6b\x56\x1b\xa6\x0d\x30\x59\x2b\x60\x9d\xd0\x83\x68\xdb\xfd\x6a\x35\x0a\xab\xc4\xb6\x95\xac\x50\xba\xb1\x82\xab\xba\x60\x87\x69\x01\x8b\x1f\x8e\x8b\xaa\x92\xce\xf1\x47\xb9\x47\x23\xab\x65\x23\x86\x16\xd8\x3b\x56\x14\x2c\x77\x75\xb2\xb2\x12\xfe\xca\x15\xcc\xa3\xd4\x7f\xf4\xb2\x72\xa7\x8c\xce\x8a\xc2\xf0\x5c\x8b\x4e\x66\xf0\xe0\xa4\xe5\xb5\x00\xf1\x4c\xd4\x65\xd8\x4e\x65\x17\x95\x76\x20\x74\x25\x39\xec\x7b\x99\x5d\x3e\x1c\x58\x62\xfe\x75\xb4\xdd\x15\xf0\xa6\xec\x54\x65\x0d\x86\x9f\xd2\xc2\x4f\x17\x2a\x33\x68\xc8\x02\xde\xa4\xbe\x52\x8f\xca\x1a\xdd\x49\x0d\xdc\x0d\x4d\xa3\x7e\xbe\xc8\x89\x1b\xb6\x1a\x09\xee\x87\x6d\xab\xaa\xec\x19\x63\x5f\xf1\x4a\xd5\xf6\x19\xf8\xa8\xeb\xaa\xb7\x66\x54\xb5\xb4\x81\x5c\x84\x56\x8c\xcd\xea\xfa\x6c\x97\x07\xbc\x58\xa6\xaa\x4f\x05\xba\xcd\xca\xa6\x6e\x33\x1e\xdc\x82\xaa\xa9\x47\x80\x82\x91\xc4\x64\xfe\x4b\x3c\x08\x47\x17\xac\xd0\x4a\x67\x06\x5b\xcd\xfd\x34\x58\x05\x7b\xbe\xb3\x66\xe8\x0b\x04\xfb\x9e\xca\xf6\xfa\x53\x1c\x54\x28\x1c\xa6\xe9\x9a\x42\xc6\x56\x9e\xe8\x78\xce\x70\x28\x86\x68\x99\x0b\xa1\x33\x9a\xd0\xa6\xf4\x0e\xeb\x70\x21\x11\x63\x48\x1a\x98\xca\xb4\x54\xf7\xf5\x4d\x00\x1b\x6b\x3a\xde\x1b\x0b\x01\xdc\x04\x0c\x4c\x44\x66\xcc\x0b\xc2\xb7\xad\xa9\x1e\x1d\x62\xdf\x8a\x4d\x19\x7e\xeb\x4d\x71\x8f\xf6\xc9\x67\x93\xff\x33\xd9\xe1\x8a\xa9\x86\x81\xd8\x39\x76\x35\x79\xc1\xfc\x3f\x4a\x8d\xa6\xc6\x58\x06\xf8\xfc\xe8\xe0\xc9\x85\xf2\x23\x8a\xee\x7b\x9c\xc8\x86\xf2\xab\x68\x07\xcf\x77\x11\xaf\x49\x5d\xfb\x9b\x21\xe0\xb4\x8a\x10\xe6\x41\xe4\x4c\xd3\x38\x1d\x4b\x35\xc3\xa0\xb0\xf8\x9d\x34\x49\x07\x29\xe4\xc3\xe9\x65\xec\xdc\x13\xe1\x60\x4e\x66\xf5\x99\x40\x1e\xa6\x7e\xa6\x41\xc2\x0e\x48\xe2\x24\xf3\x15\x1c\xe3\xb2\xc9\x12\x46\x38\xf8\x9c\x36\x4f\xea\x73\x82\xa9\xab\x7c\x87\xa5\x0d\x8d\xe9\x49\xa8\xcb\xc3\x79\xb7\x97\xc8\x4e\xe9\x3b\xf2\x7e\xd6\x6d\x34\xed\xd0\x49\xee\xd4\x93\x24\xb6\xad\x31\x40\x8a\xf3\x5a\x8e\x0a\x39\x26\x2d\x97\x8e\x24\xdb\x12\x21\xe9\x72\xa5\xd2\x6e\xf8\xe4\xdf\x9c\x8c\x57\xf1\x4f\xbb\x04\xf3\x99\x01\xfa\x01\x70\x71\xdb\x96\xda\x60\x0c\x57\x30\xc0\x03\x40\x7f\xb7\x5e\x13\x2d\x51\xbc\x40\xc8\xa6\x24\x6d\x78\xad\xdd\xb4\x2e\x96\x61\x54\x9f\x45\x79\xe9\xba\xea\x69\xdf\x10\xaf\xb5\xe9\x04\xbe\xe7\x6a\xb9\x37\x09\xcb\x96\x29\x81\xfc\xc9\x68\x79\x5a\xaa\x17\xec\x8b\x51\xd8\xbe\xf0\x20\x63\x20\xd3\x84\x13\x66\xc4\x5c\x02\xfc\xd6\x13\xe4\x10\xab\x71\xd9\x54\xa0\xe2\x20\xdf\xde\xe2\x2e\xac\x8c\xc5\xc0\x73\x7a\x7c\xd1\x31\xdd\xdc\x59\x69\x19\x81\xe2\xd8\xa3\x99\x0f\x6d\x65\x3f\x0c\x64\x7b\x1f\xce\xd0\xc6\x4e\xbd\xdd\x6c\x68\x35\xfb\xb4\xcb\x66\x4c\x68\x7b\xb5\xa4\xed\x7e\x49\xfa\xb2\xcc\x8c\xf8\xf4\x49\xc7\x7a\xca\xe6\x7b\x4d\xab\x7e\xd9\x7a\xbf\x01\x36\x54\x39\x30\xb3\x08\x00\x00"
 
 func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -134,27 +134,27 @@ func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateBuildBuildRubySh = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x56\x7f\x53\x13\xcb\x12\xfd\x7f\x3f\x45\x1b\x28\x79\xaf\x8a\xdd\x05\xea\xf9\xae\x26\x62\x5d\x40\x50\xaa\xac\xc4\x0a\x7a\x7f\x94\x7a\xa9\xcd\x6e\x27\x19\xd9\xcc\xac\x33\xb3\x81\x80\xf9\xee\xf7\xf4\x6c\xc8\x0f\x51\xcb\x7f\x60\x33\x33\xdd\x7d\xba\xfb\xf4\x99\xd9\x7a\x94\x0e\x94\x4e\x07\x99\x1b\x47\x91\x63\x4f\xb1\x21\x6d\x6a\xbd\xf8\x64\x6b\xf9\x46\x85\xcf\x4a\x55\x3c\xcc\x54\xb9\x58\xf6\x36\xcb\x39\x8a\xf0\x65\xec\x7f\xfe\x4b\x77\x11\x11\x95\x26\xcf\x4a\x72\xa6\xb6\x39\x0f\x55\xc9\x87\xdb\xfb\xab\xe5\x52\x69\xd6\xe6\x70\xfb\x40\x96\x38\x1f\x1b\x6a\x9d\xf6\xfb\xbd\x3e\x65\x9e\xb6\xef\x56\x46\xf3\xf6\xf6\x5d\x73\x76\xde\xa1\x37\x99\xf3\xb0\x1f\xb9\x76\x4b\xcc\x46\x96\x2b\x32\xde\x1b\x4a\xa7\x99\x4d\xb1\x91\xba\x99\xc3\x3f\xfa\x4a\x3e\x60\xd3\x74\xb0\x17\xcd\x23\xa0\xab\x68\x27\x80\xa3\xd6\xf6\xdd\xf1\xd1\xc5\xeb\xcb\x8b\xde\xfb\xfe\xc9\xe9\xbc\x25\x0b\x6f\xce\xbb\xa7\xdd\xde\xbc\xb5\x43\xc0\x10\x45\x86\x25\x05\x6c\xfc\xde\xa2\x83\x17\x8f\xf7\xe1\x0e\x4e\x47\x6c\x29\xf6\x4d\xbc\x17\x94\x16\x3c\x4d\x75\x5d\x96\x1d\x9a\x47\xa6\x0c\x06\x4d\x1a\x1f\xe4\xc4\x27\x82\xb1\x6c\x45\x5b\x94\x97\xa6\x2e\xe2\xdc\xe8\xa1\x1a\x51\x9e\x69\x52\xda\xb3\x1d\xb2\x65\xba\x56\x7e\x4c\x59\xe5\x29\x37\x93\x49\xa6\x0b\x47\x6a\x48\xca\xef\x38\x72\x5e\x95\x25\x4e\x52\x65\x0d\xf2\x74\x0e\x41\xa8\xf5\x67\xa6\xbc\xd2\x23\x1a\x22\x91\x0d\xb7\xc0\x04\x17\x55\xc9\x9e\x93\x24\x69\x45\xb5\x86\x3d\x7d\xf8\x40\xf1\x70\x51\x1c\x35\x48\x83\x45\xaa\xb4\xf3\x99\xce\x39\x1d\x18\xe3\xe3\xa1\xd2\xca\x8d\xb9\xa0\x4f\x9f\x3a\x54\x18\x94\xd5\x95\x8c\xb2\xee\x25\x4f\xa2\xc2\x68\xf4\x54\xe2\x1e\x15\x85\x84\x15\xa4\xa8\xb9\x71\xca\x1b\xab\xd8\x11\x20\x53\x5d\x15\x99\x80\x0a\x71\x0d\x93\xab\x0b\x23\x27\xe3\x11\x48\x13\x36\xf9\xc1\x72\xc0\x80\xfc\xe2\x19\xf8\x31\xf4\xd7\x99\xe5\x18\x89\x56\x6c\x3d\xdc\xc6\x52\x0d\xa3\x57\x56\x45\x11\x8b\xe5\x32\xf4\x4c\x0c\xab\x2a\x6b\xe7\x63\xab\x5c\x5c\x72\x96\x6a\x53\x70\xf2\xd9\x6d\x44\x12\xbb\x87\x36\x03\xab\x46\x63\x3f\x30\x37\xa9\xad\x07\xb3\x58\x8f\x36\x6c\xae\x78\x86\x78\x53\x8a\xe5\xcb\xb1\x9d\xa2\xeb\xe3\xab\xaa\x9d\xa6\xcb\xdf\x49\x3d\x40\x79\xeb\x04\x28\xdb\x4f\xf7\x70\xd2\x72\x3e\x0d\xc7\xe9\xc9\xff\xf7\xcf\x9e\x1d\x3f\x3b\x39\x3a\xf9\xdf\xde\xf1\xc1\xd9\x6f\x51\xa0\xc4\x4e\xc1\x03\x1a\x7b\x5f\x39\xb8\x31\xce\xc5\x98\xb1\x4c\xea\x97\x54\xe3\xda\x29\xa3\xab\xcc\x39\xd6\x20\x98\xf8\x4c\x01\x23\x5d\xae\x90\xb7\xb5\xf3\x33\x9a\x64\x4a\xef\x80\x88\x01\xa8\x67\xa6\x94\x7d\x1e\x8e\x36\xb3\xe2\x92\x52\x39\x9f\x14\x2b\xcb\xb0\xb0\xce\xd4\x1f\x35\x07\x14\x7d\xd7\x7b\xd9\x6b\x53\x95\xd9\x6c\x02\x06\x59\x75\xcb\x24\xc5\x21\x64\x2b\xf8\x28\x03\x31\x75\x55\xfb\x88\x6f\x2a\x63\x3d\xf5\xdf\x1f\xff\x7d\xf9\xc7\x69\xff\xe2\xbc\xd7\x3d\x6c\xdd\xdd\x85\xd3\x97\xf7\xa7\xe7\xf3\x56\x43\x9b\xf3\xa6\xcd\x42\x9d\x3e\x0e\xec\xd2\xdb\x7b\x70\xbb\xd4\x1d\x29\x7d\xb3\x1b\x18\x64\xfc\x18\x89\x56\x59\x7e\x95\x8d\x90\x88\xf0\x68\x11\xe7\xe5\xe9\xf1\xf9\x51\xf7\xf2\xac\xdf\xeb\xbe\x3b\xed\xbe\x3c\xd4\x46\x87\xe1\xc9\x72\xaf\xa6\x3f\xa5\xd5\xe0\xd6\xd2\x08\x52\x35\x61\x9b\xd7\x56\x41\x71\x06\xb5\x2a\x8b\x98\x05\x80\x97\xdf\x1f\xc1\x75\x4c\x45\xf5\x25\x46\x81\xe8\x16\x9f\xfb\xa3\xf0\xf9\x63\x46\x06\x1b\x09\x06\x41\xd1\x4e\x10\xc6\xa1\xad\x61\x5d\x18\xf8\xb9\xf9\x94\x6a\x6c\xaf\xd7\xe8\xe1\x4a\x88\x14\xcc\xa4\x0e\x31\xdf\xc0\xa5\xa3\x65\xf3\x1e\xd4\xef\xb8\xd6\x45\x89\xa6\xae\x0f\xd9\x88\x27\xcb\x94\x07\xcd\x3e\xd8\xa8\x4d\x6c\xd5\xe2\x7f\x61\xf2\xc6\xd3\xa9\xf8\xcf\x7d\x33\xc4\x55\xf0\x12\x5c\x4c\xae\x0a\x05\xa3\x8a\x52\x67\xa7\xa9\x28\x17\x26\xad\x6a\xf6\x7c\x66\xe9\xf6\x06\xfa\xe1\x27\xd5\x72\x2b\xf1\xa3\x5b\x8a\x4f\xbe\x39\xbf\x29\x12\x55\xa9\x72\x48\x02\xea\x55\xbb\x6f\x20\x63\x24\x65\x0d\xf0\x0a\xe5\xb2\x41\xc9\x45\x2c\x39\x5f\x1b\x5b\x60\x6d\xc4\xb9\x71\xd4\x6a\xd1\xa6\xe3\x0b\xf6\x01\x39\x9a\x31\x51\x4e\x28\xe6\x36\x9c\x62\xc6\xae\x35\xc5\xfd\xa5\x59\xfb\x7b\xf0\x4e\x82\x52\x82\x0b\xf0\x14\x8a\x1e\x7c\x08\xf9\xc7\x0a\xf4\x76\x50\xb6\x2f\xb5\xb2\x90\x42\x51\xd7\xb5\x01\x94\x42\x7b\xca\xb0\x9f\x39\xa3\x05\x34\xb1\x9e\x2a\x6b\xf4\x04\x54\xa2\xeb\xb1\x28\x39\xa8\x06\x69\x87\x37\x08\x6a\x41\x7c\xc3\x79\xed\xe5\xa8\x03\xc7\xae\x30\xad\xb5\xb3\xe1\x6a\x85\xe5\xee\xea\x17\xa8\x59\xee\x12\x26\x39\xa1\x73\x84\x28\x9d\x70\x19\x63\x08\xbf\xe5\x0c\xce\x34\x33\xee\x04\x20\x30\x39\x8e\xd2\x18\xca\x25\x77\x02\xe6\x85\x1a\xe1\x4f\xe8\xa8\xaa\x58\x87\xc2\x03\x82\x24\xa2\x5d\x3d\x1c\xaa\x5c\xc1\x47\x42\xed\xf8\x6b\xd3\x4c\x87\xbc\x62\x45\x3b\xfb\x2e\xfd\x47\x40\xd0\xdb\xa3\x77\xaf\x3b\x1f\x75\xba\xd3\x28\x49\xa8\x48\xf3\x37\x11\xd7\xdf\xb1\xda\xa2\x1e\x0a\xda\x26\x79\x0c\x88\x35\x06\x65\xad\x4c\x72\xb1\x39\xe8\xd2\xbd\xb6\xfd\xc4\x35\x12\xeb\x22\x31\xc9\xcb\xf2\xc4\x4c\x19\x09\x01\x39\xdc\x85\x43\x52\x68\x64\x0d\x59\x23\x28\x37\x37\x48\xec\x64\xdd\x99\xac\xbb\x98\x43\x33\x0a\x08\xdc\x30\xab\x4b\xdf\xf4\x92\x1d\x87\xc7\x05\x2e\x27\xb4\xa5\xc2\xd5\x29\x4d\xc2\x6c\xc9\x08\xe3\xd3\xdd\x17\x70\x09\x3d\x5e\x28\x4f\x41\x2b\x8c\xbb\x18\x28\x0f\x7f\xe1\x2e\x46\xdf\x55\x43\x84\x02\x8a\x00\x26\x38\x16\xa9\xf4\xf7\x4d\x80\x3b\x24\xef\x9b\x72\x99\x1a\xd1\xc0\x7c\xbd\x88\x97\x44\x98\x05\x7a\xfe\xbc\xfb\xea\xbc\xfb\xd7\x49\xaf\x7b\xf6\x40\xc4\x9b\x94\xc4\xd5\x86\x7c\xcb\xc2\x86\x7c\x6f\xd1\x2b\xd6\x2c\x71\x0b\x82\x36\x4b\x33\xa2\xe5\xf1\x4b\x8b\xdb\xbc\x21\x96\x5c\xf5\xa2\x37\xe9\x14\xc4\x30\xd8\x91\xef\xc5\x4d\x73\xb9\x34\x48\xe5\x1d\xe6\xc3\x2c\xe1\x09\xd0\x59\xf7\x24\xca\xbf\xa4\xe8\x6a\x7d\x68\x99\xc3\x66\x27\x5a\x26\x13\xfd\x62\x76\x9b\x0d\x5b\x8a\xc9\x2f\xe5\xb8\xb8\x85\xc3\x9b\x92\xe4\x52\x63\x4d\x4f\xf7\x3a\xe1\x67\x93\xf5\xfa\xb0\xa7\x55\x3d\x80\xfe\x34\xdb\x2b\xf0\x8b\xd0\x64\x74\x07\x0f\xc2\x35\xfc\x22\x0d\x8d\xb8\xde\x6b\xa9\xcc\x92\x10\x64\x43\x28\xe3\x7a\xa9\x2e\x32\x11\xcb\x67\x32\xc5\x65\x4e\xad\xbc\xd8\x04\x41\x8f\x1f\x2f\x14\x79\x75\x27\x41\xf1\xab\xd2\xcc\x82\x66\xc4\xb1\xbc\xfc\x84\x2a\xc8\x9c\x4b\x53\x85\x55\x94\xc8\x2f\x6e\x4e\x04\x96\xd7\xd7\xa3\x56\xf4\x6f\x00\x00\x00\xff\xff\x4a\x32\x5d\xfc\x93\x0b\x00\x00"
+var _dataAwsVpcPublicPrivateBuildBuildRubyShTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\x6d\x6f\x1b\x37\x12\xfe\xbe\xbf\xe2\xa9\x64\xc4\x77\x80\xb9\x6b\x07\xd7\xbb\x56\x6e\x8a\xda\xae\xd3\x1a\x28\xe4\x42\x49\xef\x05\x69\xce\xe0\x2e\x47\xbb\xac\x29\x92\x25\x67\x65\x2b\x8e\xfe\x7b\x41\xae\x2c\x59\x75\x5b\xe4\x8b\xc4\x1d\x72\x66\x9e\x79\x7b\xc8\xf1\x67\x55\xad\x6d\x55\xcb\xd8\x15\x45\x24\x86\x70\xb0\xae\xb7\x9b\x25\x85\x40\xf7\x3a\x2f\xbd\xf6\x34\x97\xda\x6c\xc4\x1c\x64\x43\x45\x41\x21\xb8\xf0\xb7\xbf\xe3\xa1\x00\x60\x5c\x23\x0d\xa2\xeb\x43\x43\x73\x6d\xe8\xd5\xc1\xc9\x4e\x6c\xb4\x25\xeb\x5e\x1d\xbc\x4c\x22\x6a\x3a\x87\xd1\xe5\x6c\x76\x3d\x83\x64\x1c\x3c\xec\x94\xd6\x93\x83\x87\xe1\xec\xfa\x14\x3f\xc8\xc8\x30\xae\x8d\x93\x51\x52\x6b\x03\x79\x38\x66\x87\x6a\x29\x43\x65\x5c\x5b\xc5\x55\x34\xae\xc5\x47\x70\xc6\x66\xf1\xf2\xb8\x58\x17\x1c\xa4\xc7\x61\x06\x87\xd1\xc1\xc3\xf9\xd9\x9b\xef\x6f\xde\x5c\xff\x34\xbb\xb8\x5c\x8f\x92\xe0\x87\xab\xe9\xe5\xf4\x7a\x3d\x3a\xc4\xe5\x6c\x56\x14\x8e\x52\x08\x18\x1d\x7c\x33\xc2\xcb\xaf\x5f\x9c\xe0\x63\x72\xda\x52\x80\xe0\xc1\xdf\xd7\xa8\x14\x2d\x2b\xdb\x1b\x73\x8a\x75\xe1\x4c\x56\x18\xc2\x78\x97\x4e\xbc\xc7\xc1\x37\xa3\xb4\x55\x8c\xd1\x18\xd7\x2b\xd1\x38\x3b\xd7\x2d\x1a\x69\xa1\x2d\x53\x98\x53\x20\xdc\x69\xee\x20\x3d\xa3\x71\x8b\x85\xb4\x2a\x42\xcf\xa1\xf9\x30\x22\xb2\x36\x06\xda\xc2\x07\xd7\x06\x8a\xb1\x70\x06\xa3\xff\x48\xcd\xda\xb6\x98\xbb\xb0\x6f\x96\x5d\x32\xe1\x0d\x31\x95\x65\x39\x2a\x7a\xcb\xda\xe0\xdd\x3b\x88\xf9\x26\x39\xba\xae\xb2\x46\xa5\x6d\x64\x69\x1b\xaa\x6a\xe7\x58\xcc\xb5\xd5\xb1\x23\x85\xf7\xef\x4f\xa1\x5c\x01\x44\x43\xe4\x71\x5c\x7e\x5e\x28\x67\xa9\xc8\x7e\xcf\x94\x4a\x6e\x13\xd2\x40\xde\x45\xcd\x2e\x68\x8a\x90\x56\xa1\xf7\x4a\x26\x50\xd9\xaf\x23\xc4\x5e\xb9\x74\x52\xb4\xc4\xc3\x26\x3d\x13\x67\x0c\xc6\x40\xac\x10\xdd\x9c\xef\x64\x20\xe1\x83\xf3\x14\x58\x53\x14\x29\x1b\xce\xee\xb4\x94\x12\x49\x73\xeb\x7a\x95\x14\xbd\x97\x93\xa6\x0b\x3a\x0a\x43\xb2\xb2\x4e\x51\xf9\x4b\xdc\xf3\x94\xf4\x9e\xeb\xd4\x41\xb7\x1d\xd7\xee\xbe\x0a\x7d\xbd\x12\xb6\xdd\xd3\xb9\xa5\x15\xa4\x5a\x42\xa4\x55\xa4\xb0\xa4\x80\xee\xd6\x4f\xaa\x6a\xfb\x5d\xf6\x75\x6f\xb9\x2f\x1b\xb7\x98\x7c\x71\x0c\x21\x02\x35\xcb\x7c\x1c\x9f\xff\xf3\xe4\xf5\x97\xe7\x5f\x5e\x9c\x5d\xfc\xe3\xf8\xfc\xe5\xeb\x7f\x15\xb9\x25\x0e\x15\xd5\xe8\x98\x7d\x9c\x54\x95\x8b\x51\xd4\xda\xca\x94\xbf\xd2\x77\x7d\xd4\xce\x7a\x19\x23\xd9\x96\x42\xb2\x59\x49\xcf\xd5\x56\x02\x0e\x7d\xe4\x15\x16\x52\xdb\x43\x7c\x1c\x80\x32\x11\x2a\xe2\x26\x1f\x1d\x66\x25\x96\x46\x47\x2e\xd5\x4e\x33\x0b\x9e\x76\xea\x9f\x15\xa7\xa0\x7b\xef\x02\x63\xf6\xd3\xf9\xff\x6e\xfe\x7d\x39\x7b\x73\x75\x3d\x7d\x35\x7a\x78\x40\xca\xcf\xcd\x92\x42\x82\x88\xf5\x7a\x34\xf4\xc2\xd5\x50\xbb\xd4\x0f\xb3\xbe\x5e\x1d\xe1\xc7\x47\x8f\x47\x98\xb6\xda\xde\x1f\xe5\xb6\x70\xdc\x51\x80\x97\xcd\xad\x6c\x29\xe6\xe6\xd8\xf8\xf9\xf6\xf2\xfc\xea\x6c\x7a\xf3\x7a\x76\x3d\x7d\x7b\x39\xfd\xf6\x95\x75\x36\x4f\x84\x6c\x58\x2f\xff\xb2\x57\xea\x0f\x01\xad\x66\x2c\x28\x34\x7d\xd0\xd2\xa0\xee\xb5\x51\x82\x12\x00\x4e\xdf\x3f\x17\x80\xd1\xb5\xff\x55\x28\x5a\xe2\x83\xd1\xf5\x49\x9b\x97\x7f\xde\x66\x59\x27\x39\xe3\x20\x6d\x4c\x08\x45\xae\x55\x96\xa7\xb6\xfa\x65\x58\xa6\x6c\x1c\x3c\xcd\xd1\x73\x49\xf6\x94\xd5\x52\x1e\x04\xdd\x73\x90\x11\xdb\x8a\x3c\xcb\xdf\x79\x6f\x95\xa1\xb0\x37\x39\x2d\x2d\xb6\x21\xd7\xc3\x3e\x84\xb0\x4e\x04\xbd\xf9\x57\xae\x19\x2c\x5d\x26\xfb\x0d\x0f\x93\xe9\xb3\x95\x6c\x62\x71\xab\x74\x80\xf0\xa8\x62\x58\x56\x8e\xd9\x09\xe9\xfd\xb0\xc7\x32\xe0\xc3\xfd\x1c\x15\x2f\xfc\x76\xab\xe4\xf6\x03\xc4\xc5\xef\xce\xef\x4f\xbe\x37\xba\x91\x9c\x3a\xa1\x8f\xbf\x83\x2c\x95\x4a\x32\x08\xa1\x74\x94\xb5\x21\x25\x52\xcc\x77\x2e\x28\x08\xd1\x52\xe3\x22\x46\x23\xec\x1b\x7e\x43\x9c\x91\x7b\x0a\x0b\x1d\x53\x8b\xc5\x3d\xa3\x4d\xe7\xee\x2c\xc4\x6c\xab\x36\xf9\x23\x78\x17\x99\xfe\xfa\x90\x2c\xe5\xa4\x67\x1b\xc5\x18\x6f\x3b\x1d\xa1\x23\x02\xfd\xda\xeb\x40\x2a\x53\xe6\x93\xa9\x4a\x89\x66\x48\x04\x92\xd1\xd9\x04\x1a\x64\x97\x3a\x38\xbb\x20\xcb\xb8\xeb\x28\x10\x34\x27\xbe\x2e\xc6\x98\x6b\xab\x40\xf7\xd4\xf4\x9c\x8e\x46\x18\x7d\x4b\xa8\xfa\x18\xf2\x7d\x49\x76\x79\xb4\xfb\x6a\xfa\x60\x8e\x40\xdc\x94\xb8\x62\x48\x13\x53\x2f\x7b\x19\xc8\xb2\x59\x15\x63\x58\x22\x15\x13\x02\xd7\x34\x7d\x40\xa7\xdb\x0e\xda\x82\x3b\xc2\xc0\xe6\x25\xce\xbc\x27\x9b\x13\xaf\x39\x45\xa1\x6d\xec\xe7\x73\xdd\x68\xb2\x5c\x62\x22\x3e\x0e\xc5\x8c\xa4\x20\x34\x0e\x4f\x62\xf5\xff\x04\x02\x3f\x9e\xbd\xfd\xfe\xf4\x67\x5b\x1d\x0e\xf4\x90\x33\x32\xfc\x96\xc9\xf4\x1f\x68\x8d\x71\xcd\xec\x26\x88\xc4\x59\x1b\xd1\x3d\x49\x53\xba\xad\x22\x11\x1e\x09\xeb\x2f\x4c\x17\x63\x4c\x89\x54\x8a\x2b\xd0\xc2\x2d\x09\x9c\x4a\x10\xdd\x50\x97\x94\x68\x15\xe1\xfa\x80\xa8\x99\x06\x24\x61\xf1\xd4\x58\x92\x47\x41\xb9\x18\xaa\x52\x34\x97\xbd\xe1\xa1\x96\x14\x29\xbf\x18\x22\x64\x20\xf8\x40\x69\xde\x21\x63\xbe\x37\xc9\x72\x7c\x4c\xe0\x16\xba\xd8\x30\x8f\xc2\x0e\xe3\x11\xea\x9e\x8b\xf1\x70\xc1\x92\x8c\x7a\x68\x04\x15\x9c\x87\x44\x24\x2f\x83\xe4\xc7\x22\x80\x3b\x69\xc1\x43\xba\x5c\xcf\x90\x16\xbd\xdd\xf8\x2b\x8b\x46\x32\xbe\xfa\x6a\xfa\xdd\xd5\xf4\xbf\x17\xd7\xd3\xd7\xcf\x98\x79\x08\x29\x99\xda\xe3\xe4\x24\xd8\xe3\xe4\x31\xbe\x23\x4b\xc9\xaf\x42\xbd\xca\xc5\x28\xb6\xc7\x6f\x82\x73\x3c\x34\x56\xba\xbf\x13\xdf\x54\x4b\xb2\xca\x85\x9b\xbc\xde\x5c\x1f\x37\x5b\x85\xca\xb8\x61\x48\x63\xa9\xad\x3e\x7d\x6a\xa9\xaf\x57\xbb\x16\xdd\xc9\xe7\x81\x28\x6f\x9e\x16\xdb\x60\x8a\x4f\x8c\x6e\xbf\x60\x5b\x32\xf9\xa4\x18\x37\x57\x6b\x7e\x28\x22\xdd\x54\x64\xf1\xc5\xf1\x69\xfe\x1c\xa2\x7e\x3a\xec\x95\xef\x6b\xa3\x9b\x61\x7b\x07\x7e\xe3\x1a\xce\x9e\x16\xeb\xa7\xf8\x13\x35\x0c\xe4\xfa\xc8\xa5\x69\x96\x52\x83\xec\x11\xa5\xe8\xb7\xec\x92\x26\x62\xfb\xf6\x85\x30\x0d\x46\x8d\xda\x07\x81\x17\x2f\x50\xef\x19\x4d\x8c\x47\xde\xb8\x55\xe6\x0c\x21\xd2\x73\x2e\xb5\x8a\xa2\x25\x19\xe7\xb3\x94\x29\xf2\xe6\xe6\x2c\xcb\x52\x39\x4b\x9f\x8d\x8a\xdf\x06\x00\xc0\x2b\xf9\x46\x68\x0b\x00\x00"
 
-func dataAwsVpcPublicPrivateBuildBuildRubyShBytes() ([]byte, error) {
+func dataAwsVpcPublicPrivateBuildBuildRubyShTplBytes() ([]byte, error) {
 	return bindataRead(
-		_dataAwsVpcPublicPrivateBuildBuildRubySh,
-		"data/aws-vpc-public-private/build/build-ruby.sh",
+		_dataAwsVpcPublicPrivateBuildBuildRubyShTpl,
+		"data/aws-vpc-public-private/build/build-ruby.sh.tpl",
 	)
 }
 
-func dataAwsVpcPublicPrivateBuildBuildRubySh() (*asset, error) {
-	bytes, err := dataAwsVpcPublicPrivateBuildBuildRubyShBytes()
+func dataAwsVpcPublicPrivateBuildBuildRubyShTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateBuildBuildRubyShTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/aws-vpc-public-private/build/build-ruby.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/build/build-ruby.sh.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x54\xcd\x8e\x9b\x30\x18\xbc\xf3\x14\x16\x52\xf6\x14\x20\xed\xae\xaa\xaa\xd7\x3e\xc6\x2a\x62\x0d\x78\x83\x15\xdb\x58\xfe\xec\x54\x59\xe4\x77\xef\x67\xfe\xa9\x36\x24\xdd\x5c\x8c\x3c\xc3\xcc\x78\xf8\x9c\x36\x22\xf8\x8b\x25\x57\xb9\xa6\xe5\x99\x99\xfc\xc2\x0c\xf0\x46\xc5\xbf\x48\x7c\x48\x7f\xa6\x87\x78\x1f\xf5\x9c\x0b\x35\x9c\x16\x82\x01\x42\xfd\x6b\xb8\x49\xff\x40\x4e\xcb\x92\x01\xe4\x67\x76\x45\x44\x39\x21\xf6\x4b\x14\x58\x69\x98\xbd\x85\x1a\x76\xea\xcd\x56\x08\x08\x77\xc2\x3c\xb6\x1e\x80\x6e\xdf\x8f\x41\xb4\x69\x2e\x3c\x64\xc4\xa4\x48\x78\x1d\xde\x6a\x77\xe4\xbd\x31\xa4\xe2\x86\x70\x85\x8f\x4e\x55\xd4\x22\x2b\xc7\x1d\x48\x0b\xc7\x45\x45\x76\x7e\x24\x0f\x2b\xca\xd9\xab\x66\xe1\xb4\x50\x33\x21\xe2\xfd\x0c\x70\x25\xb8\x0a\xd0\x6b\x2c\xcf\x41\x36\xd1\x24\xb3\x52\x67\x8d\xb5\x4d\x36\x1b\x24\x6d\x1b\x9c\x45\xd3\xe8\xf4\x37\xee\x5a\x66\x88\xf7\xf1\x71\x50\xf2\xfb\xdb\x9e\xef\x5c\xb0\xa5\x25\x34\xce\x94\x1d\x82\x9a\xc1\xd2\xfb\x6c\x89\x57\x0c\x2c\x57\x9d\x6b\x20\xfd\x47\x9a\x07\xc2\x6c\x15\x50\x56\x8f\x1e\xdd\x7b\xf2\xf4\x44\x0a\x0a\x35\x49\x33\x49\xb9\x4a\xa1\xfe\xa4\x8b\x1d\x61\xaa\x0a\xdf\x6b\xeb\x93\x6c\xd4\xb3\x23\x38\xa8\x05\x86\x90\xa8\x80\x29\x1c\xe0\x39\xdf\xa6\xc1\x79\xc3\x33\xf7\x1e\x0b\xda\x23\x4d\x26\x54\xeb\xd4\x9e\x3e\xbe\x54\x18\x94\x86\x6b\x1b\xa0\x6e\xdc\x12\xe3\x8a\x6b\x38\xfe\xa8\xd5\xad\xc7\x71\x8e\x3b\xce\x30\xc3\xd3\x85\x52\x54\x76\xda\x21\xcb\x24\x3d\x39\x52\x49\x3f\xb0\x75\x56\xc0\x8c\xad\xae\xdf\xad\x62\xd6\xf7\x74\xbb\x9d\x78\x75\x65\xb7\x14\x67\xe2\x1d\xc5\xe9\x9a\x6f\xa9\xf5\xa4\x7b\xd9\xba\x11\xc8\xa9\xe4\x7d\x1f\x3c\xf9\xfe\xed\xc7\xf3\xa1\x7a\x79\x99\x39\x5c\x81\xa5\x0a\x59\x63\x6d\xe5\x73\x2a\xa8\x39\xb1\x85\x0c\xd4\x79\x70\x1e\xeb\x76\x05\x0e\xaf\x5b\x94\x2a\x79\x3e\x62\x6d\x1b\x9e\x70\xae\xff\xcd\x8e\x2b\x4e\x11\x95\xfa\xb3\xc4\xfd\x7f\xd6\x31\x8a\x7c\xf4\x37\x00\x00\xff\xff\x16\x08\x5f\x5b\x65\x05\x00\x00"
+var _dataAwsVpcPublicPrivateBuildTemplateJsonTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xad\x57\x4b\x6f\xdb\x30\x0c\xbe\xe7\x57\x08\x06\xda\x53\xe3\xf4\x85\x61\x28\xd0\xd3\x80\x5d\xb6\xf3\x2e\x45\xe0\xca\xb6\x92\x08\x91\x65\x43\x8f\x0c\x6d\xe6\xff\x3e\xca\xf2\x43\xf2\x33\x19\xd6\x4b\x13\x91\xfc\x48\x7e\xa2\x48\xe6\xbc\x42\xf0\x17\x64\x94\x47\x05\x4e\x8e\x44\x44\x27\x22\x24\xcd\x79\xf0\x82\x82\xfb\xf0\x6b\x78\x1f\xdc\xad\xac\xce\x09\x0b\x8a\x63\x46\x24\x88\xce\xd5\x11\x42\xe7\x1b\xb4\xcb\x05\x3a\x22\xca\x51\xac\x29\x4b\x23\xc2\x4f\xe8\xa6\xac\xc5\x41\x7b\x16\x9d\xcf\xa0\x55\x96\x06\x16\x10\x5b\x6b\xc2\x53\x03\xd0\x59\xe0\xdf\x32\xc2\x49\x42\xa4\x8c\x8e\xe4\xc3\x53\xaf\x64\x92\x24\x82\xa8\x71\x99\xca\x8f\x84\xfb\xc7\x52\x1e\x8c\x6e\xc4\x71\x46\x86\x92\x42\xd0\x13\x56\xa4\xd2\xd8\x51\x46\x86\x90\x82\xec\x2d\x19\x5c\x33\xd6\xd9\x32\xbd\x07\xbe\xd4\xa1\x2f\xb0\xf9\x5a\x23\xd9\xf3\x77\xc0\x82\x98\xd4\x72\xcd\x55\x4f\x66\xcd\x28\x97\x0a\xf3\x84\x44\xea\xa3\xa8\x42\x01\xce\x46\x24\x7f\x52\xb2\xc3\x9a\xa9\x97\x20\x79\x0a\x19\x16\x7b\x12\x18\x62\x3b\x47\xb9\x16\xa0\x8a\x33\x5a\x63\x74\x07\x9d\x29\x7c\x59\x3f\x3e\x7c\x79\xba\x4f\x9f\x9f\x7d\xf3\x53\x91\x44\x34\xed\xc5\xae\x63\x0e\xa4\x0f\x8e\x8b\x5c\x19\x0e\x13\x32\x75\x1e\x61\xad\x72\xf8\x98\xa7\x3a\x51\x95\x52\xa5\x53\x36\x35\x05\x92\x13\x35\xe5\x06\x45\x07\xe2\x37\xbf\xac\x52\x2a\x4c\x61\xed\x80\xb1\x14\x2b\xd0\x8a\xe0\x44\x86\x15\x27\x5d\xc9\x34\xb5\x08\x70\x0d\x71\xf2\x40\x18\x6b\xe3\x01\x01\xe5\x8c\x72\x23\x7a\x0b\xb2\xa3\x81\x5d\x17\x68\xa3\xb2\x62\x93\x2b\x95\x6f\x3a\x07\x6b\xa0\x0b\x3c\xb3\x3c\x2f\xc2\x6f\xe6\xa2\x88\x30\xe4\x6c\x6b\xa4\xf2\x6e\xda\x67\x55\x3f\x8e\x4b\xcb\x7a\x7d\x05\xc6\x65\x59\x6e\x5c\x79\x4a\xa4\xa2\xbc\xf2\x6a\x94\xae\x88\xe6\x82\x60\xe6\x08\x48\xd2\x4b\x53\x2f\x4b\x74\x7b\x8b\x62\x2c\x0f\x28\xdc\x64\x98\xf2\x50\x1e\x46\xb8\x18\xbe\xe2\xeb\xe8\xb9\x41\xd0\x73\x62\x08\x22\x03\x04\x88\x42\x4b\xc8\xf3\xbd\x7d\x63\xef\x90\xb3\xf5\xe1\xa8\x5d\xc2\xe4\x1a\x17\x45\xa8\xf6\x9f\xff\x44\x98\x4c\x04\x2d\xaa\x92\xad\xca\x6d\x2d\x74\xfc\x61\xd2\x87\x48\xe8\xce\xeb\x75\x8e\x11\x1c\x50\x91\xf3\x8c\x70\x15\x41\xbb\x74\x4b\xfa\x82\x6e\x59\x41\xd4\x7d\xf2\x75\x82\x15\xa7\xa1\xf6\x29\xa9\x2d\x7d\xc3\x71\xf2\x6c\x12\x3c\x57\xed\x8d\xff\xc4\x52\x99\x5c\xac\x2e\x08\xbd\xa8\x46\xae\x18\xa1\xed\x50\xb7\xb4\xc0\xed\xab\x8e\x2c\x8b\x0e\x49\xd7\x51\x0f\x29\x0d\xb0\x9c\x07\xd0\x0f\x60\xdb\xb4\x95\x8a\xa5\xba\xa5\xd4\xa4\xd7\x47\x2d\xf5\x20\x35\x9c\x35\x2d\xab\x99\x0f\xa6\x6e\x86\xec\x7c\x87\xb6\x63\xb2\x58\x37\x1d\xb9\x7a\x88\x8e\xfb\xae\xf7\xf5\x7b\xb7\x7d\xb2\xdd\x4c\xf1\x46\xdb\xc4\x25\xfb\x33\x70\xbe\xfe\x03\x7f\x20\xce\x20\x76\x8a\x0b\x88\xed\x18\x9d\x01\xab\x74\x16\x70\xda\xd9\x39\x07\x64\x95\x96\x72\xf4\x47\xda\x44\xc3\x68\x95\xe6\xd1\x9c\x17\x0c\x66\xaf\xaf\x70\x29\x19\xfe\x84\x06\x48\x62\x19\x38\x9b\x48\x37\x08\x27\x1c\x5a\x85\xa5\xd0\xdd\xd1\x39\x15\x79\xa3\xb3\x80\x35\x5c\x10\xe6\x9a\x84\xa7\xbd\x14\xa5\x37\xc9\xa7\xc2\x6c\x95\x2e\x46\x1b\xcc\xff\x45\x68\xcf\x62\xc9\x0f\xac\x70\xc6\xbe\x79\xbc\x3a\x86\xb1\xa5\x07\xcb\x5f\x81\xa9\x68\x17\xc0\xa9\x00\x9c\x3d\xf1\x02\xaf\x63\x8b\xe3\x0c\x72\x5f\x7d\xb1\x40\x7b\x7d\xd5\xac\x6b\xee\x56\x39\x7b\xef\xb5\xde\x42\x16\x06\xd1\x58\xcd\xe1\xf9\x2b\xeb\x7f\x79\x55\x56\xed\x94\x33\x9d\x91\x48\xd2\x4f\xe2\x24\xc9\xb0\xe6\xc9\x21\x8a\x59\x9e\x1c\xa3\x94\x9c\xa0\x1a\x6c\x07\x77\x47\xbd\x39\x6d\x2f\x73\x03\xdf\x37\x32\xc5\x0f\xee\xf8\x70\xc0\xcd\x2f\x95\xb3\xe7\xad\x2c\x87\x9a\xcd\x6b\xda\x17\x8f\xfe\x5a\xc1\x08\xdc\x19\x4c\x1e\x58\x85\xb2\x6e\xc1\x50\x42\x93\x66\x02\x6d\xa7\xef\xcc\x66\xaa\xf0\x5e\x3a\x29\x0a\x0d\x68\x70\xe4\xfc\x84\x6a\xd7\x02\x65\x66\x53\xad\x6f\xc6\x87\x0a\x7f\x90\x8f\xfa\x27\x53\xf5\xf5\x17\x66\xda\x64\x70\xe5\xf8\x1e\x19\xde\xe5\x6c\xd8\x63\xc5\xd7\x3e\x9f\xb3\xf9\x54\x96\xd3\x33\x12\x4d\xcd\x48\xd4\xaf\x32\xf8\x0f\x9b\x1b\xce\x8a\xb1\xba\x5a\x39\xfb\xc4\x42\xa6\x6e\x82\xdb\xd5\xaa\x5c\xfd\x05\x05\x2e\x25\x4a\xd5\x0e\x00\x00"
 
 func dataAwsVpcPublicPrivateBuildTemplateJsonTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -174,7 +174,7 @@ func dataAwsVpcPublicPrivateBuildTemplateJsonTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x55\x4d\x6f\x13\x31\x10\xbd\xe7\x57\x8c\x5c\x4e\x88\x6e\x0a\xa7\xaa\x12\xb7\x4a\xdc\xe8\x85\x1b\x42\x2b\xaf\xd7\x09\x56\x1d\xdb\xf2\x47\xd0\x2a\xda\xff\xce\xd8\x8e\xe3\xfd\x48\x5b\x10\x12\xdb\x4b\xfb\xde\x8c\x67\xe6\x3d\x7b\x7a\x03\x5f\xb8\xe2\x96\x7a\xde\x43\x37\xc0\x93\xf7\xfa\x03\xf4\x1a\x94\xf6\xc0\x7b\xe1\xe1\x40\x55\xa0\x52\x0e\x9b\xcd\x91\x5a\x41\x3b\xc9\x81\x08\xb5\xb3\xb4\x15\x3d\x81\xd3\x38\x81\xe9\x2f\xd7\x52\xc6\xb8\x73\xed\x33\x1f\xae\x90\x8e\x33\xcb\xfd\x0b\xa4\xe5\x7b\xa1\xd5\x82\xc0\xd0\x56\xd1\x03\x4f\xf0\x34\xe1\x20\x16\x91\x42\x39\x4f\x15\xe3\xad\x1f\x4c\x0c\x87\x9e\xef\x68\x90\x1e\x3e\x03\xf1\x9f\x9a\x83\x60\x56\x13\x98\x66\x18\x2b\x8e\x38\x77\xeb\x42\xa7\xb0\xab\xd5\x38\x26\x74\x52\xb0\x17\xe9\xa3\x61\x2d\x13\xbd\xbd\x02\x9f\x63\x37\xc6\xea\xa3\xe8\xb9\x4d\x03\x22\xb4\x01\xa8\xfa\xc4\xc6\xde\x9d\x30\xb1\x99\xeb\x36\x12\x0c\xab\x4a\xcd\xc3\x2a\x9e\xc2\xb2\x66\x10\xbf\x59\x58\xc6\x31\x04\x9b\xb0\xdc\xe9\x60\x59\xb5\x20\x58\xe1\x87\x76\x6f\x75\x30\x04\x08\x97\x5d\xee\x2c\xca\x1c\x4f\x39\x9d\xf2\xaf\xe3\x78\x8b\xdc\x6d\x3e\xb4\x38\x9e\xaa\xe6\x11\x6b\xc5\xfc\x37\x52\xc8\xf1\x3d\xd6\x73\xe9\x40\x00\x9c\xdf\x6b\xa6\x65\xee\xef\xf6\x63\x02\x77\x56\x1f\x5a\xa3\xad\x4f\xe0\x5d\xc2\xbc\x2e\x48\xc5\xa2\xb6\x6d\x27\x35\x7b\x76\x88\x7d\x27\x77\x4d\xfa\xd9\xde\x91\x1f\xc8\x8f\xb1\x98\x50\x2f\x57\x23\x9e\x19\x72\xa5\xe0\xfd\xb5\x8a\xf7\x7f\x56\xf2\x6d\x35\xa9\x31\x13\x35\x61\xa1\xe7\x5f\x6a\xf9\xda\x78\xff\x28\x66\x2d\x16\x99\xf1\x3c\xdf\xff\xb4\x6f\xa5\x65\xba\x88\x2b\x01\x2f\xdf\x9b\x4a\xe6\x77\xea\x26\x09\x65\xcc\xe5\x43\xce\xe3\xce\xbd\x2b\xb2\xac\x5d\x6d\xb0\xb1\xa6\x24\x95\x2d\xe3\x66\x45\x62\x52\x61\x1a\x9c\xa0\x79\x7f\x4e\xc0\x8c\x1b\xf8\xf6\xf4\xf8\xf4\x80\x7b\xf4\x99\x83\x14\xce\x73\x85\xbe\x42\xd4\xcb\x01\xd3\x6a\x27\xf6\xc1\xc6\xd5\x81\xb1\x99\xc6\x7d\x91\xf5\x97\x5d\x95\x15\xe6\x37\x35\x52\x13\x77\x16\x37\xfe\xb2\x0b\xd7\x57\xbc\x52\x25\xbd\x26\x26\x53\x6e\xe0\x91\x1b\xa9\x07\xa0\xa8\x90\x07\xbd\xab\x33\x2f\x0c\x2b\xf8\xd4\x35\xdc\xcb\x73\xcf\xce\x3b\xe9\x20\x92\x47\xb3\x25\x5d\xe9\x19\x3c\x31\x33\xbe\x8c\xd9\x39\xab\x95\x9d\x82\xcb\x3f\x89\x45\xd1\x02\xe7\xc7\x14\xef\xfa\xdc\x58\x4c\x7f\xc5\xf5\x68\xe3\xc5\x44\x4f\xf7\xe5\x51\x7c\x5d\xad\xc9\x8b\x74\x3a\x78\x13\x3c\x90\x60\x65\x56\xe3\x48\x65\x48\xc1\x3f\xbd\x37\x0f\xdb\x6d\x2e\x14\xef\x53\x3c\xbd\x57\x2e\xf7\xb7\x8d\x7b\xfa\x77\x00\x00\x00\xff\xff\x11\x23\xa2\xbf\x89\x07\x00\x00"
+var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xc5\x56\xcf\x8f\xd3\x3a\x10\xbe\xf7\xaf\x18\x65\xd9\x0b\x62\xb3\x05\x84\xb4\x5a\x89\x03\x02\xc4\x01\x3d\x96\xc3\x13\x17\x84\x22\x37\x71\x8a\xb5\x8e\x1d\x9c\x49\x1f\xa5\xe4\x7f\x7f\x63\x4f\xdd\xc4\x69\x59\x10\x20\x68\x2f\xcd\x37\x3f\x3b\xdf\x17\x7b\xce\xe0\x95\x34\xd2\x09\x94\x15\xac\xb6\x70\x83\x68\x1f\x40\x65\xc1\x58\x04\x59\x29\x84\x46\x98\x5e\x68\xbd\x5d\x2c\x36\xc2\x29\xb1\xd2\x12\x32\x65\x6a\x27\x0a\x55\x65\xb0\x1b\x26\xb0\xf8\xaf\x2b\x44\x59\xca\xae\x2b\x6e\xe5\x96\x8c\x50\xc9\x5a\xf4\x1a\xe1\x29\x64\x19\xcc\x5d\x3b\x59\x3a\x89\x3f\xe4\x8a\xf6\x56\x9a\xef\x7a\x39\xb9\x56\xd6\xcc\x9a\xa2\xf4\x85\x11\x8d\x9c\xc1\x7d\x27\x5d\x51\x09\x14\x27\xb2\x4e\xd3\x36\x6a\x16\xa8\x4c\x87\xc2\x94\xb2\xc0\x6d\x2b\x67\xc1\xbb\x1d\x24\xe6\xaf\x7b\xdb\x75\x86\x8f\xf2\x46\x95\xce\x52\xfa\x21\x6d\xfc\x10\x50\xda\xde\xe0\x2c\xe1\xc3\xd4\x57\x9a\x8d\x72\xd6\x34\xd2\x60\xd1\xf5\x75\xad\x3e\xdf\x39\x93\xd6\xa9\x0d\x11\x4b\xae\x2b\x43\x83\x3e\xe2\xab\xed\x57\x5a\x95\xdf\x34\x6f\xda\xb2\x28\x55\xe5\x4e\xc0\x7b\xdf\x45\xeb\xec\x46\x55\xd2\x85\xf9\x13\xb4\x00\x18\x05\xe0\x1b\xba\xb7\xa3\xc0\x3c\x15\xc6\x90\x91\xdb\x48\x7e\xea\x36\xe2\xc1\x2d\x10\x9f\x7a\x04\x28\x18\x99\x6f\xf0\x9f\xc4\x83\x71\x72\xa1\x0e\x9d\xec\x6c\xef\xca\x51\x72\xbd\x53\xb8\x2d\xd6\xce\xf6\x6d\x46\x13\xd5\x2b\x6e\xdb\x4b\x64\x4f\x61\xf8\x39\x0c\x17\x64\xbb\xe0\xa4\x51\xef\x03\x3f\x1e\xd3\x10\xda\xe1\xc1\x8c\xad\xf0\x33\x99\xc8\x26\xd7\xd4\x48\x17\x2a\x01\xd0\xd4\xd0\x96\x56\x73\xe3\x17\x0f\x03\x58\x3b\xdb\x14\xad\x75\x18\xc0\x65\xc0\xd0\x46\x64\xc4\x3c\x23\xc5\x4a\xdb\xf2\xb6\x23\xec\x7d\xb6\xcc\xc3\xf7\x72\x99\x7d\x20\xfb\xe0\x8b\x29\xf3\xed\x6a\x19\x96\x6d\x76\xa2\xe0\xd5\xa9\x8a\x57\x3f\x5c\x72\x77\x0e\xaa\x06\x14\xeb\x0e\xce\x07\xcf\x9b\xff\xc5\xf5\xc9\x54\x5b\x07\x48\x6d\x45\x07\x3f\x65\xcc\x5f\x13\xf7\xfe\x6d\xe0\xa9\x63\xfe\x4e\xe8\xde\x0f\x3e\x8b\x61\xd2\x54\x3e\x32\x24\x1c\x16\x11\xa2\x3a\x84\x7c\x9f\x5a\xd1\xb6\x13\x6a\x61\x46\xee\xef\x22\xf6\xae\x59\xff\x22\xb3\x63\x31\x6f\x19\xf6\xc3\xfe\xc3\x5a\xfa\xfb\xc4\x86\x57\xf4\x88\xcd\xc3\xe7\xe7\x69\xe5\x73\xaf\x9b\x64\x8a\x33\x9f\x1f\x8c\x3c\xfb\x54\x61\x91\xa3\x63\xed\xe5\xd4\x71\x1e\x83\xe2\xf1\xde\x25\x45\x7c\x50\xb4\xe4\xf4\xd7\xf2\xfb\xfb\x00\x8a\x38\x83\x7f\x6f\x5e\xdc\x5c\xd3\xc5\x7b\x2b\x41\xab\x0e\xa5\x21\x91\x81\x27\xaf\x83\xd2\x9a\x5a\xad\x7b\xe7\x8f\x62\xf2\x65\x33\x9d\xbf\xcc\x88\x5e\x8d\x1c\x43\xfa\x0e\x7b\xd3\x44\x2a\xb3\xb3\xe0\x70\x09\x1d\xbf\xfc\xa3\x29\x86\x8f\x81\x7f\x4d\x21\x67\xf0\x42\xb6\xda\x6e\x41\x10\x2b\x08\xb6\x1e\xe7\x3c\x53\x4f\xc4\xa7\x12\x0a\x37\xed\x54\x40\x51\x35\xd3\x9b\x38\xf4\x42\xd7\x7f\x2a\xb5\xfd\x25\xd3\xa8\x60\x4e\x2e\xfb\x13\x89\x3c\x3c\x91\x9a\x3f\x44\x92\x3c\x47\x17\x74\x70\x8e\x1b\xcb\xac\x68\x84\x83\xcf\x61\x7d\x49\x7d\x0e\x30\x1f\x4e\xfe\xec\x48\xb5\x49\x35\xee\x10\xae\x57\xe2\x41\x87\xcc\xeb\xc6\xea\xbe\xa1\x0e\xd5\x17\xc9\x6c\x38\x6b\x91\xcf\x8c\xa2\x92\x1b\x45\x73\x66\xae\xa7\x8e\x4c\xeb\x14\x61\x6a\xe7\x4c\xa6\x6a\x79\x73\x74\x05\x67\xbf\x55\x45\x54\xcf\xf6\xd8\xf6\x48\xdb\x9f\xd3\x2c\x85\x4d\x08\xa1\x04\x1f\x11\xdb\xeb\xcb\x4b\x1e\x8b\x7f\x81\xfd\x2c\x2a\xd3\xf1\xc8\x2f\xc3\x2e\xc1\x13\xa9\x6c\x23\xa8\x93\xf3\xe9\x4e\xc4\xd8\x6c\x51\x62\xb0\xf8\x62\x8d\x3c\x2c\x4c\x67\xf0\xd6\x2a\x12\x1f\x7e\x94\x31\x11\x89\xd7\x3f\x51\x3d\x3a\x73\x04\xfa\x8d\x46\xb0\x83\xb6\x82\x96\x72\xa1\xbd\x96\xdc\x4c\xd7\xc4\x17\xca\x27\x8f\x69\xd7\x29\xad\xa3\xe4\x63\x0b\xf4\xaf\xf6\x25\x47\x5d\xa4\xad\x84\x01\x45\x85\xcd\x7c\x78\xeb\xf2\x72\x66\xdb\xf3\x37\xcf\xfe\x79\x19\x30\xd4\x51\x6b\x8f\x97\x4b\x5e\xbf\x7c\xe9\xa9\x9c\x8e\xe6\x46\x4a\x9a\x4c\x7d\xda\xe2\x61\xf0\x1c\x99\xfe\x9d\x7d\x2f\x79\xfd\xa9\xe2\x35\x6e\x2a\x9a\xff\x01\xce\xf8\x91\x3a\xb2\x0c\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployMainTfTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -194,7 +194,7 @@ func dataAwsVpcPublicPrivateDeployMainTfTpl() (*asset, error) {
 	return a, nil
 }
 
-var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x56\x6d\x6f\xdb\x38\x12\xfe\xae\x5f\x31\x55\xdc\x37\x20\x92\xae\xc1\xe1\x3e\xb8\x4d\xd0\xbc\xb5\x09\xd0\x8b\x0b\x3b\x2d\x70\xb8\x5d\xb8\xb4\x34\x92\xd8\xca\x24\x97\xa4\x9c\xb8\x8e\xff\xfb\xce\x50\x72\xec\x6c\xba\xdd\x02\x71\x4c\x0f\x39\x6f\xcf\xcc\x3c\xe4\x1e\xbc\x47\x85\x56\x78\x2c\x60\xb6\x84\x91\xf7\x7a\x1f\x0a\x0d\x4a\x7b\xc0\x42\xfa\x27\xd1\x5e\xb4\x07\xd7\xb5\x74\x40\x7f\xbe\x46\xf8\x2c\x2a\x2b\x94\x2f\x65\x83\x50\xfd\x55\x17\x4a\x6d\xc3\xa9\x02\x17\xd8\x68\x33\x47\xe5\x41\x97\x64\xc2\xb3\x09\x61\x4c\x23\x73\xe1\xa5\x56\x99\x43\xbb\x90\x39\xa6\x70\xe9\xc1\xd5\xba\x6d\x8a\xe0\x74\x86\x50\x0b\x55\x24\xec\x1c\x8b\x14\xae\x35\xcc\x75\x21\xcb\x25\x9b\x25\x3b\x3b\xee\xf7\xa1\x75\x18\xbc\x1d\x1b\xc3\x82\x34\x8a\xfa\xed\x34\xd7\xaa\x94\x55\x6b\xf1\x45\x7c\x10\xbf\xe4\x8c\xee\x3a\xd1\x5d\x04\xd0\xad\xd2\xc5\x3c\x9d\xe9\x5b\x38\x84\xb8\x16\xae\x96\xb9\xb6\x26\x33\x16\x73\xe9\xf0\x3f\xff\x8e\x23\x3a\xb8\x07\x17\xda\x51\x02\xaa\x59\x82\x42\x7f\xa3\xed\xb7\x07\xea\xbd\x0c\x62\x63\xe5\x82\x70\x98\xf6\x82\x78\x1f\xa4\x19\x42\xbc\x5a\x31\x10\x53\x69\xa6\xa2\x28\x2c\x3a\x07\xeb\x75\x6f\x78\x82\xbe\x35\x20\xc0\x2d\x55\x4e\xf8\x95\xba\x29\xd0\x42\x69\xf5\x1c\x74\x6b\x81\xad\x48\x55\x41\x21\x29\x20\xaf\x2d\xa5\xaf\x21\x5b\x74\xd9\x3d\x88\xa1\x33\x30\xed\x0d\xb0\x4b\x23\x7c\x9d\x6e\x0c\x90\xc3\x7d\x88\x37\x9a\xf1\x3e\xe9\x02\xe8\x1b\xaa\x1b\xc5\x77\x2f\x85\xca\xea\xd6\xec\x48\xba\x20\xcf\x95\x98\x51\x99\x27\x93\x0b\x10\x15\x97\x92\xca\x7b\x23\x6c\xc1\x86\x9d\xa6\xf2\x7b\xcf\xcb\x3e\x7b\xca\xd5\xa0\x2a\x50\xe5\x12\x5d\xc8\xc0\x6d\x23\x75\xae\x4e\x7b\xed\x69\x67\xeb\x10\xbc\x6d\xb1\x73\xf4\x4e\xb7\xaa\x08\x7d\x01\x9b\xca\x75\xbf\x5e\xc8\x12\x84\x5a\xbe\xa4\x53\xab\xa7\xa1\xbb\x08\x11\x90\x8a\x96\x1b\x8d\x29\x49\x5c\x4a\x38\xc3\xd3\x35\x1d\xe3\x7d\x2a\x69\xa6\xa9\x1d\xb3\xed\xa9\x84\x80\x21\xf5\x46\x6b\x93\x9e\x92\xd4\x13\x58\x5c\x8c\x9f\x43\xc9\xc6\x02\x82\xb4\x78\x70\xd4\x58\xbd\x90\x8e\x23\x8c\x5d\x8d\x4d\xc3\x15\x57\x8d\x54\x48\x18\xe6\x05\xec\xad\x48\x61\x0d\xcf\x9e\xc1\x8c\x5a\xab\xff\x99\xcd\x85\x54\xa9\xab\xe3\x2e\x19\x82\x8a\xf3\xa1\xa0\x03\x04\x1f\xb4\x28\x40\x34\x4d\x28\x7f\x69\x45\xc5\xb3\xe3\xa0\x46\x8b\x21\x6f\x42\xe1\x01\xc0\xe9\x16\x92\xcd\x69\xc6\x85\xfb\x6d\xab\x1d\x10\xe1\xcc\x7b\xc9\x9d\x45\xf2\xb2\x5e\xff\x30\x82\x4b\xe5\x3c\x07\x30\x6e\x69\x9a\x67\xad\xa4\x89\x44\xb5\x90\x56\x2b\x56\xfd\xd5\xf4\x07\x2e\xb7\xd2\xf8\xa9\x25\x2b\x11\x79\x88\xa2\x5d\x09\x95\xe6\xcd\x9b\xc9\xe9\xf8\xf2\xe3\x75\xe4\xd0\x43\xc2\x54\xd3\xaa\x7e\x89\xd6\xe2\xad\x0c\x4b\x23\x0d\x96\x42\x36\xbd\xd8\x5b\x91\x53\xaf\xd0\x4a\xdb\x17\x2f\x61\xc5\x6d\xdc\xe8\x5c\x34\xd4\x87\xad\xcd\x91\xc7\xff\x70\xf0\x6a\x2b\xe6\x60\x94\x3e\x1c\x1c\xb0\x08\xf3\x5a\x43\x7c\x3e\x1e\x8f\xc6\x20\x3c\x0c\x56\x5b\xa5\xf5\x70\xb0\xea\xce\xae\x5f\xc3\x07\x41\xd3\xde\xe8\xca\x0d\xb9\x46\x34\x14\x68\x80\xfb\x88\x27\xcf\x66\xb4\x91\xb9\xa5\xa3\x2f\xb8\x03\x1f\x62\x53\x70\xf0\xaf\x68\x1d\x51\x74\x06\x9e\x87\xe0\x20\x1e\xac\x4e\x8e\x27\x17\xd3\xc9\xe8\xd3\xf8\xf4\x7c\x1d\xb3\xe0\xc3\xe5\xd5\xf9\xd5\x68\x1d\x3f\x07\x8a\x21\x22\x16\x63\xa3\x09\xde\x62\x3e\x04\xfe\xdf\xd2\xf0\xe4\x7a\x3e\x27\xe2\x83\x1b\xe9\x6b\xea\x01\x6f\xda\x10\x4a\xc5\xe4\x4a\x4b\xe6\xc6\x42\x3a\xd3\x88\x25\x16\x91\x46\x06\x01\x06\x6f\xe1\xe0\xe8\xd9\x2b\x0a\x27\x9c\xb4\x90\xf8\x2e\xde\x23\xc8\xa8\x11\x32\xd5\x36\xcd\x6b\x58\xdf\x7b\xa4\x53\xc3\x8d\x6d\x41\x63\x4b\x08\xdc\x92\xfd\x39\x31\x13\xcd\x64\xa4\x9b\x60\xb5\x43\xeb\xff\xac\xf1\x3b\xb9\x88\x7b\x0b\xff\x15\xdf\x10\xa8\x38\x34\xf8\xbe\x26\x14\xbf\xf4\x5c\x01\x34\xda\x5f\xa0\xd2\x34\xf3\x1d\x5b\x35\x81\xac\x98\x97\x89\x52\x59\x10\xa6\xa7\xb3\x4a\xb3\x71\xcf\x45\x70\x44\x61\xd6\x7a\x8e\x1b\x49\x96\xf2\xb4\xd8\x9c\xbd\x9d\xf6\x34\xc0\xfc\xc2\xfc\x13\xfa\x9c\xca\x43\x49\x52\x16\x52\x45\x44\x0c\x4f\xba\x0a\xc5\x9f\x1c\x9e\x5d\x4d\x08\xa2\x18\x32\xf4\x79\x46\x01\xf1\xa7\x98\x76\x0d\x0b\x47\x3b\x60\x50\x58\x2a\xda\x74\xc4\x8e\xe2\x1d\xb8\x96\x6e\x09\x8f\x08\x89\xf8\x27\x33\x64\x40\x63\xa7\xd0\x5f\x63\x0c\x02\x10\xc3\x7b\x61\x7d\x54\x4a\xea\xd4\x5b\xa3\xad\x87\xb3\xf3\x93\xcb\xe3\xab\xe9\xbb\xf1\xe8\xea\xfa\xfc\xea\xec\x50\x69\x25\x99\x7b\x44\xee\xe5\x82\x1a\x5a\x37\x10\x1f\x17\x81\x50\x85\xf1\x64\xc1\x68\x27\x89\xef\x99\x41\xb9\x1b\x5a\xc3\xf4\xa5\xaa\x34\x4d\xe3\x68\xe3\x93\x4e\x26\xc4\xbd\xdd\x26\x3e\x12\xcb\x7e\x8e\x93\x25\x98\xa5\xaf\x89\xfb\x9c\x2e\x3d\x31\x2f\x26\x34\xb7\x06\xad\x67\xeb\x3f\x90\x25\xdc\x83\x34\xd3\x6c\x88\x5a\x5a\x39\x4e\x21\xa9\xbd\x37\x6e\xeb\xa4\x28\x12\xde\xbf\x8f\x74\x19\xfc\x18\x31\xcc\x6b\x2b\x5d\xd2\xa0\xc8\x94\x2e\x30\xfd\xea\x1e\x04\xc6\x7a\x8f\x75\x66\x56\x56\xb5\xa7\x5b\x38\x63\x72\x48\x54\xf5\x77\x39\xf2\x23\x64\x74\x36\x1a\xd2\xe5\x66\xc5\x1c\x09\x41\xf9\x1d\x21\x30\xca\x02\x6d\x60\x22\x41\x4f\x14\x45\x9d\xbd\x81\x7e\xfc\xe9\xe4\x7f\xd3\xcf\xe7\xe3\xc9\xe5\xe8\xea\x90\xc9\x9c\x4f\x4f\x37\xa7\xc3\x45\xcc\xe8\xf7\xac\xc7\x15\x08\xc4\x37\x58\xed\x2a\xae\x43\x15\x5c\x6b\xd8\x64\xb8\xeb\x44\xfe\x8d\x66\xc5\x85\x82\xfc\x5a\x91\x7f\x52\x9f\xd9\x77\x0b\x15\xcd\xd4\x1c\x6d\x4e\xbd\x4e\xbc\x15\x78\x37\xa1\x81\x24\xca\xe5\xdf\xbf\x51\xaf\x35\x72\x66\xfe\x48\xf8\x86\xfb\x4e\xcb\x57\x55\x58\xfe\xa4\x7e\xac\xc3\x35\xf8\xea\xc2\x92\xf3\x1e\xec\x26\xf5\x58\xc2\x16\x1f\xc1\x71\x42\x77\x67\x83\x76\xd3\x7a\x15\xce\xef\x43\x9f\x75\x5b\x90\x24\x4a\x27\x56\xf6\xdf\x85\xce\x3b\x23\xbb\xc3\xfb\x9e\xd2\x23\x3a\xe0\xa7\x1a\xcf\x31\x5b\xe0\x3b\x48\x97\x70\x71\x7d\xfd\x91\xd9\xe4\x86\x88\x42\xa8\xee\x85\x91\xf4\x6f\x84\xfb\x37\x05\xf7\x0c\x88\x96\x5e\x34\x9b\x30\xc8\x5e\x3f\x90\x49\x52\x35\x7a\x46\x20\xb5\xb6\x49\x63\xda\x78\x4b\x9f\xba\x9d\xd1\xeb\x6f\x3e\x8c\xd3\xde\xd5\xa8\xa4\x07\x1e\xf7\xf0\x30\xcb\xb6\xfb\x59\x1c\xf5\xd7\xd0\x9f\x01\x00\x00\xff\xff\x1e\x4e\xff\x5a\x03\x0b\x00\x00"
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xef\x73\xdb\x36\x12\xfd\x8e\xbf\xe2\x95\x56\xd2\x64\xc6\x24\x2f\x99\x9b\xfb\xa0\xd6\x9e\xfc\x72\x13\xcf\xe4\xec\x8e\xec\x74\xe6\xa6\xd7\x51\x21\x62\x45\xa2\xa1\xb0\x38\x00\x94\xad\xca\xfa\xdf\x6f\x96\xa4\x64\xfb\xd2\x4b\xfb\xc1\x16\xb8\xc0\xbe\xdd\x7d\x58\x3c\xe0\x08\xef\xc9\x51\xd0\x89\x0c\x16\x1b\x5c\xa6\xc4\xc7\x30\x0c\xc7\x09\x64\x6c\xfa\x46\x1d\xa9\x23\x5c\x37\x36\xc2\x46\xa4\x86\xf0\x93\xae\x83\x76\x69\x69\x5b\x42\xfd\xbf\xbe\x58\x72\xe8\x57\x19\x5a\x53\xcb\x7e\x45\x2e\x81\x97\xea\x08\x49\x20\xb4\xf7\xad\xad\x74\xb2\xec\xca\x48\x61\x6d\x2b\x2a\x70\x9e\x10\x1b\xee\x5a\xd3\x07\x5d\x10\x1a\xed\x4c\x2e\xc1\xc9\x14\xb8\x66\xac\xd8\xd8\xe5\x46\x60\xd5\xd1\xc3\xf0\xc7\xe8\x22\xf5\xd1\x5e\x7b\x2f\x86\x42\xa9\x71\xba\xa8\xd8\x2d\x6d\xdd\x05\x7a\x96\xbd\xcc\x9e\x4b\x45\x77\x83\xe9\x4e\x01\xc3\xa8\x58\xaf\x8a\x05\xdf\xe2\x04\x59\xa3\x63\x63\x2b\x0e\xbe\xf4\x81\x2a\x1b\xe9\x1f\x7f\xcf\x94\x02\x8e\xf0\x81\x63\x02\xbb\x76\x03\x47\xe9\x86\xc3\xe7\x47\xee\xa3\x0d\x99\x0f\x76\xad\x13\xcd\x47\x43\x76\x0c\xeb\xa7\xc8\xb6\x5b\x21\x62\x6e\xfd\x5c\x1b\x13\x28\x46\xec\x76\x23\xf0\x15\xa5\xce\x43\x23\x6e\x5c\x45\x06\x4b\x6e\x0d\x05\x2c\x03\xaf\xc0\x5d\x80\xa0\x58\x57\xc3\xd8\x40\x55\xe2\xb0\x41\x62\x94\xeb\xa1\xba\x47\x39\x0c\x00\xf3\x11\x40\x42\x7a\x9d\x9a\x62\x0f\xb0\xdb\x65\xc7\xc8\xf6\x9e\xd9\xb1\x02\x00\xbe\x71\x14\xa6\xc8\x0e\x56\xd4\x81\x3b\xff\xc0\x32\x24\x79\xe6\xf4\xa2\x25\x5c\x5d\x7d\x80\xae\x65\x2b\x97\x1c\x6e\x74\x30\x02\x1c\x19\x35\xa5\x24\xc3\xb1\x7a\x18\xf2\xe4\x0c\xb9\xca\x52\xec\x2b\x88\xf7\x99\xc6\xd8\x14\xa3\xf7\x7c\xc0\x3a\x41\x0a\x1d\x0d\x81\x7e\xe0\xce\x99\xbe\x2f\xb0\xdf\xb9\xe1\xeb\x99\x5d\x42\xbb\xcd\x73\x05\x6c\x9f\x48\x78\x61\x04\xd6\x61\x79\xf0\x98\x1b\x1b\x62\x61\x68\x8d\x27\x3b\x85\x7e\xfe\x04\x59\xc9\x29\x71\x79\xbf\x2a\xdf\x6e\xc5\xbd\x65\xf6\xc5\x5b\xee\x5c\xa2\xd0\x6f\xc6\xd7\xa9\x14\xb0\x9e\x41\x63\xc3\xa3\xa5\x3e\xf0\xda\x46\xc9\x30\x8b\x0d\xb5\xad\xec\xb8\x6b\xad\xa3\x29\xb2\xca\xe0\x68\x6b\x6c\xd8\xe1\xe9\x53\x2c\x74\x6c\xc6\xcf\x72\xa5\xad\x2b\x62\x93\x0d\xc5\x90\x33\x52\xcf\x93\xdd\x40\xc1\x47\xd6\x06\xba\x6d\xfb\xed\x5f\x06\x5d\xcb\xd9\x89\x68\x28\x50\x5f\xb7\x76\x9b\x47\x04\x17\xf7\x94\xec\x57\x0b\x2f\xd2\x6f\xf7\xde\x3d\x23\x52\xf9\x68\xb9\x0b\xa4\x0d\x76\xbb\x3f\xcc\xe0\xdc\xc5\x24\x09\xcc\xba\xc5\x06\x8b\xce\xb6\x06\xe4\xd6\x36\xb0\x13\xd7\xbf\x5a\xfe\x24\x56\xc1\xfa\x34\x0f\xdd\x62\xa3\xc8\x19\xa5\x1e\x5a\x70\x82\xef\xbf\xbf\x7a\x3b\x3b\xff\xf1\x5a\x45\x4a\xc8\x19\x8e\x3b\x37\x0e\x29\x04\xba\xb5\xfd\xd0\x5b\x4f\x4b\x6d\xdb\xd1\x9c\x82\xae\x48\x29\x0a\x81\xc3\xb3\xe7\xd8\x2a\x00\x2d\x57\xba\x45\xe4\x2e\x54\x24\xc7\xff\x64\xf2\xe2\xde\x2c\xc9\x38\x3e\x99\xbc\x14\x13\x55\x0d\x23\x3b\x9b\xcd\x2e\x67\xd0\x09\x93\xed\xbd\xd3\x6e\x3a\xd9\x0e\x6b\x77\xdf\xe1\xa3\x8e\x09\x2d\xd7\x71\x2a\x7b\x84\x3a\x90\x07\xa7\xe1\xe4\x85\xb2\xe5\xba\x8c\x9b\xd8\x72\x8d\x3b\xa4\x3e\x37\x87\x97\x7f\x53\x3b\x95\x82\xf6\xf8\xb6\x4f\x0e\xd9\x64\xfb\xe6\xf5\xd5\x87\xf9\xd5\xe5\xa7\xd9\xdb\xb3\x5d\x26\x86\x8f\xe7\x17\x67\x17\x97\xbb\xec\x5b\x9c\xcd\x66\x4a\x1d\xf5\xa0\x39\xdd\x52\x35\x85\xfc\xef\x12\xa1\xe2\xd5\x4a\x3b\x83\x1b\x9b\x1a\x70\x97\x7c\xd7\xa7\x52\x8b\xb8\x76\xa9\xd7\x46\x63\xa3\x6f\xf5\x86\x8c\x62\x12\x12\x30\x79\x85\x97\xa7\x4f\x5f\xe0\x6e\x58\x19\x90\xa7\x21\xdf\x53\x94\x86\xd6\xa5\xeb\xda\xf6\x3b\xec\x0e\x11\x5b\xae\xa7\x7b\x6c\x0d\x1f\x68\x69\x6f\xc9\x60\x45\x31\xea\x9a\x14\xb7\x3d\xea\xc0\xd6\xcf\xe2\xf1\x0b\x26\xaf\xb2\x11\xe1\x9f\xfa\x33\xc1\x26\x44\x46\x6a\x74\xc2\xaf\xa3\x56\x20\xc6\xe6\x57\xd4\x4c\x71\x54\xab\xb6\x17\x2b\xd1\xe5\x8a\x83\x18\xc4\xae\x06\xd4\xca\x1c\x54\x2c\xc3\xe9\x29\xca\x86\x57\xb4\xb7\x94\x85\x9c\x96\x50\x49\xb4\xb7\xa3\x0c\x88\xbe\x88\xfe\xf4\x7d\xae\x63\xa2\x20\xb5\x5a\xa7\xec\x12\xdf\x0c\x3b\x94\x7d\x8a\xf4\xee\xe2\x0a\x8e\x33\x94\x94\xaa\x32\xc6\x46\xfe\xcc\x7c\x68\x58\x9c\x3e\x20\x23\x35\xe4\xd4\xbe\x23\x1e\x38\xde\x21\x76\x86\x91\x88\x90\xeb\x3f\x83\x51\x00\xd3\xe0\x30\x5e\x63\x42\x02\x02\xc5\xa4\x43\x52\x4b\xab\x14\xdd\x7a\x0e\x09\xef\xce\xde\x9c\xbf\xbe\x98\xff\x30\xbb\xbc\xb8\x3e\xbb\x78\x77\xe2\xd8\x59\x97\x28\xe8\x2a\xd9\x35\x29\xc5\x2d\xb2\xd7\xa6\x17\x54\xed\x13\x02\x79\x8e\x36\x71\x10\x05\x95\x6e\xe8\xbc\xc8\x97\xab\x8b\xa2\xc8\xd4\x3e\xa6\xf6\x29\xaf\x29\x0d\x93\xf4\x85\xd9\x8e\xe7\x38\xdf\xc0\x6f\x52\xc3\x2e\x8f\xbc\x4c\x37\x3a\x50\xee\x03\x7b\x0a\x49\xd0\xff\xc0\x96\x4b\x0f\xb2\xeb\x81\x52\xd0\x2e\x4a\x09\x79\x93\x92\x8f\xf7\x41\x8c\xc9\x65\xfe\x90\xe9\xa6\x8f\xe3\xf5\xb4\x6a\x82\x8d\x79\x4b\xba\x74\x6c\xa8\xf8\x2d\x3e\x4a\x4c\xfc\xbe\xf4\x59\x04\x5b\x37\x69\xc1\xb7\xa5\x88\x43\xee\xea\xff\x57\xe3\x9e\xcf\xd9\xa7\x37\xff\x9a\xff\x74\x36\xbb\x3a\xbf\xbc\x38\x11\x85\x16\xbf\xf9\x9a\x42\x2f\x46\xfd\xed\x2a\x94\x8e\x52\x26\xb4\xf6\x6a\x36\xd9\x3e\x74\xdc\xf5\xd4\xc6\xce\x0b\xa4\xac\xf1\xba\xfa\xac\x6b\x8a\x3d\xcb\x7f\x6d\xe7\xbe\x42\xfa\xe2\xf7\x80\xda\x26\xac\x28\x54\x5d\xb0\xba\x1d\xc4\x34\xa7\x18\xc9\x25\xf9\xfe\xb7\x02\x5a\xbb\xf0\xff\xc9\xe5\xda\xfa\xbd\xb5\x8b\x17\x75\x3f\xfc\xca\xa6\x88\x8f\x10\xfb\x5b\xec\x87\x52\xf7\xe4\x61\x51\x5f\x5a\x04\xf1\x0b\x3a\xde\x74\xce\xb4\x14\xf6\xfd\x54\xd3\xea\x90\xfa\x62\x98\x42\x9e\x3b\xce\x83\x1d\x7f\x0d\x57\x03\xc8\xc3\x13\xf9\xde\x26\x24\xee\xdf\x5f\x72\x38\x05\x41\x2e\x16\x5e\xe2\xc3\xf5\xf5\x8f\x88\x8c\x1b\x42\xa5\xdd\xf0\x6c\xc8\xc7\x8b\xff\xf0\x50\x90\x46\x80\xee\x52\x73\x48\xc3\xa6\xf1\x76\x41\x9e\xd7\x2d\x2f\x74\x8b\x2e\xb4\x45\x56\xdb\xf4\xaa\xb6\xa9\xe9\x16\x45\xc5\xab\x69\x56\x8c\xa1\x2e\x97\xc8\xfa\xc6\x9c\x96\xe5\xfd\x7c\x99\x29\xd1\x85\x9f\x91\x2f\x0f\x32\x53\xbe\xa7\x55\xff\x58\xfd\xe5\x70\xf8\xa5\x9a\x81\x87\x7d\xed\x52\x93\x08\x96\xf6\xbe\x4f\x09\x78\x20\x54\x72\x8d\x33\x61\xf1\xc8\x43\x0e\xf9\x78\x8f\xfd\x77\x00\xa0\xb3\x6e\x69\x44\x0b\x00\x00"
 
 func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -269,7 +269,7 @@ var _bindata = map[string]func() (*asset, error){
 	"data/aws-simple/build/build-ruby.sh.tpl": dataAwsSimpleBuildBuildRubyShTpl,
 	"data/aws-simple/build/template.json.tpl": dataAwsSimpleBuildTemplateJsonTpl,
 	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
-	"data/aws-vpc-public-private/build/build-ruby.sh": dataAwsVpcPublicPrivateBuildBuildRubySh,
+	"data/aws-vpc-public-private/build/build-ruby.sh.tpl": dataAwsVpcPublicPrivateBuildBuildRubyShTpl,
 	"data/aws-vpc-public-private/build/template.json.tpl": dataAwsVpcPublicPrivateBuildTemplateJsonTpl,
 	"data/aws-vpc-public-private/deploy/main.tf.tpl": dataAwsVpcPublicPrivateDeployMainTfTpl,
 	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
@@ -330,7 +330,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 		"aws-vpc-public-private": &bintree{nil, map[string]*bintree{
 			"build": &bintree{nil, map[string]*bintree{
-				"build-ruby.sh": &bintree{dataAwsVpcPublicPrivateBuildBuildRubySh, map[string]*bintree{
+				"build-ruby.sh.tpl": &bintree{dataAwsVpcPublicPrivateBuildBuildRubyShTpl, map[string]*bintree{
 				}},
 				"template.json.tpl": &bintree{dataAwsVpcPublicPrivateBuildTemplateJsonTpl, map[string]*bintree{
 				}},
@@ -1,13 +1,17 @@
 # Generated by Otto, do not edit manually
 
 variable "infra_id" {}
-variable "aws_access_key" {}
-variable "aws_secret_key" {}
+variable "aws_access_key" { default = "" }
+variable "aws_secret_key" { default = "" }
+variable "aws_token" { default = "" }
 variable "aws_region" {}
 variable "key_name" {}
+variable "user_data" { default = "" }
 
 variable "ami" {}
-variable "instance_type" { default = "t2.micro" }
+variable "instance_type" { default = "{{ instance_type|default:"t2.micro" }}" }
+variable "instance_count" { default = "1" }
+variable "environment_suffix" { default = "" }
 variable "private_subnet_id" {}
 variable "public_subnet_id" {}
 variable "vpc_cidr" {}
@@ -16,11 +20,12 @@ variable "vpc_id" {}
 provider "aws" {
   access_key = "${var.aws_access_key}"
   secret_key = "${var.aws_secret_key}"
+  token = "${var.aws_token}"
   region     = "${var.aws_region}"
 }
 
 resource "aws_security_group" "elb" {
-  name = "{{ name }}-elb-${var.infra_id}"
+  name = "{{ name }}-elb-${var.infra_id}${var.environment_suffix}"
   vpc_id = "${var.vpc_id}"
 
   egress {
@@ -36,10 +41,17 @@ resource "aws_security_group" "elb" {
     to_port     = 80
     cidr_blocks = ["0.0.0.0/0"]
   }
+
+  {% if tags %}
+  tags {
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
+  }
+  {% endif %}
 }
 
 resource "aws_security_group" "app" {
-  name   = "{{ name }}-${var.infra_id}"
+  name   = "{{ name }}-${var.infra_id}${var.environment_suffix}"
   vpc_id = "${var.vpc_id}"
 
   ingress {
@@ -54,10 +66,17 @@ resource "aws_security_group" "app" {
     to_port     = 0
     cidr_blocks = ["0.0.0.0/0"]
   }
+
+  {% if tags %}
+  tags {
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
+  }
+  {% endif %}
 }
 
 resource "aws_elb" "app" {
-  name            = "{{ name }}-${var.infra_id}"
+  name            = "{{ name }}-${var.infra_id}${var.environment_suffix}"
   subnets         = ["${var.public_subnet_id}"]
   security_groups = ["${aws_security_group.elb.id}"]
   instances       = ["${aws_instance.app.*.id}"]
@@ -69,22 +88,57 @@ resource "aws_elb" "app" {
     instance_port     = 80
     instance_protocol = "tcp"
   }
+
+  {% if tags %}
+  tags {
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
+  }
+  {% endif %}
 }
 
 # Deploy a set of instances
 resource "aws_instance" "app" {
+  count         = "${var.instance_count}"
   ami           = "${var.ami}"
   instance_type = "${var.instance_type}"
   subnet_id     = "${var.private_subnet_id}"
   key_name      = "${var.key_name}"
+  user_data     = "${var.user_data}"
 
   vpc_security_group_ids = ["${aws_security_group.app.id}"]
 
+  {% if volume_size %}
+  root_block_device {
+    volume_size = "{{ volume_size }}"
+  }
+  {% endif %}
+
   tags {
     Name = "{{ name }}"
+    {% for t in tags %}"{{ t.Key }}" = "{{ t.Value }}"
+    {% endfor %}
   }
 }
 
 output "url" {
   value = "http://${aws_elb.app.dns_name}/"
 }
+
+{% if domain %}
+variable "domain" {}
+variable "domain_zone_id" {}
+
+# Point the domain of the application at the load balancer
+resource "aws_route53_record" "domain" {
+  zone_id = "${var.domain_zone_id}"
+  name    = "${var.domain}"
+  type    = "CNAME"
+  ttl     = "300"
+  records = ["${aws_elb.app.dns_name}"]
+}
+
+output "domain" {
+  value = "${aws_route53_record.domain.fqdn}"
+}
+{% endif %}
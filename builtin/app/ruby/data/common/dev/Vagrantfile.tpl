@@ -68,7 +68,6 @@ oe sudo add-apt-repository -y ppa:chris-lea/node.js
 oe sudo apt-add-repository -y ppa:brightbox/ruby-ng
 oe sudo apt-get update
 
-# TODO: parameterize ruby version as input
 export RUBY_VERSION="{{ ruby_version }}"
 
 ol "Installing Ruby ${RUBY_VERSION} and supporting packages..."
@@ -83,4 +82,9 @@ oe gem install bundler --no-ri --no-rdoc
 
 ol "Configuring Git to use SSH instead of HTTP so we can agent-forward private repo auth..."
 oe git config --global url."git@github.com:".insteadOf "https://github.com/"
+
+if [ -f /vagrant/Gemfile ]; then
+  ol "Bundle installing the app..."
+  cd /vagrant && oe bundle install
+fi
 SCRIPT
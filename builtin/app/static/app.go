@@ -0,0 +1,215 @@
+package staticapp
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/directory"
+	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/helper/compile"
+	execHelper "github.com/hashicorp/otto/helper/exec"
+	"github.com/hashicorp/otto/helper/hashitools"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/helper/vagrant"
+)
+
+//go:generate go-bindata -pkg=staticapp -nomemcopy -nometadata ./data/...
+
+// tfMinVersion is the version of Terraform needed for the CloudFront
+// distribution, which is newer than what other app types need.
+var tfMinVersion = version.Must(version.NewVersion("0.7.0"))
+
+// App is an implementation of app.App
+type App struct{}
+
+func (a *App) Compile(ctx *app.Context) (*app.CompileResult, error) {
+	var opts compile.AppOptions
+	custom := &customizations{Opts: &opts}
+	opts = compile.AppOptions{
+		Ctx: ctx,
+		Bindata: &bindata.Data{
+			Asset:    Asset,
+			AssetDir: AssetDir,
+			Context: map[string]interface{}{
+				"app_id": ctx.Appfile.ID,
+			},
+		},
+		Customizations: []*compile.Customization{
+			&compile.Customization{
+				Type:     "static",
+				Callback: custom.processStatic,
+				Schema:   staticSchema,
+			},
+		},
+	}
+
+	return compile.App(&opts)
+}
+
+// Build builds the site with the build command, if there is one, and
+// stores the directory of the built site as the artifact. Nothing is
+// uploaded, so the build can only be deployed from this machine.
+func (a *App) Build(ctx *app.Context) error {
+	srcDir := ctx.Appfile.SourceDir()
+	if command := staticOption(ctx.Appfile, "build_command"); command != "" {
+		ctx.Ui.Header("Building the site...")
+		cmd := exec.Command("sh", "-c", command)
+		cmd.Dir = srcDir
+		if err := execHelper.Run(ctx.Ui, cmd); err != nil {
+			return fmt.Errorf(
+				"Error running the build command %q: %s\n\n"+
+					"Please fix the error above and build again.", command, err)
+		}
+	}
+
+	dir := filepath.Join(srcDir, staticOption(ctx.Appfile, "output_dir"))
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		return fmt.Errorf(
+			"The built site wasn't found at %s.\n\n"+
+				"Set output_dir in the \"static\" customization to the directory\n"+
+				"the site is built into, relative to the application.", dir)
+	}
+
+	hash, err := siteHash(dir)
+	if err != nil {
+		return fmt.Errorf("Error reading the built site: %s", err)
+	}
+
+	build := &directory.Build{
+		Lookup: directory.Lookup{
+			AppID:       ctx.Appfile.ID,
+			Infra:       ctx.Tuple.Infra,
+			InfraFlavor: ctx.Tuple.InfraFlavor,
+		},
+		Artifact: map[string]string{
+			"path": dir,
+			"hash": hash,
+		},
+		CreatedAt: time.Now().UTC(),
+	}
+
+	ctx.Ui.Header("Storing build data in directory...")
+	if err := ctx.Directory.PutBuild(build); err != nil {
+		return fmt.Errorf(
+			"Error storing the build in the directory service: %s", err)
+	}
+
+	ctx.Ui.Header("[green]Build success!")
+	ctx.Ui.Message(fmt.Sprintf(
+		"[green]The site at %s was built. Run `otto deploy` to upload it.", dir))
+	return nil
+}
+
+func (a *App) Deploy(ctx *app.Context) error {
+	if ctx.Action != "help" && ctx.Action != "info" {
+		project, err := terraform.Project(&ctx.Shared)
+		if err != nil {
+			return err
+		}
+
+		err = hashitools.CheckVersion("terraform", project.Path(), tfMinVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	return terraform.Deploy(&terraform.DeployOptions{
+		InfraOutputMap: map[string]string{
+			"region": "aws_region",
+		},
+		ArtifactExtractors: map[string]terraform.DeployArtifactExtractor{
+			"aws": deployArtifactExtract,
+		},
+		AfterApply: uploadSite,
+	}).Route(ctx)
+}
+
+// DeployResult implements app.DeployReporter with the outputs of the
+// deploy stored in the directory.
+func (a *App) DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	return terraform.DeployResult(ctx)
+}
+
+func (a *App) Dev(ctx *app.Context) error {
+	return vagrant.Dev(&vagrant.DevOptions{
+		Instructions: strings.TrimSpace(devInstructions),
+	}).Route(ctx)
+}
+
+// DevDep returns nil since a static site has nothing for the development
+// environment of another application to use.
+func (a *App) DevDep(dst, src *app.Context) (*app.DevDep, error) {
+	return nil, nil
+}
+
+// deployArtifactExtract returns the Terraform variables of a build. The
+// site isn't used by Terraform, but the variables change with every
+// build so that a new build is deployed.
+func deployArtifactExtract(
+	ctx *app.Context,
+	build *directory.Build,
+	infra *directory.Infra) (map[string]string, error) {
+	path, ok := build.Artifact["path"]
+	if !ok {
+		return nil, fmt.Errorf(
+			"The build doesn't have a site to deploy. Please run `otto build`\n" +
+				"and try again.")
+	}
+
+	return map[string]string{
+		"site_path": path,
+		"site_hash": build.Artifact["hash"],
+	}, nil
+}
+
+// uploadSite implements terraform.DeployOptions.AfterApply by uploading
+// the site of the deploy to the bucket that Terraform created.
+func uploadSite(ctx *app.Context, deploy *directory.Deploy) error {
+	dir := deploy.Artifact["site_path"]
+	hash, err := siteHash(dir)
+	if err != nil {
+		return fmt.Errorf(
+			"Error reading the built site at %s: %s\n\n"+
+				"Builds of static sites stay on the machine they were built on.\n"+
+				"Please run `otto build` on this machine and deploy again.",
+			dir, err)
+	}
+	if hash != deploy.Artifact["site_hash"] {
+		return fmt.Errorf(
+			"The site at %s changed since it was built. Please run\n"+
+				"`otto build` again so that what is deployed matches the build.",
+			dir)
+	}
+
+	u := &siteUploader{
+		Ctx:    ctx,
+		Dir:    dir,
+		Bucket: deploy.Outputs["bucket"],
+		Region: deploy.Outputs["region"],
+	}
+
+	ctx.Ui.Header(fmt.Sprintf("Uploading the site to the bucket %s...", u.Bucket))
+	if err := u.Upload(); err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("Invalidating the CloudFront cache...")
+	return u.Invalidate(deploy.Outputs["distribution_id"])
+}
+
+const devInstructions = `
+A development environment has been created for a static site. The site
+is served with nginx from the output directory of the build, so build
+the site and refresh the page to see your changes.
+
+Edit files locally on your machine, the file changes will be synced
+to the development environment automatically.
+
+You can access the site using the IP address above on port 80.
+`
@@ -0,0 +1,346 @@
+// Code generated by go-bindata.
+// sources:
+// data/aws-simple/deploy/main.tf.tpl
+// data/aws-simple/deploy/variables.tf
+// data/aws-vpc-public-private/deploy/main.tf.tpl
+// data/aws-vpc-public-private/deploy/variables.tf
+// data/common/dev/Vagrantfile.tpl
+// DO NOT EDIT!
+
+package staticapp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataAwsSimpleDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdd\x6e\x1b\x39\x0f\xbd\x9f\xa7\x20\x94\xe6\xa6\x88\x1d\xa7\x2e\xfa\x15\x46\x7d\x51\xf4\xeb\xb6\x8b\x2d\x36\x41\x13\x14\x58\x14\x85\x20\x4b\x1c\x5b\x9b\xb1\x38\x2b\x71\xec\x18\xc6\xbc\xfb\x42\xa3\x19\x7b\xfc\xd3\x6e\x72\x15\x93\x3c\x24\x75\x78\x48\xfb\x02\x3e\xa1\x43\xaf\x18\x0d\xcc\x36\x70\xcb\x4c\x57\x60\x08\x1c\x31\xa0\xb1\x0c\x4b\xe5\x2a\x55\x14\x9b\x2c\x2b\x3d\xad\xac\x41\x0f\x42\xad\x83\x80\x6d\x06\x00\xa0\xb4\xc6\x10\xe4\x23\x6e\x60\x0a\xe2\xc5\x76\xa5\xfc\x50\xad\x83\xdc\xdb\x6b\xd1\x04\x06\xd4\x1e\xf9\x34\x70\x6f\x6f\x03\x99\x1e\xd1\x1d\xc6\x34\xa6\xd6\xed\x71\x6e\xe9\xc8\x9f\x6c\xb5\xc8\xea\x2c\xbb\x80\x87\x05\xc2\xac\xd2\x8f\xc8\x40\xae\xd8\x40\x40\xbf\xc2\x00\xbc\x40\x08\x96\x11\x98\xe0\x43\x41\x95\xf9\xcd\x93\xe3\x2b\x98\x55\x0c\x96\x03\xac\x71\xd6\xb8\xd1\x99\x92\xac\xe3\xec\x02\x6c\x80\x2a\xa0\x01\x95\xd0\xe4\xed\xdc\x3a\x08\x04\xbc\x50\x0c\xd6\x19\x7c\x02\x43\xba\x5a\xa2\x8b\x09\xc8\x3f\x82\x75\x80\x2b\xf4\x1b\x30\xd6\xa3\x66\xf2\x9b\x61\xe6\x31\x50\xe5\x35\x36\xc4\xc9\x30\x96\xa9\x3b\x01\x62\xbb\x05\xa7\x96\x08\x75\xdd\xf1\x99\x5c\xf1\x79\xc4\x4c\x83\xed\x16\x54\x59\x4a\x6b\xa0\xae\xd3\x7b\xd1\xad\xac\x27\x17\x4b\xca\x50\xe5\xb9\x7d\x6a\x89\x51\xba\x88\xb0\xb2\x9a\x15\x56\x0f\x3c\x2a\x93\xec\x39\x79\x8d\xd2\x60\x60\x4f\x91\x7b\xf6\x15\x66\x8d\xa7\xa4\xc2\xea\x68\x7a\xf7\xee\xee\xf6\xcb\xef\x1f\xfe\xca\x52\x0f\xe2\x1b\xfa\x60\xc9\x89\x09\x88\x57\xa3\x9b\x57\x83\x9b\xd1\xe0\xe6\x7f\xe2\x2a\x39\xef\x59\x31\xc6\xf2\x62\x02\xdf\x13\x20\xd9\xad\x89\x80\xbb\xa6\xfe\xd7\x58\xfe\x6a\xef\xfc\x98\xe7\xa8\x23\x42\xbc\x2f\x0a\x5a\xf7\x5d\x77\xde\x3a\x6d\x4b\x55\x44\xef\xcb\xbe\xe7\xbd\xe6\xb6\x8b\x30\x9e\x7c\x42\xbe\x9d\xfd\x1d\x93\xf4\x22\xbe\xb6\xcc\xc6\x18\xe5\xdd\x44\xad\xc3\x24\x8c\x27\x93\xc9\xf3\xc9\xbb\x7e\x99\x68\xaa\x7f\x64\x75\xd6\xd2\xd0\x18\x3a\x3d\xec\x9f\xd8\x0c\x5c\x76\x03\x8f\x64\x6f\xb7\xc7\xc6\xba\x16\xbb\xf8\xed\x25\xd8\x1c\xd0\x7b\xf2\xfb\x80\xcb\xfa\xc8\x90\xd2\x1c\x19\xeb\x5a\x6c\x2f\xa3\x14\x6d\x0e\x97\x75\x6a\x30\xb5\xc5\x6a\x1e\x7a\x3d\xfd\x19\xe5\x33\x3d\x50\x52\xbf\x7e\x4e\x1e\xa2\x52\x13\xec\xb2\x8e\x71\x3c\xfc\x03\x37\x31\xb0\xc5\xf1\xf0\x9b\x2a\xaa\x13\x28\x3a\x13\xd1\xbb\xea\x75\x76\x24\x64\x1d\x97\x28\x8f\x4b\x24\x8d\x0d\xec\xed\xac\x6a\xe6\x75\x4e\xd6\xe8\xd4\xac\x40\xd3\xc9\x0f\x00\x40\xd3\xb2\xf7\xfe\x36\xfe\x3f\x34\x6e\x30\x57\x55\xc1\xd2\x13\xb1\xa4\x46\x0e\xbf\x1e\x43\xe9\xad\x46\xa9\x0b\x15\x42\x0c\xbc\x8b\x1f\x3f\xc4\x4f\xf2\x66\x34\x12\x89\xd1\x76\xad\xf7\x9c\x26\x43\xd4\xcd\x34\x4a\x6f\x70\x8e\x5b\x43\x4b\x65\x9d\x74\x2d\xfd\x2f\xb6\x07\xab\x3d\xdc\x43\x86\xad\x8e\x64\x77\x57\x6a\x91\xed\xb2\x5c\xc0\xfd\xf8\xe4\xf0\x84\xf6\x6c\x55\x65\x49\x9e\xe1\xf3\xc3\xc3\xdd\x0e\xa0\xab\xc0\xb4\x94\x6d\x87\x9a\x5c\x6e\xe7\xbd\xce\xe3\xdf\x82\xb9\x94\x0d\x72\x0a\x6f\x47\x27\xae\xd0\xf9\x5e\xbf\x1e\x1f\x38\xdb\x9c\xa5\x27\x26\x4d\x85\xdc\x5d\x07\x11\x61\x83\xd8\x93\x38\x07\x08\xa1\xd8\x81\x22\xc9\xdf\xc5\xc3\x97\xfb\xd5\x8d\xb8\x82\xf4\xcf\xb0\xf7\xef\x2b\xf1\x63\x97\xe2\x40\xd5\xdd\x60\xb5\xd2\x0b\x94\x33\x5c\xa8\x95\x25\xdf\x7b\x19\x2b\x3f\x47\x96\xcf\x19\x8d\x8a\x17\x06\x8d\x5c\x22\x2f\xc8\xa4\x96\x3e\x7d\x7c\x88\x5d\x7c\xfe\xf8\xfe\xff\xbd\x16\x9a\x6a\xcf\x09\x5c\x59\x5c\xa3\x3f\xc7\x8d\xc7\x74\xe8\x07\x4c\x83\x86\xde\x7d\x1f\x4b\xeb\x24\x73\x01\x53\xd8\x4f\xa1\x7b\x67\xb2\x8f\xdf\x8c\xf6\xae\xa5\x7a\x6a\xcd\x6f\xdf\xbc\x1e\x8d\xf6\x22\xc9\xc9\xaf\x95\x37\x68\xe4\x2a\xee\x69\x38\x9a\xf7\x3f\x15\xfa\x8d\x8c\xfb\xe7\xe6\x30\x85\x5c\x15\x01\x0f\x02\x34\xd1\xa3\x3d\x81\xf5\x32\xc7\x77\x38\x72\x78\x38\xde\xfa\xfc\xa4\x7c\xfc\x22\xb1\xcd\x65\xee\xa7\x9c\x23\xc9\x9e\xeb\xa8\x58\xcf\x23\x79\x53\xe2\x69\xc5\x83\x1a\x2d\xdd\x1a\x3d\xdb\xdc\x6a\x75\x70\x85\xfb\x67\xa7\x53\x4d\x2f\xb0\x77\x61\x9a\x9b\x45\x15\x97\x15\x83\xa8\x7c\xd1\x1d\xa3\x86\xc6\x4e\xd8\x61\x72\x7d\x9d\xb6\xf7\x27\xf7\xac\xbf\xcb\xbd\xad\xaf\xaf\x45\x3f\x7d\xf7\x95\x7e\x54\xe1\x17\x77\xc1\x9a\xfa\x20\x43\xbf\xa6\xb4\xe6\x27\xa9\x9e\xd1\xe4\x71\xe2\xf4\xeb\xe8\x4c\xbe\x33\x3f\x9f\xfe\x1d\x00\xe4\x81\x07\x2b\x0f\x0a\x00\x00"
+
+func dataAwsSimpleDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployMainTfTpl,
+		"data/aws-simple/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsSimpleDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb4\x92\xb1\x8a\xdc\x40\x10\x44\x73\x7d\x45\xa1\x8d\xbd\x7f\xe0\xe0\xe0\x02\x3b\x32\xf8\x0e\x1c\x2e\xbd\x52\xcd\x6a\x58\x69\x46\x74\xb7\x4e\x16\xc6\xff\x6e\x66\xbc\xc1\x71\x9c\x8c\x0d\x6b\x45\x82\x2e\xbd\xea\x2a\xf5\xe1\xc3\x1d\x9e\xe6\x80\x87\xae\xa3\x19\x3e\xa7\x90\x9b\xfb\x30\x9b\x17\xd1\x28\xe7\x91\x68\x65\xb5\x93\x54\x83\xd3\x95\x5b\x8b\x1f\x0d\x00\xf4\xb4\x4e\xe3\xec\x31\x27\x7c\x44\x7b\xdb\xe0\xca\x0d\x21\x2b\x1e\xbe\x3d\xb5\x37\x59\x90\x65\xf4\x22\x69\x9b\x9f\x6f\xb1\xc6\x4e\xe9\x7f\xc0\x3e\x55\xc1\xbf\x62\x3d\x5f\x99\x76\x89\x66\xe5\xb5\x6a\x2a\xd4\x39\xcd\x59\x45\xb7\x82\x47\xa7\xec\x99\x3c\xca\x68\x7f\x63\xa5\xbc\xc4\xbc\xe7\xf5\xb5\x0e\xb1\x0e\x54\x62\x25\xd6\x38\x8e\xc8\x33\x55\x9c\xc7\x0a\xbb\xd7\x01\x3c\x72\x1e\xf3\xf6\x9f\x0e\x80\xe9\x25\x6a\x4e\x13\x93\x9f\x6c\x09\x21\x7e\xdf\xeb\xb6\x0e\x7f\x97\x3a\x10\x49\x26\x1a\x72\x80\xd2\xf2\xa2\x1d\x0d\x31\x41\x12\x5e\x01\xdf\xef\xf8\x80\xe7\x81\xb0\xe8\x44\x34\x2c\xf3\x98\xa5\x67\x8f\xf3\x86\x2f\xee\x19\x12\x9c\x8a\x67\xaa\x4a\xc8\x3a\x41\x97\x64\xc7\xf2\x89\x11\xb1\xfe\xbd\xb0\x95\x0d\x9a\x03\xce\x4b\x1c\x7b\x58\x86\x0f\xe2\xe8\x6b\x4d\x31\x5d\x20\x48\x5c\x6f\xd3\x68\x10\x74\x83\xa4\x0b\x8f\xaf\x62\x17\xfb\xd3\x2c\x3e\xec\xa4\x7d\x8c\xca\xce\xb3\x6e\x25\x62\xc9\x5b\x68\x5e\xb7\x7e\x73\x28\x95\x34\x88\xed\x91\x3e\x89\x0d\xef\x43\x7e\x0d\x00\x5c\x37\xce\x67\x21\x04\x00\x00"
+
+func dataAwsSimpleDeployVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsSimpleDeployVariablesTf,
+		"data/aws-simple/deploy/variables.tf",
+	)
+}
+
+func dataAwsSimpleDeployVariablesTf() (*asset, error) {
+	bytes, err := dataAwsSimpleDeployVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-simple/deploy/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateDeployMainTfTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8c\x56\xdd\x6e\x1b\x39\x0f\xbd\x9f\xa7\x20\x94\xe6\xa6\x88\x1d\xa7\x2e\xfa\x15\x46\x7d\x51\xf4\xeb\xb6\x8b\x2d\x36\x41\x13\x14\x58\x14\x85\x20\x4b\x1c\x5b\x9b\xb1\x38\x2b\x71\xec\x18\xc6\xbc\xfb\x42\xa3\x19\x7b\xfc\xd3\x6e\x72\x15\x93\x3c\x24\x75\x78\x48\xfb\x02\x3e\xa1\x43\xaf\x18\x0d\xcc\x36\x70\xcb\x4c\x57\x60\x08\x1c\x31\xa0\xb1\x0c\x4b\xe5\x2a\x55\x14\x9b\x2c\x2b\x3d\xad\xac\x41\x0f\x42\xad\x83\x80\x6d\x06\x00\xa0\xb4\xc6\x10\xe4\x23\x6e\x60\x0a\xe2\xc5\x76\xa5\xfc\x50\xad\x83\xdc\xdb\x6b\xd1\x04\x06\xd4\x1e\xf9\x34\x70\x6f\x6f\x03\x99\x1e\xd1\x1d\xc6\x34\xa6\xd6\xed\x71\x6e\xe9\xc8\x9f\x6c\xb5\xc8\xea\x2c\xbb\x80\x87\x05\xc2\xac\xd2\x8f\xc8\x40\xae\xd8\x40\x40\xbf\xc2\x00\xbc\x40\x08\x96\x11\x98\xe0\x43\x41\x95\xf9\xcd\x93\xe3\x2b\x98\x55\x0c\x96\x03\xac\x71\xd6\xb8\xd1\x99\x92\xac\xe3\xec\x02\x6c\x80\x2a\xa0\x01\x95\xd0\xe4\xed\xdc\x3a\x08\x04\xbc\x50\x0c\xd6\x19\x7c\x02\x43\xba\x5a\xa2\x8b\x09\xc8\x3f\x82\x75\x80\x2b\xf4\x1b\x30\xd6\xa3\x66\xf2\x9b\x61\xe6\x31\x50\xe5\x35\x36\xc4\xc9\x30\x96\xa9\x3b\x01\x62\xbb\x05\xa7\x96\x08\x75\xdd\xf1\x99\x5c\xf1\x79\xc4\x4c\x83\xed\x16\x54\x59\x4a\x6b\xa0\xae\xd3\x7b\xd1\xad\xac\x27\x17\x4b\xca\x50\xe5\xb9\x7d\x6a\x89\x51\xba\x88\xb0\xb2\x9a\x15\x56\x0f\x3c\x2a\x93\xec\x39\x79\x8d\xd2\x60\x60\x4f\x91\x7b\xf6\x15\x66\x8d\xa7\xa4\xc2\xea\x68\x7a\xf7\xee\xee\xf6\xcb\xef\x1f\xfe\xca\x52\x0f\xe2\x1b\xfa\x60\xc9\x89\x09\x88\x57\xa3\x9b\x57\x83\x9b\xd1\xe0\xe6\x7f\xe2\x2a\x39\xef\x59\x31\xc6\xf2\x62\x02\xdf\x13\x20\xd9\xad\x89\x80\xbb\xa6\xfe\xd7\x58\xfe\x6a\xef\xfc\x98\xe7\xa8\x23\x42\xbc\x2f\x0a\x5a\xf7\x5d\x77\xde\x3a\x6d\x4b\x55\x44\xef\xcb\xbe\xe7\xbd\xe6\xb6\x8b\x30\x9e\x7c\x42\xbe\x9d\xfd\x1d\x93\xf4\x22\xbe\xb6\xcc\xc6\x18\xe5\xdd\x44\xad\xc3\x24\x8c\x27\x93\xc9\xf3\xc9\xbb\x7e\x99\x68\xaa\x7f\x64\x75\xd6\xd2\xd0\x18\x3a\x3d\xec\x9f\xd8\x0c\x5c\x76\x03\x8f\x64\x6f\xb7\xc7\xc6\xba\x16\xbb\xf8\xed\x25\xd8\x1c\xd0\x7b\xf2\xfb\x80\xcb\xfa\xc8\x90\xd2\x1c\x19\xeb\x5a\x6c\x2f\xa3\x14\x6d\x0e\x97\x75\x6a\x30\xb5\xc5\x6a\x1e\x7a\x3d\xfd\x19\xe5\x33\x3d\x50\x52\xbf\x7e\x4e\x1e\xa2\x52\x13\xec\xb2\x8e\x71\x3c\xfc\x03\x37\x31\xb0\xc5\xf1\xf0\x9b\x2a\xaa\x13\x28\x3a\x13\xd1\xbb\xea\x75\x76\x24\x64\x1d\x97\x28\x8f\x4b\x24\x8d\x0d\xec\xed\xac\x6a\xe6\x75\x4e\xd6\xe8\xd4\xac\x40\xd3\xc9\x0f\x00\x40\xd3\xb2\xf7\xfe\x36\xfe\x3f\x34\x6e\x30\x57\x55\xc1\xd2\x13\xb1\xa4\x46\x0e\xbf\x1e\x43\xe9\xad\x46\xa9\x0b\x15\x42\x0c\xbc\x8b\x1f\x3f\xc4\x4f\xf2\x66\x34\x12\x89\xd1\x76\xad\xf7\x9c\x26\x43\xd4\xcd\x34\x4a\x6f\x70\x8e\x5b\x43\x4b\x65\x9d\x74\x2d\xfd\x2f\xb6\x07\xab\x3d\xdc\x43\x86\xad\x8e\x64\x77\x57\x6a\x91\xed\xb2\x5c\xc0\xfd\xf8\xe4\xf0\x84\xf6\x6c\x55\x65\x49\x9e\xe1\xf3\xc3\xc3\xdd\x0e\xa0\xab\xc0\xb4\x94\x6d\x87\x9a\x5c\x6e\xe7\xbd\xce\xe3\xdf\x82\xb9\x94\x0d\x72\x0a\x6f\x47\x27\xae\xd0\xf9\x5e\xbf\x1e\x1f\x38\xdb\x9c\xa5\x27\x26\x4d\x85\xdc\x5d\x07\x11\x61\x83\xd8\x93\x38\x07\x08\xa1\xd8\x81\x22\xc9\xdf\xc5\xc3\x97\xfb\xd5\x8d\xb8\x82\xf4\xcf\xb0\xf7\xef\x2b\xf1\x63\x97\xe2\x40\xd5\xdd\x60\xb5\xd2\x0b\x94\x33\x5c\xa8\x95\x25\xdf\x7b\x19\x2b\x3f\x47\x96\xcf\x19\x8d\x8a\x17\x06\x8d\x5c\x22\x2f\xc8\xa4\x96\x3e\x7d\x7c\x88\x5d\x7c\xfe\xf8\xfe\xff\xbd\x16\x9a\x6a\xcf\x09\x5c\x59\x5c\xa3\x3f\xc7\x8d\xc7\x74\xe8\x07\x4c\x83\x86\xde\x7d\x1f\x4b\xeb\x24\x73\x01\x53\xd8\x4f\xa1\x7b\x67\xb2\x8f\xdf\x8c\xf6\xae\xa5\x7a\x6a\xcd\x6f\xdf\xbc\x1e\x8d\xf6\x22\xc9\xc9\xaf\x95\x37\x68\xe4\x2a\xee\x69\x38\x9a\xf7\x3f\x15\xfa\x8d\x8c\xfb\xe7\xe6\x30\x85\x5c\x15\x01\x0f\x02\x34\xd1\xa3\x3d\x81\xf5\x32\xc7\x77\x38\x72\x78\x38\xde\xfa\xfc\xa4\x7c\xfc\x22\xb1\xcd\x65\xee\xa7\x9c\x23\xc9\x9e\xeb\xa8\x58\xcf\x23\x79\x53\xe2\x69\xc5\x83\x1a\x2d\xdd\x1a\x3d\xdb\xdc\x6a\x75\x70\x85\xfb\x67\xa7\x53\x4d\x2f\xb0\x77\x61\x9a\x9b\x45\x15\x97\x15\x83\xa8\x7c\xd1\x1d\xa3\x86\xc6\x4e\xd8\x61\x72\x7d\x9d\xb6\xf7\x27\xf7\xac\xbf\xcb\xbd\xad\xaf\xaf\x45\x3f\x7d\xf7\x95\x7e\x54\xe1\x17\x77\xc1\x9a\xfa\x20\x43\xbf\xa6\xb4\xe6\x27\xa9\x9e\xd1\xe4\x71\xe2\xf4\xeb\xe8\x4c\xbe\x33\x3f\x9f\xfe\x1d\x00\xe4\x81\x07\x2b\x0f\x0a\x00\x00"
+
+func dataAwsVpcPublicPrivateDeployMainTfTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateDeployMainTfTpl,
+		"data/aws-vpc-public-private/deploy/main.tf.tpl",
+	)
+}
+
+func dataAwsVpcPublicPrivateDeployMainTfTpl() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateDeployMainTfTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/deploy/main.tf.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataAwsVpcPublicPrivateDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xb4\x92\xb1\x8a\xdc\x40\x10\x44\x73\x7d\x45\xa1\x8d\xbd\x7f\xe0\xe0\xe0\x02\x3b\x32\xf8\x0e\x1c\x2e\xbd\x52\xcd\x6a\x58\x69\x46\x74\xb7\x4e\x16\xc6\xff\x6e\x66\xbc\xc1\x71\x9c\x8c\x0d\x6b\x45\x82\x2e\xbd\xea\x2a\xf5\xe1\xc3\x1d\x9e\xe6\x80\x87\xae\xa3\x19\x3e\xa7\x90\x9b\xfb\x30\x9b\x17\xd1\x28\xe7\x91\x68\x65\xb5\x93\x54\x83\xd3\x95\x5b\x8b\x1f\x0d\x00\xf4\xb4\x4e\xe3\xec\x31\x27\x7c\x44\x7b\xdb\xe0\xca\x0d\x21\x2b\x1e\xbe\x3d\xb5\x37\x59\x90\x65\xf4\x22\x69\x9b\x9f\x6f\xb1\xc6\x4e\xe9\x7f\xc0\x3e\x55\xc1\xbf\x62\x3d\x5f\x99\x76\x89\x66\xe5\xb5\x6a\x2a\xd4\x39\xcd\x59\x45\xb7\x82\x47\xa7\xec\x99\x3c\xca\x68\x7f\x63\xa5\xbc\xc4\xbc\xe7\xf5\xb5\x0e\xb1\x0e\x54\x62\x25\xd6\x38\x8e\xc8\x33\x55\x9c\xc7\x0a\xbb\xd7\x01\x3c\x72\x1e\xf3\xf6\x9f\x0e\x80\xe9\x25\x6a\x4e\x13\x93\x9f\x6c\x09\x21\x7e\xdf\xeb\xb6\x0e\x7f\x97\x3a\x10\x49\x26\x1a\x72\x80\xd2\xf2\xa2\x1d\x0d\x31\x41\x12\x5e\x01\xdf\xef\xf8\x80\xe7\x81\xb0\xe8\x44\x34\x2c\xf3\x98\xa5\x67\x8f\xf3\x86\x2f\xee\x19\x12\x9c\x8a\x67\xaa\x4a\xc8\x3a\x41\x97\x64\xc7\xf2\x89\x11\xb1\xfe\xbd\xb0\x95\x0d\x9a\x03\xce\x4b\x1c\x7b\x58\x86\x0f\xe2\xe8\x6b\x4d\x31\x5d\x20\x48\x5c\x6f\xd3\x68\x10\x74\x83\xa4\x0b\x8f\xaf\x62\x17\xfb\xd3\x2c\x3e\xec\xa4\x7d\x8c\xca\xce\xb3\x6e\x25\x62\xc9\x5b\x68\x5e\xb7\x7e\x73\x28\x95\x34\x88\xed\x91\x3e\x89\x0d\xef\x43\x7e\x0d\x00\x5c\x37\xce\x67\x21\x04\x00\x00"
+
+func dataAwsVpcPublicPrivateDeployVariablesTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAwsVpcPublicPrivateDeployVariablesTf,
+		"data/aws-vpc-public-private/deploy/variables.tf",
+	)
+}
+
+func dataAwsVpcPublicPrivateDeployVariablesTf() (*asset, error) {
+	bytes, err := dataAwsVpcPublicPrivateDeployVariablesTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/aws-vpc-public-private/deploy/variables.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataCommonDevVagrantfileTpl = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x55\x6b\x8f\xdb\x36\x10\xfc\xce\x5f\x31\x95\xe3\x3c\x00\x5b\x4a\x83\xa0\x28\xee\x85\x14\x01\x9a\x1e\xd0\xa6\x8f\x3b\x14\x05\x82\xc0\xa1\xc5\x95\x44\x84\xe6\x12\x24\xe5\x3b\xc3\xa7\xff\x5e\x90\x92\x7d\x77\xe9\x03\xed\x37\x6b\x76\x77\x76\x67\x77\x24\xcf\xf0\x8e\x2c\x79\x19\x49\x61\xbd\xc3\xcf\x31\xf2\x02\x8a\x61\x39\x82\x94\x8e\x5f\x89\x99\x98\xe1\xba\xd3\x01\x3a\x20\x76\x84\xdf\x65\xeb\xa5\x8d\x8d\x36\x84\xf6\xcb\x5a\x34\xec\x73\x96\xa2\x2d\x19\x76\x1b\xb2\x11\xdc\x88\x19\x62\xa2\x90\xce\x19\x5d\xcb\xa8\xd9\x56\x81\xfc\x56\xd7\x54\xe2\x32\x22\x74\xdc\x1b\x95\x9b\xae\x09\x9d\xb4\x6a\x99\x9a\x93\x2a\x71\xcd\xd8\xb0\xd2\xcd\x2e\xd1\x8a\xd9\xc3\xf6\x0b\xf4\x81\x72\xb7\xef\x9c\x4b\x40\x29\xc4\x14\x2e\x6b\xb6\x8d\x6e\x7b\x4f\xcf\x8b\x57\xc5\x8b\xa4\xe8\x6e\x84\xee\x04\x30\xfe\x2a\xb7\x9b\x72\xcd\xb7\x38\x47\xd1\xc9\xd0\xe9\x9a\xbd\xab\x9c\xa7\x5a\x07\xfa\xe6\x75\x21\x04\x30\xc3\x0f\x1c\x22\xd8\x9a\x1d\x2c\xc5\x1b\xf6\x9f\x1f\x95\x4f\x18\x0a\xe7\xf5\x56\x46\x5a\x4d\x40\xb1\x80\x76\x27\x28\xf6\xfb\xb4\x88\x95\x76\x2b\xa9\x94\xa7\x10\x30\x0c\x13\xf1\x15\xc5\xde\x41\x22\xec\x6c\x4d\x0a\x0d\x1b\x45\x1e\x8d\xe7\x0d\xb8\xf7\x48\x2c\xda\xb6\x50\xda\x53\x1d\xd9\xef\x10\x19\xd5\x76\x54\xf7\x68\x86\x91\x60\x35\x11\xa4\x96\x4e\xc6\xae\x3c\x10\x0c\x43\xb1\x40\x71\xa8\x2c\x16\x02\x00\xf8\xc6\x92\x3f\x41\x71\x44\xd1\x7a\xee\xdd\x03\x64\x1c\xf2\x7b\xee\xad\xca\xe7\xc2\x61\xa1\xe3\xd3\x73\xdd\x40\xda\xdd\x0b\x01\xec\xe7\xf9\xe8\x4a\x7b\x68\x8b\xe6\x58\xb1\x52\xda\x87\x52\xd1\x16\xf3\x41\x20\xc7\xcf\x51\x54\x1c\x23\x57\xf7\x59\xcb\xfd\x3e\x95\x1b\x66\x57\xbe\xe5\xde\x46\xf2\x79\x47\xff\xae\x30\x91\x65\x61\x4a\xfb\x47\xa9\xce\xf3\x56\x87\x34\x61\x11\x3a\x32\x26\x1d\xc2\x1a\x6d\xe9\x04\x45\xad\x30\xdb\x2b\xed\x07\x3c\x7d\x8a\xb5\x0c\xdd\xf4\x58\x6d\xa4\xb6\x65\xe8\x8a\x51\x0c\x59\x95\xf4\xcc\x87\x71\x05\x3f\xb2\x54\x90\xc6\xe4\xab\x34\x5e\xb6\xc9\xd2\x01\x1d\x79\xca\xba\xa5\xdd\x41\x91\x23\xab\xc8\xd6\x9a\x42\x79\xbf\x92\x43\x36\xb4\xcd\x36\xb8\xaf\xce\x1b\x49\xca\x27\xe4\xce\x93\x54\x18\x86\xbf\x9d\xe0\x8a\xfc\x76\xb4\xf9\xba\xd7\x26\x22\xe8\x48\xff\x55\xf4\x93\x50\x7b\xed\xe2\x4a\x3a\x37\x92\xfd\x24\x3f\x13\x74\x44\x60\xc4\x4e\x46\x7c\x9a\x0e\x8e\x10\xba\x4f\x68\x99\xc2\x64\x39\x93\x1d\x97\xba\xd6\xec\x13\xf0\x3f\x76\x9d\x3d\x36\xff\xf5\x03\xd5\x1d\xe7\xbd\x1f\xed\x87\x8b\x0b\x54\x1d\x6f\xe8\x80\x54\x65\xba\x84\xaf\x3f\x0a\xb2\x4a\x88\x07\xf3\xe2\x1c\x67\x67\x57\x6f\x7f\xbb\xfc\xe5\x5a\x04\x8a\x58\x92\x10\x4c\xcf\x5f\x60\x8f\xe2\xc9\x9b\x02\xaf\x2e\x9e\x7e\x8d\x3b\x18\x6e\x5b\xf2\x58\x46\x24\x67\xe1\x02\x95\xa2\x6d\x65\x7b\x63\x4e\x31\x08\x36\xb9\x60\x1c\xe4\x43\xca\xf8\x88\x27\x6f\x8a\x14\x12\x6c\x50\x5c\xda\x10\xa5\x31\xe9\x45\xb1\xad\xb6\xb7\x65\x59\x16\x82\x09\xa1\x57\x0c\xe9\xe2\xb2\xa5\x88\xde\x29\x19\x09\xcb\xdd\x5f\x22\x7a\x2c\xc7\x72\x37\x96\x8f\xa4\xe9\x60\x89\xf1\xa8\x71\xbf\x07\xf7\xd1\xf5\x71\x35\x1a\x37\x77\xa9\x65\xc4\xd9\xd9\xb3\xf7\xef\x2e\xdf\xff\xf1\x0c\x77\x23\x71\x24\x42\x45\xb1\xae\x32\x5d\x95\x4e\x1d\x96\x72\x2b\xb5\x91\x6b\x43\x95\xa2\x46\xf6\x26\x3e\x54\x29\xd2\x77\x94\x3c\xf6\x79\xe9\x46\x87\x48\x16\xdf\xbe\xc4\x94\xba\x1a\xc3\xa7\x39\xea\x99\xe3\x3f\x4f\x35\xe6\x68\xab\xe8\x36\x79\x33\xff\x58\x29\xae\xfb\xec\xe1\x43\x7c\x3f\x87\x6e\x40\xde\xb3\xbf\x0f\xce\x87\x11\x70\xb2\x25\xbc\x7e\xf9\x1a\x89\xfc\x8b\x9c\x61\x38\x1d\xbd\xad\x9b\xc9\xda\xf9\x0b\xa3\x0d\x05\xd4\x9d\xb4\x6d\xf2\xb8\xe7\xbe\xed\xb2\xeb\x1e\x7d\x17\x17\xb8\xe9\x74\xdd\x21\xa4\x57\x43\x1b\xc2\x46\x87\x40\x21\x73\x1c\x31\x6e\x9a\x53\x31\x88\xbc\xd0\xe3\xa5\xa6\x7f\x99\xf1\x3c\xf0\x14\xa2\xf4\x51\x4c\xb6\xfa\x73\x00\x12\x7c\x83\xca\xfd\x06\x00\x00"
+
+func dataCommonDevVagrantfileTplBytes() ([]byte, error) {
+	return bindataRead(
+		_dataCommonDevVagrantfileTpl,
+		"data/common/dev/Vagrantfile.tpl",
+	)
+}
+
+func dataCommonDevVagrantfileTpl() (*asset, error) {
+	bytes, err := dataCommonDevVagrantfileTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/common/dev/Vagrantfile.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/aws-simple/deploy/main.tf.tpl": dataAwsSimpleDeployMainTfTpl,
+	"data/aws-simple/deploy/variables.tf": dataAwsSimpleDeployVariablesTf,
+	"data/aws-vpc-public-private/deploy/main.tf.tpl": dataAwsVpcPublicPrivateDeployMainTfTpl,
+	"data/aws-vpc-public-private/deploy/variables.tf": dataAwsVpcPublicPrivateDeployVariablesTf,
+	"data/common/dev/Vagrantfile.tpl": dataCommonDevVagrantfileTpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"aws-simple": &bintree{nil, map[string]*bintree{
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsSimpleDeployMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataAwsSimpleDeployVariablesTf, map[string]*bintree{
+				}},
+			}},
+		}},
+		"aws-vpc-public-private": &bintree{nil, map[string]*bintree{
+			"deploy": &bintree{nil, map[string]*bintree{
+				"main.tf.tpl": &bintree{dataAwsVpcPublicPrivateDeployMainTfTpl, map[string]*bintree{
+				}},
+				"variables.tf": &bintree{dataAwsVpcPublicPrivateDeployVariablesTf, map[string]*bintree{
+				}},
+			}},
+		}},
+		"common": &bintree{nil, map[string]*bintree{
+			"dev": &bintree{nil, map[string]*bintree{
+				"Vagrantfile.tpl": &bintree{dataCommonDevVagrantfileTpl, map[string]*bintree{
+				}},
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,64 @@
+package staticapp
+
+import (
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/helper/compile"
+	"github.com/hashicorp/otto/helper/schema"
+)
+
+// staticSchema is the schema of the "static" customization. It is also
+// read during the build, which needs the build command and output dir.
+var staticSchema = map[string]*schema.FieldSchema{
+	"build_command": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Command that builds the site, run locally in the app dir",
+	},
+
+	"output_dir": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "public",
+		Description: "Directory of the built site, relative to the app dir",
+	},
+
+	"index_document": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "index.html",
+		Description: "Page served for directories",
+	},
+
+	"error_document": &schema.FieldSchema{
+		Type:        schema.TypeString,
+		Default:     "",
+		Description: "Page served for errors such as missing pages",
+	},
+}
+
+type customizations struct {
+	Opts *compile.AppOptions
+}
+
+func (c *customizations) processStatic(d *schema.FieldData) error {
+	c.Opts.Bindata.Context["output_dir"] = d.Get("output_dir")
+	c.Opts.Bindata.Context["index_document"] = d.Get("index_document")
+	c.Opts.Bindata.Context["error_document"] = d.Get("error_document")
+	return nil
+}
+
+// staticOption returns the value of a "static" customization from the
+// Appfile, or the default if it isn't set.
+func staticOption(f *appfile.File, k string) string {
+	var raw map[string]interface{}
+	if cs := f.Customization.Filter("static"); len(cs) > 0 {
+		raw = cs[len(cs)-1].Config
+	}
+
+	d := &schema.FieldData{Raw: raw, Schema: staticSchema}
+	v, ok, err := d.GetOkErr(k)
+	if err != nil || !ok {
+		v = staticSchema[k].DefaultOrZero()
+	}
+
+	result, _ := v.(string)
+	return result
+}
@@ -0,0 +1,41 @@
+#--------------------------------------------------------------------
+# Access Info
+#--------------------------------------------------------------------
+
+variable "aws_access_key" {
+    description = "Access key for AWS"
+    default = ""
+}
+
+variable "aws_secret_key" {
+    description = "Secret key for AWS"
+    default = ""
+}
+
+variable "aws_token" {
+    description = "Session token for temporary AWS credentials"
+    default = ""
+}
+
+variable "aws_region" {
+    description = "Region where we will operate."
+}
+
+#--------------------------------------------------------------------
+# Deploy Info
+#--------------------------------------------------------------------
+
+variable "environment_suffix" {
+    description = "Suffix for the names of resources in an environment"
+    default = ""
+}
+
+# The site is uploaded by Otto after Terraform runs. These identify the
+# build so that deploying a new build is a change.
+variable "site_path" {
+    description = "Directory of the built site"
+}
+
+variable "site_hash" {
+    description = "Hash of the built site"
+}
@@ -0,0 +1,60 @@
+# Generated by Otto, do not edit!
+#
+# This is the Vagrantfile generated by Otto for the development of
+# this application/service. It should not be hand-edited. To modify the
+# Vagrantfile, use the Appfile.
+
+Vagrant.configure("2") do |config|
+  config.vm.box = "hashicorp/precise64"
+
+  # Host only network
+  config.vm.network "private_network", ip: "{{ dev_ip_address }}"
+
+  # Setup a synced folder from our working directory to /vagrant
+  config.vm.synced_folder "{{ path.working }}", "/vagrant",
+    owner: "vagrant", group: "vagrant"
+
+  # Foundation configuration (if any)
+  {% for dir in foundation_dirs.dev %}
+  dir = "/otto/foundation-{{ forloop.Counter }}"
+  config.vm.synced_folder "{{ dir }}", dir
+  config.vm.provision "shell", inline: "cd #{dir} && bash #{dir}/main.sh"
+  {% endfor %}
+
+  # Load all our fragments here for any dependencies.
+  {% for fragment in dev_fragments %}
+  {{ fragment|read }}
+  {% endfor %}
+
+  # Serve the built site
+  config.vm.provision "shell", inline: $script_app
+
+  # Make it so that `vagrant ssh` goes directly to the correct dir
+  config.vm.provision "shell", inline:
+    %Q[echo "cd /vagrant" >> /home/vagrant/.bashrc]
+end
+
+$script_app = <<SCRIPT
+set -e
+
+oe() { "$@" 2>&1 | logger -t otto > /dev/null; }
+ol() { echo "[otto] $@"; }
+
+ol "Installing nginx..."
+oe sudo apt-get update -y
+oe sudo apt-get install -y nginx
+
+ol "Serving /vagrant/{{ output_dir }}..."
+cat <<'NGINX' | sudo tee /etc/nginx/sites-available/default > /dev/null
+server {
+    listen 80 default_server;
+    root /vagrant/{{ output_dir }};
+    index {{ index_document }};
+    {% if error_document %}error_page 404 /{{ error_document }};{% endif %}
+
+    # Files change through the synced folder, which sendfile misses
+    sendfile off;
+}
+NGINX
+oe sudo service nginx restart
+SCRIPT
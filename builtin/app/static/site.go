@@ -0,0 +1,227 @@
+package staticapp
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/otto/app"
+)
+
+// siteFiles returns the paths of the files of the site in dir, relative
+// to dir and with forward slashes, sorted.
+func siteFiles(dir string) ([]string, error) {
+	var result []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		result = append(result, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// siteHash returns a hash of the names and contents of the files of the
+// site in dir. It identifies the build, so a deploy can check that the
+// site wasn't changed since it was built.
+func siteHash(dir string) (string, error) {
+	files, err := siteFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, name := range files {
+		fmt.Fprintf(h, "%s\x00", name)
+		if err := hashFile(h, filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// siteUploader uploads a site to an S3 bucket and invalidates the
+// CloudFront distribution in front of it.
+type siteUploader struct {
+	Ctx    *app.Context
+	Dir    string
+	Bucket string
+	Region string
+}
+
+// Upload makes the bucket match the site. Files that are already in
+// the bucket with the same content are skipped, and objects that are no
+// longer part of the site are deleted.
+func (u *siteUploader) Upload() error {
+	conn := s3.New(session.New(u.config()))
+
+	// S3 uses the MD5 of the content as the ETag of objects that weren't
+	// uploaded in parts, which is every object we upload.
+	existing := make(map[string]string)
+	err := conn.ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(u.Bucket),
+	}, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			existing[*obj.Key] = strings.Trim(*obj.ETag, `"`)
+		}
+
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing the bucket %s: %s", u.Bucket, err)
+	}
+
+	files, err := siteFiles(u.Dir)
+	if err != nil {
+		return err
+	}
+
+	var uploaded int
+	for _, name := range files {
+		path := filepath.Join(u.Dir, filepath.FromSlash(name))
+		sum, err := fileMD5(path)
+		if err != nil {
+			return err
+		}
+
+		etag, ok := existing[name]
+		delete(existing, name)
+		if ok && etag == sum {
+			continue
+		}
+
+		if err := u.put(conn, name, path); err != nil {
+			return err
+		}
+		uploaded++
+	}
+
+	for name := range existing {
+		_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(u.Bucket),
+			Key:    aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting %s from the bucket: %s", name, err)
+		}
+	}
+
+	u.Ctx.Ui.Message(fmt.Sprintf(
+		"Uploaded %d changed file(s) and deleted %d old file(s).",
+		uploaded, len(existing)))
+	return nil
+}
+
+// Invalidate invalidates every path of the distribution so that the new
+// site is served right away instead of after the cache expires.
+func (u *siteUploader) Invalidate(distributionID string) error {
+	conn := cloudfront.New(session.New(u.config()))
+	_, err := conn.CreateInvalidation(&cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &cloudfront.InvalidationBatch{
+			CallerReference: aws.String(fmt.Sprintf("otto-%d", time.Now().UnixNano())),
+			Paths: &cloudfront.Paths{
+				Quantity: aws.Int64(1),
+				Items:    []*string{aws.String("/*")},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"Error invalidating the CloudFront distribution %s: %s",
+			distributionID, err)
+	}
+
+	return nil
+}
+
+func (u *siteUploader) put(conn *s3.S3, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(u.Bucket),
+		Key:         aws.String(name),
+		Body:        f,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("Error uploading %s: %s", name, err)
+	}
+
+	return nil
+}
+
+// config returns the AWS configuration using the credentials of the
+// deploy, so the site is uploaded to the same account that Terraform
+// created the bucket in. Without keys, the AWS profile or instance role
+// is used, like Terraform does.
+func (u *siteUploader) config() *aws.Config {
+	config := aws.NewConfig().WithRegion(u.Region)
+	creds := u.Ctx.Shared.DeployCredsVars()
+	if creds["aws_access_key"] != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			creds["aws_access_key"],
+			creds["aws_secret_key"],
+			creds["aws_token"]))
+	}
+
+	return config
+}
+
+func fileMD5(path string) (string, error) {
+	h := md5.New()
+	if err := hashFile(h, path); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
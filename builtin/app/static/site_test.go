@@ -0,0 +1,29 @@
+package staticapp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+)
+
+func TestSiteUploaderConfig(t *testing.T) {
+	ctx := &app.Context{}
+	ctx.InfraCreds = map[string]string{
+		"aws_access_key":           "base",
+		"aws_secret_key":           "base-secret",
+		"deploy.aws_access_key":    "deploy",
+		"deploy.aws_secret_key":    "deploy-secret",
+		"deploy.aws_session_token": "deploy-token",
+	}
+
+	u := &siteUploader{Ctx: ctx, Region: "us-east-1"}
+	creds, err := u.config().Credentials.Get()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if creds.AccessKeyID != "deploy" ||
+		creds.SecretAccessKey != "deploy-secret" ||
+		creds.SessionToken != "deploy-token" {
+		t.Fatalf("bad: %#v", creds)
+	}
+}
@@ -0,0 +1,16 @@
+package staticapp
+
+import (
+	"github.com/hashicorp/otto/app"
+)
+
+// Tuples is the list of tuples that this built-in app implementation knows
+// that it can support.
+var Tuples = app.TupleSlice([]app.Tuple{
+	{"static", "aws", "simple"},
+	{"static", "aws", "vpc-public-private"},
+})
+
+func init() {
+	app.Register("static", app.StructFactory(new(App)), Tuples...)
+}
@@ -85,7 +85,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataAwsSimpleDeployMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x90\xcd\x4e\x04\x21\x10\x84\xef\xf3\x14\x84\x78\x05\x77\x3d\x78\xf3\x59\x08\x0b\x1d\xd3\x91\xbf\xf0\xa7\x66\xb3\xef\x2e\x33\x40\xd8\x89\xdc\xba\xea\xa3\x29\x2a\x44\x5f\x51\x43\x24\x54\x7e\x27\x4a\xee\x1b\x69\x47\x2a\x05\x29\x89\x2f\xf8\x25\x1f\x84\xbe\xdc\xab\x8c\xbc\xd9\x62\xe9\x0f\x7a\x80\x09\x54\x84\xfc\x1f\x5c\xfa\x00\x23\x7c\xa2\x77\x0b\xea\x73\x33\x1f\xdb\x66\xbd\x2e\x06\x08\x55\xde\xa5\x62\xd8\x75\xa6\x48\xbe\x44\x05\xfb\x1d\xfe\xda\x19\xd6\x76\xb3\x84\x36\x18\xa0\xdb\xc1\xa0\xd3\xf0\xb3\x23\xd7\xfe\x4e\x88\x58\x65\x06\x86\xe1\x10\x2f\xfc\xc2\xdf\xf8\x7b\xf7\xa4\xc5\xa7\x94\x16\x47\xb4\x16\x92\x39\x69\x61\x79\x4d\x11\xbb\x32\x3f\x59\x6e\x0e\x32\x43\xbd\x88\x2e\x89\x50\x6e\x06\xd5\xc0\x6a\x50\x27\xa6\xcd\x02\xf5\x93\xa9\x50\xc7\xb3\xbd\x2b\xbd\x03\x5f\x72\x28\x79\x76\x20\xa4\xd6\xb1\x35\x3d\x9b\xa8\xd2\x94\x91\xaf\x17\xc1\x67\x57\x7c\x90\xc7\x96\xbf\x00\x00\x00\xff\xff\x16\xa3\xcf\x82\xcc\x01\x00\x00"
+var _dataAwsSimpleDeployMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x64\x91\x4b\x6e\xc4\x20\x10\x44\xf7\x3e\x05\x42\xd9\x42\x66\xb2\xc8\x2e\x67\x41\x3d\xd0\x8a\x5a\xfe\x80\x68\x60\x62\x8d\x7c\xf7\xc8\x06\xc7\xb6\xb2\xf4\xab\x67\x28\x15\x21\xfa\x42\x0e\xa3\x90\xf0\x64\x29\x5e\x9d\x10\x42\x80\xb5\xc8\x6c\x7a\x9c\xc5\x97\x90\x6f\xaf\x02\x51\xc3\x93\xcd\xc1\x17\xb9\x89\x8c\x36\x62\xfa\x2f\x1e\xbc\x89\xc9\xf7\x38\x5d\x9d\x0d\xb5\x38\xe2\x37\xf9\x53\x5e\xbf\x17\xd9\x2d\x5d\x37\x7a\x97\x07\x14\xd2\xfa\x89\xf3\xa0\xee\x7b\x49\xf6\x39\x5a\x5c\xff\xd1\xef\xd5\x51\xf0\x64\xc5\x34\x86\x01\x65\xb7\x39\x34\x39\xfc\x59\x95\x7b\xbd\x27\x44\x2a\x90\x50\x51\xd8\xe0\x4d\xdf\xf4\x87\xfe\xac\x19\x8c\x74\x2a\x38\x52\xab\xd6\xe3\xac\x26\x18\xf1\xc8\x7a\x9c\xcd\x4a\xf6\x0d\xf2\x63\xc2\xa4\xc8\x1d\x46\x45\x26\xe4\xc7\x40\xb6\x69\x25\xd8\x8b\x53\x82\x35\xe4\x4e\xa1\x25\x17\xaf\xf1\x4a\xea\x06\x3e\xa7\x90\xd3\xbe\x81\x01\xe7\x22\xf2\xdf\x73\x15\x18\x72\xeb\x57\x87\xd0\xfb\x56\xba\x99\xdb\x29\xbf\x03\x00\x55\x67\xb9\x38\xeb\x01\x00\x00"
 
 func dataAwsSimpleDeployMainTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -105,7 +105,7 @@ func dataAwsSimpleDeployMainTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x91\x3d\x6b\xc3\x30\x10\x86\x77\xff\x8a\xc3\x99\x53\xc8\xd2\x4e\x1d\x42\x02\xad\x87\x42\x88\xa1\x1d\xcd\x59\x3e\xc5\xa2\x8a\x64\xf4\x11\x53\x4a\xff\x7b\x2f\x6a\x86\xd6\x54\x5e\x92\xc3\xdb\xbd\xef\x63\xe9\xd1\x62\x79\x83\x29\x16\xb0\x16\x82\xbc\x87\xca\x48\x5b\xdc\x86\x59\x9c\xd0\x29\x6c\x35\x41\x89\xa3\x6f\x30\xfd\xa0\x79\xa7\x8f\x12\x3e\x0b\xe0\xe9\xc8\x0b\xa7\x86\xa0\xac\x81\x47\x28\x2f\x27\xe0\x00\x48\xeb\x60\xfd\x56\x97\xc5\xd7\x94\xe2\x49\x38\x0a\x33\x94\x3a\x05\x66\x28\x8e\x0e\x1c\xcd\xb4\xf7\x69\x09\x63\x4f\x8e\x60\xe4\x4f\x69\x0d\x76\x20\x87\x81\xee\x12\xe8\x4a\x37\x6c\xfa\x89\x0c\xf3\x34\x78\x0a\x41\x99\x83\xbf\x1a\xf9\x5b\xd1\x51\xe5\xec\xbe\x54\x10\x2c\x68\x8c\x46\xf4\x7c\xaf\xd0\xc3\xc6\x1a\x1f\x75\x79\x49\x4b\x8c\x3a\x9c\x93\xcc\x58\x3e\xc8\x7b\x5c\xc9\x15\x4e\xdc\xb1\xd5\xc6\xe0\x91\x72\xee\xeb\xe7\x24\x3e\x45\xfe\x36\x7d\x6c\x0d\xbf\xdb\x10\x5b\xad\x44\xa6\xbe\x4b\x4b\xf8\x89\x4e\xfa\xa7\x41\x34\xaa\xcb\x14\x5f\x77\x1b\xa8\xb6\xff\x34\x84\xea\xdc\x4c\x67\x53\x6d\xf7\xe7\xd6\x77\x00\x00\x00\xff\xff\x3d\x39\x1a\xdf\x44\x03\x00\x00"
+var _dataAwsSimpleDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xac\x91\x31\x6b\xf3\x30\x10\x86\x77\xff\x8a\x17\x67\xce\x07\x59\xbe\x4e\x1d\x42\x02\xad\x87\x42\x48\xa0\x1d\xcd\x45\x3e\x27\x87\x65\xc9\x48\x72\x8c\x29\xfd\xef\xc5\x6a\x86\xd6\xad\x53\x4a\xe2\xc9\x70\xef\xf3\x9c\xb8\x77\x36\xbf\xc1\x97\xcc\xb0\x54\x8a\xbd\x47\x66\x4a\x9b\xdc\xc6\x99\x9c\xc8\x09\xed\x35\x23\xa5\xce\xe7\x14\x17\xe4\x15\xf7\x29\x5e\x13\x00\x28\xd8\x2b\x27\x4d\x10\x6b\x70\x8f\xf4\xfc\x82\x8a\x7b\x94\xd6\x61\xf9\xb2\x4b\xcf\xb1\x92\x5a\x1d\x86\x48\x9a\xbc\x8d\xb5\x9e\x95\xe3\x70\x41\xbb\x8b\x81\xbf\x6a\x83\xad\xd8\x4c\x1a\xbd\x1f\x7e\x63\x26\x4a\x03\xd7\x8d\x75\xe4\xfa\x41\x0f\xe5\xb8\x60\x13\x84\xb4\xff\x6d\x95\xe3\x83\xd8\xa9\x3d\xdb\x38\x44\x77\x64\xc7\xe8\x18\x9d\x68\x0d\xdb\xb0\xa3\xc0\xff\xa2\x68\x76\x75\xed\x0f\x6c\xd8\x91\x86\xe7\x10\xc4\x1c\xfc\xd5\xca\xcf\x77\xac\x65\xaa\xea\xa7\x0c\xc1\x42\x53\x6b\xd4\x11\x9d\x84\x23\x56\xd6\xf8\x56\x7f\xbb\x17\xd5\x32\xbf\x2b\xff\xd3\xa2\x5c\xd0\xe8\x76\x15\xf7\xb9\xa1\x9a\xa7\x5a\xda\x3d\xc6\xd2\x63\xe4\x2b\xe9\xdb\xbd\xe1\x90\x37\xed\x5e\x8b\x9a\xc0\x37\x71\x88\x8f\xe8\x88\x3f\x35\x2a\x97\x62\x02\x7c\xde\xac\x90\xad\x7f\x20\x94\x14\xee\x02\xb3\xca\xd6\xdb\x81\x7a\x1f\x00\xf8\x63\xc5\x17\xd1\x03\x00\x00"
 
 func dataAwsSimpleDeployVariablesTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -125,7 +125,7 @@ func dataAwsSimpleDeployVariablesTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xe4\x94\xcb\x6e\xc3\x20\x10\x45\xf7\xfe\x0a\x64\x75\x6b\x1a\x27\x52\xda\x4d\xbf\x05\x11\x18\xb5\xd3\xda\x60\xf1\x70\x5b\x45\xfe\xf7\x62\x1e\xc2\x51\xaa\x48\x59\x27\x3b\xee\x3d\x64\x86\xb3\xf0\x64\xf4\x8c\x12\x0c\x69\xf9\xb7\x6d\xc9\xb9\x21\xe1\xc7\x85\x00\x6b\xd9\x17\xfc\x92\x37\xd2\x3e\x9d\x67\x6e\x68\xa8\x59\xcd\x97\x36\x82\x16\x84\x01\x77\x0d\xd6\x3c\x83\x06\xde\x51\xab\x0a\xa5\x73\x28\x97\xa6\x19\xb5\xf4\x03\x90\x56\x68\x65\xfd\xd0\xf5\x65\x0b\xab\xbd\x11\xb0\xde\xa1\xcf\x89\xe9\xd6\x1d\x9b\x58\xa2\x92\xf0\xb3\x76\x7d\x1a\x30\x19\x9c\xb9\x83\x0e\xa7\x18\xee\xe8\x8e\xf6\xf4\x35\x75\x7c\xc4\xcd\x7a\x23\xe6\x9d\xc2\x76\x9d\xe2\x23\xd4\x2e\x24\x6c\x4d\xca\xeb\xfc\x49\x81\xeb\x50\x56\x22\x47\x79\x5a\xe6\xe6\x49\x5c\x40\xe1\xcc\x50\xe6\xf2\x53\xa3\x62\x5c\x4a\xb3\xd9\xeb\x98\xaa\x13\xb7\x2e\x58\x60\x1f\xda\xba\x7a\x7b\x9b\x2e\x97\xa0\xb7\x60\xae\xc1\x35\xfd\xd7\xe4\xfe\x3e\x93\xfb\x1b\x26\x5f\x1e\xdb\xe4\xe1\x3e\x93\x87\x1b\x26\x8f\x0f\x66\x52\x7b\x37\x79\x57\x4c\xc6\xa9\xe1\x1b\x52\x7c\xce\x7c\xf0\xf9\xb1\x49\x27\x2d\xc6\x69\x26\xe3\xbf\xfc\x05\x00\x00\xff\xff\xef\x0d\x94\xd4\xa6\x04\x00\x00"
+var _dataAwsVpcPublicPrivateDeployMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xe4\x94\x4d\x6e\xc3\x20\x10\x85\xf7\x3e\x05\x42\xdd\x9a\xe6\x47\x4a\xbb\xe9\x59\x10\x81\x51\x4b\x1d\x03\x62\x80\x34\x8a\x7c\xf7\xca\x80\x6b\x5b\xa9\x22\x65\x9d\x65\xde\xfb\xc8\xcc\x7c\x0b\x3b\x6f\x93\x56\xe0\x09\x15\x67\xa4\xe4\xda\x10\x42\x88\x90\x12\x10\x79\x07\x17\xf2\x41\xe8\xcb\x35\x09\xcf\xc4\x19\xf9\x9c\x0f\x34\x83\x08\xd2\x43\xb8\x05\xe7\xbc\x82\xc1\x76\x60\xd6\x4c\x8e\x6a\xed\xe1\x53\xdb\x45\x5f\x7e\x0f\xb4\x19\x9a\xa6\xb7\x2a\x9e\x80\x50\x69\x0d\xc6\x53\xbb\x9d\x96\x44\x1b\xbd\x84\xf1\x0d\x7b\x2d\x4c\x3b\x9e\xd0\xe4\x52\x1b\x05\x3f\x63\xb7\x2d\x03\x9c\xd7\x49\x04\x68\xb5\xcb\xe1\x86\x6d\xd8\x96\xbd\x97\x4e\xf4\x7a\xb1\x59\xaf\xeb\x4e\x1d\x5c\x5a\x23\x7a\x98\xbb\x0e\x2e\x7c\x4c\xa6\xe3\xe3\xd1\x40\x68\xb5\x9a\x89\x1a\xd5\x69\x95\x4b\x4e\xae\xa0\xe4\x24\xd7\xaa\x96\xdf\x56\x1b\x2e\x94\xf2\x8b\xbd\x0e\xa5\x3a\x0a\x0c\xda\x1a\xfe\x65\x31\xcc\xaf\x97\xe9\xb0\x06\x23\x82\xbf\x05\xc7\xf4\x5f\x93\xbb\xc7\x4c\xee\xee\x98\x7c\x7b\x6e\x93\xfb\xc7\x4c\xee\xef\x98\x3c\x3c\x99\x49\x1b\x83\x8b\x61\x32\x99\xa7\x02\xfe\x7d\x88\x92\x38\xc5\x7a\x6c\xd1\xc9\x26\xe3\xac\x92\xf9\x5f\x7e\x07\x00\x41\xb4\x75\xdc\xc5\x04\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployMainTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -145,7 +145,7 @@ func dataAwsVpcPublicPrivateDeployMainTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataAwsVpcPublicPrivateDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x91\xc1\x6a\x83\x40\x10\x86\xef\x3e\xc5\x60\xce\x16\x72\x69\x4f\x3d\x84\x14\x5a\x0f\x85\x50\xa1\x3d\xca\xb8\x19\xe3\xd2\xcd\xae\xec\xac\x4a\x29\x7d\xf7\xae\x1b\x0f\x8d\x74\x25\x90\x0c\xde\xe6\xfb\x3f\xf5\x9f\x55\x76\x83\x49\x56\xb0\x11\x82\x98\x21\xd7\xb5\x49\x6e\xe3\x4c\x7a\xb4\x12\x2b\x45\x90\xe2\xc0\x25\x86\x17\x94\x9f\xf4\x95\xc2\x77\x02\x7e\xf6\xc4\xc2\xca\xd6\x49\xa3\xe1\x11\xd2\xe9\x0b\x3c\x00\xb5\xb1\xb0\xf9\x28\xd2\xe4\x67\x6e\x61\x12\x96\xdc\x82\xa5\x08\xc0\x82\xc5\xd2\xc1\xa3\x91\xf4\x5b\x58\xc2\xd0\x90\x25\x18\xfc\x23\x95\x02\xd3\x92\x45\x47\x77\x41\x74\x65\x37\xbe\xe9\x67\xd2\xde\xa7\x80\xc9\x39\xa9\x0f\x7c\xb5\xf2\x6f\x45\x47\x19\x6b\xf7\x35\x07\x67\x40\x61\xa7\x45\xe3\xff\xcb\x35\xb0\x35\x9a\x3b\x95\x4e\x74\x8d\x9d\x72\x23\xe9\x1d\xd9\x43\x7d\x8f\xeb\x7a\x8d\xb3\xee\x2a\xe4\xd1\x57\x36\x86\x5d\xac\xff\xe2\x05\x26\x0c\x02\xf6\xbf\xa1\x63\xb2\x17\x18\x02\x76\x6e\xf0\x97\x2d\x35\x1e\x69\x21\x3d\x1e\x3f\x20\xe7\x49\xee\x2a\x4d\x2e\x6b\xad\xec\xfd\x39\x23\xf9\xdd\x69\x0b\x27\x78\x66\xe8\x5b\x51\xca\x7d\x24\xf9\xbe\xdb\x42\xfe\x34\x26\x7e\x03\x00\x00\xff\xff\xb8\x11\x18\xfb\x94\x03\x00\x00"
+var _dataAwsVpcPublicPrivateDeployVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xac\x92\xc1\x4a\xc3\x40\x10\x86\xef\x79\x8a\x21\x3d\x57\xe8\x45\x4f\x1e\x4a\x05\xed\x41\x28\x16\xf4\x18\xa6\xdb\x49\xb3\x64\xb3\x1b\x66\x26\x0d\x41\x7c\x77\xc9\xb6\x07\x1b\x4d\x55\xda\x3d\x2d\xcc\xf7\x7f\xb3\xcc\xec\x64\x7a\x85\x93\x4c\x60\x6e\x0c\x89\xc0\xd2\xe7\x21\xb9\x8e\x33\xd9\x23\x5b\xdc\x38\x82\x14\x5b\xc9\x30\x36\xc8\x4a\xea\x52\x78\x4f\x00\x00\xb6\x24\x86\x6d\xad\x36\x78\xb8\x87\xf4\xf8\x82\x92\x3a\xc8\x03\xc3\xfc\x6d\x9d\x1e\xb1\x1c\x1b\xa7\x3d\x92\x26\x1f\x43\xad\x90\x61\xd2\x33\xda\x75\x04\xfe\xab\xd5\x50\x92\x1f\x35\x8a\xf4\xd7\xc8\x44\xa9\x52\x55\x07\x46\xee\x7a\x3d\x18\xa6\x2d\x79\xb5\xe8\xe4\xb7\x56\x4c\x3b\x1b\xc6\xfa\xbc\xc4\x22\xb4\x05\x31\x41\x4b\xd0\x5a\xe7\x20\xd4\xc4\xa8\x74\x13\x45\x93\x8b\xd7\xfe\x48\x9e\x18\x1d\x08\xa9\x5a\xbf\x93\x8b\x95\x5f\xe7\x58\xd9\xb1\x55\x3f\x2f\x41\x03\x38\x6c\xbc\x29\xa0\xb5\x5a\xc0\x22\x78\x69\xdc\xb7\x79\x61\x65\xa7\x77\xf9\x2d\xce\xf2\x19\x0e\x66\xb7\x41\xe9\x7d\x59\x11\x44\xc7\x36\xb5\x7e\x82\x23\x06\x11\xfb\xd9\xd0\x08\xf1\x1f\x0c\x11\x3b\x35\x94\xd4\x65\x1e\x2b\x3a\x93\xee\x3f\x5e\x44\x4e\x93\xd2\x6c\x3c\xe9\xb4\x66\xbb\x47\x1d\xcb\xaf\x0e\x55\x38\xc0\x03\xc3\xbe\x36\x99\xdd\x8e\x24\x5f\x57\x0b\x58\x3e\xf4\x89\xcf\x01\x00\x50\x8a\x74\xf9\x21\x04\x00\x00"
 
 func dataAwsVpcPublicPrivateDeployVariablesTfBytes() ([]byte, error) {
 	return bindataRead(
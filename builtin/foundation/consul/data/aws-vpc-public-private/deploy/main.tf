@@ -1,6 +1,7 @@
 provider "aws" {
     access_key = "${var.aws_access_key}"
     secret_key = "${var.aws_secret_key}"
+    token = "${var.aws_token}"
     region = "${var.region}"
 }
 
@@ -73,7 +73,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataSimpleMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x94\x4f\x4f\xdc\x3c\x10\xc6\xef\xfb\x29\x46\x81\x03\xe8\x85\xbc\x80\xaa\xde\x7a\x40\x1c\xda\x1e\xfa\x47\xdd\xaa\x3d\x46\x5e\x67\xd8\x58\x64\x6d\xcb\x9e\xec\x6a\xb5\xda\xef\xde\xf1\x38\x21\x9b\x20\xa8\x1a\x38\xc0\x78\x3c\x7e\x9e\x9f\xc7\x73\x06\x1f\xd1\x62\x50\x84\x35\xac\xf6\xf0\x8d\xc8\x5d\x41\xed\xc0\x3a\x02\xac\x0d\xc1\x46\xd9\x4e\xb5\xed\xbe\x5c\x2c\xb6\x2a\x18\xb5\x6a\x11\x0a\xb5\x8b\x95\xd2\x1a\x63\xac\x9e\x70\x5f\xc0\x61\x01\xfc\xd5\x18\x75\x30\x9e\x8c\xb3\xf0\x01\x8a\x7b\x49\x00\x4e\x80\x47\x17\xe0\xfe\xf7\xb2\x58\x1c\xe7\x55\x22\xea\x80\xf4\x46\x95\xa5\x24\xfc\xa5\x4a\xc0\x35\xa7\xbf\x52\xe1\x87\x2c\xc2\xae\xc1\x80\xb0\xe3\x5f\xd3\xb6\xe0\xbc\x98\x2e\x67\xc5\x62\x6c\x2a\xdf\xad\x5a\xa3\xdf\x90\xf4\xe0\x2c\xa1\xa5\x08\xee\x11\x94\x85\xe5\xf2\x13\xe4\x3d\xa2\x92\x1c\xac\x83\xb2\x04\x19\x50\xfa\x9f\x1d\x08\x60\x63\x23\x29\xcb\x51\x39\xd5\x07\xb7\x35\x35\x06\xb1\x90\x8f\x1a\x99\xa6\x73\xce\x0f\x2c\xac\x9c\xb2\x3e\x16\x9c\x36\x42\x9b\xa6\x8d\x71\x49\xcb\x54\x92\x81\x69\x5a\x8e\x1f\x45\xc4\x19\x7c\x51\xc6\xc2\xaf\xef\x0f\x40\x8d\xa2\x0c\x47\xb3\xc1\x14\xc5\x2d\x86\x3d\x35\xc6\xae\xcb\x45\xc0\xe8\xba\xa0\x7b\xe0\x5b\xaf\x0b\x28\x36\x9c\x94\x85\x6b\x53\x87\x6a\xd5\x3a\xfd\x94\x8e\xba\xbd\x29\xe5\xe7\xff\xdb\xf7\xc5\x82\x57\xd1\x26\xba\x55\x6d\x59\x62\xe7\xbd\x0b\x24\x92\x28\x74\x38\x5d\x6d\x5c\x24\xab\x36\x18\x87\x55\x5e\x26\xb5\x8e\x70\x80\xaf\x1c\x4e\xb5\x1d\x77\x68\x01\xc7\xac\xfd\x67\x83\x03\xfa\xd8\xad\x2c\xf7\x89\x89\xfd\x45\x0f\x7a\x63\x72\x63\x51\x27\xfe\x7c\x15\xc4\x3b\x0c\x5f\x5f\x48\xc9\x62\x76\xed\x66\xde\x72\x25\xb6\x97\x2b\x0f\x4d\xc0\x96\x2b\x53\xc3\x8b\x4f\xd0\xf6\x4c\xca\x44\xa4\x34\xb5\xe0\x9f\x50\x99\x6f\x11\x44\x77\x8c\xe8\xee\x5d\xce\xdd\x28\x3f\x74\x9e\xf1\x95\xb3\x55\xab\x3a\xab\x9b\x13\x10\x2f\x50\x0c\xfa\x7a\x18\x9f\x07\x5b\xb9\x5b\x4c\x6a\xe8\xe0\x3a\x42\xde\x97\xfe\xfe\x0f\xd6\xdc\x85\x3b\x95\x1f\x12\xcd\xd1\xcd\x28\x0c\x90\xaa\x7e\xd3\x8c\x47\x4f\xe3\x15\xf3\xac\x67\x5a\x4d\x74\x54\xa2\xe3\x9f\x0a\x41\xef\x20\x5f\xc1\xbc\xcf\x86\x36\xbb\x29\xfa\xe5\x5e\xeb\xa4\xe0\xdc\x48\x99\x4f\x1f\xea\x1f\x5f\xb6\xd8\x84\xeb\xab\x3e\x2a\x15\xa3\xd3\x46\x91\x4c\x9e\x89\xa7\x0c\xf4\xb9\x5b\x9e\xa5\xe4\xf8\x4c\xc0\x69\xc9\x53\xdd\x27\xf1\xc9\x0e\xb9\xeb\x34\x73\x64\xd8\xa4\x37\xab\xbc\x07\xb3\xf1\x2d\x6e\x78\x2a\x89\x1e\x6e\x7a\x1e\x4c\x5d\xc4\x71\x1a\xa5\x1d\xe3\x44\x7a\x9e\x44\x33\x7f\x5c\xb3\xf2\xca\x84\xc9\xe3\x4e\xc1\xf4\x2a\xb3\x95\xf4\x00\xaf\xcf\x0f\x98\x8f\xbb\x88\xbe\x35\x74\x51\x5c\x17\x57\x30\xbb\xbe\xcb\x2b\xb8\xbd\x14\x8b\xe3\x44\x1d\x07\xd1\x74\xd2\x8a\xaf\x3f\x01\x00\x00\xff\xff\xab\x9a\x37\x46\x8c\x06\x00\x00"
+var _dataSimpleMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x54\x4f\x6f\xdb\x3e\x0c\xbd\xfb\x53\x10\x6a\x0f\x2d\x7e\xad\xfb\x07\x3f\xec\xb6\x43\xd1\xc3\xb6\xc3\xfe\x60\x19\xb6\xa3\xc1\xc8\x6c\x2c\xc4\x96\x04\x89\x4e\x60\x04\xfe\xee\x83\x24\xbb\x8e\x5d\x24\x58\xdd\x4b\x43\x51\x8f\x7c\xef\x51\xbc\x80\x4f\xa4\xc9\x21\x53\x09\xeb\x0e\xbe\x33\x9b\x1b\x28\x0d\x68\xc3\x40\xa5\x62\x68\x50\xb7\x58\xd7\x5d\x9e\x65\x3b\x74\x0a\xd7\x35\x81\xc0\xbd\x2f\x50\x4a\xf2\xbe\xd8\x52\x27\xe0\x90\x01\x00\x94\xe4\xa5\x53\x96\x95\xd1\xf0\x11\xc4\x53\x4c\x80\x2d\x75\xf0\x62\x1c\x3c\xfd\x59\x89\x21\xed\x05\xdb\x9a\x43\x8a\xc8\xfa\x25\xac\x27\xe9\x88\xcf\xc0\xae\x62\xc2\x7b\x61\xd9\x6c\x49\x9f\x44\xf4\x3e\xfc\x1b\x73\x22\x28\x53\x63\x8d\x43\xd7\x05\x78\x90\x8e\x4a\xd2\xac\xb0\xf6\xff\x52\xca\xd1\x46\x99\x53\xb5\x7e\xc6\x43\xd8\x57\xe4\x08\xf6\x04\x7b\x55\xd7\x60\x6c\x74\x20\x5f\x80\x79\x5f\x15\xb6\x5d\xd7\x4a\x9e\x91\xe3\xd9\x68\x26\xcd\x1e\xcc\x0b\xa0\x86\xd5\xea\x33\xa4\x3b\x51\x21\x36\xb0\x71\xa8\x19\x92\x5b\xe1\xb7\x74\x14\xdd\x56\xda\x33\x6a\x49\x3e\x56\xb5\xce\xec\x54\x49\x2e\x52\x48\xa5\x26\x83\x43\x9d\xcb\xc3\x0e\x5d\x3e\x37\xbe\x0f\x72\x4c\x86\xcd\xd3\xa6\x78\x4c\x4b\xe2\xce\x32\x62\x28\x1e\x26\xc9\x20\x7c\xb3\x8c\x14\xef\x63\x87\x17\xf0\x15\x95\x86\xdf\x3f\x9e\x81\x2b\xe4\xa4\x9c\x34\x9a\x43\x94\x76\xe4\x3a\xae\x94\xde\xe4\x99\x23\x6f\x5a\x27\x07\x37\x76\x56\x0a\x10\x0d\xaa\xc1\x11\xa9\x4a\x57\xac\x6b\x23\xb7\xa1\xd4\xc3\x7d\x1e\xff\xee\x1e\x3e\x88\x2c\x03\x20\x1d\xa4\x2f\x4a\xed\x0b\xdf\x5a\x6b\x1c\xc7\x96\xd8\xb5\x34\x3f\xad\x8c\x67\x8d\x0d\xf9\xf1\x34\x50\xc4\x8d\x87\x03\x7c\xc3\x86\x02\xb6\x61\x36\x02\xfa\xd4\xfb\xaf\x8a\x46\x5f\x7c\xbb\xd6\xc4\xa0\xfc\x30\x05\x63\xbf\x3e\xb0\xd1\x24\x83\x39\x6c\x80\x2b\x02\xa5\x99\x9c\xa6\x81\xec\xc6\x2c\xb8\x25\x24\x01\x22\x21\x8f\x13\xb2\xb3\xb2\x50\x25\xbc\xf9\xa2\xb4\x83\x26\x79\x50\x24\x57\x65\x9f\x26\xfa\x48\x95\xe5\x95\x28\xd1\x63\x7e\x7f\xf7\xf8\x7f\xca\x6d\xd0\x8e\x63\xa9\x6c\x61\x74\x51\x63\xab\x65\x75\x24\xc4\x1b\x29\xc6\xfe\x06\x31\xbe\x8c\xb4\xd2\x28\xa9\x30\xed\xce\xb4\x4c\xc0\x71\xf2\xff\x83\x0d\x32\xed\x31\xbd\x70\x5e\x4a\xb7\x50\x61\x14\xa9\x18\x2e\x2d\xf4\x18\xd4\x38\x41\xbe\xcf\x16\x68\xb1\x8f\x22\xf6\xf1\x2e\x20\x18\x18\x24\x0b\x96\x73\x36\x8e\xd9\xbd\x18\x8e\x87\x5e\x67\x80\x4b\x22\x79\xaa\x3e\xe2\xf7\xd9\x79\x5d\x4f\xf2\x28\xd0\x7b\x23\x15\x72\x5c\x4b\x33\x4e\x49\xd0\xd7\x69\x79\x6d\x25\xc5\x17\x0d\x1c\x43\x1e\xf7\x7d\x14\x9f\xdd\x88\x5e\x87\x85\x14\x37\x51\x78\xb3\x68\x2d\xa8\xc6\xd6\xd4\x90\xe6\xd8\x8f\x07\x89\x1a\x5a\x4f\xd3\xaa\x0a\x37\xa6\x75\xf5\xba\xa6\x16\xfc\xb6\xd4\x15\x16\x95\x9b\x3d\xee\x10\x0c\xaf\x12\x60\x7c\x80\xb7\x97\x07\x4a\xe5\xae\xbc\xad\x15\x5f\x89\x5b\x71\x03\x0b\xfb\xae\x6f\xe0\xe1\x3a\x52\x9c\xd6\xed\xb4\x88\xe6\x6b\x38\xf2\xfa\x3b\x00\x8f\x49\x06\x04\x36\x07\x00\x00"
 
 func dataSimpleMainTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func dataSimpleOutputsTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataVpcPublicPrivateMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x57\x6d\x8b\x1b\xb7\x13\x7f\xef\x4f\x31\x6c\x0e\xfe\x77\xfc\xcf\xeb\xb3\x1b\xf2\xa2\xa5\x85\x4b\x52\xda\x40\x93\x2b\x24\xb4\xd0\x10\x16\x79\x77\x6c\xab\xa7\x95\x16\x49\xeb\xab\x7b\xf8\xbb\x77\xf4\xb0\xf6\x6a\xed\x7b\x0a\x6d\xa0\xf5\xc1\x61\x4b\x23\xcd\xd3\x6f\x7e\x33\x7a\x06\x3f\xa0\x44\xcd\x2c\x56\x30\xdf\xc0\x95\xb5\xea\x1c\x2a\x05\x52\x59\xc0\x8a\x5b\xa8\x99\x6c\x99\x10\x9b\x7c\x34\x6a\xb4\x5a\xf3\x0a\x35\x64\xec\xc6\x64\x70\x3b\x02\x60\x65\x89\xc6\x14\xd7\xb8\x81\x6f\x21\x3b\xb9\x5d\x33\x9d\xd3\x66\xb1\x5f\xdf\x66\x24\x66\xb0\xd4\x68\x0f\xc5\xf6\xeb\x5e\x4c\xe3\x92\x2b\x09\xee\x93\x88\x85\x75\x12\xd9\x8e\x46\xcf\xe0\x2d\xe3\x12\x7e\xf9\xf9\x15\xd8\x15\xb3\x70\xc3\x85\x80\x52\x49\xeb\x56\x71\x8d\x7a\x63\x57\x5c\x2e\xf3\x91\x46\xa3\x5a\x5d\xa2\xb7\xb6\x58\x37\x65\x06\x59\x4d\x42\xc1\xf0\x92\x57\xba\x98\x0b\x55\x5e\x3b\x55\xd3\x8b\xdc\xff\x4d\xa6\x2f\xb2\x11\xed\x5a\xb6\x34\x70\x0b\xef\x58\x8d\x6e\x5b\x51\x54\x32\xd8\x06\xf5\x1f\x56\x08\x4d\x3b\x17\xbc\x04\xd3\xce\x25\x5a\xe0\x06\x6e\x56\xa8\x11\x3a\x95\xc6\x19\x24\xb1\x74\x41\xb5\x8a\xec\x44\xe0\xd2\xa2\x76\xc2\xde\xde\xa5\x1a\x98\x17\x6e\x22\x0b\xc3\xcd\xc1\x46\x00\xb2\xba\xe0\x15\x1c\x7c\x7c\x74\xa2\x5b\xb9\x73\x2a\xe7\x95\x8f\x60\xe2\xd8\xf0\x88\xf7\x72\x46\x5e\xce\x9e\x07\xd9\x9a\x35\x45\x50\x58\xf0\xa6\x50\xb2\x10\xac\x95\xe5\x8a\x64\xad\x6e\x71\xe4\x65\x06\xa1\xe8\xec\xeb\x07\x43\xf3\x35\xe1\xe7\x9e\x68\xf8\x44\x31\xdd\x45\x81\x09\x50\x52\x6c\x1e\x0c\x45\xb8\xf7\x48\x2c\x1e\xe7\x7e\xe7\xf1\x34\x7a\xec\xf7\x9f\xc1\x6b\x85\x46\xfe\xcf\x01\xdb\x92\x31\x64\x2b\x27\x8f\x2f\x7f\x83\x1b\x04\xc1\x64\x45\x36\x9e\xc3\xbc\xb5\x31\xc7\x93\xd4\x3b\x13\x2f\x91\x18\x52\x3b\x47\x4a\x35\x69\xf3\xf5\x43\x00\x64\xd2\x5d\xb5\x50\x1a\xbe\xff\xe9\xa5\x89\x12\x6c\x2e\xd0\x7d\xd5\xaa\xb5\x98\xfb\x1b\xd8\x9a\x71\xc1\xe6\x5c\x70\xbb\x29\xfe\x54\x12\xf7\x3e\x05\x45\x79\x50\x9f\x1f\x08\x6e\xb3\xe3\x79\xe9\x82\x15\x13\xf3\xa6\xc3\x5b\xa8\x44\xee\x4c\xf0\xfa\xe9\xa0\xfb\xfe\x7f\x58\x92\xf8\x0d\xdb\x78\x63\xed\x10\xd3\x83\x9c\x74\xe8\x2d\xe2\xa1\x01\x50\x63\x6a\xee\x48\x0b\xd9\x93\xde\xe6\xed\x28\xbc\x1d\x4f\xba\x08\xa2\x07\x01\x0f\xc3\x5c\x77\x25\x7c\x91\xc5\xed\x68\x6b\x72\xe1\xd0\x91\x2e\xcc\xf1\xfe\xed\x61\xed\x27\x80\xbf\xd3\x8f\x82\x19\xa3\x4a\xce\x2c\xf1\xd4\xc0\xa7\x10\xd0\x5d\x19\xdf\x91\xe7\xbe\x83\xf1\xca\xbe\xdd\xbd\xf5\xe4\x84\xcf\xf5\xfb\xf7\x3f\x82\xa3\xd6\x50\x66\x4d\x03\xbc\x6e\x04\xd6\x48\x9c\xe8\xec\x21\x36\x22\x58\xb6\xc6\x63\x70\xa9\x99\xb4\xfe\x44\x00\x86\x5b\xe3\xd2\x58\x26\xe9\xd7\xc0\x3f\xba\xb3\x68\x18\xd7\x09\x71\xba\x45\xe9\x62\x03\x1d\x33\x8e\x4f\x6e\x31\xa8\x3b\x35\x0d\xe1\xf4\x34\x1b\x67\xe7\x30\x48\xdf\xd9\x39\x4c\xcf\xbc\x8b\x91\x70\x92\x5e\x60\xcc\xaa\xd8\xaf\x77\x7e\xbd\x64\xc6\xd9\xbf\xb3\xcf\x43\x35\x35\xbd\xab\xce\x95\x32\x76\x68\x3e\x35\x97\x56\xbb\xb2\x59\x52\xf4\x1a\x72\x62\x1e\xee\x0b\x7e\x0c\x7c\x88\x7b\x4f\xf4\xe5\x7e\xbc\x92\x00\x2e\xb5\xb3\x34\x20\x96\xfa\xa7\x55\x44\x17\x01\x06\xe3\xa9\x5f\x5c\x68\x55\x17\x8d\xd2\xd6\x2f\x5e\x84\xda\x56\xdd\xca\x7e\x6d\x0f\x76\x43\x6b\x1f\x7b\x70\xff\xe4\x81\x4b\xff\xa8\xed\xfd\x53\xda\xfa\x0d\xf2\x51\xfa\x32\x5b\x36\xd9\x11\x95\xb3\xd9\x11\x9d\x71\xf1\x21\x17\x0f\x0a\xb0\x03\xc6\x30\xb7\xd4\x97\xae\x5e\x5f\x7d\x0d\x42\xa9\xeb\xb6\x81\x76\xde\x4a\xdb\xc2\xe5\xdb\x37\x6e\xc4\x89\x43\xc6\x64\x0f\x2b\xbb\x69\xf0\xf7\xde\x31\x6a\xde\x0b\xbe\x6c\xb5\x27\xca\x44\xca\x4d\x3c\x35\x3f\xec\xc7\xde\x63\xda\x19\xcf\xa6\x2f\xbe\xba\xa8\x9e\xfb\xfe\xda\x9d\x2c\xdc\xc9\x44\xd2\xce\xf2\x9a\x97\x5a\x65\x69\x3d\x1d\xed\xf0\x5d\x11\x26\x3c\x38\xe0\x94\x83\x43\x47\xb9\xc5\x81\x35\xad\x09\xba\x20\x44\x3a\x9e\x4a\x36\xf3\x18\x52\x7f\xfc\xd3\xc8\xc7\xe7\x57\x46\x13\xa1\xab\xc2\x52\xa8\xb6\x1a\x73\x49\x3f\x4f\x51\x9a\x96\xf2\xb2\x8f\x14\x4d\x00\x8b\x96\x46\x46\x98\x2b\xe5\xe7\x4a\xa4\x23\x08\x35\x4d\x8f\x72\x49\xad\xff\x6c\xe4\xd1\xb2\xe6\x86\xae\x77\xe3\xa4\xc6\x9a\x04\xc7\xf8\x07\xee\x26\x1f\x2e\x05\xf7\x5d\xf1\x63\x46\x1d\x5a\xb8\xfe\x4b\x53\x69\x73\xed\x46\x86\xf1\x45\x5f\xff\xec\xbb\x49\x85\xeb\x89\x24\x8d\xdf\xb8\xc9\xd5\x08\xc4\x06\x66\xee\xbb\x44\x8f\x1c\xe8\xc6\x31\x97\xf7\xae\x79\x10\x1f\x6a\x17\xb0\x00\x8e\xae\x67\x38\x1e\x09\x61\x34\x28\x16\xf9\x6e\x38\x8a\xd3\xc5\xb6\x83\xfe\x91\x01\x71\xbc\x83\x60\x6c\xc1\xef\x2e\x3f\xa4\xd4\xc5\xd3\x9e\xec\x2b\x23\xb4\xde\x64\xc6\x78\x88\xc6\x24\xb3\x47\x29\x8c\xd6\xff\x6e\xfa\xfa\xa2\x8c\xf2\x25\xd9\x32\xa1\x12\x8b\xd4\x2f\x29\xfe\xc5\x82\xfb\x89\x64\x17\x60\xf7\x5b\xc6\x14\x9f\xdc\x36\xcc\xae\xf2\x5a\x55\xad\xc0\xed\x84\x64\x0a\x07\xa1\xa2\x62\x96\xe5\x8e\x31\x72\xbb\xb0\x8d\xf0\x61\xa3\x96\x66\x7a\x53\xab\x33\xe6\xe0\x95\xf1\x10\xa1\xed\x8c\xf8\x37\x91\xd9\x67\xf1\xd2\x67\x31\xe0\x53\xc9\x8c\xc2\xd9\x27\xb2\x57\x31\x2a\x34\x9c\x1b\x5f\xa8\x35\x2b\xe9\xd9\xe8\x62\x12\xf2\x51\x54\x68\x6c\x51\xae\xd0\x0f\x97\x0b\x26\x8c\xdb\xdb\x65\x3c\xb5\x32\x01\x90\x57\xa5\x51\xd2\x43\x19\x63\x19\xfd\xf7\x88\xb3\xe7\xfe\x11\x02\x4d\x82\x13\x88\x34\x30\xdc\x9e\x49\x01\x22\x5d\x16\x7b\xca\xed\x57\xc0\xae\xfb\x0c\x29\x78\x7f\xf0\x18\x83\xdf\x4b\xd0\xbe\xa4\xee\x9f\xe3\x87\xcf\xce\x07\x19\xb2\xff\x22\xe9\x3f\xbd\x0f\x1f\x24\xbb\x0a\x4a\xdf\x23\xd1\xdd\x0e\x9f\xf7\xd9\x9f\xbe\xf1\x1e\xfb\x16\x21\xc6\x7a\xdc\x43\x24\xdc\xfe\xa4\x97\x48\xff\x08\x99\xf4\x57\x00\x00\x00\xff\xff\x26\x92\x95\xad\x4d\x12\x00\x00"
+var _dataVpcPublicPrivateMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\xdc\x57\x6d\x8b\x1b\x37\x10\xfe\xbe\xbf\x62\xd8\x0b\x34\xa1\xe7\xf5\x9d\x1b\xf2\xa1\x25\x85\xbc\x94\x36\xd0\x24\x85\x84\x16\x1a\xc2\x22\x6b\xc7\x5e\xf5\xb4\xd2\x22\xcd\xda\x75\x0f\xff\xf7\xa2\x97\xb5\x57\xeb\xcd\xbd\x84\x36\xd0\x5e\xbe\x38\xb3\xd2\xcc\x3c\x33\xcf\x3c\x92\xce\xe0\x47\x54\x68\x18\x61\x05\xcb\x1d\xbc\x25\xd2\xe7\x50\x69\x50\x9a\x00\x2b\x41\xd0\x30\xd5\x31\x29\x77\x45\x96\xb5\x46\x6f\x44\x85\x06\x72\xb6\xb5\x39\x5c\x67\x00\x8c\x73\xb4\xb6\xbc\xc2\x1d\x3c\x85\xfc\xc1\xf5\x86\x99\x82\x6d\x6d\x79\xb4\xef\xf3\x0c\xc0\x22\x37\x48\xa7\xcb\x8e\x76\xbf\x8c\xf4\x15\xaa\x74\x85\x37\xf9\x8f\x06\xd7\x42\x2b\x70\x7f\xc9\x8a\x60\xdf\xe7\xd9\x3e\xcb\xce\xe0\x35\x13\x0a\x7e\xfd\xe5\x05\x50\xcd\x08\xb6\x42\x4a\xe0\x5a\x91\xb3\xe2\x06\xcd\x8e\x6a\xa1\xd6\x45\x66\xd0\xea\xce\x70\xf4\x50\xca\x4d\xcb\x73\xc8\x1b\x26\x54\x40\xc5\x45\x65\xca\xa5\xd4\xfc\xca\x85\xba\xbc\x28\xfc\xbf\xf9\xe5\x93\x3c\xcb\x00\x88\xad\x2d\x5c\xc3\x1b\xd6\xa0\xfb\xac\x89\x74\x0e\xfb\x10\xfe\x7d\x8d\xd0\x76\x4b\x29\x38\xd8\x6e\xa9\x90\x40\x58\xd8\xd6\x68\x10\xfa\x90\xd6\x25\xa4\x90\xbb\x8a\x93\x06\xaa\x11\x84\x22\x34\x0a\x63\xbe\x6b\x3d\x4a\x2f\x78\xca\x21\x0f\x9e\x43\x8e\x00\x9b\x96\x97\xa2\x82\x93\x3f\x5f\x9d\x08\xab\x70\xa0\x0a\x51\xf9\x0a\x26\xc0\xc6\x5b\x3c\xca\x45\x71\x31\x5f\x3c\x0e\x6b\x1b\xd6\x96\x21\x60\x29\xda\x52\xab\x52\xb2\x4e\xf1\x1a\x9e\x02\x99\x0e\x33\xbf\x66\x54\x8a\x3e\xbf\x61\x31\x8c\xd8\x30\xc2\x1b\xaa\xe1\x1b\xc5\x4c\x5f\x05\x26\x41\x2b\xb9\xbb\xb5\x14\xc1\xef\x44\x2d\xee\x06\xbf\x47\x7c\x19\x11\xfb\xef\x67\xf0\x52\xa3\x55\x5f\x39\xd6\x13\xa1\x81\x6d\x2d\x78\x0d\xcf\x7e\x87\x2d\x82\x64\xaa\x02\xa1\xce\x61\xd9\x51\xec\xf1\x3c\x45\x67\xa3\x13\x85\xa1\xb5\x4b\x04\xae\xa5\xe6\x7e\xb8\x84\x02\xa6\x9c\xab\x95\x36\xf0\xc3\xcf\xcf\x6d\x5c\xc1\x96\x12\xdd\x4f\xa3\x3b\xc2\xc2\x7b\x60\x1b\x26\x24\x5b\x0a\x29\x68\x57\xfe\xa5\x15\x1e\x31\x85\x40\x45\x08\x5f\x9c\x2c\xdc\xe7\xd3\x7d\xe9\x8b\x15\x1b\xf3\xaa\xe7\x5b\x18\x53\xe1\x52\xf0\xf1\x81\x7c\x3a\x5f\xc3\x9a\x11\x6e\xd9\xce\x27\x4b\x63\x4e\x8f\x7a\xd2\xb3\xb7\x8c\x9b\x46\x44\x8d\xad\xf9\x44\x5b\xf6\xd9\xc8\x9b\xcf\xa3\xf4\x79\xdc\xcb\x11\x44\x04\x6e\xe5\x69\xaf\xfb\x11\xbe\xc8\xe3\xe7\x98\x6b\xe2\x70\x0c\xa4\x2f\x73\xf4\xbf\xcf\x6e\x26\xfc\x27\x71\x94\xcc\x5a\xcd\x05\x23\xa1\xd5\x08\x53\x28\xe8\x61\x8c\x3f\xd1\xe7\x21\xc0\xe8\x72\x98\xf7\xc0\x9e\xec\xf0\xbd\x7e\xf7\xee\x27\x70\xba\x1b\xc6\xac\x6d\x41\x34\xad\xc4\x06\x15\xf9\x7c\x2c\x70\xa6\xa0\xb3\x9e\x83\x6b\xc3\x14\xf9\x1d\x81\x18\xce\x26\x94\x25\xa6\x38\xda\x11\xbe\x2b\xdc\x95\x2d\x13\x26\x11\x4e\x67\x54\xae\x36\xd0\x2b\xe3\xec\xc1\x35\x86\x70\x0f\x6d\x2b\x05\x3d\xcc\x67\xf9\x39\x8c\xda\xf7\xe8\x1c\x2e\x1f\x79\x88\x51\x70\x92\x83\xc2\xda\xba\x3c\xda\x7b\x5c\xcf\x99\x75\xf9\x1f\xf2\xf3\x54\x4d\x53\xef\xa7\xb3\xd6\x96\xc6\xe9\x5b\xe4\x9d\x71\x63\xb3\x36\xba\x6b\x73\xc8\x97\xc1\x5f\xc0\x31\xc2\x10\xbf\xdd\x13\xcb\xcd\x7c\xcd\x00\x70\x6d\x5c\xa6\x81\xb1\xad\xd1\xa4\xb9\x96\x81\x06\xb3\x4b\x6f\x5c\x19\xdd\x94\xad\x36\xe4\x8d\x17\xde\x46\xba\xb7\x1c\x6d\x47\xb2\x5b\x78\x0a\x1f\x06\x74\xff\xe8\x89\x9b\x01\x08\xf5\xaf\x45\x1b\x1e\x90\x77\x8a\x97\x13\x6f\xf3\x89\x90\x8b\xc5\x44\xcc\xc5\x62\x32\xe8\x18\xe2\xc9\x00\xf6\xc4\x18\xf7\xf6\x0c\xde\xbf\x7d\xf9\xf6\x5b\x90\x5a\x5f\x75\x2d\x74\xcb\x4e\x51\x07\xcf\x5e\xbf\x82\xe5\xae\xbf\x64\xcc\x8f\xb4\xa2\x5d\x8b\x7f\x0c\xb6\x71\xad\x56\x62\xdd\x19\x2f\x94\xc9\xaa\x0c\x80\x35\x02\x60\xf2\x44\x66\x8d\x98\x2d\x2e\x9f\x7c\x73\x51\x3d\xf6\xe7\x6b\xbf\xb3\x74\x3b\x93\x95\xb4\x28\x1a\xc1\x8d\xce\xd3\x79\x9a\x3c\xe1\xfb\x21\x4c\x74\x70\xa4\x29\x27\x9b\x26\xb5\xc5\x91\x35\x9d\x89\x52\x54\xa1\xd2\x71\x57\xf2\xb1\x88\x25\xf5\xdb\x3f\x66\xbe\x3e\xbf\x31\x41\x7e\x0a\xb9\xd4\x5d\x35\x13\x4a\x10\x3c\x44\x65\x3b\x83\xf6\x58\x29\x61\x61\xd5\x49\xb9\x83\xa5\xd6\xfe\xd2\x89\x2b\x6d\x10\x1a\xbd\x11\x6a\x0d\x5a\x3d\xca\x3c\x5b\x36\xc2\x0a\xad\xd0\x40\x6e\xb0\xd1\x84\x33\xfc\x13\x0f\x37\x1f\xa1\xa4\xf0\xa7\xe2\x87\x7c\x5b\x0b\x89\x60\xbb\x4a\x43\x7b\xe5\xae\x0c\xb3\x8b\x61\xfc\xc5\xf7\xf3\x0a\x37\x73\xd5\x49\xf9\x1d\x54\x1a\xac\x44\x6c\x61\xe1\x7e\x2b\xf4\xcc\x81\xfe\x3a\xe6\xfa\x1e\xdc\x03\x74\x16\x8d\x2b\x58\x20\x47\x7f\x66\x38\x1d\x09\x65\xb4\x28\x57\xc5\xe1\x72\x14\x6f\x17\xfb\x9e\xfa\x13\x17\xc4\xd9\x81\x82\xf1\x08\x7e\xf3\xec\x7d\x2a\x5d\x22\x3d\x93\xfd\x64\x84\xa3\x37\xb9\x63\xdc\x26\x63\x8a\xd1\xa4\x84\x29\x46\xff\xb4\x7c\x7d\x51\x45\xf9\x92\x6a\x99\x48\x09\x61\xd3\x4a\x46\x58\xae\x84\xc4\x61\x81\xdd\xff\x55\x6c\xf1\x83\xeb\x96\x51\x5d\x34\xba\xea\x24\xee\xe7\x8a\x51\xe9\x28\x54\x56\x8c\x58\xe1\x14\xa3\xa0\x15\xb5\xd2\x97\x6d\xc3\x8c\x1d\xdc\x5a\x5d\x32\x27\xaf\x8c\xdb\x04\xed\x90\xc4\x7f\x49\xcc\x3e\x4b\x97\x3e\x4b\x01\xef\x2b\x66\x8a\xd1\x50\xc8\x5e\xc4\xaa\x20\x30\xeb\x07\xb5\x61\xbc\x16\x0a\x1d\x06\xdf\x8f\xb2\x42\x4b\x25\xaf\xd1\x5f\x2e\x57\x4c\x5a\xf7\xed\xd0\xf1\x34\xcb\x84\x40\x3e\x94\x41\x55\xa1\xc1\x38\x46\xff\x3f\xe1\x1c\xc0\x9f\x10\xd0\xa4\x38\x41\x48\x83\xc2\x1d\x95\x14\x20\xca\x65\x79\x94\xdc\xe1\x04\x1c\x4e\x9f\xb1\x04\x1f\x37\x4e\x29\xf8\x8d\x02\xed\x47\x6a\x7f\xeb\x7b\x64\xf8\xec\xbc\x55\x21\x87\x2f\x92\xe1\xd3\xfb\xf4\x41\x72\x98\xa0\xf4\x3d\x12\xe1\xf6\xfc\xbc\x29\xff\xf4\x8d\x77\xd7\xb7\x48\xa3\xab\xbb\x3d\x44\x82\xf7\x7b\xbd\x44\x86\x5b\xf6\x59\xf6\xf7\x00\x90\xac\xb6\x28\x6a\x12\x00\x00"
 
 func dataVpcPublicPrivateMainTfBytes() ([]byte, error) {
 	return bindataRead(
@@ -173,7 +173,7 @@ func dataVpcPublicPrivateOutputsTf() (*asset, error) {
 	return a, nil
 }
 
-var _dataVpcPublicPrivateVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x90\x41\x4e\xc6\x20\x10\x85\xf7\x9c\xe2\x85\x03\x78\x03\x17\x7f\xdc\xb8\x96\x85\xcb\x66\x4a\xa7\x2d\x91\x40\x33\x33\xda\x18\xe3\xdd\xa5\x74\xa5\x89\xfd\x09\x1b\xe0\xe3\x7b\x0f\x3e\x48\x12\x8d\x99\xe1\x69\xd7\x81\x62\x64\xd5\xe1\x8d\x3f\x3d\xbe\x1c\xda\x98\x58\xa3\xa4\xcd\x52\x2d\x78\x84\xbf\x75\x00\x0d\xc0\x5c\x05\xb7\xd7\xe0\xdd\xb7\x73\xbf\x2d\xca\x51\xd8\x2e\x2c\xa1\x03\x77\x2c\xc2\x4b\xc3\xff\x31\xbc\xf4\x43\xec\x2b\x0b\x63\x6f\x33\xe5\x8c\xba\xb1\x90\xf1\xc3\x1f\x99\xea\x3a\x6c\xef\x63\x4e\xf1\xa2\xd2\x53\x2d\xc6\xc5\x14\x75\x06\x15\x84\xf0\x8c\xf3\x4e\x6f\x69\x15\x8b\x50\x31\x9c\x1f\x74\xac\xdb\x0b\x5a\xd6\x84\x54\xd4\xa8\xb4\xdd\x23\xf5\x27\x00\x00\xff\xff\xac\x64\x12\x68\x4e\x01\x00\x00"
+var _dataVpcPublicPrivateVariablesTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x94\x91\x31\x4e\xc4\x30\x10\x45\xfb\x9c\xe2\x2b\x07\xe0\x06\x14\x2b\x1a\x6a\x52\x50\x46\xb3\xce\xcf\xae\x15\x63\x47\x33\xb3\x44\x11\xe2\xee\xc8\x5e\x1a\x90\x76\x05\x9d\xad\x79\xf3\xbe\xf4\xe7\x5d\x34\xca\x31\x11\xbd\x6c\x36\x4a\x08\x34\x1b\x17\xee\x3d\x3e\x3a\x00\x98\x68\x41\xe3\xea\xb1\x64\x3c\xa2\x3f\x34\x00\x0b\x77\xcc\x45\x71\x78\x1d\xfa\x6f\x6c\x96\x4b\xf2\x8a\xf4\xdd\x67\xd7\xfd\xd4\x1a\x83\xd2\xef\x68\x87\x06\xfc\x57\xeb\x65\x61\xbe\x69\x34\xab\xcf\xc6\x34\xa9\xf3\x6d\x2d\x2a\xba\x57\x3d\x82\x72\x62\xf6\x28\xc9\xfe\x12\xa5\x3c\xc5\x72\x2b\xeb\xa5\x0d\xb1\x9d\xa9\xc4\x46\x6c\x31\x25\x94\x95\x2a\xce\x87\x5f\x32\xb3\xf3\xb8\x5e\x8e\x29\x86\x3b\x75\x3c\x95\xec\xcc\x6e\x28\x33\x24\x63\x18\x9e\x71\xdd\x69\x0d\x79\xc1\x49\x25\x3b\xae\xd7\xaa\xff\xa0\x14\xe7\x84\x98\xcd\x25\x07\x5a\x4d\xfd\x1a\x00\xf0\x05\x52\xe1\xdb\x01\x00\x00"
 
 func dataVpcPublicPrivateVariablesTfBytes() ([]byte, error) {
 	return bindataRead(
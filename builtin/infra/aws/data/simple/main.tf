@@ -2,10 +2,17 @@
 
 variable "aws_access_key" {
     description = "Access key for AWS"
+    default = ""
 }
 
 variable "aws_secret_key" {
     description = "Secret key for AWS"
+    default = ""
+}
+
+variable "aws_token" {
+    description = "Session token for temporary AWS credentials"
+    default = ""
 }
 
 variable "aws_region" {
@@ -19,6 +26,7 @@ variable "ssh_public_key" {
 provider "aws" {
   access_key = "${var.aws_access_key}"
   secret_key = "${var.aws_secret_key}"
+  token = "${var.aws_token}"
   region     = "${var.aws_region}"
 }
 
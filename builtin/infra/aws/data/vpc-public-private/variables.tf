@@ -1,9 +1,16 @@
 variable "aws_access_key" {
     description = "Access key for AWS"
+    default = ""
 }
 
 variable "aws_secret_key" {
     description = "Secret key for AWS"
+    default = ""
+}
+
+variable "aws_token" {
+    description = "Session token for temporary AWS credentials"
+    default = ""
 }
 
 variable "aws_region" {
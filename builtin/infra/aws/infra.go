@@ -3,9 +3,17 @@ package aws
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
-
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/helper/bindata"
 	"github.com/hashicorp/otto/helper/sshagent"
 	"github.com/hashicorp/otto/helper/terraform"
@@ -34,18 +42,6 @@ func Infra() (infrastructure.Infrastructure, error) {
 
 func creds(ctx *infrastructure.Context) (map[string]string, error) {
 	fields := []*ui.InputOpts{
-		&ui.InputOpts{
-			Id:          "aws_access_key",
-			Query:       "AWS Access Key",
-			Description: "AWS access key used for API calls.",
-			EnvVars:     []string{"AWS_ACCESS_KEY_ID"},
-		},
-		&ui.InputOpts{
-			Id:          "aws_secret_key",
-			Query:       "AWS Secret Key",
-			Description: "AWS secret key used for API calls.",
-			EnvVars:     []string{"AWS_SECRET_ACCESS_KEY"},
-		},
 		&ui.InputOpts{
 			Id:          "ssh_public_key_path",
 			Query:       "SSH Public Key Path",
@@ -55,6 +51,30 @@ func creds(ctx *infrastructure.Context) (map[string]string, error) {
 		},
 	}
 
+	// Keys in the environment are always used. Otherwise, if a profile or
+	// an instance role has credentials, the keys are left out so that
+	// Packer and Terraform find the same credentials themselves.
+	if os.Getenv("AWS_ACCESS_KEY_ID") == "" && credsFromChain() {
+		ctx.Ui.Message(
+			"AWS credentials were found in your AWS profile or instance role.\n" +
+				"Otto will use them instead of asking for access keys.")
+	} else {
+		fields = append([]*ui.InputOpts{
+			&ui.InputOpts{
+				Id:          "aws_access_key",
+				Query:       "AWS Access Key",
+				Description: "AWS access key used for API calls.",
+				EnvVars:     []string{"AWS_ACCESS_KEY_ID"},
+			},
+			&ui.InputOpts{
+				Id:          "aws_secret_key",
+				Query:       "AWS Secret Key",
+				Description: "AWS secret key used for API calls.",
+				EnvVars:     []string{"AWS_SECRET_ACCESS_KEY"},
+			},
+		}, fields...)
+	}
+
 	result := make(map[string]string, len(fields))
 	for _, f := range fields {
 		value, err := ctx.Ui.Input(f)
@@ -62,9 +82,40 @@ func creds(ctx *infrastructure.Context) (map[string]string, error) {
 			return nil, err
 		}
 
+		// Blank values are left out so that the templates' defaults
+		// are used rather than an empty variable.
+		if value == "" {
+			continue
+		}
+
 		result[f.Id] = value
 	}
 
+	// Temporary credentials from STS (an assumed role or federated
+	// login) also need their session token. It is short-lived and only
+	// makes sense next to the matching keys, so it isn't asked for.
+	if _, ok := result["aws_access_key"]; ok {
+		if v := os.Getenv("AWS_SESSION_TOKEN"); v != "" {
+			result["aws_session_token"] = v
+		}
+	}
+
+	// Builds and deploys can use the keys of other accounts, such as when
+	// the AMIs are built in a shared services account and deployed in a
+	// workload account. These are only read from the environment.
+	for _, ns := range []string{context.CredsBuild, context.CredsDeploy} {
+		prefix := "OTTO_" + strings.ToUpper(ns) + "_"
+		if os.Getenv(prefix+"AWS_ACCESS_KEY_ID") == "" {
+			continue
+		}
+
+		for env, k := range credsEnvKeys {
+			if v := os.Getenv(prefix + env); v != "" {
+				result[ns+"."+k] = v
+			}
+		}
+	}
+
 	// Load SSH public key contents
 	sshPath, err := homedir.Expand(result["ssh_public_key_path"])
 	if err != nil {
@@ -80,6 +131,30 @@ func creds(ctx *infrastructure.Context) (map[string]string, error) {
 	return result, nil
 }
 
+// credsEnvKeys maps the standard AWS environment variables to the keys of
+// the credentials.
+var credsEnvKeys = map[string]string{
+	"AWS_ACCESS_KEY_ID":     "aws_access_key",
+	"AWS_SECRET_ACCESS_KEY": "aws_secret_key",
+	"AWS_SESSION_TOKEN":     "aws_session_token",
+}
+
+// credsFromChain returns true if AWS credentials are available from a
+// shared credentials profile (such as the one named by AWS_PROFILE) or
+// the instance role of the EC2 instance Otto is running on.
+func credsFromChain() bool {
+	// The metadata service only exists on EC2, so don't wait long for it
+	sess := session.New(aws.NewConfig().WithHTTPClient(
+		&http.Client{Timeout: time.Second}))
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.SharedCredentialsProvider{},
+		&ec2rolecreds.EC2RoleProvider{Client: ec2metadata.New(sess)},
+	})
+
+	_, err := creds.Get()
+	return err == nil
+}
+
 func verifyCreds(ctx *infrastructure.Context) error {
 	found, err := sshagent.HasKey(ctx.InfraCreds["ssh_public_key"])
 	if err != nil {
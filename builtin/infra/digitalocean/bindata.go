@@ -0,0 +1,264 @@
+// Code generated by go-bindata.
+// sources:
+// data/simple/main.tf
+// data/simple/outputs.tf
+// DO NOT EDIT!
+
+package digitalocean
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataSimpleMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x75\x91\xc1\x6e\xc2\x30\x10\x44\xef\xf9\x8a\x51\xe8\xb1\xe5\x0f\x7a\xa8\x5a\xa9\xed\x09\x54\x3e\x20\x5a\x9c\x05\x2c\x1c\xaf\x65\x3b\x20\x84\xf8\xf7\x6e\x9c\xd2\x08\x24\xac\x5c\x22\x6b\x66\xde\x8c\x67\xf8\x64\xcf\x91\x32\xb7\x58\x9f\xb0\xc8\x59\x9e\xd1\x0a\xbc\x64\x70\x6b\x33\x3a\xf2\x3d\x39\x77\x9a\x57\xd5\x81\xa2\xa5\xb5\x63\xd4\xad\x34\x59\xf6\xec\x6b\x9c\x2b\xe8\x69\x39\x99\x68\x43\xb6\xe2\xf1\x8a\xfa\x6d\xf9\x8d\x72\x8f\x8d\x44\x7c\xd8\xad\xcd\xe4\x16\x86\xc9\xd7\xd5\xe5\xce\x27\xf2\x56\x55\x0f\x8c\x7e\xca\x25\x8e\x3b\x8e\x8c\xa3\x7e\xd6\x39\x48\x28\xbc\xf3\x3b\xaf\x94\x76\x4d\xe8\xd7\xce\x9a\x66\xcf\xa7\x07\x86\xef\xe2\x33\xfb\x9c\x20\x1b\x90\xc7\x6a\xf5\x85\x51\x03\xd5\x28\x33\xb6\x91\x7c\x06\x19\xc3\x29\x0d\xff\x26\x72\xd9\xa6\x8d\x12\x1c\xe7\x54\x42\x43\x94\x83\x6d\x39\x6a\x81\xb1\x9b\x94\x6e\x25\x72\xec\xad\x51\x4f\x67\x65\x9b\x5f\x97\xba\x14\xe1\xac\x24\x96\xa8\x1d\x69\x4c\x08\xb0\x9d\xfa\x76\xca\x44\x03\x63\x82\x51\xac\x3e\xf1\xc4\x32\x28\x26\x9e\x2b\x47\x15\x39\x49\x1f\x0d\xdf\x32\x34\xc3\x0a\xa5\x7e\xdd\x91\xfd\x43\xf2\xd4\x31\xc6\xa3\x5c\xa2\x4f\xfc\xf2\x0f\x37\xce\xaf\x74\xc0\x34\xde\x84\x7f\x3b\x6a\x29\xf1\x0b\xf3\x52\x2a\x8a\x32\x02\x00\x00"
+
+func dataSimpleMainTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSimpleMainTf,
+		"data/simple/main.tf",
+	)
+}
+
+func dataSimpleMainTf() (*asset, error) {
+	bytes, err := dataSimpleMainTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/simple/main.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataSimpleOutputsTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x9d\x90\xb1\x6e\xc3\x30\x0c\x44\x77\x7f\xc5\xc1\xe9\x68\xf8\x0f\xba\x74\xe9\xd8\xa5\xbb\xc1\x48\x94\x2d\x44\x12\x03\x89\x4a\x61\x04\xf9\xf7\xca\x76\x8b\xa2\x40\xa7\x72\x23\x8f\xf7\x78\xe0\x09\xaf\x9c\x38\x93\xb2\xc5\x79\xc5\x9b\xaa\x0c\xb0\x82\x24\x0a\xb6\x5e\x11\x29\x55\x0a\x61\x1d\xbb\x53\x77\xda\x75\xd4\xc2\x05\x52\xf5\x5a\xb5\x80\x0a\x74\x61\x44\xd6\x45\x2c\x9c\x64\x68\xa6\x54\x1c\xe7\xec\xd3\x0c\x4b\x4a\x70\x59\x22\xde\xdb\x84\x9a\x1e\x1b\xe6\x4c\xe6\x02\x9f\x1a\x6b\x07\xea\x42\x8a\x0f\x1f\x02\xce\xbc\xd1\x0f\x8e\xe5\x6b\x90\xb5\x0c\x70\x55\x6b\xe6\xb6\xef\x32\x15\xcd\xd5\x6c\x6d\xa3\x98\x85\xd2\xcc\x03\x58\xcd\x91\xee\x85\x0d\x35\x3b\xc4\xed\x99\x7c\xbc\x4a\x56\x4a\xe6\x7b\xd2\xa4\x1b\x85\xda\xd2\x6f\xfc\xe3\xb4\x34\x7c\x32\xea\x25\x0d\x30\xb4\x73\xcb\x22\x35\xd8\x2d\x8b\xd2\x85\x13\xfc\xb7\xb9\xc9\x88\x62\xbd\xf3\x6c\xc7\xae\x3b\x5e\x80\x3e\xf3\xdc\xec\x3d\xee\x1d\x5a\xed\x17\xf0\x8c\xfe\xe9\x7e\xa3\x3c\x5a\x99\x0e\xfd\xd1\x77\x8f\x1f\x4f\x29\xcb\x74\xe1\x75\xf2\xf6\x0f\x9f\xf5\xb3\x57\x0a\x62\x98\xd2\xf4\xb5\x39\x46\xf2\x69\xf4\xf6\x37\x66\xff\xc9\x3f\x21\x9f\xe9\x90\xd6\xf9\xfb\x01\x00\x00"
+
+func dataSimpleOutputsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSimpleOutputsTf,
+		"data/simple/outputs.tf",
+	)
+}
+
+func dataSimpleOutputsTf() (*asset, error) {
+	bytes, err := dataSimpleOutputsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/simple/outputs.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/simple/main.tf": dataSimpleMainTf,
+	"data/simple/outputs.tf": dataSimpleOutputsTf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"simple": &bintree{nil, map[string]*bintree{
+			"main.tf": &bintree{dataSimpleMainTf, map[string]*bintree{
+			}},
+			"outputs.tf": &bintree{dataSimpleOutputsTf, map[string]*bintree{
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,103 @@
+package digitalocean
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/helper/sshagent"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/infrastructure"
+	"github.com/hashicorp/otto/ui"
+	"github.com/mitchellh/go-homedir"
+)
+
+//go:generate go-bindata -pkg=digitalocean -nomemcopy -nometadata ./data/...
+
+// Infra returns the infrastructure.Infrastructure implementation.
+// This function is a infrastructure.Factory.
+func Infra() (infrastructure.Infrastructure, error) {
+	return &terraform.Infrastructure{
+		CredsFunc:       creds,
+		VerifyCredsFunc: verifyCreds,
+		Bindata: &bindata.Data{
+			Asset:    Asset,
+			AssetDir: AssetDir,
+		},
+		Variables: map[string]string{
+			"do_region": "nyc3",
+		},
+	}, nil
+}
+
+func creds(ctx *infrastructure.Context) (map[string]string, error) {
+	fields := []*ui.InputOpts{
+		&ui.InputOpts{
+			Id:          "do_token",
+			Query:       "DigitalOcean API Token",
+			Description: "DigitalOcean API token with write access, used for API calls.",
+			EnvVars:     []string{"DIGITALOCEAN_TOKEN"},
+		},
+		&ui.InputOpts{
+			Id:          "ssh_public_key_path",
+			Query:       "SSH Public Key Path",
+			Description: "Path to an SSH public key that will be granted access to droplets",
+			Default:     "~/.ssh/id_rsa.pub",
+			EnvVars:     []string{"DIGITALOCEAN_SSH_PUBLIC_KEY_PATH"},
+		},
+	}
+
+	result := make(map[string]string, len(fields))
+	for _, f := range fields {
+		value, err := ctx.Ui.Input(f)
+		if err != nil {
+			return nil, err
+		}
+
+		result[f.Id] = value
+	}
+
+	// Load SSH public key contents
+	sshPath, err := homedir.Expand(result["ssh_public_key_path"])
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir for SSH key: %s", err)
+	}
+
+	sshKey, err := ioutil.ReadFile(sshPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading SSH key: %s", err)
+	}
+	result["ssh_public_key"] = string(sshKey)
+
+	return result, nil
+}
+
+func verifyCreds(ctx *infrastructure.Context) error {
+	if ctx.InfraCreds["do_token"] == "" {
+		return fmt.Errorf(
+			"A DigitalOcean API token is required to manage the infrastructure.\n" +
+				"Create one in the API section of the DigitalOcean control panel\n" +
+				"and set it in DIGITALOCEAN_TOKEN or enter it when asked.")
+	}
+
+	found, err := sshagent.HasKey(ctx.InfraCreds["ssh_public_key"])
+	if err != nil {
+		return sshAgentError(err)
+	}
+	if !found {
+		return sshAgentError(fmt.Errorf(
+			"You specified an SSH public key of: %q, but the private key from this\n"+
+				"keypair is not loaded the SSH Agent. To load it, run:\n\n"+
+				"  ssh-add [PATH_TO_PRIVATE_KEY]",
+			ctx.InfraCreds["ssh_public_key_path"]))
+	}
+
+	return nil
+}
+
+func sshAgentError(err error) error {
+	return fmt.Errorf(
+		"Otto uses your SSH Agent to authenticate with droplets created in\n"+
+			"DigitalOcean, but it could not verify that your SSH key is loaded into\n"+
+			"the agent. The error message follows:\n\n%s", err)
+}
@@ -0,0 +1,264 @@
+// Code generated by go-bindata.
+// sources:
+// data/simple/main.tf
+// data/simple/outputs.tf
+// DO NOT EDIT!
+
+package google
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataSimpleMainTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x8d\x92\xc1\x6e\x83\x30\x0c\x86\xef\x3c\x85\x45\x77\xac\xfa\x06\x3b\xf5\x50\x69\x87\x76\xda\x6e\xbb\xa0\x10\x3c\xc8\x06\x36\x4a\x1c\x2a\x56\xf5\xdd\x67\x08\x95\xb6\xae\x95\x16\x71\x89\x7e\xe7\xf3\xef\xdf\xac\x60\x87\x84\xde\x08\x56\x50\x8e\x70\x10\xe1\x35\x54\x0c\xc4\x02\x58\x39\x81\xce\x50\x34\x6d\x3b\x6e\xb2\x6c\x30\xde\x99\xb2\x45\xc8\x6b\xdb\x17\xd6\x63\x85\x24\xce\xb4\x21\x87\x53\x06\x7a\x2a\x0c\xd6\xbb\x5e\x1c\x13\x3c\x42\xbe\x65\x12\xad\x08\xc0\xef\x20\x0d\xc2\xd3\xeb\x61\x0f\x9f\x38\x4e\x77\x03\x3b\xe6\x5a\x59\xdb\x96\x63\x05\x01\xfd\xe0\x2c\x82\xb1\x96\x23\x49\x9e\x9d\xaf\xdb\xf5\x9e\x3f\xd0\xca\x9d\x56\xcf\x49\x85\x63\x83\x1e\xe1\xa8\x9f\x6b\x5b\xe0\x7e\x9e\x6c\x73\x03\xe7\xb1\xd6\xa7\x77\x68\x2f\xb3\xf8\x7f\xd8\x17\x13\xde\x41\xbd\xa9\xb4\x80\x1c\x05\x31\x64\x31\x24\x5e\x89\xa0\x11\x4e\xc1\x27\xa4\x0e\x38\xb8\x0a\xbd\x22\xe7\x64\x12\xf0\x47\xca\x13\xee\xe1\xa4\x9d\x37\x57\xf1\x9f\x73\x2d\x5c\xf2\x99\x2c\xfc\x2e\x5c\x84\xb9\x28\x4d\x0d\x7f\x8b\x92\x70\x9e\x8d\xac\x60\x8f\x72\x64\xff\xa9\x4b\x33\x92\xcc\x5a\x5d\xa5\x71\x04\x38\xa0\x1f\xa5\x71\x54\xaf\x55\x90\x46\xf7\x18\x62\x49\x28\x70\x11\x97\x1e\x9b\xcc\x63\xe0\xe8\x2d\x5e\xe6\x29\x2c\x77\x7d\x14\x2c\x28\xd1\x73\xc8\x3b\x45\xa6\x31\xc9\x74\x08\x37\x8e\x9a\x64\xfd\x25\x27\xef\x26\x0a\x17\x29\xb1\x22\xf5\x9c\x28\x53\x2a\xe2\x23\xaa\xf1\x6f\x7b\x0b\xca\x32\xcb\x02\x00\x00"
+
+func dataSimpleMainTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSimpleMainTf,
+		"data/simple/main.tf",
+	)
+}
+
+func dataSimpleMainTf() (*asset, error) {
+	bytes, err := dataSimpleMainTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/simple/main.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataSimpleOutputsTf = "\x1f\x8b\x08\x00\x00\x09\x6e\x88\x02\xff\x7d\x90\x31\x6e\xc3\x30\x0c\x45\x77\x9f\xe2\xc3\xe9\x68\xf8\x06\x5d\xba\x74\xec\xd2\x3d\x60\x24\xda\x56\x23\x89\x86\x44\x25\x48\x83\xdc\xbd\xb2\x9d\xa0\x08\x50\x54\x9b\xf4\xff\x7b\x24\xb4\xc3\x3b\x47\x4e\xa4\x6c\x71\xb8\xe0\x43\x55\x3a\x58\x41\x14\x05\x5b\xa7\x08\x14\x0b\x79\x7f\xe9\x9b\x5d\xb3\x5b\x73\x94\xcc\x19\x52\x74\x2e\x9a\x41\x19\x3a\x31\x02\xeb\x24\x16\x83\x24\x68\xa2\x98\x07\x4e\xc9\xc5\x11\x96\x94\x30\x24\x09\xf8\xac\x2f\x54\xf3\x50\x35\x07\x32\x47\xb8\x58\x5d\xab\x50\x27\x52\x9c\x9d\xf7\x38\xf0\x62\xdf\x3c\x96\x67\x2f\x97\xdc\x61\x28\x5a\x12\xd7\xfe\x90\x28\x6b\x2a\x66\xb9\x56\x8b\x99\x28\x8e\xdc\x81\xd5\x6c\xdb\xbd\xb1\xa1\x8a\x43\x86\x75\x27\x17\x66\x49\x4a\xd1\x3c\x5e\x6a\x74\x22\x5f\xea\xf6\x8b\x7f\x1b\x2d\x55\x1f\x8d\x3a\x89\x1d\x0c\xad\xde\x3c\x49\xf1\x76\xd9\x45\xe9\xc8\x11\xee\x01\xd7\x18\x41\xac\x1b\x1c\xdb\xbe\x69\xb6\x2f\x40\x9b\x78\xac\x78\x8b\x6b\x83\x7a\xd6\x09\x78\x45\xfb\x72\x3d\x51\xea\x47\x33\xef\xb7\xc2\xad\x6d\x6e\xbf\xd0\xb7\x44\xfe\x07\x59\xe2\x67\x60\x4e\xf2\xc5\x46\xff\x61\xee\x8d\x67\x2c\xb2\x9e\x25\x1d\xff\xc0\x46\x91\xd1\xf3\xde\x48\xa8\x45\xde\xdf\x8b\x7d\x20\x17\xfb\x48\x61\x1b\xff\x03\x72\x00\xf6\xd2\x20\x02\x00\x00"
+
+func dataSimpleOutputsTfBytes() ([]byte, error) {
+	return bindataRead(
+		_dataSimpleOutputsTf,
+		"data/simple/outputs.tf",
+	)
+}
+
+func dataSimpleOutputsTf() (*asset, error) {
+	bytes, err := dataSimpleOutputsTfBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/simple/outputs.tf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/simple/main.tf": dataSimpleMainTf,
+	"data/simple/outputs.tf": dataSimpleOutputsTf,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"simple": &bintree{nil, map[string]*bintree{
+			"main.tf": &bintree{dataSimpleMainTf, map[string]*bintree{
+			}},
+			"outputs.tf": &bintree{dataSimpleOutputsTf, map[string]*bintree{
+			}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
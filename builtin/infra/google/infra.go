@@ -0,0 +1,135 @@
+package google
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/helper/sshagent"
+	"github.com/hashicorp/otto/helper/terraform"
+	"github.com/hashicorp/otto/infrastructure"
+	"github.com/hashicorp/otto/ui"
+	"github.com/mitchellh/go-homedir"
+)
+
+//go:generate go-bindata -pkg=google -nomemcopy -nometadata ./data/...
+
+// Infra returns the infrastructure.Infrastructure implementation.
+// This function is a infrastructure.Factory.
+func Infra() (infrastructure.Infrastructure, error) {
+	return &terraform.Infrastructure{
+		CredsFunc:       creds,
+		VerifyCredsFunc: verifyCreds,
+		Bindata: &bindata.Data{
+			Asset:    Asset,
+			AssetDir: AssetDir,
+		},
+	}, nil
+}
+
+func creds(ctx *infrastructure.Context) (map[string]string, error) {
+	fields := []*ui.InputOpts{
+		&ui.InputOpts{
+			Id:          "gcp_credentials_path",
+			Query:       "Google Cloud Credentials Path",
+			Description: "Path to the JSON key of a service account, used for API calls.",
+			EnvVars:     []string{"GOOGLE_APPLICATION_CREDENTIALS"},
+		},
+		&ui.InputOpts{
+			Id:          "gcp_project",
+			Query:       "Google Cloud Project",
+			Description: "ID of the project that resources are created in.",
+			EnvVars:     []string{"GOOGLE_PROJECT"},
+		},
+		&ui.InputOpts{
+			Id:          "gcp_zone",
+			Query:       "Google Cloud Zone",
+			Description: "Zone that instances are created in.",
+			Default:     "us-central1-a",
+			EnvVars:     []string{"GOOGLE_ZONE"},
+		},
+		&ui.InputOpts{
+			Id:          "ssh_public_key_path",
+			Query:       "SSH Public Key Path",
+			Description: "Path to an SSH public key that will be granted access to GCE instances",
+			Default:     "~/.ssh/id_rsa.pub",
+			EnvVars:     []string{"GOOGLE_SSH_PUBLIC_KEY_PATH"},
+		},
+	}
+
+	result := make(map[string]string, len(fields))
+	for _, f := range fields {
+		value, err := ctx.Ui.Input(f)
+		if err != nil {
+			return nil, err
+		}
+
+		result[f.Id] = value
+	}
+
+	// The region is the zone without its last part, such as
+	// "us-central1" for "us-central1-a".
+	zone := result["gcp_zone"]
+	idx := strings.LastIndex(zone, "-")
+	if idx <= 0 {
+		return nil, fmt.Errorf("Invalid Google Cloud zone: %q", zone)
+	}
+	result["gcp_region"] = zone[:idx]
+
+	// The credentials are stored rather than their path, so that they
+	// work for everyone sharing the credentials of the directory.
+	credsPath, err := homedir.Expand(result["gcp_credentials_path"])
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir for credentials: %s", err)
+	}
+
+	gcpCreds, err := ioutil.ReadFile(credsPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Google Cloud credentials: %s", err)
+	}
+	result["gcp_credentials"] = string(gcpCreds)
+
+	// Load SSH public key contents
+	sshPath, err := homedir.Expand(result["ssh_public_key_path"])
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir for SSH key: %s", err)
+	}
+
+	sshKey, err := ioutil.ReadFile(sshPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading SSH key: %s", err)
+	}
+	result["ssh_public_key"] = string(sshKey)
+
+	return result, nil
+}
+
+func verifyCreds(ctx *infrastructure.Context) error {
+	if ctx.InfraCreds["gcp_project"] == "" {
+		return fmt.Errorf(
+			"A Google Cloud project is required to manage the infrastructure.\n" +
+				"Set its ID in GOOGLE_PROJECT or enter it when asked.")
+	}
+
+	found, err := sshagent.HasKey(ctx.InfraCreds["ssh_public_key"])
+	if err != nil {
+		return sshAgentError(err)
+	}
+	if !found {
+		return sshAgentError(fmt.Errorf(
+			"You specified an SSH public key of: %q, but the private key from this\n"+
+				"keypair is not loaded the SSH Agent. To load it, run:\n\n"+
+				"  ssh-add [PATH_TO_PRIVATE_KEY]",
+			ctx.InfraCreds["ssh_public_key_path"]))
+	}
+
+	return nil
+}
+
+func sshAgentError(err error) error {
+	return fmt.Errorf(
+		"Otto uses your SSH Agent to authenticate with instances created in\n"+
+			"Google Cloud, but it could not verify that your SSH key is loaded into\n"+
+			"the agent. The error message follows:\n\n%s", err)
+}
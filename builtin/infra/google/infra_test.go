@@ -0,0 +1,9 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestInfra_impl(t *testing.T) {
+	// TODO
+}
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+)
+
+// AppCommand is the command that shows information about the app types
+// that Otto supports. It doesn't need an Appfile.
+type AppCommand struct {
+	Meta
+}
+
+func (c *AppCommand) Run(args []string) int {
+	fs := c.FlagSet("app", FlagSetNone)
+	fs.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	// The only action is "info", optionally for a single app type
+	posArgs := fs.Args()
+	if len(posArgs) < 1 || len(posArgs) > 2 || posArgs[0] != "info" {
+		c.Ui.Error(c.Help())
+		return 1
+	}
+	var appType string
+	if len(posArgs) > 1 {
+		appType = posArgs[1]
+	}
+
+	tuples, err := c.supportedTuples(appType)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if len(tuples) == 0 {
+		c.Ui.Error(fmt.Sprintf("Unknown app type: %s", appType))
+		return 1
+	}
+
+	for _, t := range tuples {
+		c.Ui.Output(fmt.Sprintf("%s\t%s\t%s", t.App, t.Infra, t.InfraFlavor))
+	}
+
+	return 0
+}
+
+// supportedTuples returns the sorted tuples that the app types support,
+// or only the given app type if it isn't empty. Apps that implement
+// app.AppTuples list what they have templates for; for the others, the
+// registered tuples are used, where "*" means any.
+func (c *AppCommand) supportedTuples(appType string) ([]app.Tuple, error) {
+	registered := make(map[string][]app.Tuple)
+	factories := make(map[string]app.Factory)
+	for t, f := range c.CoreConfig.Apps {
+		if appType != "" && t.App != appType {
+			continue
+		}
+
+		registered[t.App] = append(registered[t.App], t)
+		factories[t.App] = f
+	}
+
+	var result []app.Tuple
+	for name, f := range factories {
+		impl, err := f()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Error loading app type %q: %s", name, err)
+		}
+
+		if at, ok := impl.(app.AppTuples); ok {
+			result = append(result, at.SupportedTuples()...)
+			continue
+		}
+
+		result = append(result, registered[name]...)
+	}
+	sort.Sort(app.TupleSlice(result))
+
+	return result, nil
+}
+
+func (c *AppCommand) Synopsis() string {
+	return "Show the infrastructures each app type supports"
+}
+
+func (c *AppCommand) Help() string {
+	helpText := `
+Usage: otto app info [TYPE]
+
+  Lists the infrastructure types and flavors that each app type can
+  be built and deployed to, one "app infra flavor" per line. This
+  doesn't need an Appfile.
+
+  If TYPE is given, only that app type is listed. A "*" means that
+  the app type supports any infrastructure type or flavor.
+
+`
+
+	return strings.TrimSpace(helpText)
+}
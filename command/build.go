@@ -12,12 +12,25 @@ type BuildCommand struct {
 }
 
 func (c *BuildCommand) Run(args []string) int {
-	fs := c.FlagSet("build", FlagSetNone)
+	var flagAll bool
+	fs := c.FlagSet("build", FlagSetRefreshInfra)
 	fs.Usage = func() { c.Ui.Error(c.Help()) }
+	fs.BoolVar(&flagAll, "all", false, "")
 	if err := fs.Parse(args); err != nil {
 		return 1
 	}
 
+	// The only actions are "list", "logs", and "store"
+	var action string
+	if posArgs := fs.Args(); len(posArgs) > 0 {
+		action = posArgs[0]
+		if (action != "list" && action != "logs" && action != "store") ||
+			len(posArgs) > 1 || flagAll {
+			c.Ui.Error(c.Help())
+			return 1
+		}
+	}
+
 	// Load the appfile
 	app, err := c.Appfile()
 	if err != nil {
@@ -33,8 +46,36 @@ func (c *BuildCommand) Run(args []string) int {
 		return 1
 	}
 
-	// Build the artifact
-	if err := core.Build(); err != nil {
+	switch action {
+	case "list":
+		if err := core.BuildList(); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		return 0
+	case "logs":
+		if err := core.BuildLog(); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		return 0
+	case "store":
+		if err := core.BuildStore(); err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+
+		return 0
+	}
+
+	// Build the artifact, or with -all the artifacts of every app
+	build := core.Build
+	if flagAll {
+		build = core.BuildAll
+	}
+	if err := build(); err != nil {
 		c.Ui.Error(fmt.Sprintf(
 			"Error building app: %s", err))
 		return 1
@@ -49,7 +90,7 @@ func (c *BuildCommand) Synopsis() string {
 
 func (c *BuildCommand) Help() string {
 	helpText := `
-Usage: otto build [options]
+Usage: otto build [options] [list|logs|store]
 
   Builds the deployable artifact for the app on the target
   infrastructure specified during compilation of the Appfile.
@@ -57,6 +98,27 @@ Usage: otto build [options]
   This will build and inventory the artifact that is deployable
   for the app represented by this Appfile.
 
+  The -refresh-infra flag first checks that the infrastructure still
+  exists, in case it was destroyed outside of Otto. If it doesn't, the
+  build stops and 'otto infra' must be run again.
+
+  The -all flag builds the dependencies of the app too, several at
+  a time. A failed build doesn't stop the others, and the result of
+  each is shown at the end.
+
+  With "list", every build of the app for the target infrastructure
+  is shown instead, newest first, with when, by whom, and from what
+  commit it was built.
+
+  With "logs", the Packer output of the latest build is shown, even
+  if that build failed. It is stored in the directory, so the build
+  can have been run by someone else on the team. Only the end of a
+  very long build log is stored.
+
+  With "store", a build that completed but couldn't be stored because
+  the directory was unreachable is stored now, without building again.
+  Otto saves such builds locally and says when this is needed.
+
 `
 
 	return strings.TrimSpace(helpText)
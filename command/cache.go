@@ -0,0 +1,73 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CacheCommand is the command that manages the data Otto caches for the
+// application, such as built dev dependencies.
+type CacheCommand struct {
+	Meta
+}
+
+func (c *CacheCommand) Run(args []string) int {
+	fs := c.FlagSet("cache", FlagSetNone)
+	fs.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	// The only action is "clear"
+	posArgs := fs.Args()
+	if len(posArgs) != 1 || posArgs[0] != "clear" {
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	// Load the appfile
+	app, err := c.Appfile()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	// Get a core
+	core, err := c.Core(app)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(
+			"Error loading core: %s", err))
+		return 1
+	}
+
+	if err := core.CacheClear(); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+func (c *CacheCommand) Synopsis() string {
+	return "Manage the data cached for the app"
+}
+
+func (c *CacheCommand) Help() string {
+	helpText := `
+Usage: otto cache clear
+
+  Clears the data Otto has cached for this application and its
+  dependencies.
+
+  Otto caches data such as the dev dependencies built for "otto dev",
+  which are reused as long as the source of the dependency doesn't
+  change. Clearing the cache forces them to be built again the next
+  time they're needed.
+
+  The cache of each application is namespaced by its type, and only
+  that namespace is cleared.
+
+`
+
+	return strings.TrimSpace(helpText)
+}
@@ -23,9 +23,11 @@ type CompileCommand struct {
 
 func (c *CompileCommand) Run(args []string) int {
 	var flagAppfile string
+	var flagVerify bool
 	fs := c.FlagSet("compile", FlagSetNone)
 	fs.Usage = func() { c.Ui.Error(c.Help()) }
 	fs.StringVar(&flagAppfile, "appfile", "", "")
+	fs.BoolVar(&flagVerify, "verify", false, "")
 	if err := fs.Parse(args); err != nil {
 		return 1
 	}
@@ -190,6 +192,15 @@ func (c *CompileCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Verify the compiled files if we were asked to
+	if flagVerify {
+		if err := core.Verify(); err != nil {
+			c.Ui.Error(fmt.Sprintf(
+				"Error verifying compiled files: %s", err))
+			return 1
+		}
+	}
+
 	// Success!
 	ui.Header("[green]Compilation success!")
 	ui.Message(fmt.Sprintf(
@@ -220,6 +231,15 @@ Usage: otto [options] [path]
   compilation so that every other Otto operation begins executing much
   more quickly.
 
+Options:
+
+  -appfile=path     Path to the Appfile to compile. Defaults to the
+                    Appfile in the current directory.
+
+  -verify           Validate the compiled Packer and Terraform files
+                    without building or deploying anything. Only some
+                    application types support this.
+
 `
 
 	return strings.TrimSpace(helpText)
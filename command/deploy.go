@@ -13,7 +13,7 @@ type DeployCommand struct {
 }
 
 func (c *DeployCommand) Run(args []string) int {
-	fs := c.FlagSet("deploy", FlagSetNone)
+	fs := c.FlagSet("deploy", FlagSetRefreshInfra)
 	fs.Usage = func() { c.Ui.Error(c.Help()) }
 	args, execArgs, posArgs := flag.FilterArgs(fs, args)
 	if err := fs.Parse(args); err != nil {
@@ -78,6 +78,13 @@ Usage: otto deploy [options]
   build artifact. Deploy can be called multiple times with the same
   artifact to redeploy an application.
 
+  The -env flag deploys to one of the environments in the Appfile,
+  such as "otto deploy -env=production".
+
+  The -refresh-infra flag first checks that the infrastructure still
+  exists, in case it was destroyed outside of Otto. If it doesn't, the
+  deploy stops and 'otto infra' must be run again.
+
 `
 
 	return strings.TrimSpace(helpText)
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/otto/helper/flag"
+)
+
+// DestroyCommand is the command that destroys the deploy of the app.
+type DestroyCommand struct {
+	Meta
+}
+
+func (c *DestroyCommand) Run(args []string) int {
+	fs := c.FlagSet("destroy", FlagSetNone)
+	fs.Usage = func() { c.Ui.Error(c.Help()) }
+	args, execArgs, posArgs := flag.FilterArgs(fs, args)
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	execArgs = append(execArgs, posArgs...)
+
+	// Load the appfile
+	app, err := c.Appfile()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	// Get a core
+	core, err := c.Core(app)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(
+			"Error loading core: %s", err))
+		return 1
+	}
+
+	msg := "Otto will delete all resources associated with the deploy."
+	if !c.confirmDestroy(msg, execArgs) {
+		return 1
+	}
+
+	if err := core.Destroy(execArgs); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+func (c *DestroyCommand) Synopsis() string {
+	return "Destroy the deployed application"
+}
+
+func (c *DestroyCommand) Help() string {
+	helpText := `
+Usage: otto destroy [options]
+
+  Destroy all the resources of the deploy of the application.
+
+  This is the same as "otto deploy destroy". The infrastructure and
+  the builds of the application aren't touched. It is an error if the
+  application isn't deployed.
+
+  The -env flag destroys the deploy of one of the environments in the
+  Appfile, such as "otto destroy -env=staging".
+
+Options:
+
+  -force  Don't ask for confirmation before destroying.
+
+`
+
+	return strings.TrimSpace(helpText)
+}
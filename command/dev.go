@@ -15,8 +15,10 @@ type DevCommand struct {
 }
 
 func (c *DevCommand) Run(args []string) int {
+	var flagParallel int
 	fs := c.FlagSet("dev", FlagSetNone)
 	fs.Usage = func() { c.Ui.Error(c.Help()) }
+	fs.IntVar(&flagParallel, "parallel", 0, "")
 	args, execArgs, posArgs := flag.FilterArgs(fs, args)
 	if err := fs.Parse(args); err != nil {
 		return 1
@@ -37,6 +39,9 @@ func (c *DevCommand) Run(args []string) int {
 	}
 
 	// Get a core
+	if flagParallel > 0 {
+		c.CoreConfig.DevParallelism = flagParallel
+	}
 	core, err := c.Core(app)
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf(
@@ -92,6 +97,11 @@ Usage: otto dev [subcommand] [options]
   The list of available subcommands depends on the type of application
   you're developing. To see the list, run "otto dev help".
 
+Options:
+
+  -parallel=4    The number of upstream dependencies to build at the
+                 same time when building the development environment.
+
 `
 
 	return strings.TrimSpace(helpText)
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/otto/directory"
+)
+
+// directoryFromURL returns the directory backend for a URL set with
+// EnvDirectory. The scheme is the type of backend. For "consul", the
+// host is the address of the Consul agent and the path is the KV prefix.
+// If the host is empty, the CONSUL_HTTP_ADDR environment variable or
+// Consul's default address is used. For "s3", the host is the bucket,
+// the path is the key prefix, and the "region" query parameter is the
+// region of the bucket.
+func directoryFromURL(raw string) (directory.Backend, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s: %s", EnvDirectory, err)
+	}
+
+	switch u.Scheme {
+	case "consul":
+		config := api.DefaultConfig()
+		if u.Host != "" {
+			config.Address = u.Host
+		}
+
+		client, err := api.NewClient(config)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating Consul client: %s", err)
+		}
+
+		return &directory.ConsulBackend{
+			Client: client,
+			Prefix: strings.Trim(u.Path, "/"),
+		}, nil
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf(
+				"The S3 directory in %s requires a bucket, such as\n"+
+					"s3://BUCKET/PREFIX", EnvDirectory)
+		}
+
+		return &directory.S3Backend{
+			Bucket: u.Host,
+			Prefix: strings.Trim(u.Path, "/"),
+			Region: u.Query().Get("region"),
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"Unknown directory backend type in %s: %q", EnvDirectory, u.Scheme)
+	}
+}
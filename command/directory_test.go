@@ -0,0 +1,41 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestDirectoryFromURL(t *testing.T) {
+	b, err := directoryFromURL("consul://127.0.0.1:8500/team/otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	consul, ok := b.(*directory.ConsulBackend)
+	if !ok {
+		t.Fatalf("bad: %#v", b)
+	}
+	if consul.Prefix != "team/otto" {
+		t.Fatalf("bad: %#v", consul)
+	}
+
+	b, err = directoryFromURL("s3://bucket/otto?region=us-west-2")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	s3, ok := b.(*directory.S3Backend)
+	if !ok {
+		t.Fatalf("bad: %#v", b)
+	}
+	if s3.Bucket != "bucket" || s3.Prefix != "otto" || s3.Region != "us-west-2" {
+		t.Fatalf("bad: %#v", s3)
+	}
+
+	if _, err := directoryFromURL("s3:///otto"); err == nil {
+		t.Fatal("should error")
+	}
+
+	if _, err := directoryFromURL("nope://foo"); err == nil {
+		t.Fatal("should error")
+	}
+}
@@ -34,6 +34,21 @@ const (
 	// DefaultDataDir is the default directory for the directory
 	// data if a directory in the Appfile isn't specified.
 	DefaultDataDir = "otto-data"
+
+	// EnvDirectory is the environment variable that configures a shared
+	// directory backend with a URL such as "consul://127.0.0.1:8500/otto".
+	// If it isn't set, the local directory is used.
+	EnvDirectory = "OTTO_DIRECTORY"
+
+	// EnvOutput is the environment variable that configures the format
+	// of the output. If it is "json", Otto outputs newline delimited JSON
+	// events for other programs such as CI jobs. See ui.JSON.
+	EnvOutput = "OTTO_OUTPUT"
+
+	// EnvQuiet is the environment variable that, if set to a non-empty
+	// value, hides everything but warnings, errors, and the output of
+	// the tools Otto runs. See ui.Quiet.
+	EnvQuiet = "OTTO_QUIET"
 )
 
 // FlagSetFlags is an enum to define what flags are present in the
@@ -42,12 +57,20 @@ type FlagSetFlags uint
 
 const (
 	FlagSetNone FlagSetFlags = 0
+
+	// FlagSetRefreshInfra adds the -refresh-infra flag, which checks that
+	// the infrastructure still exists before building or deploying. See
+	// otto.CoreConfig.RefreshInfra.
+	FlagSetRefreshInfra FlagSetFlags = 1 << 0
 )
 
 // Meta are the meta-options that are available on all or most commands.
 type Meta struct {
 	CoreConfig *otto.CoreConfig
 	Ui         cli.Ui
+
+	// Flags set by FlagSet
+	flagRefreshInfra bool
 }
 
 // Appfile loads the compiled Appfile. If the Appfile isn't compiled yet,
@@ -91,6 +114,7 @@ func (m *Meta) Core(f *appfile.Compiled) (*otto.Core, error) {
 	config.CompileDir = filepath.Join(
 		rootDir, DefaultOutputDir, DefaultOutputDirCompiledData)
 	config.Ui = m.OttoUi()
+	config.RefreshInfra = m.flagRefreshInfra
 
 	config.Directory, err = m.Directory(&config)
 	if err != nil {
@@ -144,6 +168,10 @@ func (m *Meta) RootDir() (string, error) {
 // Appfile. If no directory backend is specified, a local folder
 // will be used.
 func (m *Meta) Directory(config *otto.CoreConfig) (directory.Backend, error) {
+	if raw := os.Getenv(EnvDirectory); raw != "" {
+		return directoryFromURL(raw)
+	}
+
 	return &directory.BoltBackend{
 		Dir: filepath.Join(config.DataDir, "directory"),
 	}, nil
@@ -155,6 +183,10 @@ func (m *Meta) Directory(config *otto.CoreConfig) (directory.Backend, error) {
 func (m *Meta) FlagSet(n string, fs FlagSetFlags) *flag.FlagSet {
 	f := flag.NewFlagSet(n, flag.ContinueOnError)
 
+	if fs&FlagSetRefreshInfra != 0 {
+		f.BoolVar(&m.flagRefreshInfra, "refresh-infra", false, "")
+	}
+
 	// Create an io.Writer that writes to our Ui properly for errors.
 	// This is kind of a hack, but it does the job. Basically: create
 	// a pipe, use a scanner to break it into lines, and output each line
@@ -173,7 +205,18 @@ func (m *Meta) FlagSet(n string, fs FlagSetFlags) *flag.FlagSet {
 
 // OttoUi returns the ui.Ui object.
 func (m *Meta) OttoUi() ui.Ui {
-	return NewUi(m.Ui)
+	var result ui.Ui
+	if os.Getenv(EnvOutput) == "json" {
+		result = &ui.JSON{Writer: os.Stdout}
+	} else {
+		result = NewUi(m.Ui)
+	}
+
+	if os.Getenv(EnvQuiet) != "" {
+		result = &ui.Quiet{Ui: result}
+	}
+
+	return result
 }
 
 // confirmDestroy is a little helper that will ask the user to confirm a
@@ -56,7 +56,8 @@ Usage: otto status
 
   This command will show whether an application has a development
   environment created, a build made, a deploy done, the infrastructure
-  ready, etc.
+  ready, etc. It also shows the artifact of the build and the version
+  and outputs of the deploy, such as the URL of the application.
 
   The output from this command is loaded from a cache and may not represent
   the true state of the world if external changes have happened outside of
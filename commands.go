@@ -5,13 +5,19 @@ import (
 	"os/signal"
 
 	appCustom "github.com/hashicorp/otto/builtin/app/custom"
+	appDocker "github.com/hashicorp/otto/builtin/app/docker"
 	appDockerExt "github.com/hashicorp/otto/builtin/app/docker-external"
-	appGo "github.com/hashicorp/otto/builtin/app/go"
+	_ "github.com/hashicorp/otto/builtin/app/go"
+	_ "github.com/hashicorp/otto/builtin/app/java"
 	appNode "github.com/hashicorp/otto/builtin/app/node"
 	appPHP "github.com/hashicorp/otto/builtin/app/php"
+	appPython "github.com/hashicorp/otto/builtin/app/python"
 	appRuby "github.com/hashicorp/otto/builtin/app/ruby"
+	_ "github.com/hashicorp/otto/builtin/app/static"
 	foundationConsul "github.com/hashicorp/otto/builtin/foundation/consul"
 	infraAws "github.com/hashicorp/otto/builtin/infra/aws"
+	infraDigitalOcean "github.com/hashicorp/otto/builtin/infra/digitalocean"
+	infraGoogle "github.com/hashicorp/otto/builtin/infra/google"
 
 	"github.com/hashicorp/otto/app"
 	"github.com/hashicorp/otto/appfile/detect"
@@ -48,11 +54,13 @@ func init() {
 		},
 	}
 
-	apps := appGo.Tuples.Map(app.StructFactory(new(appGo.App)))
+	apps := app.Registered()
 	apps.Add(appCustom.Tuples.Map(app.StructFactory(new(appCustom.App))))
+	apps.Add(appDocker.Tuples.Map(app.StructFactory(new(appDocker.App))))
 	apps.Add(appDockerExt.Tuples.Map(app.StructFactory(new(appDockerExt.App))))
 	apps.Add(appNode.Tuples.Map(app.StructFactory(new(appNode.App))))
 	apps.Add(appPHP.Tuples.Map(app.StructFactory(new(appPHP.App))))
+	apps.Add(appPython.Tuples.Map(app.StructFactory(new(appPython.App))))
 	apps.Add(appRuby.Tuples.Map(app.StructFactory(new(appRuby.App))))
 
 	foundations := foundationConsul.Tuples.Map(foundation.StructFactory(new(foundationConsul.Foundation)))
@@ -62,7 +70,9 @@ func init() {
 			Apps:        apps,
 			Foundations: foundations,
 			Infrastructures: map[string]infrastructure.Factory{
-				"aws": infraAws.Infra,
+				"aws":          infraAws.Infra,
+				"digitalocean": infraDigitalOcean.Infra,
+				"google":       infraGoogle.Infra,
 			},
 		},
 		Ui: Ui,
@@ -73,6 +83,10 @@ func init() {
 			Type: "go",
 			File: []string{"*.go"},
 		},
+		&detect.Detector{
+			Type: "java",
+			File: []string{"pom.xml", "build.gradle"},
+		},
 		&detect.Detector{
 			Type: "node",
 			File: []string{"package.json"},
@@ -81,13 +95,33 @@ func init() {
 			Type: "php",
 			File: []string{"*.php", "composer.json"},
 		},
+		&detect.Detector{
+			Type: "python",
+			File: []string{"requirements.txt", "setup.py", "*.py"},
+		},
 		&detect.Detector{
 			Type: "ruby",
 			File: []string{"*.rb", "Gemfile", "config.ru"},
 		},
+		&detect.Detector{
+			Type: "docker",
+			File: []string{"Dockerfile"},
+		},
 	}
 
 	Commands = map[string]cli.CommandFactory{
+		"app": func() (cli.Command, error) {
+			return &command.AppCommand{
+				Meta: meta,
+			}, nil
+		},
+
+		"cache": func() (cli.Command, error) {
+			return &command.CacheCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"compile": func() (cli.Command, error) {
 			return &command.CompileCommand{
 				Meta:      meta,
@@ -107,6 +141,12 @@ func init() {
 			}, nil
 		},
 
+		"destroy": func() (cli.Command, error) {
+			return &command.DestroyCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"dev": func() (cli.Command, error) {
 			return &command.DevCommand{
 				Meta: meta,
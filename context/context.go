@@ -1,6 +1,8 @@
 package context
 
 import (
+	"strings"
+
 	"github.com/hashicorp/otto/appfile"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/ui"
@@ -41,3 +43,86 @@ type Shared struct {
 	// called.
 	FoundationDirs []string
 }
+
+// infraCredsVars maps the keys of InfraCreds that differ from the names
+// of the variables the templates declare for them.
+var infraCredsVars = map[string]string{
+	"aws_session_token": "aws_token",
+}
+
+// SensitiveVars are the variables from InfraCredsVars that are secret.
+// Packer and Terraform redact their values from the output.
+var SensitiveVars = []string{"aws_secret_key", "aws_token", "do_token", "gcp_credentials"}
+
+// The namespaces of InfraCreds for credentials that are only used to
+// build or to deploy, such as when the builds are made in a different
+// account than the deploys. A key in a namespace is prefixed with the
+// namespace and a ".", such as "deploy.aws_access_key".
+const (
+	CredsBuild  = "build"
+	CredsDeploy = "deploy"
+)
+
+// credsNamespaceKeys are the keys of InfraCreds that are replaced as a
+// whole by the keys of a namespace, since keys of different accounts
+// don't work together.
+var credsNamespaceKeys = []string{
+	"aws_access_key",
+	"aws_secret_key",
+	"aws_session_token",
+}
+
+// InfraCredsVars returns the InfraCreds as a set of variables for Packer
+// and Terraform. Keys are copied as-is unless the templates know them
+// by another name, such as the AWS session token. Keys in a namespace
+// aren't included.
+func (s *Shared) InfraCredsVars() map[string]string {
+	return s.credsVars("")
+}
+
+// BuildCredsVars is the same as InfraCredsVars, but the credentials in
+// the CredsBuild namespace are used if there are any.
+func (s *Shared) BuildCredsVars() map[string]string {
+	return s.credsVars(CredsBuild)
+}
+
+// DeployCredsVars is the same as InfraCredsVars, but the credentials in
+// the CredsDeploy namespace are used if there are any.
+func (s *Shared) DeployCredsVars() map[string]string {
+	return s.credsVars(CredsDeploy)
+}
+
+func (s *Shared) credsVars(namespace string) map[string]string {
+	creds := make(map[string]string, len(s.InfraCreds))
+	namespaced := make(map[string]string)
+	for k, v := range s.InfraCreds {
+		if idx := strings.Index(k, "."); idx >= 0 {
+			if k[:idx] == namespace {
+				namespaced[k[idx+1:]] = v
+			}
+
+			continue
+		}
+
+		creds[k] = v
+	}
+	if len(namespaced) > 0 {
+		for _, k := range credsNamespaceKeys {
+			delete(creds, k)
+		}
+		for k, v := range namespaced {
+			creds[k] = v
+		}
+	}
+
+	result := make(map[string]string, len(creds))
+	for k, v := range creds {
+		if nk, ok := infraCredsVars[k]; ok {
+			k = nk
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
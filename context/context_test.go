@@ -0,0 +1,88 @@
+package context
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSharedCredsVars(t *testing.T) {
+	s := &Shared{
+		InfraCreds: map[string]string{
+			"aws_access_key":           "base",
+			"aws_secret_key":           "base-secret",
+			"aws_session_token":        "base-token",
+			"ssh_public_key":           "key",
+			"deploy.aws_access_key":    "deploy",
+			"deploy.aws_secret_key":    "deploy-secret",
+			"deploy.aws_session_token": "deploy-token",
+		},
+	}
+
+	cases := []struct {
+		Name   string
+		Vars   map[string]string
+		Result map[string]string
+	}{
+		{
+			"infra",
+			s.InfraCredsVars(),
+			map[string]string{
+				"aws_access_key": "base",
+				"aws_secret_key": "base-secret",
+				"aws_token":      "base-token",
+				"ssh_public_key": "key",
+			},
+		},
+
+		{
+			"build",
+			s.BuildCredsVars(),
+			map[string]string{
+				"aws_access_key": "base",
+				"aws_secret_key": "base-secret",
+				"aws_token":      "base-token",
+				"ssh_public_key": "key",
+			},
+		},
+
+		{
+			"deploy",
+			s.DeployCredsVars(),
+			map[string]string{
+				"aws_access_key": "deploy",
+				"aws_secret_key": "deploy-secret",
+				"aws_token":      "deploy-token",
+				"ssh_public_key": "key",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		if !reflect.DeepEqual(tc.Vars, tc.Result) {
+			t.Fatalf("%s: %#v", tc.Name, tc.Vars)
+		}
+	}
+}
+
+func TestSharedCredsVars_partial(t *testing.T) {
+	// The session token of the base credentials must not be used with
+	// the keys of another account.
+	s := &Shared{
+		InfraCreds: map[string]string{
+			"aws_access_key":        "base",
+			"aws_secret_key":        "base-secret",
+			"aws_session_token":     "base-token",
+			"build.aws_access_key":  "build",
+			"build.aws_secret_key":  "build-secret",
+			"deploy.aws_access_key": "deploy",
+		},
+	}
+
+	expected := map[string]string{
+		"aws_access_key": "build",
+		"aws_secret_key": "build-secret",
+	}
+	if actual := s.BuildCredsVars(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
@@ -3,6 +3,9 @@ package directory
 import (
 	"io"
 	"os"
+	"time"
+
+	"github.com/hashicorp/otto/helper/uuid"
 )
 
 // Backend is the interface for any directory service. It is effectively
@@ -15,15 +18,25 @@ type Backend interface {
 	//
 	// GetBlob reads that data back out.
 	//
+	// DeleteBlob removes the data. It is not an error if it doesn't exist.
+	//
 	// ListBlob lists the binary data stored.
 	PutBlob(string, *BlobData) error
 	GetBlob(string) (*BlobData, error)
+	DeleteBlob(string) error
 
 	// PutInfra and GetInfra are the functions used to store and retrieve
 	// data about infrastructures.
 	PutInfra(*Infra) error
 	GetInfra(*Infra) (*Infra, error)
 
+	// PutCreds stores the encrypted credentials of an infrastructure.
+	//
+	// GetCreds reads them back, or returns nil if there are none. The
+	// parameter must fill in the Infra field.
+	PutCreds(*Creds) error
+	GetCreds(*Creds) (*Creds, error)
+
 	// PutDev stores the result of a dev.
 	//
 	// GetDev queries a dev. The result is returned. The parameter
@@ -32,19 +45,58 @@ type Backend interface {
 	GetDev(*Dev) (*Dev, error)
 	DeleteDev(*Dev) error
 
-	// PutBuild stores the result of a build.
+	// PutBuild stores the result of a build. Every build is kept, not
+	// just the latest.
 	//
-	// GetBuild queries a build. The result is returned. The parameter
-	// must fill in the App, Infra, and InfraFlavor fields.
+	// GetBuild queries a build. The latest build is returned. The
+	// parameter must fill in the App, Infra, and InfraFlavor fields.
+	//
+	// ListBuilds returns every build matching the lookup, oldest first.
+	// Only the AppID, Infra, and InfraFlavor fields of the lookup are
+	// used, and an empty field matches any value.
+	//
+	// DeleteBuild removes every build for the App, Infra, and
+	// InfraFlavor fields, including the history. It is not an error if
+	// there are none.
+	//
+	// PutBuild may be called from multiple goroutines at once, such as
+	// by app.BuildAll, and concurrent builds for different App, Infra,
+	// and InfraFlavor fields must all be stored.
 	PutBuild(*Build) error
 	GetBuild(*Build) (*Build, error)
+	ListBuilds(*Lookup) ([]*Build, error)
+	DeleteBuild(*Build) error
 
-	// PutDeploy stores the result of a build.
+	// PutDeploy stores the result of a build. Each version of a deploy
+	// is kept in the history. If the Version is zero, the deploy is
+	// assigned the next version. Otherwise, that version is updated.
 	//
-	// GetDeploy queries a deploy. The result is returned. The parameter
-	// must fill in the App, Infra, and InfraFlavor fields.
+	// GetDeploy queries a deploy. The latest version is returned. The
+	// parameter must fill in the App, Infra, and InfraFlavor fields.
+	//
+	// ListDeploys returns every version of a deploy, oldest first. The
+	// parameter must fill in the App, Infra, and InfraFlavor fields.
+	//
+	// DeleteDeploy removes a deploy, including every version in the
+	// history. It is not an error if it doesn't exist.
 	PutDeploy(*Deploy) error
 	GetDeploy(*Deploy) (*Deploy, error)
+	ListDeploys(*Deploy) ([]*Deploy, error)
+	DeleteDeploy(*Deploy) error
+
+	// Lock takes the lock with the given key so that only one Otto at
+	// a time works on what it guards, such as the builds and deploys of
+	// an app (see AppLockKey). It doesn't wait: if the lock is held,
+	// ErrLocked is returned. Otherwise the returned function releases
+	// the lock.
+	Lock(string) (func(), error)
+}
+
+// InfraCredsBackend is implemented by backends that store their data
+// within the infrastructure and can use its credentials, such as the
+// S3Backend. Otto sets the credentials once it has read them.
+type InfraCredsBackend interface {
+	SetInfraCreds(map[string]string)
 }
 
 // Build represents a build of an App.
@@ -53,10 +105,42 @@ type Build struct {
 	// are required.
 	Lookup
 
-	// Resulting artifact from the build
+	// Resulting artifact from the build. If multiple artifacts were
+	// created for the same key (such as multiple AMIs in a single region),
+	// this will be the most recent one.
 	Artifact map[string]string
+
+	// Artifacts is the complete list of artifacts from the build for
+	// each key, in the order they were created. This can be used to choose
+	// a specific artifact when more than one exists for a key.
+	Artifacts map[string][]string
+
+	// CreatedAt, GitSHA, and Builder record when, from what commit, and
+	// by whom the build was made. Builds stored before these existed
+	// have zero values, and GitSHA is empty if the app isn't in a Git
+	// repository.
+	CreatedAt time.Time
+	GitSHA    string
+	Builder   string
+
+	// ID identifies the build in the history, so that a specific build
+	// can be deployed later, such as to promote it to another
+	// environment. PutBuild sets a new ID for every build. Builds stored
+	// before IDs existed have an empty ID.
+	ID string
+}
+
+func (b *Build) setId() {
+	b.ID = uuid.GenerateUUID()
 }
 
+// buildsByTime sorts builds by CreatedAt, oldest first.
+type buildsByTime []*Build
+
+func (s buildsByTime) Len() int           { return len(s) }
+func (s buildsByTime) Less(i, j int) bool { return s[i].CreatedAt.Before(s[j].CreatedAt) }
+func (s buildsByTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 // BlobData is the metadata and data associated with stored binary
 // data. The fields and their usage varies depending on the operations,
 // so please read the documentation for each field carefully.
@@ -2,13 +2,16 @@ package directory
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/boltdb/bolt"
+	"github.com/hashicorp/otto/helper/lockfile"
 )
 
 var (
@@ -28,6 +31,10 @@ var (
 	boltDataVersion byte = 1
 )
 
+// boltCredsKey is the key of the credentials within the bucket of an
+// infrastructure. It can't collide with the keys of infraKey.
+var boltCredsKey = []byte("creds")
+
 // BoltBackend is a Directory backend that stores data on local disk
 // using BoltDB.
 //
@@ -89,6 +96,19 @@ func (b *BoltBackend) PutBlob(k string, d *BlobData) error {
 	})
 }
 
+func (b *BoltBackend) DeleteBlob(k string) error {
+	db, err := b.db()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBlobBucket)
+		return bucket.Delete([]byte(k))
+	})
+}
+
 func (b *BoltBackend) GetInfra(infra *Infra) (*Infra, error) {
 	db, err := b.db()
 	if err != nil {
@@ -150,6 +170,60 @@ func (b *BoltBackend) PutInfra(infra *Infra) error {
 	})
 }
 
+func (b *BoltBackend) GetCreds(creds *Creds) (*Creds, error) {
+	db, err := b.db()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var result *Creds
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltInfraBucket).Bucket([]byte(
+			creds.Lookup.Infra))
+		if bucket == nil {
+			return nil
+		}
+
+		data := bucket.Get(boltCredsKey)
+		if data == nil {
+			return nil
+		}
+
+		result = &Creds{}
+		return b.structRead(result, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (b *BoltBackend) PutCreds(creds *Creds) error {
+	db, err := b.db()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		data, err := b.structData(creds)
+		if err != nil {
+			return err
+		}
+
+		bucket := tx.Bucket(boltInfraBucket)
+		bucket, err = bucket.CreateBucketIfNotExists([]byte(
+			creds.Lookup.Infra))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(boltCredsKey, data)
+	})
+}
+
 func (b *BoltBackend) GetDev(dev *Dev) (*Dev, error) {
 	db, err := b.db()
 	if err != nil {
@@ -271,6 +345,9 @@ func (b *BoltBackend) GetBuild(build *Build) (*Build, error) {
 }
 
 func (b *BoltBackend) PutBuild(build *Build) error {
+	// Every build is a new one in the history
+	build.setId()
+
 	db, err := b.db()
 	if err != nil {
 		return err
@@ -298,10 +375,101 @@ func (b *BoltBackend) PutBuild(build *Build) error {
 			return err
 		}
 
+		// Store the build in the history, then as the latest
+		history := bucket.Bucket([]byte("builds"))
+		if history == nil {
+			history, err = bucket.CreateBucket([]byte("builds"))
+			if err != nil {
+				return err
+			}
+
+			// Keep the build stored before the history existed
+			if old := bucket.Get([]byte("build")); old != nil {
+				if err := b.historyAppend(history, old); err != nil {
+					return err
+				}
+			}
+		}
+		if err := b.historyAppend(history, data); err != nil {
+			return err
+		}
+
 		return bucket.Put([]byte("build"), data)
 	})
 }
 
+func (b *BoltBackend) ListBuilds(lookup *Lookup) ([]*Build, error) {
+	db, err := b.db()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var result []*Build
+	err = db.View(func(tx *bolt.Tx) error {
+		apps := tx.Bucket(boltAppsBucket)
+		return apps.ForEach(func(appID, v []byte) error {
+			// Only nested buckets are apps
+			if v != nil {
+				return nil
+			}
+			if lookup.AppID != "" && string(appID) != lookup.AppID {
+				return nil
+			}
+
+			app := apps.Bucket(appID)
+			return app.ForEach(func(k, v []byte) error {
+				if v != nil {
+					return nil
+				}
+
+				// Builds stored before the history existed only have
+				// the latest build.
+				bucket := app.Bucket(k)
+				read := func(data []byte) error {
+					var build Build
+					if err := b.structRead(&build, data); err != nil {
+						return err
+					}
+					if lookup.Infra != "" && build.Lookup.Infra != lookup.Infra {
+						return nil
+					}
+					if lookup.InfraFlavor != "" &&
+						build.Lookup.InfraFlavor != lookup.InfraFlavor {
+						return nil
+					}
+
+					result = append(result, &build)
+					return nil
+				}
+				history := bucket.Bucket([]byte("builds"))
+				if history == nil {
+					if data := bucket.Get([]byte("build")); data != nil {
+						return read(data)
+					}
+
+					return nil
+				}
+
+				return history.ForEach(func(_, data []byte) error {
+					return read(data)
+				})
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Each history is in order, but the histories need to be merged
+	sort.Stable(buildsByTime(result))
+	return result, nil
+}
+
+func (b *BoltBackend) DeleteBuild(build *Build) error {
+	return b.deleteInfraKeys(&build.Lookup, "build", "builds")
+}
+
 func (b *BoltBackend) GetDeploy(deploy *Deploy) (*Deploy, error) {
 	db, err := b.db()
 	if err != nil {
@@ -326,7 +494,8 @@ func (b *BoltBackend) GetDeploy(deploy *Deploy) (*Deploy, error) {
 		}
 
 		// Get the key for this infra
-		data := bucket.Get([]byte("deploy"))
+		key, _ := deploy.keys()
+		data := bucket.Get([]byte(key))
 		if data == nil {
 			return nil
 		}
@@ -353,14 +522,9 @@ func (b *BoltBackend) PutDeploy(deploy *Deploy) error {
 	defer db.Close()
 
 	return db.Update(func(tx *bolt.Tx) error {
-		data, err := b.structData(deploy)
-		if err != nil {
-			return err
-		}
-
 		// Get the app bucket
 		bucket := tx.Bucket(boltAppsBucket)
-		bucket, err = bucket.CreateBucketIfNotExists([]byte(
+		bucket, err := bucket.CreateBucketIfNotExists([]byte(
 			deploy.Lookup.AppID))
 		if err != nil {
 			return err
@@ -373,10 +537,145 @@ func (b *BoltBackend) PutDeploy(deploy *Deploy) error {
 			return err
 		}
 
-		return bucket.Put([]byte("deploy"), data)
+		// Store the version in the history, then as the latest
+		key, historyKey := deploy.keys()
+		history, err := bucket.CreateBucketIfNotExists([]byte(historyKey))
+		if err != nil {
+			return err
+		}
+		if deploy.Version == 0 {
+			deploy.Version, err = history.NextSequence()
+			if err != nil {
+				return err
+			}
+		}
+
+		data, err := b.structData(deploy)
+		if err != nil {
+			return err
+		}
+
+		if err := history.Put(boltVersionKey(deploy.Version), data); err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(key), data)
+	})
+}
+
+func (b *BoltBackend) ListDeploys(deploy *Deploy) ([]*Deploy, error) {
+	db, err := b.db()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var result []*Deploy
+	err = db.View(func(tx *bolt.Tx) error {
+		// Get the app bucket
+		bucket := tx.Bucket(boltAppsBucket).Bucket([]byte(
+			deploy.Lookup.AppID))
+		if bucket == nil {
+			return nil
+		}
+
+		// Get the infra bucket
+		bucket = bucket.Bucket([]byte(fmt.Sprintf(
+			"%s-%s", deploy.Lookup.Infra, deploy.Lookup.InfraFlavor)))
+		if bucket == nil {
+			return nil
+		}
+
+		// Deploys stored before the history existed only have the
+		// latest version.
+		key, historyKey := deploy.keys()
+		history := bucket.Bucket([]byte(historyKey))
+		if history == nil {
+			if data := bucket.Get([]byte(key)); data != nil {
+				var d Deploy
+				if err := b.structRead(&d, data); err != nil {
+					return err
+				}
+
+				result = append(result, &d)
+			}
+
+			return nil
+		}
+
+		// The keys are big-endian so they iterate in version order
+		return history.ForEach(func(k, data []byte) error {
+			var d Deploy
+			if err := b.structRead(&d, data); err != nil {
+				return err
+			}
+
+			result = append(result, &d)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (b *BoltBackend) DeleteDeploy(deploy *Deploy) error {
+	key, historyKey := deploy.keys()
+	return b.deleteInfraKeys(&deploy.Lookup, key, historyKey)
+}
+
+// deleteInfraKeys deletes the latest record at key and the history
+// bucket from the infra bucket of an app.
+func (b *BoltBackend) deleteInfraKeys(lookup *Lookup, key, history string) error {
+	db, err := b.db()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		// Get the app bucket
+		bucket := tx.Bucket(boltAppsBucket).Bucket([]byte(lookup.AppID))
+		if bucket == nil {
+			return nil
+		}
+
+		// Get the infra bucket
+		bucket = bucket.Bucket([]byte(fmt.Sprintf(
+			"%s-%s", lookup.Infra, lookup.InfraFlavor)))
+		if bucket == nil {
+			return nil
+		}
+
+		if bucket.Bucket([]byte(history)) != nil {
+			if err := bucket.DeleteBucket([]byte(history)); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Delete([]byte(key))
 	})
 }
 
+// historyAppend stores data under the next sequence of a history bucket.
+func (b *BoltBackend) historyAppend(history *bolt.Bucket, data []byte) error {
+	seq, err := history.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	return history.Put(boltVersionKey(seq), data)
+}
+
+// boltVersionKey is the key in a history bucket for a version.
+func boltVersionKey(v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return buf[:]
+}
+
 func (b *BoltBackend) infraKey(infra *Infra) string {
 	key := "root"
 	if infra.Lookup.Foundation != "" {
@@ -386,18 +685,34 @@ func (b *BoltBackend) infraKey(infra *Infra) string {
 	return key
 }
 
+// Lock implements Backend. The lock is a file lock in the directory, so
+// it works across processes on this machine.
+func (b *BoltBackend) Lock(key string) (func(), error) {
+	path := filepath.Join(b.Dir, "locks", filepath.FromSlash(key)+".lock")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	unlock, err := lockfile.TryLock(path)
+	if err == lockfile.ErrLocked {
+		err = ErrLocked
+	}
+
+	return unlock, err
+}
+
 // db returns the database handle, and sets up the DB if it has never
 // been created.
 func (b *BoltBackend) db() (*bolt.DB, error) {
 	// Make the directory to store our DB
 	if err := os.MkdirAll(b.Dir, 0755); err != nil {
-		return nil, err
+		return nil, &ConnectionError{Backend: "local", Err: err}
 	}
 
 	// Create/Open the DB
 	db, err := bolt.Open(filepath.Join(b.Dir, "otto.db"), 0644, nil)
 	if err != nil {
-		return nil, err
+		return nil, &ConnectionError{Backend: "local", Err: err}
 	}
 
 	// Create the buckets
@@ -3,7 +3,9 @@ package directory
 import (
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/boltdb/bolt"
 )
@@ -50,3 +52,111 @@ func TestBoltBackend_version(t *testing.T) {
 		t.Fatal("should error")
 	}
 }
+
+func TestBoltBackend_buildLegacy(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// Manually insert a build stored before the metadata fields existed
+	b := &BoltBackend{Dir: td}
+	db, err := b.db()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(boltAppsBucket).CreateBucketIfNotExists([]byte("foo"))
+		if err != nil {
+			return err
+		}
+		bucket, err = bucket.CreateBucketIfNotExists([]byte("aws-simple"))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte("build"), []byte(`{
+			"AppID": "foo",
+			"Infra": "aws",
+			"InfraFlavor": "simple",
+			"Artifact": {"us-east-1": "ami-1"}
+		}`))
+	})
+	db.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	build, err := b.GetBuild(&Build{Lookup: Lookup{
+		AppID: "foo", Infra: "aws", InfraFlavor: "simple"}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if build == nil || build.Artifact["us-east-1"] != "ami-1" {
+		t.Fatalf("bad: %#v", build)
+	}
+	if !build.CreatedAt.IsZero() || build.GitSHA != "" || build.Builder != "" {
+		t.Fatalf("bad: %#v", build)
+	}
+
+	// The legacy build is kept in the history once a new build is stored
+	newBuild := *build
+	newBuild.Artifact = map[string]string{"us-east-1": "ami-2"}
+	newBuild.CreatedAt = time.Now().UTC()
+	if err := b.PutBuild(&newBuild); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	builds, err := b.ListBuilds(&Lookup{AppID: "foo"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(builds) != 2 ||
+		builds[0].Artifact["us-east-1"] != "ami-1" ||
+		builds[1].Artifact["us-east-1"] != "ami-2" {
+		t.Fatalf("bad: %#v", builds)
+	}
+}
+
+func TestBoltBackend_putBuildParallel(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// Store the builds of several apps at the same time, as parallel
+	// builds do. Every build must be stored.
+	b := &BoltBackend{Dir: td}
+	apps := []string{"foo", "bar", "baz", "qux"}
+	errCh := make(chan error, len(apps))
+	var wg sync.WaitGroup
+	for _, a := range apps {
+		wg.Add(1)
+		go func(a string) {
+			defer wg.Done()
+			errCh <- b.PutBuild(&Build{
+				Lookup:   Lookup{AppID: a, Infra: "aws", InfraFlavor: "simple"},
+				Artifact: map[string]string{"us-east-1": a},
+			})
+		}(a)
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	for _, a := range apps {
+		build, err := b.GetBuild(&Build{Lookup: Lookup{
+			AppID: a, Infra: "aws", InfraFlavor: "simple"}})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if build == nil || build.Artifact["us-east-1"] != a {
+			t.Fatalf("bad: %#v", build)
+		}
+	}
+}
@@ -0,0 +1,434 @@
+package directory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// ConsulBackend is a Directory backend that stores data in the Consul
+// KV store.
+//
+// Unlike the BoltBackend, the ConsulBackend can be shared by a whole
+// team. Writes that depend on the existing data, such as assigning the
+// next deploy version, are made while holding a Consul lock so that
+// concurrent Otto runs don't overwrite each other.
+//
+// Consul limits the size of values to 512KB, which also limits the size
+// of blobs.
+type ConsulBackend struct {
+	// Client is the Consul API client to use.
+	Client *api.Client
+
+	// Prefix is the KV prefix that all data is stored under. This
+	// defaults to "otto".
+	Prefix string
+}
+
+// consulLockWaitTime is how long Lock waits for a held lock before
+// giving up with ErrLocked.
+const consulLockWaitTime = time.Second
+
+func (b *ConsulBackend) GetBlob(k string) (*BlobData, error) {
+	pair, _, err := b.Client.KV().Get(b.key("blob", k), nil)
+	if err != nil {
+		return nil, consulErr(err)
+	}
+	if pair == nil {
+		return nil, nil
+	}
+
+	return &BlobData{
+		Key:  k,
+		Data: bytes.NewReader(pair.Value),
+	}, nil
+}
+
+func (b *ConsulBackend) PutBlob(k string, d *BlobData) error {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, d.Data); err != nil {
+		return err
+	}
+
+	_, err := b.Client.KV().Put(&api.KVPair{
+		Key:   b.key("blob", k),
+		Value: buf.Bytes(),
+	}, nil)
+	return consulErr(err)
+}
+
+func (b *ConsulBackend) DeleteBlob(k string) error {
+	_, err := b.Client.KV().Delete(b.key("blob", k), nil)
+	return consulErr(err)
+}
+
+func (b *ConsulBackend) GetInfra(infra *Infra) (*Infra, error) {
+	var result Infra
+	ok, err := b.get(b.infraKey(infra), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *ConsulBackend) PutInfra(infra *Infra) error {
+	if infra.ID == "" {
+		infra.setId()
+	}
+
+	return b.put(b.infraKey(infra), infra)
+}
+
+func (b *ConsulBackend) GetCreds(creds *Creds) (*Creds, error) {
+	var result Creds
+	ok, err := b.get(b.key("infra", creds.Lookup.Infra, "creds"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *ConsulBackend) PutCreds(creds *Creds) error {
+	return b.put(b.key("infra", creds.Lookup.Infra, "creds"), creds)
+}
+
+func (b *ConsulBackend) GetDev(dev *Dev) (*Dev, error) {
+	var result Dev
+	ok, err := b.get(b.key("apps", dev.Lookup.AppID, "dev"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *ConsulBackend) PutDev(dev *Dev) error {
+	if dev.ID == "" {
+		dev.setId()
+	}
+
+	return b.put(b.key("apps", dev.Lookup.AppID, "dev"), dev)
+}
+
+func (b *ConsulBackend) DeleteDev(dev *Dev) error {
+	_, err := b.Client.KV().Delete(b.key("apps", dev.Lookup.AppID, "dev"), nil)
+	return consulErr(err)
+}
+
+func (b *ConsulBackend) GetBuild(build *Build) (*Build, error) {
+	var result Build
+	ok, err := b.get(b.appKey(&build.Lookup, "build"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *ConsulBackend) PutBuild(build *Build) error {
+	// Every build is a new one in the history
+	build.setId()
+
+	return b.withLock(b.appKey(&build.Lookup, "lock"), func() error {
+		seq, err := b.nextSequence(b.appKey(&build.Lookup, "builds"))
+		if err != nil {
+			return err
+		}
+
+		// Store the build in the history, then as the latest
+		key := b.appKey(&build.Lookup, "builds", consulSequence(seq))
+		if err := b.put(key, build); err != nil {
+			return err
+		}
+
+		return b.put(b.appKey(&build.Lookup, "build"), build)
+	})
+}
+
+func (b *ConsulBackend) ListBuilds(lookup *Lookup) ([]*Build, error) {
+	prefix := b.key("apps") + "/"
+	if lookup.AppID != "" {
+		prefix = b.key("apps", lookup.AppID) + "/"
+	}
+
+	pairs, _, err := b.Client.KV().List(prefix, nil)
+	if err != nil {
+		return nil, consulErr(err)
+	}
+
+	// The pairs are sorted by key, and the sequences are zero-padded,
+	// so each history is already in order.
+	var result []*Build
+	for _, pair := range pairs {
+		if path.Base(path.Dir(pair.Key)) != "builds" {
+			continue
+		}
+
+		var build Build
+		if err := json.Unmarshal(pair.Value, &build); err != nil {
+			return nil, fmt.Errorf("Error reading %s: %s", pair.Key, err)
+		}
+		if lookup.Infra != "" && build.Lookup.Infra != lookup.Infra {
+			continue
+		}
+		if lookup.InfraFlavor != "" && build.Lookup.InfraFlavor != lookup.InfraFlavor {
+			continue
+		}
+
+		result = append(result, &build)
+	}
+
+	// Each history is in order, but the histories need to be merged
+	sort.Stable(buildsByTime(result))
+	return result, nil
+}
+
+func (b *ConsulBackend) DeleteBuild(build *Build) error {
+	return b.withLock(b.appKey(&build.Lookup, "lock"), func() error {
+		return b.deleteTree(
+			b.appKey(&build.Lookup, "build"),
+			b.appKey(&build.Lookup, "builds"))
+	})
+}
+
+func (b *ConsulBackend) GetDeploy(deploy *Deploy) (*Deploy, error) {
+	key, _ := deploy.keys()
+	var result Deploy
+	ok, err := b.get(b.appKey(&deploy.Lookup, key), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *ConsulBackend) PutDeploy(deploy *Deploy) error {
+	if deploy.ID == "" {
+		deploy.setId()
+	}
+
+	key, historyKey := deploy.keys()
+
+	return b.withLock(b.appKey(&deploy.Lookup, "lock"), func() error {
+		if deploy.Version == 0 {
+			var err error
+			deploy.Version, err = b.nextSequence(b.appKey(&deploy.Lookup, historyKey))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Store the version in the history, then as the latest
+		versionKey := b.appKey(&deploy.Lookup, historyKey, consulSequence(deploy.Version))
+		if err := b.put(versionKey, deploy); err != nil {
+			return err
+		}
+
+		return b.put(b.appKey(&deploy.Lookup, key), deploy)
+	})
+}
+
+func (b *ConsulBackend) ListDeploys(deploy *Deploy) ([]*Deploy, error) {
+	_, historyKey := deploy.keys()
+	pairs, _, err := b.Client.KV().List(
+		b.appKey(&deploy.Lookup, historyKey)+"/", nil)
+	if err != nil {
+		return nil, consulErr(err)
+	}
+
+	// The versions are zero-padded so the pairs are in version order
+	var result []*Deploy
+	for _, pair := range pairs {
+		var d Deploy
+		if err := json.Unmarshal(pair.Value, &d); err != nil {
+			return nil, fmt.Errorf("Error reading %s: %s", pair.Key, err)
+		}
+
+		result = append(result, &d)
+	}
+
+	return result, nil
+}
+
+func (b *ConsulBackend) DeleteDeploy(deploy *Deploy) error {
+	key, historyKey := deploy.keys()
+	return b.withLock(b.appKey(&deploy.Lookup, "lock"), func() error {
+		return b.deleteTree(
+			b.appKey(&deploy.Lookup, key),
+			b.appKey(&deploy.Lookup, historyKey))
+	})
+}
+
+// deleteTree deletes the latest record at key and its history.
+func (b *ConsulBackend) deleteTree(key, history string) error {
+	if _, err := b.Client.KV().DeleteTree(history+"/", nil); err != nil {
+		return consulErr(err)
+	}
+
+	_, err := b.Client.KV().Delete(key, nil)
+	return consulErr(err)
+}
+
+// withLock calls f while holding the Consul lock at key. The lock is
+// held with a session, so it is released if this process dies.
+func (b *ConsulBackend) withLock(key string, f func() error) error {
+	lock, err := b.Client.LockKey(key)
+	if err != nil {
+		return err
+	}
+
+	lostCh, err := lock.Lock(nil)
+	if err != nil {
+		if err := b.ping(); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Error acquiring lock %s: %s", key, err)
+	}
+	defer lock.Unlock()
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	// If the lock was lost partway through, another writer may have
+	// made changes at the same time.
+	select {
+	case <-lostCh:
+		return fmt.Errorf("Lock %s was lost while writing", key)
+	default:
+		return nil
+	}
+}
+
+// Lock implements Backend with a Consul lock. The lock is held with a
+// session, so it is released if this process dies.
+func (b *ConsulBackend) Lock(key string) (func(), error) {
+	key = b.key("locks", key)
+	lock, err := b.Client.LockKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only wait a moment for the lock, since it being held means that
+	// another Otto is running.
+	stopCh := make(chan struct{})
+	timer := time.AfterFunc(consulLockWaitTime, func() { close(stopCh) })
+	lostCh, err := lock.Lock(stopCh)
+	timer.Stop()
+	if err != nil {
+		if err := b.ping(); err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("Error acquiring lock %s: %s", key, err)
+	}
+	if lostCh == nil {
+		return nil, ErrLocked
+	}
+
+	return func() { lock.Unlock() }, nil
+}
+
+// nextSequence returns the next sequence in the history under prefix.
+// This must be called while holding the lock for the history.
+func (b *ConsulBackend) nextSequence(prefix string) (uint64, error) {
+	keys, _, err := b.Client.KV().Keys(prefix+"/", "/", nil)
+	if err != nil {
+		return 0, consulErr(err)
+	}
+
+	var last uint64
+	for _, k := range keys {
+		v, err := strconv.ParseUint(path.Base(k), 10, 64)
+		if err == nil && v > last {
+			last = v
+		}
+	}
+
+	return last + 1, nil
+}
+
+// consulSequence is the key in a history for a sequence. It is
+// zero-padded so that the keys sort in order.
+func consulSequence(v uint64) string {
+	return fmt.Sprintf("%020d", v)
+}
+
+func (b *ConsulBackend) get(key string, d interface{}) (bool, error) {
+	pair, _, err := b.Client.KV().Get(key, nil)
+	if err != nil {
+		return false, consulErr(err)
+	}
+	if pair == nil {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(pair.Value, d); err != nil {
+		return false, fmt.Errorf("Error reading %s: %s", key, err)
+	}
+
+	return true, nil
+}
+
+func (b *ConsulBackend) put(key string, d interface{}) error {
+	// Human-readable like the BoltBackend to make it easy to debug
+	data, err := json.MarshalIndent(d, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	_, err = b.Client.KV().Put(&api.KVPair{Key: key, Value: data}, nil)
+	return consulErr(err)
+}
+
+// ping returns a *ConnectionError if Consul can't be reached. Errors
+// from acquiring a lock don't keep their cause, so this tells apart a
+// Consul that is down from other lock errors.
+func (b *ConsulBackend) ping() error {
+	_, err := b.Client.Status().Leader()
+	if err = consulErr(err); IsConnectionError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// consulErr returns err as a *ConnectionError if it shows that Consul
+// couldn't be reached.
+func consulErr(err error) error {
+	return connectionErr("Consul", err)
+}
+
+func (b *ConsulBackend) key(parts ...string) string {
+	prefix := b.Prefix
+	if prefix == "" {
+		prefix = "otto"
+	}
+
+	return strings.Join(append([]string{prefix}, parts...), "/")
+}
+
+func (b *ConsulBackend) appKey(lookup *Lookup, parts ...string) string {
+	infra := fmt.Sprintf("%s-%s", lookup.Infra, lookup.InfraFlavor)
+	return b.key(append([]string{"apps", lookup.AppID, infra}, parts...)...)
+}
+
+func (b *ConsulBackend) infraKey(infra *Infra) string {
+	key := "root"
+	if infra.Lookup.Foundation != "" {
+		key = fmt.Sprintf("foundation-%s", infra.Lookup.Foundation)
+	}
+
+	return b.key("infra", infra.Lookup.Infra, key)
+}
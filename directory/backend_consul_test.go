@@ -0,0 +1,30 @@
+package directory
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testutil"
+)
+
+func TestConsulBackend_impl(t *testing.T) {
+	var _ Backend = new(ConsulBackend)
+}
+
+func TestConsulBackend(t *testing.T) {
+	// This skips the test if Consul isn't installed
+	srv := testutil.NewTestServer(t)
+	defer srv.Stop()
+
+	config := api.DefaultConfig()
+	config.Address = srv.HTTPAddr
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	TestBackend(t, &ConsulBackend{
+		Client: client,
+		Prefix: "otto-test",
+	})
+}
@@ -0,0 +1,682 @@
+package directory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/otto/helper/uuid"
+)
+
+// S3Backend is a Directory backend that stores data as JSON objects in
+// an S3 bucket.
+//
+// Like the ConsulBackend, the S3Backend can be shared by a whole team.
+// Writes that depend on the existing data, such as assigning the next
+// deploy version, are made while holding a lock object. S3 has no
+// conditional writes, so the lock is only best-effort: it is written,
+// then read back after a short wait to check that no one else took it.
+//
+// S3 reads may not see a write right away. Objects written by this
+// backend are cached, so reading back something just written always
+// returns what was written.
+type S3Backend struct {
+	// Bucket is the name of the bucket to store data in. It must
+	// already exist.
+	Bucket string
+
+	// Prefix is the key prefix that all data is stored under. This
+	// defaults to "otto".
+	Prefix string
+
+	// Region is the region of the bucket. This defaults to "us-east-1".
+	Region string
+
+	// AccessKey and SecretKey are the credentials to use. If they
+	// aren't set, the infrastructure credentials are used if they
+	// were set with SetInfraCreds, otherwise the AWS defaults (such as
+	// the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment
+	// variables).
+	AccessKey string
+	SecretKey string
+
+	lock      sync.Mutex
+	conn      *s3.S3
+	infraKeys [3]string
+	written   map[string][]byte
+}
+
+// s3LockTimeout is how long to wait for a lock before giving up, and
+// s3LockStale is how old a lock can get before it is assumed to be
+// left over from an Otto run that died.
+const (
+	s3LockTimeout = 2 * time.Minute
+	s3LockStale   = 10 * time.Minute
+)
+
+// s3LockSettle is how long to wait after writing a lock before reading
+// it back to check that it is ours. This is a variable for tests.
+var s3LockSettle = 2 * time.Second
+
+// s3Lock is the content of a lock object.
+type s3Lock struct {
+	ID      string
+	Created time.Time
+}
+
+// SetInfraCreds implements InfraCredsBackend, using the AWS credentials
+// of the infrastructure if AccessKey and SecretKey aren't set.
+func (b *S3Backend) SetInfraCreds(creds map[string]string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	keys := [3]string{
+		creds["aws_access_key"],
+		creds["aws_secret_key"],
+		creds["aws_session_token"],
+	}
+	if keys != b.infraKeys {
+		b.infraKeys = keys
+		b.conn = nil
+	}
+}
+
+func (b *S3Backend) GetBlob(k string) (*BlobData, error) {
+	data, err := b.getObject(b.key("blob", k))
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	return &BlobData{
+		Key:  k,
+		Data: bytes.NewReader(data),
+	}, nil
+}
+
+func (b *S3Backend) PutBlob(k string, d *BlobData) error {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, d.Data); err != nil {
+		return err
+	}
+
+	return b.putObject(b.key("blob", k), buf.Bytes())
+}
+
+func (b *S3Backend) DeleteBlob(k string) error {
+	return b.deleteObject(b.key("blob", k))
+}
+
+func (b *S3Backend) GetInfra(infra *Infra) (*Infra, error) {
+	var result Infra
+	ok, err := b.get(b.infraKey(infra), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *S3Backend) PutInfra(infra *Infra) error {
+	if infra.ID == "" {
+		infra.setId()
+	}
+
+	return b.put(b.infraKey(infra), infra)
+}
+
+func (b *S3Backend) GetCreds(creds *Creds) (*Creds, error) {
+	var result Creds
+	ok, err := b.get(b.key("infra", creds.Lookup.Infra, "creds"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *S3Backend) PutCreds(creds *Creds) error {
+	return b.put(b.key("infra", creds.Lookup.Infra, "creds"), creds)
+}
+
+func (b *S3Backend) GetDev(dev *Dev) (*Dev, error) {
+	var result Dev
+	ok, err := b.get(b.key("apps", dev.Lookup.AppID, "dev"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *S3Backend) PutDev(dev *Dev) error {
+	if dev.ID == "" {
+		dev.setId()
+	}
+
+	return b.put(b.key("apps", dev.Lookup.AppID, "dev"), dev)
+}
+
+func (b *S3Backend) DeleteDev(dev *Dev) error {
+	return b.deleteObject(b.key("apps", dev.Lookup.AppID, "dev"))
+}
+
+func (b *S3Backend) GetBuild(build *Build) (*Build, error) {
+	var result Build
+	ok, err := b.get(b.appKey(&build.Lookup, "build"), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *S3Backend) PutBuild(build *Build) error {
+	// Every build is a new one in the history
+	build.setId()
+
+	return b.withLock(b.appKey(&build.Lookup, "lock"), func() error {
+		seq, err := b.nextSequence(b.appKey(&build.Lookup, "builds"), 0)
+		if err != nil {
+			return err
+		}
+
+		// Store the build in the history, then as the latest
+		key := b.appKey(&build.Lookup, "builds", s3Sequence(seq))
+		if err := b.put(key, build); err != nil {
+			return err
+		}
+
+		return b.put(b.appKey(&build.Lookup, "build"), build)
+	})
+}
+
+func (b *S3Backend) ListBuilds(lookup *Lookup) ([]*Build, error) {
+	prefix := b.key("apps") + "/"
+	if lookup.AppID != "" {
+		prefix = b.key("apps", lookup.AppID) + "/"
+	}
+
+	keys, err := b.listObjects(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// The keys are sorted, and the sequences are zero-padded, so each
+	// history is already in order.
+	var result []*Build
+	for _, k := range keys {
+		if path.Base(path.Dir(k)) != "builds" {
+			continue
+		}
+
+		var build Build
+		ok, err := b.get(k, &build)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if lookup.Infra != "" && build.Lookup.Infra != lookup.Infra {
+			continue
+		}
+		if lookup.InfraFlavor != "" && build.Lookup.InfraFlavor != lookup.InfraFlavor {
+			continue
+		}
+
+		result = append(result, &build)
+	}
+
+	// Each history is in order, but the histories need to be merged
+	sort.Stable(buildsByTime(result))
+	return result, nil
+}
+
+func (b *S3Backend) DeleteBuild(build *Build) error {
+	return b.withLock(b.appKey(&build.Lookup, "lock"), func() error {
+		return b.deleteTree(
+			b.appKey(&build.Lookup, "build"),
+			b.appKey(&build.Lookup, "builds"))
+	})
+}
+
+func (b *S3Backend) GetDeploy(deploy *Deploy) (*Deploy, error) {
+	key, _ := deploy.keys()
+	var result Deploy
+	ok, err := b.get(b.appKey(&deploy.Lookup, key), &result)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (b *S3Backend) PutDeploy(deploy *Deploy) error {
+	if deploy.ID == "" {
+		deploy.setId()
+	}
+
+	key, historyKey := deploy.keys()
+
+	return b.withLock(b.appKey(&deploy.Lookup, "lock"), func() error {
+		if deploy.Version == 0 {
+			// The latest deploy is also checked since a listing may
+			// not include a version that was just written.
+			var latest Deploy
+			if _, err := b.get(b.appKey(&deploy.Lookup, key), &latest); err != nil {
+				return err
+			}
+
+			var err error
+			deploy.Version, err = b.nextSequence(
+				b.appKey(&deploy.Lookup, historyKey), latest.Version)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Store the version in the history, then as the latest
+		versionKey := b.appKey(&deploy.Lookup, historyKey, s3Sequence(deploy.Version))
+		if err := b.put(versionKey, deploy); err != nil {
+			return err
+		}
+
+		return b.put(b.appKey(&deploy.Lookup, key), deploy)
+	})
+}
+
+func (b *S3Backend) ListDeploys(deploy *Deploy) ([]*Deploy, error) {
+	_, historyKey := deploy.keys()
+	keys, err := b.listObjects(b.appKey(&deploy.Lookup, historyKey) + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	// The versions are zero-padded so the keys are in version order
+	var result []*Deploy
+	for _, k := range keys {
+		var d Deploy
+		ok, err := b.get(k, &d)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, &d)
+		}
+	}
+
+	return result, nil
+}
+
+func (b *S3Backend) DeleteDeploy(deploy *Deploy) error {
+	key, historyKey := deploy.keys()
+	return b.withLock(b.appKey(&deploy.Lookup, "lock"), func() error {
+		return b.deleteTree(
+			b.appKey(&deploy.Lookup, key),
+			b.appKey(&deploy.Lookup, historyKey))
+	})
+}
+
+// deleteTree deletes the latest record at key and its history.
+func (b *S3Backend) deleteTree(key, history string) error {
+	keys, err := b.listObjects(history + "/")
+	if err != nil {
+		return err
+	}
+
+	for _, k := range append(keys, key) {
+		if err := b.deleteObject(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withLock calls f while holding the lock object at key.
+func (b *S3Backend) withLock(key string, f func() error) error {
+	lock := &s3Lock{ID: uuid.GenerateUUID()}
+	deadline := time.Now().Add(s3LockTimeout)
+	for {
+		ok, err := b.tryLock(key, lock)
+		if err != nil {
+			return err
+		}
+		if ok {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf(
+				"Timed out waiting for the lock %s in S3. If no other Otto\n"+
+					"is running, the lock is left over and will expire by\n"+
+					"itself, or it can be deleted from the bucket.", key)
+		}
+
+		time.Sleep(s3LockSettle)
+	}
+	defer b.deleteObject(key)
+
+	return f()
+}
+
+// Lock implements Backend with a lock object, like the one used for
+// writes. The lock object is rewritten while it is held so that it
+// doesn't go stale during a long build or deploy.
+func (b *S3Backend) Lock(key string) (func(), error) {
+	key = b.key("locks", key)
+	lock := &s3Lock{ID: uuid.GenerateUUID()}
+	ok, err := b.tryLock(key, lock)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrLocked
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(s3LockStale / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				lock.Created = time.Now().UTC()
+				b.put(key, lock)
+			case <-doneCh:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(doneCh)
+			b.deleteObject(key)
+		})
+	}, nil
+}
+
+// tryLock makes one attempt to take the lock object at key, returning
+// whether it was taken.
+func (b *S3Backend) tryLock(key string, lock *s3Lock) (bool, error) {
+	var current s3Lock
+	ok, err := b.getUncached(key, &current)
+	if err != nil {
+		return false, err
+	}
+	if ok && time.Since(current.Created) <= s3LockStale {
+		return false, nil
+	}
+
+	// Take the lock since it is free, then check that no one else
+	// took it at the same time.
+	lock.Created = time.Now().UTC()
+	if err := b.put(key, lock); err != nil {
+		return false, err
+	}
+
+	time.Sleep(s3LockSettle)
+	ok, err = b.getUncached(key, &current)
+	if err != nil {
+		return false, err
+	}
+
+	return ok && current.ID == lock.ID, nil
+}
+
+// nextSequence returns the next sequence in the history under prefix,
+// which is after min. This must be called while holding the lock for
+// the history.
+func (b *S3Backend) nextSequence(prefix string, min uint64) (uint64, error) {
+	keys, err := b.listObjects(prefix + "/")
+	if err != nil {
+		return 0, err
+	}
+
+	last := min
+	for _, k := range keys {
+		v, err := strconv.ParseUint(path.Base(k), 10, 64)
+		if err == nil && v > last {
+			last = v
+		}
+	}
+
+	return last + 1, nil
+}
+
+// s3Sequence is the key in a history for a sequence. It is zero-padded
+// so that the keys sort in order.
+func s3Sequence(v uint64) string {
+	return fmt.Sprintf("%020d", v)
+}
+
+func (b *S3Backend) get(key string, d interface{}) (bool, error) {
+	data, err := b.getObject(key)
+	if err != nil {
+		return false, err
+	}
+	if data == nil {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(data, d); err != nil {
+		return false, fmt.Errorf("Error reading %s: %s", key, err)
+	}
+
+	return true, nil
+}
+
+// getUncached is like get, but always reads from S3. This is used for
+// the locks, which other Otto runs write.
+func (b *S3Backend) getUncached(key string, d interface{}) (bool, error) {
+	b.lock.Lock()
+	delete(b.written, key)
+	b.lock.Unlock()
+
+	return b.get(key, d)
+}
+
+func (b *S3Backend) put(key string, d interface{}) error {
+	// Human-readable like the BoltBackend to make it easy to debug
+	data, err := json.MarshalIndent(d, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return b.putObject(key, data)
+}
+
+func (b *S3Backend) getObject(key string) ([]byte, error) {
+	b.lock.Lock()
+	data, ok := b.written[key]
+	b.lock.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	resp, err := b.s3().GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(b.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchKey" {
+			return nil, nil
+		}
+
+		if cerr := s3ConnectionErr(err); cerr != nil {
+			return nil, cerr
+		}
+
+		return nil, fmt.Errorf("Error reading %s from S3: %s", key, err)
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (b *S3Backend) putObject(key string, data []byte) error {
+	_, err := b.s3().PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(b.Bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		if cerr := s3ConnectionErr(err); cerr != nil {
+			return cerr
+		}
+
+		return fmt.Errorf("Error writing %s to S3: %s", key, err)
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.written == nil {
+		b.written = make(map[string][]byte)
+	}
+	b.written[key] = data
+	return nil
+}
+
+func (b *S3Backend) deleteObject(key string) error {
+	_, err := b.s3().DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(b.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if cerr := s3ConnectionErr(err); cerr != nil {
+			return cerr
+		}
+
+		return fmt.Errorf("Error deleting %s from S3: %s", key, err)
+	}
+
+	// A nil entry records the delete so a stale read can't bring it back
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.written == nil {
+		b.written = make(map[string][]byte)
+	}
+	b.written[key] = nil
+	return nil
+}
+
+// listObjects returns the keys of every object under prefix, sorted. A
+// listing may not include objects that were just written, so the keys
+// written by this backend are merged in.
+func (b *S3Backend) listObjects(prefix string) ([]string, error) {
+	keys := make(map[string]struct{})
+	err := b.s3().ListObjectsPages(&s3.ListObjectsInput{
+		Bucket: aws.String(b.Bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			keys[*obj.Key] = struct{}{}
+		}
+
+		return true
+	})
+	if err != nil {
+		if cerr := s3ConnectionErr(err); cerr != nil {
+			return nil, cerr
+		}
+
+		return nil, fmt.Errorf("Error listing %s in S3: %s", prefix, err)
+	}
+
+	b.lock.Lock()
+	for k, data := range b.written {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		if data == nil {
+			delete(keys, k)
+		} else {
+			keys[k] = struct{}{}
+		}
+	}
+	b.lock.Unlock()
+
+	result := make([]string, 0, len(keys))
+	for k := range keys {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// s3ConnectionErr returns a *ConnectionError if err shows that S3
+// couldn't be reached, or nil otherwise.
+func s3ConnectionErr(err error) error {
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "RequestError" {
+		return &ConnectionError{Backend: "S3", Err: err}
+	}
+
+	return nil
+}
+
+// s3 returns the S3 client, creating it if needed.
+func (b *S3Backend) s3() *s3.S3 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.conn != nil {
+		return b.conn
+	}
+
+	region := b.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	config := aws.NewConfig().WithRegion(region)
+	accessKey, secretKey, token := b.AccessKey, b.SecretKey, ""
+	if accessKey == "" && secretKey == "" {
+		accessKey, secretKey, token = b.infraKeys[0], b.infraKeys[1], b.infraKeys[2]
+	}
+	if accessKey != "" || secretKey != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			accessKey, secretKey, token))
+	}
+
+	b.conn = s3.New(session.New(config))
+	return b.conn
+}
+
+func (b *S3Backend) key(parts ...string) string {
+	prefix := b.Prefix
+	if prefix == "" {
+		prefix = "otto"
+	}
+
+	return strings.Join(append([]string{prefix}, parts...), "/")
+}
+
+func (b *S3Backend) appKey(lookup *Lookup, parts ...string) string {
+	infra := fmt.Sprintf("%s-%s", lookup.Infra, lookup.InfraFlavor)
+	return b.key(append([]string{"apps", lookup.AppID, infra}, parts...)...)
+}
+
+func (b *S3Backend) infraKey(infra *Infra) string {
+	key := "root"
+	if infra.Lookup.Foundation != "" {
+		key = fmt.Sprintf("foundation-%s", infra.Lookup.Foundation)
+	}
+
+	return b.key("infra", infra.Lookup.Infra, key)
+}
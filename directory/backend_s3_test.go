@@ -0,0 +1,32 @@
+package directory
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/otto/helper/uuid"
+)
+
+func TestS3Backend_impl(t *testing.T) {
+	var _ Backend = new(S3Backend)
+	var _ InfraCredsBackend = new(S3Backend)
+}
+
+func TestS3Backend(t *testing.T) {
+	// This test uses a real bucket, with the credentials from the
+	// environment, so it only runs when one is given.
+	bucket := os.Getenv("OTTO_TEST_S3_BUCKET")
+	if bucket == "" {
+		t.Skip("OTTO_TEST_S3_BUCKET not set")
+	}
+
+	defer func(d time.Duration) { s3LockSettle = d }(s3LockSettle)
+	s3LockSettle = 100 * time.Millisecond
+
+	TestBackend(t, &S3Backend{
+		Bucket: bucket,
+		Prefix: "otto-test/" + uuid.GenerateUUID(),
+		Region: os.Getenv("AWS_DEFAULT_REGION"),
+	})
+}
@@ -0,0 +1,62 @@
+package directory
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+)
+
+// BuildLogMaxSize is the most of a build log that is stored, before
+// compression. Only the end of a longer log is kept since that is where
+// a failed build shows what went wrong.
+const BuildLogMaxSize = 1024 * 1024
+
+// PutBuildLog stores the log of the latest build of an app, replacing
+// the log of the build before it. The log is stored as a compressed blob
+// so that it doesn't bloat the directory. The AppID, Infra, and
+// InfraFlavor fields of the lookup are used.
+func PutBuildLog(b Backend, lookup *Lookup, log []byte) error {
+	if len(log) > BuildLogMaxSize {
+		log = log[len(log)-BuildLogMaxSize:]
+
+		// Start at a whole line so the log doesn't begin mid-sentence
+		if i := bytes.IndexByte(log, '\n'); i >= 0 {
+			log = log[i+1:]
+		}
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(log); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return b.PutBlob(buildLogKey(lookup), &BlobData{Data: &buf})
+}
+
+// GetBuildLog returns the log stored with PutBuildLog, or nil if there
+// is none.
+func GetBuildLog(b Backend, lookup *Lookup) ([]byte, error) {
+	data, err := b.GetBlob(buildLogKey(lookup))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	defer data.Close()
+
+	r, err := gzip.NewReader(data.Data)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading build log: %s", err)
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+func buildLogKey(lookup *Lookup) string {
+	return fmt.Sprintf(
+		"build-log/%s/%s-%s", lookup.AppID, lookup.Infra, lookup.InfraFlavor)
+}
@@ -0,0 +1,84 @@
+package directory
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestBuildLog(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	b := &BoltBackend{Dir: td}
+	lookup := &Lookup{AppID: "foo", Infra: "aws", InfraFlavor: "simple"}
+
+	// No log yet
+	log, err := GetBuildLog(b, lookup)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if log != nil {
+		t.Fatalf("bad: %q", log)
+	}
+
+	if err := PutBuildLog(b, lookup, []byte("hello\nworld\n")); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	log, err = GetBuildLog(b, lookup)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(log) != "hello\nworld\n" {
+		t.Fatalf("bad: %q", log)
+	}
+
+	// Other infrastructures have their own log
+	log, err = GetBuildLog(b, &Lookup{AppID: "foo", Infra: "aws", InfraFlavor: "vpc"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if log != nil {
+		t.Fatalf("bad: %q", log)
+	}
+}
+
+func TestBuildLog_truncate(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	b := &BoltBackend{Dir: td}
+	lookup := &Lookup{AppID: "foo", Infra: "aws", InfraFlavor: "simple"}
+
+	line := strings.Repeat("x", 99) + "\n"
+	var buf bytes.Buffer
+	for buf.Len() <= BuildLogMaxSize {
+		buf.WriteString(line)
+	}
+	buf.WriteString("the end\n")
+
+	if err := PutBuildLog(b, lookup, buf.Bytes()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	log, err := GetBuildLog(b, lookup)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(log) > BuildLogMaxSize {
+		t.Fatalf("too long: %d", len(log))
+	}
+	if !strings.HasPrefix(string(log), line) {
+		t.Fatalf("should start at a line: %q", log[:200])
+	}
+	if !strings.HasSuffix(string(log), "the end\n") {
+		t.Fatal("should keep the end")
+	}
+}
@@ -0,0 +1,71 @@
+package directory
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"time"
+)
+
+// ConnectionError is returned by a Backend when the service or file that
+// stores the directory can't be reached, such as when Consul is down or
+// the local database can't be opened. Unlike other errors, the operation
+// may succeed if it is tried again later.
+type ConnectionError struct {
+	// Backend is the name of the backend, such as "Consul".
+	Backend string
+
+	// Err is the error that the backend failed with.
+	Err error
+}
+
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("Error connecting to the %s directory: %s", e.Backend, e.Err)
+}
+
+// IsConnectionError returns true if err is a *ConnectionError.
+func IsConnectionError(err error) bool {
+	_, ok := err.(*ConnectionError)
+	return ok
+}
+
+// ConnectionRetryWaits are how long RetryConnection waits before each
+// retry. The number of waits is the number of retries.
+var ConnectionRetryWaits = []time.Duration{
+	1 * time.Second,
+	2 * time.Second,
+	4 * time.Second,
+	8 * time.Second,
+}
+
+// RetryConnection calls f, and calls it again after each of the
+// ConnectionRetryWaits for as long as it fails with a *ConnectionError.
+// The error of the last call is returned.
+func RetryConnection(f func() error) error {
+	err := f()
+	for i, wait := range ConnectionRetryWaits {
+		if !IsConnectionError(err) {
+			break
+		}
+
+		log.Printf(
+			"[WARN] directory unreachable, retrying in %s (retry %d of %d): %s",
+			wait, i+1, len(ConnectionRetryWaits), err)
+		time.Sleep(wait)
+		err = f()
+	}
+
+	return err
+}
+
+// connectionErr returns err as a *ConnectionError from the given backend
+// if it is a network error, and returns it as-is otherwise.
+func connectionErr(backend string, err error) error {
+	switch err.(type) {
+	case net.Error, *url.Error:
+		return &ConnectionError{Backend: backend, Err: err}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,62 @@
+package directory
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRetryConnection(t *testing.T) {
+	old := ConnectionRetryWaits
+	defer func() { ConnectionRetryWaits = old }()
+	ConnectionRetryWaits = []time.Duration{0, 0}
+
+	connErr := &ConnectionError{Backend: "test", Err: errors.New("down")}
+	cases := []struct {
+		Errs  []error
+		Calls int
+		Err   error
+	}{
+		{[]error{nil}, 1, nil},
+		{[]error{connErr, nil}, 2, nil},
+		{[]error{connErr, connErr, connErr}, 3, connErr},
+		{[]error{ErrLocked}, 1, ErrLocked},
+	}
+
+	for i, tc := range cases {
+		calls := 0
+		err := RetryConnection(func() error {
+			calls++
+			return tc.Errs[calls-1]
+		})
+		if err != tc.Err {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if calls != tc.Calls {
+			t.Fatalf("%d: %d calls", i, calls)
+		}
+	}
+}
+
+func TestBoltBackend_connectionError(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// The directory of the DB is a file, so the DB can't be opened
+	path := filepath.Join(td, "file")
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b := &BoltBackend{Dir: path}
+	_, err = b.GetInfra(&Infra{Lookup: Lookup{Infra: "foo"}})
+	if !IsConnectionError(err) {
+		t.Fatalf("bad: %#v", err)
+	}
+}
@@ -0,0 +1,13 @@
+package directory
+
+// Creds are the credentials of an infrastructure, stored in the directory
+// so that everyone sharing it doesn't have to enter them again.
+type Creds struct {
+	// Lookup information for the Creds. The only required field is Infra.
+	Lookup
+
+	// Data is the encrypted credentials. The directory stores it as-is
+	// and never sees the plaintext, so the credentials are encrypted at
+	// rest in every backend.
+	Data []byte
+}
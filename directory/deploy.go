@@ -1,6 +1,8 @@
 package directory
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/otto/helper/uuid"
 )
 
@@ -10,16 +12,93 @@ type Deploy struct {
 	// are required.
 	Lookup
 
+	// Environment is the name of the Appfile environment that was
+	// deployed, such as "production". Each environment has its own
+	// deploys, so this is used for lookups as well. It is empty for the
+	// default environment.
+	Environment string `json:"environment,omitempty"`
+
 	// These fields should be set for Put and will be populated on Get
 	State  DeployState       // State of the deploy
 	Deploy map[string]string // Deploy information
 
+	// Outputs are the output data from the deploy, such as the address
+	// the app can be reached at. This is dependent on each app type.
+	Outputs map[string]string
+
+	// Artifact are the variables for the build artifact that was
+	// deployed, so that the deploy can be repeated (such as to roll
+	// back to it).
+	Artifact map[string]string `json:"artifact"`
+
+	// BuildID is the ID of the build the artifact came from. A build
+	// promoted from another environment keeps its ID, so the deploys of
+	// every environment show which build they run. It is empty if the
+	// artifact didn't come from a build with an ID.
+	BuildID string `json:"build_id,omitempty"`
+
+	// VarsHash is a hash of the variables (including the artifact) and
+	// the configuration of the deploy. A deploy with the same hash as
+	// the last successful one is skipped, since it wouldn't change
+	// anything.
+	VarsHash string `json:"vars_hash,omitempty"`
+
+	// Version is the version of this deploy in the deploy history. This
+	// is set by PutDeploy. Use MarkNewVersion to record a new version
+	// rather than updating the current one.
+	Version uint64 `json:"version"`
+
+	// ActiveColor and InactiveColor are the two sets of instances of a
+	// blue-green deploy. The load balancer sends traffic to the active
+	// color. ColorArtifacts are the artifact variables last deployed to
+	// each color, so a rollback can flip back to the inactive color.
+	// These are empty for deploys that replace instances in place.
+	ActiveColor    string                       `json:"active_color,omitempty"`
+	InactiveColor  string                       `json:"inactive_color,omitempty"`
+	ColorArtifacts map[string]map[string]string `json:"color_artifacts,omitempty"`
+
+	// Changes are the changes to resources that the deploy made, as
+	// planned by Terraform before applying, so the history shows what
+	// each deploy did.
+	Changes []*DeployChange `json:"changes,omitempty"`
+
 	// Private fields. These are usually set on Get or Put.
 	//
 	// DO NOT MODIFY THESE.
 	ID string
 }
 
+// DeployChange is a change a deploy made to a single resource.
+type DeployChange struct {
+	Action   string `json:"action"`   // Action is one of the DeployChange constants
+	Resource string `json:"resource"` // Resource is the address, i.e. "aws_instance.app"
+}
+
+// The actions of a DeployChange. A resource that can't be updated in
+// place is replaced: destroyed and created again.
+const (
+	DeployChangeAdd     = "add"
+	DeployChangeChange  = "change"
+	DeployChangeDestroy = "destroy"
+	DeployChangeReplace = "replace"
+)
+
+func (c *DeployChange) String() string {
+	verb := c.Action
+	switch c.Action {
+	case DeployChangeAdd:
+		verb = "added"
+	case DeployChangeChange:
+		verb = "changed"
+	case DeployChangeDestroy:
+		verb = "destroyed"
+	case DeployChangeReplace:
+		verb = "replaced"
+	}
+
+	return fmt.Sprintf("%s %s", verb, c.Resource)
+}
+
 // IsNew reports if this deploy is freshly created and not yet run
 func (d *Deploy) IsNew() bool {
 	return d != nil && d.State == DeployStateNew
@@ -35,6 +114,17 @@ func (d *Deploy) IsFailed() bool {
 	return d != nil && d.State == DeployStateFail
 }
 
+// IsDestroyed reports if this deploy was destroyed.
+func (d *Deploy) IsDestroyed() bool {
+	return d != nil && d.State == DeployStateDestroyed
+}
+
+// IsPartial reports if only some resources of this deploy were applied,
+// such as with a targeted apply.
+func (d *Deploy) IsPartial() bool {
+	return d != nil && d.State == DeployStatePartial
+}
+
 // MarkFailed sets a deploy's state to failed
 func (d *Deploy) MarkFailed() {
 	d.State = DeployStateFail
@@ -45,11 +135,37 @@ func (d *Deploy) MarkSuccessful() {
 	d.State = DeployStateSuccess
 }
 
+// MarkPartial sets a deploy's state to partial
+func (d *Deploy) MarkPartial() {
+	d.State = DeployStatePartial
+}
+
+// MarkDestroyed sets a deploy's state to destroyed
+func (d *Deploy) MarkDestroyed() {
+	d.State = DeployStateDestroyed
+}
+
+// MarkNewVersion makes the next PutDeploy store this deploy as a new
+// version, keeping the current version in the history.
+func (d *Deploy) MarkNewVersion() {
+	d.Version = 0
+}
+
 // MarkGone resets a deploy's state to the "new" state
 func (d *Deploy) MarkGone() {
 	d.State = DeployStateNew
 }
 
+// keys returns the keys that the latest deploy and the history of
+// deploys are stored under for the environment of the deploy.
+func (d *Deploy) keys() (string, string) {
+	if d.Environment == "" {
+		return "deploy", "deploys"
+	}
+
+	return "deploy-" + d.Environment, "deploys-" + d.Environment
+}
+
 func (d *Deploy) setId() {
 	d.ID = uuid.GenerateUUID()
 }
@@ -14,4 +14,6 @@ const (
 	DeployStateNew     DeployState = iota
 	DeployStateFail
 	DeployStateSuccess
+	DeployStateDestroyed
+	DeployStatePartial
 )
@@ -4,9 +4,9 @@ package directory
 
 import "fmt"
 
-const _DeployState_name = "DeployStateInvalidDeployStateNewDeployStateFailDeployStateSuccess"
+const _DeployState_name = "DeployStateInvalidDeployStateNewDeployStateFailDeployStateSuccessDeployStateDestroyedDeployStatePartial"
 
-var _DeployState_index = [...]uint8{0, 18, 32, 47, 65}
+var _DeployState_index = [...]uint8{0, 18, 32, 47, 65, 85, 103}
 
 func (i DeployState) String() string {
 	if i >= DeployState(len(_DeployState_index)-1) {
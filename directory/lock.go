@@ -0,0 +1,18 @@
+package directory
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLocked is returned by Backend.Lock when the lock is already held,
+// usually by another Otto run against the same directory.
+var ErrLocked = errors.New("another operation is in progress")
+
+// AppLockKey returns the key of the lock that guards the builds and
+// deploys of an app. The AppID, Infra, and InfraFlavor fields of the
+// lookup are used.
+func AppLockKey(lookup *Lookup) string {
+	return fmt.Sprintf(
+		"apps/%s/%s-%s", lookup.AppID, lookup.Infra, lookup.InfraFlavor)
+}
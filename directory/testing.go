@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestBackend is a public test helper that verifies a backend
@@ -48,6 +49,24 @@ func TestBackend(t *testing.T, b Backend) {
 		t.Fatalf("GetBlob bad data: %s", buf.String())
 	}
 
+	// DeleteBlob
+	if err := b.DeleteBlob("foo"); err != nil {
+		t.Fatalf("DeleteBlob error: %s", err)
+	}
+	data, err = b.GetBlob("foo")
+	if err != nil {
+		t.Fatalf("GetBlob error: %s", err)
+	}
+	if data != nil {
+		data.Close()
+		t.Fatalf("GetBlob should be nil data after delete")
+	}
+
+	// DeleteBlob (doesn't exist)
+	if err := b.DeleteBlob("foo"); err != nil {
+		t.Fatalf("DeleteBlob error: %s", err)
+	}
+
 	//---------------------------------------------------------------
 	// Infra
 	//---------------------------------------------------------------
@@ -114,6 +133,161 @@ func TestBackend(t *testing.T, b Backend) {
 		t.Fatalf("GetInfra (exist) bad: %#v", actualInfra)
 	}
 
+	//---------------------------------------------------------------
+	// Creds
+	//---------------------------------------------------------------
+
+	// GetCreds (doesn't exist)
+	creds := &Creds{Lookup: Lookup{Infra: "foo"}}
+	actualCreds, err := b.GetCreds(creds)
+	if err != nil {
+		t.Fatalf("GetCreds (non-exist) error: %s", err)
+	}
+	if actualCreds != nil {
+		t.Fatal("GetCreds (non-exist): creds should be nil")
+	}
+
+	// PutCreds
+	creds.Data = []byte("encrypted")
+	if err := b.PutCreds(creds); err != nil {
+		t.Fatalf("PutCreds err: %s", err)
+	}
+
+	// GetCreds (exists)
+	actualCreds, err = b.GetCreds(creds)
+	if err != nil {
+		t.Fatalf("GetCreds (exist) error: %s", err)
+	}
+	if !reflect.DeepEqual(actualCreds, creds) {
+		t.Fatalf("GetCreds (exist) bad: %#v", actualCreds)
+	}
+
+	// The infra of the creds is unaffected
+	actualInfra, err = b.GetInfra(&Infra{Lookup: Lookup{Infra: "foo"}})
+	if err != nil {
+		t.Fatalf("GetInfra (exist) error: %s", err)
+	}
+	if actualInfra == nil || actualInfra.Outputs["foo"] != "bar" {
+		t.Fatalf("GetInfra (exist) bad: %#v", actualInfra)
+	}
+
+	//---------------------------------------------------------------
+	// Build
+	//---------------------------------------------------------------
+
+	// GetBuild (doesn't exist)
+	build := &Build{Lookup: Lookup{
+		AppID: "foo", Infra: "bar", InfraFlavor: "baz"}}
+	buildResult, err := b.GetBuild(build)
+	if err != nil {
+		t.Fatalf("GetBuild (non-exist) error: %s", err)
+	}
+	if buildResult != nil {
+		t.Fatal("GetBuild (non-exist): result should be nil")
+	}
+
+	// PutBuild
+	build.Artifact = map[string]string{"foo": "bar"}
+	build.CreatedAt = time.Date(2015, 10, 1, 12, 0, 0, 0, time.UTC)
+	build.GitSHA = "abc123"
+	build.Builder = "otto"
+	if err := b.PutBuild(build); err != nil {
+		t.Fatalf("PutBuild err: %s", err)
+	}
+
+	// GetBuild (exists)
+	buildResult, err = b.GetBuild(build)
+	if err != nil {
+		t.Fatalf("GetBuild (exist) error: %s", err)
+	}
+	if !reflect.DeepEqual(buildResult, build) {
+		t.Fatalf("GetBuild (exist) bad: %#v", buildResult)
+	}
+
+	// PutBuild (another build)
+	oldBuild := *build
+	build.Artifact = map[string]string{"foo": "baz"}
+	build.CreatedAt = build.CreatedAt.Add(time.Hour)
+	if err := b.PutBuild(build); err != nil {
+		t.Fatalf("PutBuild err: %s", err)
+	}
+	if build.ID == "" || build.ID == oldBuild.ID {
+		t.Fatalf("PutBuild should set a new ID: %q", build.ID)
+	}
+
+	// GetBuild (latest)
+	buildResult, err = b.GetBuild(build)
+	if err != nil {
+		t.Fatalf("GetBuild (latest) error: %s", err)
+	}
+	if !reflect.DeepEqual(buildResult, build) {
+		t.Fatalf("GetBuild (latest) bad: %#v", buildResult)
+	}
+
+	// PutBuild (another infra)
+	otherBuild := &Build{
+		Lookup:    Lookup{AppID: "foo", Infra: "bar", InfraFlavor: "other"},
+		Artifact:  map[string]string{"foo": "qux"},
+		CreatedAt: build.CreatedAt.Add(time.Hour),
+	}
+	if err := b.PutBuild(otherBuild); err != nil {
+		t.Fatalf("PutBuild err: %s", err)
+	}
+
+	// ListBuilds
+	buildList, err := b.ListBuilds(&Lookup{AppID: "foo"})
+	if err != nil {
+		t.Fatalf("ListBuilds error: %s", err)
+	}
+	if !reflect.DeepEqual(buildList, []*Build{&oldBuild, build, otherBuild}) {
+		t.Fatalf("ListBuilds bad: %#v", buildList)
+	}
+
+	// ListBuilds (filtered)
+	buildList, err = b.ListBuilds(&Lookup{AppID: "foo", InfraFlavor: "other"})
+	if err != nil {
+		t.Fatalf("ListBuilds (filtered) error: %s", err)
+	}
+	if !reflect.DeepEqual(buildList, []*Build{otherBuild}) {
+		t.Fatalf("ListBuilds (filtered) bad: %#v", buildList)
+	}
+
+	// ListBuilds (doesn't exist)
+	buildList, err = b.ListBuilds(&Lookup{AppID: "nope"})
+	if err != nil {
+		t.Fatalf("ListBuilds (non-exist) error: %s", err)
+	}
+	if len(buildList) != 0 {
+		t.Fatalf("ListBuilds (non-exist) bad: %#v", buildList)
+	}
+
+	// DeleteBuild
+	if err := b.DeleteBuild(build); err != nil {
+		t.Fatalf("DeleteBuild error: %s", err)
+	}
+	buildResult, err = b.GetBuild(build)
+	if err != nil {
+		t.Fatalf("GetBuild (deleted) error: %s", err)
+	}
+	if buildResult != nil {
+		t.Fatalf("GetBuild (deleted) bad: %#v", buildResult)
+	}
+	buildList, err = b.ListBuilds(&Lookup{AppID: "foo"})
+	if err != nil {
+		t.Fatalf("ListBuilds (deleted) error: %s", err)
+	}
+	if !reflect.DeepEqual(buildList, []*Build{otherBuild}) {
+		t.Fatalf("ListBuilds (deleted) bad: %#v", buildList)
+	}
+
+	// DeleteBuild (doesn't exist)
+	if err := b.DeleteBuild(build); err != nil {
+		t.Fatalf("DeleteBuild (non-exist) error: %s", err)
+	}
+	if err := b.DeleteBuild(&Build{Lookup: Lookup{AppID: "nope"}}); err != nil {
+		t.Fatalf("DeleteBuild (non-exist) error: %s", err)
+	}
+
 	//---------------------------------------------------------------
 	// Deploy
 	//---------------------------------------------------------------
@@ -149,6 +323,116 @@ func TestBackend(t *testing.T, b Backend) {
 		t.Fatalf("GetDeploy (exist) bad: %#v", deployResult)
 	}
 
+	// PutDeploy (exists)
+	deploy.Outputs = map[string]string{"foo": "bar"}
+	if err := b.PutDeploy(deploy); err != nil {
+		t.Fatalf("PutDeploy err: %s", err)
+	}
+
+	// GetDeploy (exists)
+	deployResult, err = b.GetDeploy(deploy)
+	if err != nil {
+		t.Fatalf("GetDeploy (exist) error: %s", err)
+	}
+	if !reflect.DeepEqual(deployResult, deploy) {
+		t.Fatalf("GetDeploy (exist) bad: %#v", deployResult)
+	}
+
+	// PutDeploy (new version)
+	oldDeploy := *deploy
+	deploy.MarkNewVersion()
+	deploy.Artifact = map[string]string{"ami": "ami-123456"}
+	if err := b.PutDeploy(deploy); err != nil {
+		t.Fatalf("PutDeploy err: %s", err)
+	}
+	if deploy.Version <= oldDeploy.Version {
+		t.Fatalf("PutDeploy: version not incremented: %d", deploy.Version)
+	}
+
+	// GetDeploy (latest version)
+	deployResult, err = b.GetDeploy(deploy)
+	if err != nil {
+		t.Fatalf("GetDeploy (exist) error: %s", err)
+	}
+	if !reflect.DeepEqual(deployResult, deploy) {
+		t.Fatalf("GetDeploy (exist) bad: %#v", deployResult)
+	}
+
+	// ListDeploys
+	deploys, err := b.ListDeploys(deploy)
+	if err != nil {
+		t.Fatalf("ListDeploys error: %s", err)
+	}
+	expectedDeploys := []*Deploy{&oldDeploy, deploy}
+	if !reflect.DeepEqual(deploys, expectedDeploys) {
+		t.Fatalf("ListDeploys bad: %#v", deploys)
+	}
+
+	// ListDeploys (doesn't exist)
+	deploys, err = b.ListDeploys(&Deploy{Lookup: Lookup{
+		AppID: "foo", Infra: "bar", InfraFlavor: "qux"}})
+	if err != nil {
+		t.Fatalf("ListDeploys error: %s", err)
+	}
+	if len(deploys) != 0 {
+		t.Fatalf("ListDeploys (non-exist) bad: %#v", deploys)
+	}
+
+	// PutDeploy (other environment)
+	envDeploy := &Deploy{Lookup: deploy.Lookup, Environment: "production"}
+	if err := b.PutDeploy(envDeploy); err != nil {
+		t.Fatalf("PutDeploy (environment) err: %s", err)
+	}
+	deployResult, err = b.GetDeploy(&Deploy{
+		Lookup: deploy.Lookup, Environment: "production"})
+	if err != nil {
+		t.Fatalf("GetDeploy (environment) error: %s", err)
+	}
+	if !reflect.DeepEqual(deployResult, envDeploy) {
+		t.Fatalf("GetDeploy (environment) bad: %#v", deployResult)
+	}
+	deployResult, err = b.GetDeploy(deploy)
+	if err != nil {
+		t.Fatalf("GetDeploy (exist) error: %s", err)
+	}
+	if !reflect.DeepEqual(deployResult, deploy) {
+		t.Fatalf("GetDeploy (environment collision) bad: %#v", deployResult)
+	}
+	deploys, err = b.ListDeploys(envDeploy)
+	if err != nil {
+		t.Fatalf("ListDeploys (environment) error: %s", err)
+	}
+	if !reflect.DeepEqual(deploys, []*Deploy{envDeploy}) {
+		t.Fatalf("ListDeploys (environment) bad: %#v", deploys)
+	}
+	if err := b.DeleteDeploy(envDeploy); err != nil {
+		t.Fatalf("DeleteDeploy (environment) error: %s", err)
+	}
+
+	// DeleteDeploy
+	if err := b.DeleteDeploy(deploy); err != nil {
+		t.Fatalf("DeleteDeploy error: %s", err)
+	}
+	deployResult, err = b.GetDeploy(deploy)
+	if err != nil {
+		t.Fatalf("GetDeploy (deleted) error: %s", err)
+	}
+	if deployResult != nil {
+		t.Fatalf("GetDeploy (deleted) bad: %#v", deployResult)
+	}
+	deploys, err = b.ListDeploys(deploy)
+	if err != nil {
+		t.Fatalf("ListDeploys (deleted) error: %s", err)
+	}
+	if len(deploys) != 0 {
+		t.Fatalf("ListDeploys (deleted) bad: %#v", deploys)
+	}
+
+	// DeleteDeploy (doesn't exist)
+	if err := b.DeleteDeploy(deploy); err != nil {
+		t.Fatalf("DeleteDeploy (non-exist) error: %s", err)
+	}
+
 	//---------------------------------------------------------------
 	// Dev
 	//---------------------------------------------------------------
@@ -201,4 +485,36 @@ func TestBackend(t *testing.T, b Backend) {
 	if err != nil {
 		t.Fatalf("DeleteDev error: %s", err)
 	}
+
+	//---------------------------------------------------------------
+	// Lock
+	//---------------------------------------------------------------
+
+	lockKey := AppLockKey(&Lookup{AppID: "foo", Infra: "bar", InfraFlavor: "baz"})
+
+	// Lock
+	unlock, err := b.Lock(lockKey)
+	if err != nil {
+		t.Fatalf("Lock error: %s", err)
+	}
+
+	// Lock (held)
+	if _, err := b.Lock(lockKey); err != ErrLocked {
+		t.Fatalf("Lock (held) should be ErrLocked, got: %v", err)
+	}
+
+	// Lock (other key)
+	unlockOther, err := b.Lock(AppLockKey(&Lookup{AppID: "other"}))
+	if err != nil {
+		t.Fatalf("Lock (other key) error: %s", err)
+	}
+	unlockOther()
+
+	// Lock (released)
+	unlock()
+	unlock, err = b.Lock(lockKey)
+	if err != nil {
+		t.Fatalf("Lock (released) error: %s", err)
+	}
+	unlock()
 }
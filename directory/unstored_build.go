@@ -0,0 +1,67 @@
+package directory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// UnstoredBuildFile is the name of the file that a build is saved to
+// when it succeeded but couldn't be stored in the directory, so that it
+// can be stored later without building again.
+const UnstoredBuildFile = "unstored-build.json"
+
+// WriteUnstoredBuild saves the build to UnstoredBuildFile within dir,
+// replacing any build that was saved there before. The path to the file
+// is returned.
+func WriteUnstoredBuild(dir string, b *Build) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, UnstoredBuildFile)
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if err := json.NewEncoder(f).Encode(b); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// ReadUnstoredBuild reads the build saved with WriteUnstoredBuild within
+// dir, or returns nil if there is none.
+func ReadUnstoredBuild(dir string) (*Build, error) {
+	f, err := os.Open(filepath.Join(dir, UnstoredBuildFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	defer f.Close()
+
+	var result Build
+	if err := json.NewDecoder(f).Decode(&result); err != nil {
+		return nil, fmt.Errorf("Error reading unstored build: %s", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteUnstoredBuild deletes the build saved with WriteUnstoredBuild
+// within dir. It is not an error if there is none.
+func DeleteUnstoredBuild(dir string) error {
+	err := os.Remove(filepath.Join(dir, UnstoredBuildFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
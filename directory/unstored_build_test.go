@@ -0,0 +1,57 @@
+package directory
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestUnstoredBuild(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// No build yet
+	b, err := ReadUnstoredBuild(td)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if b != nil {
+		t.Fatalf("bad: %#v", b)
+	}
+
+	build := &Build{
+		Lookup:   Lookup{AppID: "foo", Infra: "aws", InfraFlavor: "simple"},
+		Artifact: map[string]string{"us-east-1": "ami-1"},
+	}
+	if _, err := WriteUnstoredBuild(td, build); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b, err = ReadUnstoredBuild(td)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(b, build) {
+		t.Fatalf("bad: %#v", b)
+	}
+
+	if err := DeleteUnstoredBuild(td); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	b, err = ReadUnstoredBuild(td)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if b != nil {
+		t.Fatalf("bad: %#v", b)
+	}
+
+	// Deleting again is fine
+	if err := DeleteUnstoredBuild(td); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
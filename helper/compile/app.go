@@ -2,15 +2,30 @@ package compile
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/otto/app"
 	"github.com/hashicorp/otto/foundation"
 	"github.com/hashicorp/otto/helper/bindata"
+	"github.com/hashicorp/otto/ui"
 )
 
+// offlineBox is the name of the Vagrant box file in the offline
+// directory that the dev environment uses for offline builds.
+const offlineBox = "dev.box"
+
+// DefaultBuilder is the Packer builder that AWS builds use if the Appfile
+// doesn't set builders.
+const DefaultBuilder = "amazon-ebs"
+
+// validBuilders are the Packer builders that the build templates of app
+// types support in the builders of the Appfile.
+var validBuilders = []string{"amazon-ebs", "amazon-chroot"}
+
 // AppOptions are the options for compiling an application.
 //
 // These options may be modified during customization processing, and
@@ -34,6 +49,17 @@ type AppOptions struct {
 	// in the Appfile. See the Customization docs for more information.
 	Customizations []*Customization
 
+	// Offline is true if the templates of the app type support building
+	// and developing without network access. Compiling an Appfile that
+	// sets build_offline fails if this is false.
+	Offline bool
+
+	// Builders is true if the build templates of the app type build an
+	// image on AWS with a Packer builder for each of the "builders" in
+	// the Appfile. Compiling an Appfile that sets builders fails if this
+	// is false.
+	Builders bool
+
 	// Callbacks are called just prior to compilation completing.
 	Callbacks []CompileCallback
 }
@@ -62,14 +88,85 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 	data.Context["name"] = ctx.Appfile.Application.Name
 	data.Context["dev_fragments"] = ctx.DevDepFragments
 	data.Context["dev_ip_address"] = ctx.DevIPAddress
+	if hc := ctx.Appfile.Application.HealthCheck; hc != nil {
+		data.Context["health_check"] = hc
+	}
+	if v := ctx.Appfile.Application.InstanceType; v != "" {
+		data.Context["instance_type"] = v
+	}
+	if v := ctx.Appfile.Application.BuildInstanceType; v != "" {
+		data.Context["build_instance_type"] = v
+	}
+	if v := ctx.Appfile.Application.SourceAMI; v != "" {
+		data.Context["source_ami"] = v
+	}
+	if v := ctx.Appfile.Application.VolumeSize; v > 0 {
+		data.Context["volume_size"] = v
+	}
+	if v := ctx.Appfile.Application.Domain; v != "" {
+		data.Context["domain"] = v
+	}
+
+	if tags := ctx.Appfile.Application.Tags; len(tags) > 0 {
+		data.Context["tags"] = appTags(tags)
+	}
+
+	// Only the names of the build environment are compiled. The values
+	// are given to Packer as variables during the build so that secrets
+	// never end up in the compiled files.
+	if env := ctx.Appfile.Application.BuildEnv; len(env) > 0 {
+		names := make([]string, 0, len(env))
+		for k := range env {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		data.Context["build_env"] = names
+	}
+
+	builders, err := appBuilders(opts)
+	if err != nil {
+		return nil, err
+	}
+	data.Context["builders"] = builders
+
+	if proxy := proxyEnv(); len(proxy) > 0 {
+		data.Context["proxy_env"] = proxy
+	}
+
+	// Offline builds read the files they would download from the offline
+	// directory. The box is optional: without it, the box must already
+	// be added to Vagrant.
+	if ctx.Appfile.Application.BuildOffline {
+		if !opts.Offline {
+			return nil, fmt.Errorf(
+				"The %q application type doesn't support build_offline.\n"+
+					"Builds of this type download packages, which requires network access.",
+				ctx.Appfile.Application.Type)
+		}
+
+		offlineDir := ctx.Appfile.OfflineDir()
+		data.Context["build_offline"] = true
+		data.Context["offline_dir"] = offlineDir
+
+		box := filepath.Join(offlineDir, offlineBox)
+		if _, err := os.Stat(box); err == nil {
+			data.Context["offline_box"] = box
+		}
+	}
 
 	if data.Context["path"] == nil {
 		data.Context["path"] = make(map[string]string)
 	}
 	pathMap := data.Context["path"].(map[string]string)
-	pathMap["cache"] = ctx.CacheDir
+	pathMap["cache"] = ctx.AppCacheDir()
 	pathMap["compiled"] = ctx.Dir
-	pathMap["working"] = filepath.Dir(ctx.Appfile.Path)
+	pathMap["working"] = ctx.Appfile.SourceDir()
+	if _, err := os.Stat(pathMap["working"]); err != nil {
+		return nil, fmt.Errorf(
+			"The source path of the application, %s, couldn't be read: %s\n\n"+
+				"Please fix the source_path in the Appfile and compile again.",
+			pathMap["working"], err)
+	}
 	foundationDirsContext := map[string][]string{
 		"dev":     make([]string, len(ctx.FoundationDirs)),
 		"dev_dep": make([]string, len(ctx.FoundationDirs)),
@@ -85,7 +182,7 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 	data.Context["foundation_dirs"] = foundationDirsContext
 
 	// Process the customizations!
-	err := processCustomizations(&processOpts{
+	err = processCustomizations(&processOpts{
 		Customizations: opts.Customizations,
 		Appfile:        ctx.Appfile,
 		Bindata:        data,
@@ -94,12 +191,23 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 		return nil, err
 	}
 
+	// A provisioning script from the project is copied next to the
+	// build template, which runs it after the standard build steps.
+	provisionPath := appProvisionScript(ctx)
+	if provisionPath != "" {
+		data.Context["provision_script"] = "provision.sh"
+	}
+
+	// Make sure the app has templates for the infrastructure before
+	// copying anything, since a missing directory is otherwise ignored.
+	infraDir := fmt.Sprintf("data/%s-%s", ctx.Tuple.Infra, ctx.Tuple.InfraFlavor)
+	if err := appSupportsInfra(ctx, data, infraDir); err != nil {
+		return nil, err
+	}
+
 	// Create the directory list that we'll copy from, and copy those
 	// directly into the compilation directory.
-	bindirs := []string{
-		"data/common",
-		fmt.Sprintf("data/%s-%s", ctx.Tuple.Infra, ctx.Tuple.InfraFlavor),
-	}
+	bindirs := []string{"data/common", infraDir}
 	for _, dir := range bindirs {
 		// Copy all the common files that exist
 		if err := data.CopyDir(ctx.Dir, dir); err != nil {
@@ -112,6 +220,12 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 		}
 	}
 
+	if provisionPath != "" {
+		if err := copyProvisionScript(ctx, provisionPath); err != nil {
+			return nil, err
+		}
+	}
+
 	// Callbacks
 	for _, cb := range opts.Callbacks {
 		if err := cb(); err != nil {
@@ -119,6 +233,14 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 		}
 	}
 
+	// Terraform configurations from the project are merged in last so
+	// that they replace everything that was compiled.
+	for _, name := range deployOverrideDirs {
+		if err := appDeployOverride(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
 	// If the DevDep fragment exists, then use it
 	fragmentPath := filepath.Join(ctx.Dir, "dev-dep", "Vagrantfile.fragment")
 	if _, err := os.Stat(fragmentPath); err != nil {
@@ -135,3 +257,211 @@ func App(opts *AppOptions) (*app.CompileResult, error) {
 		DevDepFragmentPath: fragmentPath,
 	}, nil
 }
+
+// appTag is a tag of the application in the template context.
+type appTag struct {
+	Key   string
+	Value string
+}
+
+// appTags returns the tags of the application sorted by key, so that
+// the compiled templates don't change between compiles.
+func appTags(tags map[string]string) []*appTag {
+	result := make([]*appTag, 0, len(tags))
+	for k, v := range tags {
+		result = append(result, &appTag{Key: k, Value: v})
+	}
+	sort.Sort(appTagsByKey(result))
+	return result
+}
+
+type appTagsByKey []*appTag
+
+func (s appTagsByKey) Len() int           { return len(s) }
+func (s appTagsByKey) Less(i, j int) bool { return s[i].Key < s[j].Key }
+func (s appTagsByKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// proxyEnvVars are the proxy environment variables that are passed on
+// to the machines Otto builds and develops in.
+var proxyEnvVars = []string{"http_proxy", "https_proxy", "no_proxy"}
+
+// proxyVar is a proxy setting in the template context.
+type proxyVar struct {
+	Name  string
+	Value string
+}
+
+// proxyEnv returns the proxy settings from the environment Otto is run
+// in. Both the upper and lower case variables are read since tools don't
+// agree on which to use; the upper case one wins if both are set.
+func proxyEnv() []*proxyVar {
+	var result []*proxyVar
+	for _, k := range proxyEnvVars {
+		v := os.Getenv(strings.ToUpper(k))
+		if v == "" {
+			v = os.Getenv(k)
+		}
+		if v != "" {
+			result = append(result, &proxyVar{Name: k, Value: v})
+		}
+	}
+
+	return result
+}
+
+// SupportedTuples returns the tuples of the infrastructures and flavors
+// that the app type has templates for, sorted. The templates are in
+// directories of the data named "INFRA-FLAVOR", such as
+// "data/aws-vpc-public-private".
+//
+// This can be used to implement app.AppTuples.
+func SupportedTuples(appType string, data *bindata.Data) []app.Tuple {
+	names, err := data.AssetDir("data")
+	if err != nil {
+		return nil
+	}
+
+	result := make([]app.Tuple, 0, len(names))
+	for _, name := range names {
+		idx := strings.Index(name, "-")
+		if idx < 0 {
+			continue
+		}
+
+		result = append(result, app.Tuple{
+			App:         appType,
+			Infra:       name[:idx],
+			InfraFlavor: name[idx+1:],
+		})
+	}
+	sort.Sort(app.TupleSlice(result))
+
+	return result
+}
+
+// appSupportsInfra checks that the app has templates in dir for the
+// infra and flavor of the app. Apps that have templates for other
+// infrastructures but not this one can't be built or deployed, so that
+// is an error listing the combinations the app supports.
+func appSupportsInfra(ctx *app.Context, data *bindata.Data, dir string) error {
+	if _, err := data.AssetDir(dir); err == nil {
+		return nil
+	}
+
+	// Apps without any infrastructure directories, such as custom apps,
+	// don't depend on the infrastructure.
+	tuples := SupportedTuples(ctx.Tuple.App, data)
+	if len(tuples) == 0 {
+		return nil
+	}
+	supported := make([]string, len(tuples))
+	for i, t := range tuples {
+		supported[i] = fmt.Sprintf("  %s (flavor: %s)", t.Infra, t.InfraFlavor)
+	}
+
+	return fmt.Errorf(
+		"The %q app type doesn't support the infrastructure %q with the\n"+
+			"flavor %q. The app can be built and deployed to the following\n"+
+			"infrastructures and flavors:\n\n%s\n\n"+
+			"Please change the type or flavor of the infrastructure in the\n"+
+			"Appfile and run `otto compile` again.",
+		ctx.Tuple.App, ctx.Tuple.Infra, ctx.Tuple.InfraFlavor,
+		strings.Join(supported, "\n"))
+}
+
+// appProvisionScript returns the path to the provisioning script of the
+// project: the one set in the Appfile, or ".otto/provision.sh" next to
+// the Appfile. If the script doesn't exist, it returns an empty string
+// and the build runs only the standard steps.
+func appProvisionScript(ctx *app.Context) string {
+	dir := filepath.Dir(ctx.Appfile.Path)
+	path := filepath.Join(dir, ".otto", "provision.sh")
+	if v := ctx.Appfile.Application.ProvisionScript; v != "" {
+		path = v
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if ctx.Appfile.Application.ProvisionScript != "" {
+			ui.Warn(ctx.Ui, fmt.Sprintf(
+				"The provision script %s wasn't found, so the build\n"+
+					"will only run the standard steps.", path))
+		}
+
+		return ""
+	}
+
+	return path
+}
+
+// copyProvisionScript copies the provisioning script into the build
+// directory of the compiled app, if the app has one.
+func copyProvisionScript(ctx *app.Context, path string) error {
+	buildDir := filepath.Join(ctx.Dir, "build")
+	if _, err := os.Stat(buildDir); err != nil {
+		return nil
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("Error reading provision script: %s", err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(
+		filepath.Join(buildDir, "provision.sh"),
+		os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return fmt.Errorf("Error copying provision script: %s", err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("Error copying provision script: %s", err)
+	}
+
+	return nil
+}
+
+// appBuilders returns the Packer builders to build the application with,
+// validating the builders set in the Appfile.
+func appBuilders(opts *AppOptions) ([]string, error) {
+	ctx := opts.Ctx
+	builders := ctx.Appfile.Application.Builders
+	if len(builders) == 0 {
+		return []string{DefaultBuilder}, nil
+	}
+
+	if !opts.Builders || ctx.Tuple.Infra != "aws" {
+		return nil, fmt.Errorf(
+			"The %q application type doesn't support builders on the %q\n"+
+				"infrastructure. Only app types that build AMIs support it.",
+			ctx.Appfile.Application.Type, ctx.Tuple.Infra)
+	}
+
+	seen := make(map[string]bool)
+	for _, b := range builders {
+		if seen[b] {
+			return nil, fmt.Errorf(
+				"The builder %q is in the builders of the Appfile more than once.", b)
+		}
+		seen[b] = true
+
+		valid := false
+		for _, v := range validBuilders {
+			if b == v {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf(
+				"Unsupported builder %q in the Appfile. The supported builders\n"+
+					"are: %s", b, strings.Join(validBuilders, ", "))
+		}
+	}
+
+	return builders, nil
+}
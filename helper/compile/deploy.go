@@ -0,0 +1,143 @@
+package compile
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/ui"
+)
+
+// deployOverrideDirs are the directories of compiled Terraform
+// configurations that a project can override with a directory of the
+// same name in ".otto" next to the Appfile.
+var deployOverrideDirs = []string{"deploy", "deploy-bluegreen"}
+
+// terraformOutputRegexp matches the declaration of an output in a
+// Terraform configuration.
+var terraformOutputRegexp = regexp.MustCompile(`(?m)^\s*output\s+"([^"]+)"`)
+
+// appDeployOverride merges the Terraform configuration in ".otto/NAME"
+// next to the Appfile into the compiled directory NAME. Its files replace
+// the compiled files with the same path and other files are added, so a
+// project can change a single file or replace every one of them.
+//
+// The rest of Otto uses the outputs of the bundled configuration, such as
+// "ip" for the health check and `otto deploy ssh`, so the merged
+// configuration must still declare all of them.
+func appDeployOverride(ctx *app.Context, name string) error {
+	src := filepath.Join(filepath.Dir(ctx.Appfile.Path), ".otto", name)
+	if fi, err := os.Stat(src); err != nil || !fi.IsDir() {
+		return nil
+	}
+
+	dst := filepath.Join(ctx.Dir, name)
+	if _, err := os.Stat(dst); err != nil {
+		ui.Warn(ctx.Ui, fmt.Sprintf(
+			"The %q app type doesn't have a %s configuration for this\n"+
+				"infrastructure, so .otto/%s is ignored.",
+			ctx.Tuple.App, name, name))
+		return nil
+	}
+
+	required, err := terraformOutputs(dst)
+	if err != nil {
+		return err
+	}
+
+	ui.Info(ctx.Ui, fmt.Sprintf(
+		"Merging the Terraform configuration from .otto/%s...", name))
+	if err := copyTree(dst, src); err != nil {
+		return fmt.Errorf("Error copying .otto/%s: %s", name, err)
+	}
+
+	merged, err := terraformOutputs(dst)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for k := range required {
+		if !merged[k] {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(
+			"The Terraform configuration in .otto/%s is missing outputs that\n"+
+				"Otto needs: %s\n\n"+
+				"Please declare these outputs, such as by copying them from the\n"+
+				"compiled configuration in %s, and run `otto compile` again.",
+			name, strings.Join(missing, ", "), dst)
+	}
+
+	return nil
+}
+
+// terraformOutputs returns the outputs declared by the Terraform
+// configuration in dir. Modules in subdirectories aren't read, since
+// their outputs aren't outputs of the configuration.
+func terraformOutputs(dir string) (map[string]bool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool)
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading %s: %s", path, err)
+		}
+
+		for _, m := range terraformOutputRegexp.FindAllStringSubmatch(string(data), -1) {
+			result[m[1]] = true
+		}
+	}
+
+	return result, nil
+}
+
+// copyTree copies the files within src into dst, keeping the structure
+// of the directories and replacing any files that already exist.
+func copyTree(dst, src string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := os.OpenFile(
+			target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		_, err = io.Copy(out, in)
+		return err
+	})
+}
@@ -2,7 +2,6 @@ package compile
 
 import (
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/otto/foundation"
@@ -51,7 +50,7 @@ func Foundation(opts *FoundationOptions) (*foundation.CompileResult, error) {
 	data.Context["name"] = ctx.Appfile.Application.Name
 	data.Context["path"] = map[string]string{
 		"compiled": ctx.Dir,
-		"working":  filepath.Dir(ctx.Appfile.Path),
+		"working":  ctx.Appfile.SourceDir(),
 	}
 	data.Context["app_config"] = ctx.AppConfig
 	if ctx.AppConfig == nil {
@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"errors"
 	"io"
 	"log"
 	"os"
@@ -10,10 +11,23 @@ import (
 	"github.com/hashicorp/otto/ui"
 )
 
+// ErrInterrupted is returned by RunCancel when the command was
+// interrupted before it completed.
+var ErrInterrupted = errors.New("interrupted")
+
 // Run runs the given command and streams all the output to the
 // given UI. It also connects stdin properly so that input works as
 // expected.
 func Run(uiVal ui.Ui, cmd *exec.Cmd) error {
+	return RunCancel(uiVal, cmd, nil)
+}
+
+// RunCancel is the same as Run, but the command can be cancelled. If
+// cancelCh is closed or receives a value while the command is running,
+// the command is sent an interrupt and RunCancel waits for it to exit
+// so that it has a chance to gracefully clean up. In this case,
+// ErrInterrupted is returned.
+func RunCancel(uiVal ui.Ui, cmd *exec.Cmd, cancelCh <-chan struct{}) error {
 	out_r, out_w := io.Pipe()
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = out_w
@@ -43,7 +57,29 @@ func Run(uiVal ui.Ui, cmd *exec.Cmd) error {
 	// Run the command
 	log.Printf("[DEBUG] execDir: %s", cmd.Dir)
 	log.Printf("[DEBUG] exec: %s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
-	err := cmd.Run()
+	err := cmd.Start()
+	if err == nil {
+		doneCh := make(chan error, 1)
+		go func() {
+			doneCh <- cmd.Wait()
+		}()
+
+		select {
+		case err = <-doneCh:
+		case <-cancelCh:
+			log.Printf("[INFO] interrupting: %s", cmd.Path)
+
+			// Not every platform supports sending an interrupt. If we
+			// can't, then the best we can do is kill the process.
+			if serr := cmd.Process.Signal(os.Interrupt); serr != nil {
+				log.Printf("[WARN] error interrupting, killing: %s", serr)
+				cmd.Process.Kill()
+			}
+
+			<-doneCh
+			err = ErrInterrupted
+		}
+	}
 
 	// Wait for all the output to finish
 	out_w.Close()
@@ -35,3 +35,24 @@ func TestRun(t *testing.T) {
 		t.Fatalf("bad: %s", output.String())
 	}
 }
+
+func TestRunCancel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows. Not running this test.")
+	}
+
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skipf("sleep not found, skipping test: %s", err)
+	}
+
+	cmd := exec.Command("sleep", "10")
+	ui := new(ui.Mock)
+
+	cancelCh := make(chan struct{})
+	close(cancelCh)
+
+	err := RunCancel(ui, cmd, cancelCh)
+	if err != ErrInterrupted {
+		t.Fatalf("err: %s", err)
+	}
+}
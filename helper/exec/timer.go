@@ -0,0 +1,59 @@
+package exec
+
+import (
+	"sync"
+	"time"
+)
+
+// Timer cancels a long running command, such as a Packer build or a
+// Terraform apply, that doesn't finish within Timeout, so that a stalled
+// cloud operation can't hang Otto forever.
+//
+// Cancel is called once when the timeout expires. It should interrupt
+// the command, such as by closing the channel given to RunCancel.
+type Timer struct {
+	Timeout time.Duration
+	Cancel  func()
+
+	lock     sync.Mutex
+	timer    *time.Timer
+	timedOut bool
+}
+
+// Start starts the timer. It does nothing if Timeout isn't positive.
+func (t *Timer) Start() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.Timeout <= 0 || t.timer != nil {
+		return
+	}
+
+	t.timer = time.AfterFunc(t.Timeout, t.timeout)
+}
+
+// Stop stops the timer. The command is no longer cancelled.
+func (t *Timer) Stop() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+}
+
+// TimedOut returns true if the command was cancelled because it didn't
+// finish within Timeout.
+func (t *Timer) TimedOut() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.timedOut
+}
+
+func (t *Timer) timeout() {
+	t.lock.Lock()
+	t.timedOut = true
+	t.timer = nil
+	t.lock.Unlock()
+
+	t.Cancel()
+}
@@ -0,0 +1,40 @@
+package exec
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimer(t *testing.T) {
+	cancelCh := make(chan struct{})
+	timer := &Timer{
+		Timeout: 10 * time.Millisecond,
+		Cancel:  func() { close(cancelCh) },
+	}
+	timer.Start()
+	defer timer.Stop()
+
+	select {
+	case <-cancelCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("should cancel")
+	}
+
+	if !timer.TimedOut() {
+		t.Fatal("should be timed out")
+	}
+}
+
+func TestTimer_stop(t *testing.T) {
+	timer := &Timer{
+		Timeout: 10 * time.Millisecond,
+		Cancel:  func() { t.Fatal("should not cancel") },
+	}
+	timer.Start()
+	timer.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	if timer.TimedOut() {
+		t.Fatal("should not be timed out")
+	}
+}
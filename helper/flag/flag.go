@@ -2,8 +2,22 @@ package flag
 
 import (
 	"flag"
+	"strings"
 )
 
+// StringSlice is a flag.Value that collects every value of a flag that
+// can be given more than once, such as "-target=a -target=b".
+type StringSlice []string
+
+func (s *StringSlice) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *StringSlice) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
 // FilterArgs filters the args slice to only include the the flags
 // in the given flagset and returns a new arg slice that has the
 // included args as well as a slice that has only the excluded args.
@@ -15,10 +29,14 @@ func FilterArgs(fs *flag.FlagSet, args []string) ([]string, []string, []string)
 	exc := make([]string, 0, len(args))
 	pos := make([]string, 0, len(args))
 
-	// Make a map of the valid flags
-	flags := make(map[string]struct{})
+	// Make a map of the valid flags, and whether they're boolean flags
+	// that don't take a value as the next argument
+	flags := make(map[string]bool)
 	fs.VisitAll(func(f *flag.Flag) {
-		flags[f.Name] = struct{}{}
+		bf, ok := f.Value.(interface {
+			IsBoolFlag() bool
+		})
+		flags[f.Name] = ok && bf.IsBoolFlag()
 	})
 
 	// Go through each, parse out a single argument, and determine where
@@ -59,7 +77,7 @@ const (
 // filterOne is based very heavily on the official flag package
 // "parseOne" function. We do this on purpose so that we parse things
 // as similarly as possible in order to split the args.
-func filterOne(flags map[string]struct{}, args []string, i int) (int, filterLoc) {
+func filterOne(flags map[string]bool, args []string, i int) (int, filterLoc) {
 	// Get the arg
 	s := args[i]
 	if s == "-h" || s == "--help" {
@@ -101,12 +119,14 @@ func filterOne(flags map[string]struct{}, args []string, i int) (int, filterLoc)
 
 	// Determine where this will go from here on out
 	pos := filterLocInc
-	if _, valid := flags[name]; !valid {
+	isBool, valid := flags[name]
+	if !valid {
 		pos = filterLocExc
 	}
 
-	// It must have a value, which might be the next argument.
-	if !has_value && len(args) > i+1 {
+	// It must have a value, which might be the next argument, unless
+	// it is a boolean flag.
+	if !has_value && !isBool && len(args) > i+1 {
 		return 2, pos
 	}
 
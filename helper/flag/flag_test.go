@@ -79,3 +79,36 @@ func TestFilterArgs(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterArgs_bool(t *testing.T) {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool("foo", false, "")
+
+	inc, exc, pos := FilterArgs(fs, []string{"-foo", "hello", "-bar", "baz"})
+	if !reflect.DeepEqual(inc, []string{"-foo"}) {
+		t.Fatalf("bad: %#v", inc)
+	}
+	if !reflect.DeepEqual(exc, []string{"-bar", "baz"}) {
+		t.Fatalf("bad: %#v", exc)
+	}
+	if !reflect.DeepEqual(pos, []string{"hello"}) {
+		t.Fatalf("bad: %#v", pos)
+	}
+}
+
+func TestStringSlice(t *testing.T) {
+	var value StringSlice
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&value, "target", "")
+	if err := fs.Parse([]string{"-target=a", "-target", "b"}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := StringSlice{"a", "b"}
+	if !reflect.DeepEqual(value, expected) {
+		t.Fatalf("bad: %#v", value)
+	}
+	if value.String() != "a,b" {
+		t.Fatalf("bad: %s", value.String())
+	}
+}
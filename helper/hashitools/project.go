@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sync"
 
 	"github.com/hashicorp/go-checkpoint"
 	"github.com/hashicorp/go-version"
@@ -16,6 +17,10 @@ import (
 
 var (
 	versionRe = regexp.MustCompile(`v?(\d+\.\d+\.[^\s]+)`)
+
+	// installLock serializes InstallIfNeeded so that builds running in
+	// parallel don't prompt for or run the same install at once.
+	installLock sync.Mutex
 )
 
 // Project represents a HashiCorp Go project and provides various operations
@@ -35,7 +40,13 @@ type Project struct {
 
 // InstallIfNeeded will check if installation of this project is required
 // and will invoke the installer if needed.
+//
+// This is safe to call from multiple goroutines. Only one of them checks
+// and installs at a time, so the others find the completed install.
 func (p *Project) InstallIfNeeded() error {
+	installLock.Lock()
+	defer installLock.Unlock()
+
 	log.Printf("[DEBUG] installIfNeeded: %s", p.Name)
 
 	// Start grabbing the latest version as early as possible since
@@ -116,7 +127,12 @@ func (p *Project) LatestVersion() (*version.Version, error) {
 
 // Version reads the version of this project.
 func (p *Project) Version() (*version.Version, error) {
-	path := p.Path()
+	return readVersion(p.Name, p.Path())
+}
+
+// readVersion reads the version of the project named name by running the
+// binary at path with --version. It returns nil if the binary isn't found.
+func readVersion(name, path string) (*version.Version, error) {
 	if !filepath.IsAbs(path) {
 		// Look for it on the path first if we don't have a full path to it
 		_, err := exec.LookPath(path)
@@ -143,10 +159,10 @@ func (p *Project) Version() (*version.Version, error) {
 		if runErr != nil {
 			return nil, fmt.Errorf(
 				"Error checking %s version: %s\n\n%s",
-				p.Name, runErr, buf.String())
+				name, runErr, buf.String())
 		}
 		return nil, fmt.Errorf(
-			"unable to find %s version in output: %q", p.Name, buf.String())
+			"unable to find %s version in output: %q", name, buf.String())
 	}
 
 	return version.NewVersion(matches[1])
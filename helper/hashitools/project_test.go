@@ -1,6 +1,7 @@
 package hashitools
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-version"
@@ -57,3 +58,24 @@ func TestVersionRe(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckVersion(t *testing.T) {
+	min := version.Must(version.NewVersion("0.6.0"))
+
+	versionCache["/bin/old-terraform"] = version.Must(version.NewVersion("0.5.3"))
+	versionCache["/bin/new-terraform"] = version.Must(version.NewVersion("0.6.4"))
+	defer delete(versionCache, "/bin/old-terraform")
+	defer delete(versionCache, "/bin/new-terraform")
+
+	err := CheckVersion("terraform", "/bin/old-terraform", min)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "Terraform >= 0.6.0 is required, found 0.5.3") {
+		t.Fatalf("bad: %s", err)
+	}
+
+	if err := CheckVersion("terraform", "/bin/new-terraform", min); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,53 @@
+package hashitools
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+)
+
+// versionCache caches the versions read by CheckVersion by path, so each
+// binary is only run with --version once per process.
+var (
+	versionCache     = make(map[string]*version.Version)
+	versionCacheLock sync.Mutex
+)
+
+// CheckVersion verifies that the binary of the project named name at path,
+// such as "terraform", is at least version min. The error explains which
+// version is required and which was found, so that an incompatible version
+// fails up front rather than with a confusing error halfway through a run.
+func CheckVersion(name, path string, min *version.Version) error {
+	versionCacheLock.Lock()
+	defer versionCacheLock.Unlock()
+
+	current, ok := versionCache[path]
+	if !ok {
+		var err error
+		current, err = readVersion(name, path)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf(
+				"%s >= %s is required, but it wasn't found at %q.\n"+
+					"Please install it or add it to your PATH.",
+				strings.Title(name), min, path)
+		}
+
+		log.Printf("[DEBUG] %s version: %s", name, current)
+		versionCache[path] = current
+	}
+
+	if current.LessThan(min) {
+		return fmt.Errorf(
+			"%s >= %s is required, found %s.\n"+
+				"Please upgrade %s and try again.",
+			strings.Title(name), min, current, strings.Title(name))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package lockfile
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLocked is returned by TryLock when the lock is held, either by
+// another process or by another lock on the same path in this one.
+var ErrLocked = errors.New("lock is held")
+
+// Lock takes an exclusive lock on the file at path like TryLock, but
+// waits for the lock if it is held. In that case, wait is called once
+// before waiting so the user can be told why nothing is happening.
+func Lock(path string, wait func()) (func(), error) {
+	waited := false
+	for {
+		unlock, err := TryLock(path)
+		if err != ErrLocked {
+			return unlock, err
+		}
+
+		if !waited {
+			waited = true
+			if wait != nil {
+				wait()
+			}
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+}
@@ -0,0 +1,74 @@
+package lockfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTryLock(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "lock")
+	unlock, err := TryLock(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := TryLock(path); err != ErrLocked {
+		t.Fatalf("err: %s", err)
+	}
+
+	unlock()
+	unlock, err = TryLock(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	unlock()
+}
+
+func TestLock(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "lock")
+	unlock, err := Lock(path, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Try to get the lock while it is held
+	waitCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		unlock2, err := Lock(path, func() { close(waitCh) })
+		if err != nil {
+			t.Errorf("err: %s", err)
+			return
+		}
+		unlock2()
+	}()
+
+	select {
+	case <-waitCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("should wait")
+	}
+
+	unlock()
+	select {
+	case <-doneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("should get lock")
+	}
+}
@@ -0,0 +1,31 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package lockfile
+
+import (
+	"os"
+	"syscall"
+)
+
+// TryLock takes an exclusive lock on the file at path without waiting,
+// creating the file if it doesn't exist, and returns a function to
+// release it. The lock is also released if the process exits, so a
+// crashed process never leaves a stale lock behind.
+func TryLock(path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			err = ErrLocked
+		}
+
+		return nil, err
+	}
+
+	return func() { f.Close() }, nil
+}
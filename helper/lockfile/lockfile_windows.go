@@ -0,0 +1,42 @@
+// +build windows
+
+package lockfile
+
+import (
+	"os"
+	"syscall"
+)
+
+// errSharingViolation is the error Windows returns when opening a file
+// that is already open without sharing.
+const errSharingViolation syscall.Errno = 32
+
+// TryLock takes an exclusive lock on the file at path without waiting,
+// creating the file if it doesn't exist, and returns a function to
+// release it. The file is opened without sharing, so the lock is also
+// released if the process exits.
+func TryLock(path string) (func(), error) {
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := syscall.CreateFile(
+		name,
+		syscall.GENERIC_READ|syscall.GENERIC_WRITE,
+		0,
+		nil,
+		syscall.OPEN_ALWAYS,
+		syscall.FILE_ATTRIBUTE_NORMAL,
+		0)
+	if err != nil {
+		if err == errSharingViolation {
+			err = ErrLocked
+		}
+
+		return nil, err
+	}
+
+	f := os.NewFile(uintptr(h), path)
+	return func() { f.Close() }, nil
+}
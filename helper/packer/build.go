@@ -4,13 +4,23 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/armon/circbuf"
 	"github.com/hashicorp/atlas-go/archive"
 	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/foundation"
+	"github.com/hashicorp/otto/helper/compile"
+	execHelper "github.com/hashicorp/otto/helper/exec"
+	"github.com/hashicorp/otto/ui"
 )
 
 type BuildOptions struct {
@@ -26,8 +36,36 @@ type BuildOptions struct {
 	// to a different key for a Packer variable. The key of this map
 	// is the infra output key, and teh value is the Packer variable name.
 	InfraOutputMap map[string]string
+
+	// VarFiles is a list of additional JSON variable files to pass to
+	// Packer. See Packer.VarFiles for the precedence rules.
+	VarFiles []string
+
+	// ArtifactParser, if set, is used to parse the artifacts out of the
+	// Packer output. If this isn't set, the parser is chosen based on
+	// the target infrastructure.
+	ArtifactParser func(map[string][]string) OutputCallback
+
+	// ProgressPhases, if set, are the phases of the build used to show
+	// its progress. If this isn't set, the phases are chosen based on
+	// the target infrastructure.
+	ProgressPhases []*ProgressPhase
+
+	// MaxRetries is the number of times the build is retried if it
+	// fails with a transient error from the provider. If this is zero,
+	// DefaultBuildRetries is used. Set it to a negative number to
+	// disable retries.
+	MaxRetries int
 }
 
+// DefaultBuildRetries is the number of times a build is retried if
+// BuildOptions.MaxRetries isn't set.
+const DefaultBuildRetries = 2
+
+// DefaultBuildTimeout is how long a build may run, including retries,
+// before it is cancelled if the Appfile doesn't set build_timeout.
+var DefaultBuildTimeout = 2 * time.Hour
+
 // Build can be used to build an artifact with Packer and parse the
 // artifact out into a Build properly.
 //
@@ -43,14 +81,27 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 		return err
 	}
 
+	timeout, err := buildTimeout(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctx.Ui.Header("Querying infrastructure data for build...")
 
 	// Get the infrastructure, since it needs to be ready for building
 	// to occur. We'll copy the outputs and the credentials as variables
 	// to Packer.
-	infra, err := ctx.Directory.GetInfra(&directory.Infra{
-		Lookup: directory.Lookup{
-			Infra: ctx.Appfile.ActiveInfrastructure().Name}})
+	//
+	// An unreachable directory is retried for a while, and otherwise
+	// fails the build here rather than after Packer has run.
+	var infra *directory.Infra
+	err = directory.RetryConnection(func() error {
+		var err error
+		infra, err = ctx.Directory.GetInfra(&directory.Infra{
+			Lookup: directory.Lookup{
+				Infra: ctx.Appfile.ActiveInfrastructure().Name}})
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -76,9 +127,85 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 
 		vars[k] = v
 	}
-	for k, v := range ctx.InfraCreds {
+	for k, v := range ctx.BuildCredsVars() {
 		vars[k] = v
 	}
+	if f, ok := infraVars[ctx.Tuple.Infra]; ok {
+		if err := f(ctx, infra, vars); err != nil {
+			return err
+		}
+	}
+
+	// Templates that support it copy the build into the extra regions
+	// listed in the Appfile, so it can be deployed in any of them.
+	if regions := ctx.Appfile.ActiveInfrastructure().BuildRegions; len(regions) > 0 {
+		vars["build_regions"] = strings.Join(regions, ",")
+	}
+
+	// They also share the build with the other accounts so it can be
+	// deployed there, such as when builds are made in a shared account.
+	if accounts := ctx.Appfile.ActiveInfrastructure().ShareAccounts; len(accounts) > 0 {
+		vars["share_accounts"] = strings.Join(accounts, ",")
+	}
+
+	// The template's default instance type is used unless the Appfile
+	// sets one for the build.
+	if v := ctx.Appfile.Application.BuildInstanceType; v != "" {
+		vars["build_instance_type"] = v
+	}
+	if v := ctx.Appfile.Application.SourceAMI; v != "" {
+		vars["source_ami"] = v
+	}
+
+	// The build runs in the default VPC unless the Appfile sets one. The
+	// VPC of the infrastructure isn't used since the build needs a subnet
+	// that Otto can SSH into.
+	delete(vars, "vpc_id")
+	delete(vars, "subnet_id")
+	if v := ctx.Appfile.Application.VPCID; v != "" {
+		vars["vpc_id"] = v
+		vars["subnet_id"] = ctx.Appfile.Application.SubnetID
+	}
+
+	// Builds use on-demand instances unless a spot price is set. The
+	// watcher makes sure we don't wait forever for spot capacity.
+	var spot *spotWatcher
+	var spotCallback OutputCallback
+	spotPrice := ctx.Appfile.Application.BuildSpotPrice
+	if spotPrice != "" {
+		vars["spot_price"] = spotPrice
+		vars["spot_price_auto_product"] = spotPriceAutoProduct
+
+		spot = &spotWatcher{Timeout: SpotRequestTimeout}
+		spotCallback = spot.Callback
+	}
+
+	// An existing key pair from the Appfile is used for SSH instead of
+	// the temporary key pair Packer creates, so the key must exist in
+	// the region of the build.
+	if v := ctx.Appfile.Application.SSHKeyName; v != "" {
+		keyPath, err := ctx.Appfile.SSHPrivateKeyPath()
+		if err != nil {
+			return fmt.Errorf("Error loading SSH private key path: %s", err)
+		}
+
+		vars["ssh_key_name"] = v
+		vars["ssh_private_key_file"] = keyPath
+	}
+
+	// The build environment is passed as "build_env_NAME" variables.
+	// Empty values are read from our own environment so secrets can be
+	// kept out of the Appfile. Their values may be secrets, so they're
+	// redacted like the credentials.
+	sensitiveVars := append([]string(nil), context.SensitiveVars...)
+	for k, v := range ctx.Appfile.Application.BuildEnv {
+		if v == "" {
+			v = os.Getenv(k)
+		}
+
+		vars["build_env_"+k] = v
+		sensitiveVars = append(sensitiveVars, "build_env_"+k)
+	}
 
 	// Setup the vars
 	if err := foundation.WriteVars(&ctx.Shared); err != nil {
@@ -86,7 +213,7 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 	}
 
 	ctx.Ui.Header("Building deployment archive...")
-	slugPath, err := createAppSlug(filepath.Dir(ctx.Appfile.Path))
+	slugPath, err := createAppSlug(ctx.Appfile.SourceDir())
 	if err != nil {
 		return err
 	}
@@ -100,7 +227,11 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 			InfraFlavor: ctx.Tuple.InfraFlavor,
 		},
 
-		Artifact: make(map[string]string),
+		Artifact:  make(map[string]string),
+		Artifacts: make(map[string][]string),
+		CreatedAt: time.Now().UTC(),
+		GitSHA:    gitSHA(filepath.Dir(ctx.Appfile.Path)),
+		Builder:   builderName(),
 	}
 
 	// Get the paths for Packer execution
@@ -112,30 +243,207 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 	if opts.TemplatePath == "" {
 		templatePath = filepath.Join(packerDir, "template.json")
 	}
+	if err := checkTemplate(ctx, templatePath); err != nil {
+		return err
+	}
+	if err := checkBuildEnv(ctx, templatePath); err != nil {
+		return err
+	}
+
+	if err := checkVolumeSize(ctx, vars, templatePath); err != nil {
+		return err
+	}
+
+	// Determine how to parse the artifacts for this infrastructure
+	parseArtifact, ok := artifactParsers[ctx.Tuple.Infra]
+	if opts.ArtifactParser != nil {
+		parseArtifact, ok = opts.ArtifactParser, true
+	}
+	if !ok {
+		return fmt.Errorf(
+			"Unknown build target infrastructure: %s\n\n"+
+				"This app currently doesn't know how to build for this infrastructure.\n"+
+				"Please report this to the project.",
+			ctx.Tuple.Infra)
+	}
+
+	// Show the progress of the build so that long builds don't look
+	// like they're hanging.
+	callbacks := map[string]OutputCallback{
+		"artifact": parseDockerArtifact(
+			build.Artifacts, parseArtifact(build.Artifacts)),
+	}
+	phases := progressPhases[ctx.Tuple.Infra]
+	if !defaultBuilders(ctx) {
+		// The phases are those of the default builder, and the output
+		// of many builders is interleaved, so there's no progress.
+		phases = nil
+	}
+	if opts.ProgressPhases != nil {
+		phases = opts.ProgressPhases
+	}
+	if len(phases) > 0 {
+		callbacks["ui"] = ProgressCallback(ctx.Ui, phases)
+	}
+
+	// Keep the Packer output so it can be stored in the directory for
+	// `otto build logs`. Only the end of it is stored, so only that much
+	// is kept in memory.
+	buildLog, err := circbuf.NewBuffer(directory.BuildLogMaxSize)
+	if err != nil {
+		return err
+	}
+	logCallback := func(o *Output) {
+		if len(o.Data) > 1 {
+			buildLog.Write([]byte(o.Data[1] + "\n"))
+		}
+	}
+	callbacks["ui"] = chainCallbacks(callbacks["ui"], logCallback, spotCallback)
+
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultBuildRetries
+	}
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	// Build and execute Packer
+	p := &Packer{
+		Path:          project.Path(),
+		Dir:           packerDir,
+		Ui:            ctx.Ui,
+		Variables:     vars,
+		SensitiveVars: sensitiveVars,
+		VarFiles:      opts.VarFiles,
+		Callbacks:     callbacks,
+		MaxRetries:    maxRetries,
+
+		// Artifacts reported by a failed attempt must not end up in the
+		// build, which is only stored once an attempt succeeds. The
+		// progress starts over as well.
+		OnRetry: func(int) {
+			for k := range build.Artifacts {
+				delete(build.Artifacts, k)
+			}
+			if len(phases) > 0 {
+				callbacks["ui"] = chainCallbacks(
+					ProgressCallback(ctx.Ui, phases), logCallback, spotCallback)
+			}
+
+			buildLog.Write([]byte("\n[otto] Retrying the build after a transient error\n\n"))
+		},
+	}
+	if spot != nil {
+		spot.Cancel = p.Cancel
+	}
+
+	// Listen for interrupts so we can cancel the build. Packer is given
+	// the chance to clean up any resources it created.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	go func() {
+		select {
+		case <-sigCh:
+			p.Cancel()
+		case <-doneCh:
+		}
+	}()
+
+	// Validate the template first so that syntax or variable errors
+	// show up before a potentially very slow build starts.
+	ctx.Ui.Header("Validating Packer template...")
+	if err := p.Validate(templatePath); err != nil {
+		if err == execHelper.ErrInterrupted {
+			return buildInterruptedErr()
+		}
+
+		return err
+	}
+
+	ui.Emit(ctx.Ui, &ui.Event{
+		Type: ui.EventBuildStart,
+		Data: map[string]string{
+			"app":   ctx.Appfile.Application.Name,
+			"infra": ctx.Tuple.Infra,
+		},
+	})
 
 	ctx.Ui.Header("Building deployment artifact with Packer...")
 	ctx.Ui.Message(
 		"Raw Packer output will begin streaming in below. Otto\n" +
 			"does not create this output. It is mirrored directly from\n" +
 			"Packer while the build is being run.\n\n")
+	timer := &execHelper.Timer{Timeout: timeout, Cancel: p.Cancel}
+	timer.Start()
+	err = p.Execute("build", templatePath)
+	timer.Stop()
+	storeBuildLog(ctx, &build.Lookup, buildLog.Bytes())
+	if err != nil {
+		if err == execHelper.ErrInterrupted {
+			if timer.TimedOut() {
+				return fmt.Errorf(
+					"The build didn't finish within %s, so it was cancelled. Packer\n"+
+						"was given the chance to clean up any resources it created.\n\n"+
+						"A cloud operation may have stalled. Please try again, or raise\n"+
+						"build_timeout in the Appfile if the build needs more time.",
+					timeout)
+			}
+			if spot != nil && spot.TimedOut() {
+				return spotBuildErr(spotPrice, fmt.Sprintf(
+					"The spot request for the build wasn't fulfilled within %s,\n"+
+						"so the build was cancelled.", SpotRequestTimeout))
+			}
+
+			return buildInterruptedErr()
+		}
+		if execErr, ok := err.(*ExecError); ok {
+			if spot != nil {
+				if e := spotBuildError(execErr); e != nil {
+					return spotBuildErr(spotPrice, fmt.Sprintf(
+						"Error building with Packer: %s", e.Message))
+				}
+			}
+
+			name := "Artifact"
+			if ctx.Tuple.Infra == "aws" && opts.ArtifactParser == nil {
+				name = "AMI"
+			}
+
+			return buildExecErr(name, execErr)
+		}
 
-	// Build and execute Packer
-	p := &Packer{
-		Path:      project.Path(),
-		Dir:       packerDir,
-		Ui:        ctx.Ui,
-		Variables: vars,
-		Callbacks: map[string]OutputCallback{
-			"artifact": ParseArtifactAmazon(build.Artifact),
-		},
-	}
-	if err := p.Execute("build", templatePath); err != nil {
 		return err
 	}
 
+	// The primary artifact for each region is the most recent one that
+	// Packer reported. All of them are still available in Artifacts.
+	for region, ids := range build.Artifacts {
+		build.Artifact[region] = ids[len(ids)-1]
+		if region == DockerArtifactKey {
+			continue
+		}
+		if len(ids) > 1 && ctx.Tuple.Infra == "aws" && opts.ArtifactParser == nil {
+			ui.Warn(ctx.Ui, fmt.Sprintf(
+				"Multiple AMIs were built for region %s: %s\n"+
+					"Deploys will use %s unless another one is chosen with\n"+
+					"`otto deploy -ami=ID`.",
+				region, strings.Join(ids, ", "), build.Artifact[region]))
+		}
+	}
+
 	// Store the build!
 	ctx.Ui.Header("Storing build data in directory...")
-	if err := ctx.Directory.PutBuild(build); err != nil {
+	err = directory.RetryConnection(func() error {
+		return ctx.Directory.PutBuild(build)
+	})
+	if directory.IsConnectionError(err) {
+		return unstoredBuildErr(ctx, build, err)
+	}
+	if err != nil {
 		return fmt.Errorf(
 			"Error storing the build in the directory service: %s\n\n"+
 				"Despite the build itself completing successfully, Otto must\n"+
@@ -145,21 +453,279 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 			err)
 	}
 
+	// Let tools reading the events find the artifacts without having
+	// to parse the Packer output.
+	regions := make([]string, 0, len(build.Artifacts))
+	for region := range build.Artifacts {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	for _, region := range regions {
+		for _, id := range build.Artifacts[region] {
+			ui.Emit(ctx.Ui, &ui.Event{
+				Type: ui.EventBuildArtifact,
+				Data: map[string]string{"region": region, "id": id},
+			})
+		}
+	}
+	ui.Emit(ctx.Ui, &ui.Event{
+		Type: ui.EventBuildSuccess,
+		Data: build.Artifact,
+	})
+
 	ctx.Ui.Header("[green]Build success!")
 	ctx.Ui.Message(
 		"[green]The build was completed successfully and stored within\n" +
 			"the directory service, meaning other members of your team\n" +
 			"don't need to rebuild this same version and can deploy it\n" +
 			"immediately.")
+	if image, ok := build.Artifact[DockerArtifactKey]; ok {
+		ctx.Ui.Message(fmt.Sprintf(
+			"[green]The Docker image of the build was pushed as: %s", image))
+	}
+
+	return nil
+}
+
+// Verify checks the syntax of the compiled Packer template with
+// `packer validate -syntax-only`. Nothing is built and the infrastructure
+// doesn't have to exist, so this can run right after compiling. The Dir
+// and TemplatePath of the options are used like Build uses them.
+//
+// This function can be used to implement app.AppVerify.Verify.
+func Verify(ctx *app.Context, opts *BuildOptions) error {
+	project := Project(&ctx.Shared)
+	if err := project.InstallIfNeeded(); err != nil {
+		return err
+	}
+
+	packerDir := opts.Dir
+	templatePath := opts.TemplatePath
+	if opts.Dir == "" {
+		packerDir = filepath.Join(ctx.Dir, "build")
+	}
+	if opts.TemplatePath == "" {
+		templatePath = filepath.Join(packerDir, "template.json")
+	}
+	if err := checkTemplate(ctx, templatePath); err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("Validating Packer template...")
+	p := &Packer{
+		Path: project.Path(),
+		Dir:  packerDir,
+		Ui:   ctx.Ui,
+	}
+	return p.ValidateSyntax(templatePath)
+}
+
+// storeBuildLog stores the Packer output of a build in the directory,
+// whether the build succeeded or not, so that it can be looked at with
+// `otto build logs`. Failing to store it doesn't fail the build.
+func storeBuildLog(ctx *app.Context, lookup *directory.Lookup, data []byte) {
+	if err := directory.PutBuildLog(ctx.Directory, lookup, data); err != nil {
+		log.Printf("[ERROR] error storing build log: %s", err)
+		ui.Warn(ctx.Ui, fmt.Sprintf(
+			"The build log couldn't be stored in the directory: %s", err))
+	}
+}
+
+// unstoredBuildErr saves a build that couldn't be stored because the
+// directory is unreachable, and returns the error that explains how to
+// store it once the directory is back.
+func unstoredBuildErr(ctx *app.Context, build *directory.Build, err error) error {
+	path, werr := directory.WriteUnstoredBuild(ctx.LocalDir, build)
+	if werr != nil {
+		log.Printf("[ERROR] error saving unstored build: %s", werr)
+		return fmt.Errorf(
+			"%s\n\n"+
+				"The build completed successfully, but the directory couldn't be\n"+
+				"reached to store it, and it couldn't be saved locally either: %s\n\n"+
+				"The artifacts of the build are: %v",
+			err, werr, build.Artifact)
+	}
+
+	return fmt.Errorf(
+		"%s\n\n"+
+			"The build completed successfully, but the directory couldn't be\n"+
+			"reached to store it. The build was saved to %s instead.\n\n"+
+			"Once the directory is reachable again, run `otto build store` to\n"+
+			"store the build without building again.",
+		err, path)
+}
+
+// buildTimeout returns the build_timeout from the Appfile, or
+// DefaultBuildTimeout if it isn't set.
+func buildTimeout(ctx *app.Context) (time.Duration, error) {
+	raw := ctx.Appfile.Application.BuildTimeout
+	if raw == "" {
+		return DefaultBuildTimeout, nil
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing build_timeout: %s", err)
+	}
+
+	return d, nil
+}
+
+// buildInterruptedErr is the error returned when a build is interrupted.
+// We never store a build in this case, so the last successful build
+// remains the one that will be deployed.
+func buildInterruptedErr() error {
+	return fmt.Errorf(
+		"Build interrupted. Packer was given the chance to clean up any\n" +
+			"resources it created. The build wasn't stored in the directory, so\n" +
+			"any prior successful build is still the one that will be deployed.")
+}
+
+// buildExecErr turns a failed Packer build into an error message that
+// is targeted at the kind of failure that happened. The name is what is
+// being built, such as "AMI".
+func buildExecErr(name string, err *ExecError) error {
+	var advice string
+	switch err.Kind {
+	case ExecErrorTemplate:
+		advice = "The Packer template Otto generated couldn't be used. If you\n" +
+			"customized the build, please check your customizations. Otherwise,\n" +
+			"please report this to the Otto project."
+	case ExecErrorProvider:
+		advice = "The build failed while creating the artifact. This is usually\n" +
+			"caused by the infrastructure provider (credentials, limits, etc.)\n" +
+			"or a failing build script. Nothing was stored, so please fix\n" +
+			"the error above and rebuild."
+	default:
+		return err
+	}
+
+	// If builds reported errors, lead with them since they're the
+	// actual reason the build failed.
+	var reason string
+	for _, e := range err.Errors {
+		reason += fmt.Sprintf("%s build failed because: %s\n", name, e.Message)
+	}
+	if reason != "" {
+		reason += "\n"
+	}
+
+	return fmt.Errorf(
+		"%sError building with Packer (exit code %d): %s\n\n"+
+			"Last output from Packer:\n\n%s\n\n%s",
+		reason, err.ExitCode, err.Err,
+		strings.TrimSpace(strings.Join(err.Output, "\n")),
+		advice)
+}
+
+// checkTemplate returns an error if there is no compiled Packer template
+// at templatePath, such as when the app has no build templates for the
+// infrastructure.
+func checkTemplate(ctx *app.Context, templatePath string) error {
+	_, err := os.Stat(templatePath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf(
+			"The %q app type has no build templates for the infrastructure %q\n"+
+				"with the flavor %q, so it can't be built. `otto compile` lists\n"+
+				"the infrastructures and flavors the app can be built for.",
+			ctx.Tuple.App, ctx.Tuple.Infra, ctx.Tuple.InfraFlavor)
+	}
+
+	return err
+}
+
+// checkBuildEnv returns an error if the Appfile sets a build environment
+// that the Packer template doesn't declare, since Packer would ignore it
+// and the app would be built without it.
+func checkBuildEnv(ctx *app.Context, templatePath string) error {
+	env := ctx.Appfile.Application.BuildEnv
+	if len(env) == 0 {
+		return nil
+	}
+
+	declared, err := templateVariables(templatePath)
+	if err != nil {
+		return fmt.Errorf("Error reading the Packer template: %s", err)
+	}
+	for k := range env {
+		if _, ok := declared["build_env_"+k]; !ok {
+			return fmt.Errorf(
+				"The build doesn't use the build_env of the Appfile. If it was just\n"+
+					"added, run `otto compile` and build again. Otherwise, the %q app\n"+
+					"type doesn't support build_env for the infrastructure %q with\n"+
+					"the flavor %q, and it must be removed from the Appfile.",
+				ctx.Tuple.App, ctx.Tuple.Infra, ctx.Tuple.InfraFlavor)
+		}
+	}
+
+	return nil
+}
+
+// infraVars are the functions that set the variables the templates of
+// an infrastructure type need but that aren't named the same in the
+// infrastructure outputs and credentials.
+var infraVars = map[string]func(*app.Context, *directory.Infra, map[string]string) error{
+	"digitalocean": varsDigitalOcean,
+	"google":       varsGoogle,
+}
+
+// varsDigitalOcean sets the API token and the region of the build for
+// the DigitalOcean templates.
+func varsDigitalOcean(
+	ctx *app.Context, infra *directory.Infra, vars map[string]string) error {
+	token := ctx.BuildCredsVars()["do_token"]
+	if token == "" {
+		return fmt.Errorf(
+			"A DigitalOcean API token wasn't found in the credentials of the\n" +
+				"infrastructure. Please set do_token in the credentials and try again.")
+	}
+
+	vars["do_token"] = token
+	vars["do_region"] = infra.Outputs["region"]
+	return nil
+}
+
+// varsGoogle sets the credentials, the project, and the zone of the
+// build for the Google Cloud templates.
+func varsGoogle(
+	ctx *app.Context, infra *directory.Infra, vars map[string]string) error {
+	creds := ctx.BuildCredsVars()
+	for _, k := range []string{"gcp_credentials", "gcp_project", "gcp_zone"} {
+		if creds[k] == "" {
+			return fmt.Errorf(
+				"The %s of the Google Cloud infrastructure wasn't found in its\n"+
+					"credentials. Please set it in the credentials and try again.", k)
+		}
+
+		vars[k] = creds[k]
+	}
 
 	return nil
 }
 
+// defaultBuilders returns true if the application is built with the
+// default builder of the app type, rather than builders from the Appfile.
+func defaultBuilders(ctx *app.Context) bool {
+	b := ctx.Appfile.Application.Builders
+	return len(b) == 0 || (len(b) == 1 && b[0] == compile.DefaultBuilder)
+}
+
+// artifactParsers are the functions used to parse the artifacts out of
+// the Packer output for each infrastructure type.
+var artifactParsers = map[string]func(map[string][]string) OutputCallback{
+	"aws":          ParseArtifactAmazon,
+	"digitalocean": ParseArtifactDigitalOcean,
+	"google":       ParseArtifactGoogle,
+}
+
 // ParseArtifactAmazon parses AMIs out of the output.
 //
 // The map will be populated where the key is the region and the value is
-// the AMI ID.
-func ParseArtifactAmazon(m map[string]string) OutputCallback {
+// the list of AMI IDs for that region, in the order they were reported.
+// A single ID event can contain AMIs for multiple regions (when AMIs are
+// copied across regions) and multiple ID events can be reported for the
+// same region (when a template has multiple builders).
+func ParseArtifactAmazon(m map[string][]string) OutputCallback {
 	return func(o *Output) {
 		// We're looking for ID events.
 		//
@@ -168,9 +734,117 @@ func ParseArtifactAmazon(m map[string]string) OutputCallback {
 			return
 		}
 
-		// TODO: multiple AMIs
-		parts := strings.Split(o.Data[2], ":")
-		m[parts[0]] = parts[1]
+		// Multiple AMIs are comma-separated in a single ID.
+		//
+		// Example: us-east-1:ami-9d66def6,us-west-2:ami-1b2c3d4e
+		parseArtifactRegions(m, "Amazon", o.Data[2])
+	}
+}
+
+// ParseArtifactDigitalOcean parses snapshot IDs out of the output.
+//
+// The map will be populated where the key is the region and the value is
+// the list of snapshot IDs for that region, in the order they were
+// reported.
+func ParseArtifactDigitalOcean(m map[string][]string) OutputCallback {
+	return func(o *Output) {
+		// We're looking for ID events.
+		//
+		// Example: 1440649959,digitalocean,artifact,0,id,nyc3:12345678
+		if len(o.Data) < 3 || o.Data[1] != "id" {
+			return
+		}
+
+		parseArtifactRegions(m, "DigitalOcean", o.Data[2])
+	}
+}
+
+// ParseArtifactGoogle parses GCE image names out of the output.
+//
+// Images aren't tied to a region or zone, so the map will be populated
+// with the "image" key where the value is the list of image names, in the
+// order they were reported.
+func ParseArtifactGoogle(m map[string][]string) OutputCallback {
+	return func(o *Output) {
+		// We're looking for ID events.
+		//
+		// Example: 1440649959,googlecompute,artifact,0,id,otto-1440649959
+		if len(o.Data) < 3 || o.Data[1] != "id" {
+			return
+		}
+
+		m["image"] = append(m["image"], o.Data[2])
+	}
+}
+
+// ParseArtifactDocker parses Docker images out of the output.
+//
+// The map will be populated with the "image" key where the value is the
+// list of image references, in the order they were reported. When the
+// image is tagged and pushed with post-processors, the last reference is
+// the pushed image (such as "repo:tag" or "repo@sha256:digest").
+func ParseArtifactDocker(m map[string][]string) OutputCallback {
+	return func(o *Output) {
+		// We're looking for ID events.
+		//
+		// Example: 1440649959,docker,artifact,0,id,hashicorp/app:1440649959
+		if len(o.Data) < 3 || o.Data[1] != "id" {
+			return
+		}
+
+		m["image"] = append(m["image"], o.Data[2])
+	}
+}
+
+// DockerBuilderName is the name of the builder in templates that build a
+// Docker image of the app alongside the main artifact, such as an AMI.
+const DockerBuilderName = "otto-docker"
+
+// DockerArtifactKey is the key of the Docker image in the artifacts of a
+// build, next to the keys of the main artifact, such as the regions.
+const DockerArtifactKey = "docker"
+
+// parseDockerArtifact returns a callback that parses the artifacts of the
+// DockerBuilderName builder into the map under DockerArtifactKey, and
+// passes all other artifacts to cb.
+//
+// The image is reported by the builder as its ID, then again by the
+// post-processors as the reference it is tagged and pushed as, so the
+// last reference is the pushed image.
+func parseDockerArtifact(m map[string][]string, cb OutputCallback) OutputCallback {
+	return func(o *Output) {
+		if o.Target != DockerBuilderName {
+			cb(o)
+			return
+		}
+
+		// Example: 1440649959,otto-docker,artifact,1,id,hashicorp/app:1440649959
+		if len(o.Data) < 3 || o.Data[1] != "id" {
+			return
+		}
+
+		// Every post-processor reports the image it was given, so skip
+		// the repeats.
+		ids := m[DockerArtifactKey]
+		if len(ids) > 0 && ids[len(ids)-1] == o.Data[2] {
+			return
+		}
+
+		m[DockerArtifactKey] = append(ids, o.Data[2])
+	}
+}
+
+// parseArtifactRegions parses a comma-separated list of "region:id"
+// artifact IDs into the map. Invalid entries are logged and skipped.
+func parseArtifactRegions(m map[string][]string, provider, raw string) {
+	for _, part := range strings.Split(raw, ",") {
+		parts := strings.SplitN(part, ":", 2)
+		if len(parts) != 2 {
+			log.Printf("[WARN] invalid %s artifact ID: %s", provider, part)
+			continue
+		}
+
+		m[parts[0]] = append(m[parts[0]], parts[1])
 	}
 }
 
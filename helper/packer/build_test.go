@@ -0,0 +1,220 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestParseArtifactAmazon(t *testing.T) {
+	cases := map[string]struct {
+		Outputs []*Output
+		Result  map[string][]string
+	}{
+		"single": {
+			[]*Output{
+				&Output{Data: []string{"0", "id", "us-east-1:ami-1"}},
+			},
+			map[string][]string{
+				"us-east-1": []string{"ami-1"},
+			},
+		},
+
+		"multiple regions": {
+			[]*Output{
+				&Output{Data: []string{"0", "id", "us-east-1:ami-1,us-west-2:ami-2"}},
+			},
+			map[string][]string{
+				"us-east-1": []string{"ami-1"},
+				"us-west-2": []string{"ami-2"},
+			},
+		},
+
+		"multiple per region": {
+			[]*Output{
+				&Output{Data: []string{"0", "id", "us-east-1:ami-1"}},
+				&Output{Data: []string{"1", "id", "us-east-1:ami-2"}},
+			},
+			map[string][]string{
+				"us-east-1": []string{"ami-1", "ami-2"},
+			},
+		},
+
+		"ignore other events": {
+			[]*Output{
+				&Output{Data: []string{"0", "builder-id", "mitchellh.amazonebs"}},
+				&Output{Data: []string{"0", "id", "invalid"}},
+			},
+			map[string][]string{},
+		},
+	}
+
+	for k, tc := range cases {
+		actual := make(map[string][]string)
+		cb := ParseArtifactAmazon(actual)
+		for _, o := range tc.Outputs {
+			cb(o)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Result) {
+			t.Fatalf("%s: bad: %#v", k, actual)
+		}
+	}
+}
+
+func TestParseDockerArtifact(t *testing.T) {
+	outputs := []*Output{
+		&Output{Target: "otto", Data: []string{"0", "id", "us-east-1:ami-1"}},
+		&Output{Target: "otto-docker", Data: []string{"0", "id", "sha256:abc"}},
+		&Output{Target: "otto-docker", Data: []string{"1", "builder-id", "packer.post-processor.docker-tag"}},
+		&Output{Target: "otto-docker", Data: []string{"1", "id", "foo/app:1"}},
+		&Output{Target: "otto-docker", Data: []string{"2", "id", "foo/app:1"}},
+	}
+
+	m := make(map[string][]string)
+	cb := parseDockerArtifact(m, ParseArtifactAmazon(m))
+	for _, o := range outputs {
+		cb(o)
+	}
+
+	expected := map[string][]string{
+		"us-east-1": []string{"ami-1"},
+		"docker":    []string{"sha256:abc", "foo/app:1"},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Fatalf("bad: %#v", m)
+	}
+}
+
+func TestParseArtifactDigitalOcean(t *testing.T) {
+	actual := make(map[string][]string)
+	cb := ParseArtifactDigitalOcean(actual)
+	cb(&Output{Data: []string{"0", "builder-id", "pearkes.digitalocean"}})
+	cb(&Output{Data: []string{"0", "id", "nyc3:12345"}})
+
+	expected := map[string][]string{
+		"nyc3": []string{"12345"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestCheckBuildEnv(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "template.json")
+	template := `{"variables": {"build_env_FOO": "", "slug_path": null}}`
+	if err := ioutil.WriteFile(path, []byte(template), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		Env map[string]string
+		Err bool
+	}{
+		{nil, false},
+		{map[string]string{"FOO": "bar"}, false},
+		{map[string]string{"FOO": "bar", "BAR": ""}, true},
+	}
+
+	for i, tc := range cases {
+		ctx := new(app.Context)
+		ctx.Appfile = &appfile.File{
+			Application: &appfile.Application{BuildEnv: tc.Env},
+		}
+
+		err := checkBuildEnv(ctx, path)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+	}
+}
+
+func TestVarsDigitalOcean(t *testing.T) {
+	ctx := new(app.Context)
+	infra := &directory.Infra{Outputs: map[string]string{"region": "nyc3"}}
+
+	vars := make(map[string]string)
+	if err := varsDigitalOcean(ctx, infra, vars); err == nil {
+		t.Fatal("should error without a token")
+	}
+
+	ctx.InfraCreds = map[string]string{"do_token": "foo"}
+	if err := varsDigitalOcean(ctx, infra, vars); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]string{
+		"do_token":  "foo",
+		"do_region": "nyc3",
+	}
+	if !reflect.DeepEqual(vars, expected) {
+		t.Fatalf("bad: %#v", vars)
+	}
+}
+
+func TestVarsGoogle(t *testing.T) {
+	ctx := new(app.Context)
+	infra := &directory.Infra{Outputs: map[string]string{"region": "us-central1"}}
+
+	vars := make(map[string]string)
+	ctx.InfraCreds = map[string]string{"gcp_project": "foo", "gcp_zone": "us-central1-a"}
+	if err := varsGoogle(ctx, infra, vars); err == nil {
+		t.Fatal("should error without credentials")
+	}
+
+	ctx.InfraCreds["gcp_credentials"] = "{}"
+	if err := varsGoogle(ctx, infra, vars); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]string{
+		"gcp_credentials": "{}",
+		"gcp_project":     "foo",
+		"gcp_zone":        "us-central1-a",
+	}
+	if !reflect.DeepEqual(vars, expected) {
+		t.Fatalf("bad: %#v", vars)
+	}
+}
+
+func TestParseArtifactGoogle(t *testing.T) {
+	actual := make(map[string][]string)
+	cb := ParseArtifactGoogle(actual)
+	cb(&Output{Data: []string{"0", "builder-id", "packer.googlecompute"}})
+	cb(&Output{Data: []string{"0", "id", "otto-1"}})
+	cb(&Output{Data: []string{"1", "id", "otto-2"}})
+
+	expected := map[string][]string{
+		"image": []string{"otto-1", "otto-2"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestParseArtifactDocker(t *testing.T) {
+	actual := make(map[string][]string)
+	cb := ParseArtifactDocker(actual)
+	cb(&Output{Data: []string{"0", "builder-id", "packer.post-processor.docker-push"}})
+	cb(&Output{Data: []string{"0", "id", "foo/bar:1"}})
+	cb(&Output{Data: []string{"1", "id", "foo/bar@sha256:abc"}})
+
+	expected := map[string][]string{
+		"image": []string{"foo/bar:1", "foo/bar@sha256:abc"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
@@ -0,0 +1,170 @@
+package packer
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// execErrorLines is the number of lines of output kept for an ExecError.
+const execErrorLines = 20
+
+// ExecErrorKind is the classification of why a Packer execution failed.
+type ExecErrorKind byte
+
+const (
+	// ExecErrorUnknown is used when the cause of the failure couldn't
+	// be determined from the output.
+	ExecErrorUnknown ExecErrorKind = iota
+
+	// ExecErrorTemplate is a failure to parse or validate the template
+	// before any builds start.
+	ExecErrorTemplate
+
+	// ExecErrorProvider is a failure within a builder or provisioner
+	// while a build is running, usually due to an error from the
+	// provider (credentials, quotas, failing scripts, etc.).
+	ExecErrorProvider
+)
+
+// ExecError is the error returned by Execute when Packer exits with
+// an error.
+type ExecError struct {
+	// Err is the underlying error from running Packer.
+	Err error
+
+	// ExitCode is the exit code of Packer, or -1 if it is unknown (for
+	// example if Packer couldn't be started at all).
+	ExitCode int
+
+	// Output is the last lines of UI output from Packer, oldest first.
+	Output []string
+
+	// Kind is the classification of the failure based on the output.
+	Kind ExecErrorKind
+
+	// Errors are the errors that builds reported while Packer was
+	// running, in the order they were reported.
+	Errors []*BuildError
+}
+
+// BuildError is an error reported by a single build (such as
+// "amazon-ebs") while Packer is running.
+type BuildError struct {
+	// Timestamp is when Packer reported the error. It is the zero time
+	// if Packer didn't report a valid timestamp.
+	Timestamp time.Time
+
+	// Builder is the name of the build that errored.
+	Builder string
+
+	// Message is the error message.
+	Message string
+}
+
+func (e *BuildError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Builder, e.Message)
+}
+
+// ErrorCallback returns a callback for the "error" type that parses the
+// error events from Packer and calls f with each of them. Since Packer
+// reports the errors as they happen, this can be used to react to
+// provider errors before the build exits.
+func ErrorCallback(f func(*BuildError)) OutputCallback {
+	return func(o *Output) {
+		if e := parseBuildError(o); e != nil {
+			f(e)
+		}
+	}
+}
+
+// parseBuildError parses an error event. Errors that aren't from a
+// build return nil.
+//
+// Example: 1440649959,amazon-ebs,error,Error launching source instance
+func parseBuildError(o *Output) *BuildError {
+	if o.Target == "" || len(o.Data) < 1 {
+		return nil
+	}
+
+	var ts time.Time
+	if v, err := strconv.ParseInt(o.Timestamp, 10, 64); err == nil {
+		ts = time.Unix(v, 0).UTC()
+	}
+
+	return &BuildError{
+		Timestamp: ts,
+		Builder:   o.Target,
+		Message:   o.Data[0],
+	}
+}
+
+func (e *ExecError) Error() string {
+	return fmt.Sprintf(
+		"Error executing Packer: %s\n\n"+
+			"The error messages from Packer are usually very informative.\n"+
+			"Please read it carefully and fix any issues it mentions. If\n"+
+			"the message isn't clear, please report this to the Otto project.",
+		e.Err)
+}
+
+// execErrorRecorder watches the output of a Packer execution so that an
+// ExecError can be built if it fails.
+type execErrorRecorder struct {
+	lines  []string
+	kind   ExecErrorKind
+	errors []*BuildError
+}
+
+// UI should be registered as a callback for the "ui" type.
+func (r *execErrorRecorder) UI(o *Output) {
+	if len(o.Data) < 2 {
+		return
+	}
+
+	r.lines = append(r.lines, strings.Split(o.Data[1], "\n")...)
+	if len(r.lines) > execErrorLines {
+		r.lines = r.lines[len(r.lines)-execErrorLines:]
+	}
+
+	// Template errors are only ever reported through the UI, since they
+	// happen before any builds (targets) exist.
+	if o.Data[0] == "error" && r.kind == ExecErrorUnknown {
+		msg := o.Data[1]
+		if strings.Contains(msg, "Failed to parse template") ||
+			strings.Contains(msg, "Template validation failed") ||
+			strings.Contains(msg, "Error initializing core") {
+			r.kind = ExecErrorTemplate
+		}
+	}
+}
+
+// Error should be registered as a callback for the "error" type.
+func (r *execErrorRecorder) Error(o *Output) {
+	// An error with a target is an error from a specific build.
+	if e := parseBuildError(o); e != nil {
+		r.kind = ExecErrorProvider
+		r.errors = append(r.errors, e)
+	}
+}
+
+// ExecError returns the ExecError for the given error from running Packer.
+func (r *execErrorRecorder) ExecError(err error) *ExecError {
+	code := -1
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			code = status.ExitStatus()
+		}
+	}
+
+	return &ExecError{
+		Err:      err,
+		ExitCode: code,
+		Output:   r.lines,
+		Kind:     r.kind,
+		Errors:   r.errors,
+	}
+}
@@ -0,0 +1,124 @@
+package packer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExecErrorRecorder(t *testing.T) {
+	cases := map[string]struct {
+		Messages []string
+		Kind     ExecErrorKind
+	}{
+		"unknown": {
+			[]string{
+				"1376289459,,ui,say,foo",
+			},
+			ExecErrorUnknown,
+		},
+
+		"template": {
+			[]string{
+				"1376289459,,ui,error,Failed to parse template: bad",
+			},
+			ExecErrorTemplate,
+		},
+
+		"provider": {
+			[]string{
+				"1376289459,amazon-ebs,error,Error launching source instance",
+				"1376289459,,ui,error,Build 'amazon-ebs' errored: bad",
+			},
+			ExecErrorProvider,
+		},
+	}
+
+	for name, tc := range cases {
+		var r execErrorRecorder
+		ui := &packerUi{Callbacks: map[string]OutputCallback{
+			"ui":    r.UI,
+			"error": r.Error,
+		}}
+		for _, msg := range tc.Messages {
+			ui.Raw(msg + "\n")
+		}
+		ui.Finish()
+
+		err := r.ExecError(errors.New("failed"))
+		if err.Kind != tc.Kind {
+			t.Fatalf("%s: bad kind: %d", name, err.Kind)
+		}
+		if err.ExitCode != -1 {
+			t.Fatalf("%s: bad exit code: %d", name, err.ExitCode)
+		}
+	}
+}
+
+func TestExecErrorRecorder_lines(t *testing.T) {
+	var r execErrorRecorder
+	for i := 0; i < execErrorLines+5; i++ {
+		r.UI(&Output{Type: "ui", Data: []string{"say", fmt.Sprintf("%d", i)}})
+	}
+
+	err := r.ExecError(errors.New("failed"))
+	if len(err.Output) != execErrorLines {
+		t.Fatalf("bad: %#v", err.Output)
+	}
+	if err.Output[0] != "5" {
+		t.Fatalf("bad: %#v", err.Output)
+	}
+}
+
+func TestExecErrorRecorder_errors(t *testing.T) {
+	var r execErrorRecorder
+	r.Error(&Output{Type: "error", Data: []string{"not a build"}})
+	r.Error(&Output{
+		Timestamp: "1440649959",
+		Target:    "amazon-ebs",
+		Type:      "error",
+		Data:      []string{"RequestLimitExceeded"},
+	})
+
+	err := r.ExecError(errors.New("failed"))
+	expected := []*BuildError{
+		&BuildError{
+			Timestamp: time.Unix(1440649959, 0).UTC(),
+			Builder:   "amazon-ebs",
+			Message:   "RequestLimitExceeded",
+		},
+	}
+	if !reflect.DeepEqual(err.Errors, expected) {
+		t.Fatalf("bad: %#v", err.Errors)
+	}
+}
+
+func TestErrorCallback(t *testing.T) {
+	var actual []*BuildError
+	ui := &packerUi{Callbacks: map[string]OutputCallback{
+		"error": ErrorCallback(func(e *BuildError) {
+			actual = append(actual, e)
+		}),
+	}}
+	ui.Raw("1440649959,otto,error,Error launching source instance: bad%!(PACKER_COMMA) really\n")
+	ui.Raw("bad,otto,error,no timestamp\n")
+	ui.Raw("1440649959,,error,not a build\n")
+	ui.Finish()
+
+	expected := []*BuildError{
+		&BuildError{
+			Timestamp: time.Unix(1440649959, 0).UTC(),
+			Builder:   "otto",
+			Message:   "Error launching source instance: bad, really",
+		},
+		&BuildError{
+			Builder: "otto",
+			Message: "no timestamp",
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
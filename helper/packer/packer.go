@@ -4,9 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/otto/context"
@@ -32,7 +37,10 @@ func Project(ctx *context.Shared) *hashitools.Project {
 	}
 }
 
-// Packer wraps `packer` execution into an easy-to-use API
+// Packer wraps `packer` execution into an easy-to-use API.
+//
+// Different Packers can execute at the same time, such as when several
+// applications are built in parallel, as long as they don't share a Dir.
 type Packer struct {
 	// Path is the path to Packer itself. If empty, "packer"
 	// will be used and looked up via the PATH var.
@@ -47,15 +55,238 @@ type Packer struct {
 	Ui ui.Ui
 
 	// Callbacks is a list of callbacks that will be called for certain
-	// event types within the output
+	// event types within the output. A callback for the "ui" type is
+	// called in addition to streaming the output to Ui.
 	Callbacks map[string]OutputCallback
 
 	// Variables is a list of variables to pass to Packer.
 	Variables map[string]string
+
+	// SensitiveVars are the names of the Variables that are secret, such
+	// as credentials. Their values are replaced with "***" in all the
+	// output of Packer before it reaches the Ui or the Callbacks.
+	SensitiveVars []string
+
+	// VarFiles is a list of paths to JSON variable files to pass to
+	// Packer. Relative paths are relative to Dir. Each file must exist.
+	//
+	// Later files take precedence over earlier files, and Variables take
+	// precedence over all of them.
+	VarFiles []string
+
+	// MaxRetries is the number of times Execute runs Packer again if it
+	// fails with a transient error, such as a request limit from the
+	// provider. Zero disables retries.
+	//
+	// RetryPatterns are the patterns that mark a failure as transient.
+	// They're matched against the errors and the last lines of output.
+	// If this is nil, DefaultRetryPatterns is used.
+	//
+	// RetryBackoff is how long to wait before the first retry. The wait
+	// doubles with each retry. If this is zero, DefaultRetryBackoff is
+	// used.
+	//
+	// OnRetry, if set, is called before each retry. Any state collected
+	// by the Callbacks during the failed attempt should be reset here.
+	MaxRetries    int
+	RetryPatterns []*regexp.Regexp
+	RetryBackoff  time.Duration
+	OnRetry       func(attempt int)
+
+	cancelLock sync.Mutex
+	cancelCh   chan struct{}
 }
 
+// DefaultRetryPatterns are the patterns of transient errors that are
+// retried if MaxRetries is set.
+var DefaultRetryPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`RequestLimitExceeded`),
+	regexp.MustCompile(`Throttling`),
+	regexp.MustCompile(`InsufficientInstanceCapacity`),
+	regexp.MustCompile(`capacity-not-available`),
+	regexp.MustCompile(`ServiceUnavailable`),
+}
+
+// DefaultRetryBackoff is the wait before the first retry if RetryBackoff
+// isn't set.
+const DefaultRetryBackoff = 30 * time.Second
+
 // Execute executes a raw Packer command.
+//
+// If Packer fails with a transient error, it is run again up to
+// MaxRetries times. If Packer fails, the returned error will be an
+// *ExecError from the last attempt.
 func (p *Packer) Execute(commandRaw ...string) error {
+	backoff := p.RetryBackoff
+	if backoff == 0 {
+		backoff = DefaultRetryBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := p.executeOnce(commandRaw...)
+		execErr, ok := err.(*ExecError)
+		if !ok || attempt > p.MaxRetries || !p.retryable(execErr) {
+			return err
+		}
+
+		log.Printf("[WARN] retrying transient Packer failure: %s", err)
+		if p.Ui != nil {
+			ui.Warn(p.Ui, fmt.Sprintf(
+				"Packer failed with a transient error. Retrying in %s\n"+
+					"(retry %d of %d)...", backoff, attempt, p.MaxRetries))
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-p.cancelChan():
+			return execHelper.ErrInterrupted
+		}
+		backoff *= 2
+
+		if p.OnRetry != nil {
+			p.OnRetry(attempt)
+		}
+	}
+}
+
+// retryable returns true if the failure matches a retry pattern.
+func (p *Packer) retryable(err *ExecError) bool {
+	patterns := p.RetryPatterns
+	if patterns == nil {
+		patterns = DefaultRetryPatterns
+	}
+
+	lines := make([]string, 0, len(err.Errors)+len(err.Output))
+	for _, e := range err.Errors {
+		lines = append(lines, e.Message)
+	}
+	lines = append(lines, err.Output...)
+
+	for _, re := range patterns {
+		for _, line := range lines {
+			if re.MatchString(line) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// executeOnce runs Packer a single time for Execute.
+func (p *Packer) executeOnce(commandRaw ...string) error {
+	// Build our custom UI that we'll use that'll call the registered
+	// callbacks as well as streaming data to the UI. The recorder watches
+	// the output so we can build a useful error if Packer fails.
+	var recorder execErrorRecorder
+	callbacks := make(map[string]OutputCallback)
+	for n, cb := range p.Callbacks {
+		callbacks[n] = cb
+	}
+	callbacks["ui"] = chainCallbacks(p.uiCallback, callbacks["ui"], recorder.UI)
+	callbacks["error"] = chainCallbacks(recorder.Error, callbacks["error"])
+
+	err := p.execute(callbacks, commandRaw...)
+	if err == execHelper.ErrInterrupted {
+		return err
+	}
+	if err != nil {
+		return recorder.ExecError(err)
+	}
+
+	return nil
+}
+
+// Validate runs `packer validate` against the template at the given path
+// using the same directory and variables that Execute would use.
+//
+// The output of Packer isn't streamed to the UI. Instead, if validation
+// fails, the output is included in the returned error.
+func (p *Packer) Validate(templatePath string) error {
+	return p.validate("validate", templatePath)
+}
+
+// ValidateSyntax runs `packer validate -syntax-only` against the template
+// at the given path. Unlike Validate, the variables don't have to be
+// set, so this can check a template before there is anything to build.
+func (p *Packer) ValidateSyntax(templatePath string) error {
+	return p.validate("validate", "-syntax-only", templatePath)
+}
+
+// validate runs a validate command for Validate and ValidateSyntax.
+func (p *Packer) validate(commandRaw ...string) error {
+	var output []string
+	callbacks := map[string]OutputCallback{
+		"ui": func(o *Output) {
+			if len(o.Data) > 1 {
+				output = append(output, o.Data[1])
+			}
+		},
+	}
+
+	err := p.execute(callbacks, commandRaw...)
+	if err == execHelper.ErrInterrupted {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"Error validating Packer template: %s\n\n"+
+				"Packer output:\n\n%s\n\n"+
+				"The template and variables were checked before starting the\n"+
+				"build. Please fix the errors above and try again.",
+			err, strings.TrimSpace(strings.Join(output, "\n")))
+	}
+
+	return nil
+}
+
+// Cancel interrupts any running Packer command and waits for Packer to
+// gracefully clean up. Execute and Validate will return
+// exec.ErrInterrupted (from helper/exec) when they are cancelled.
+//
+// Once cancelled, all future commands on this Packer will also be
+// cancelled immediately.
+func (p *Packer) Cancel() {
+	ch := p.cancelChan()
+
+	p.cancelLock.Lock()
+	defer p.cancelLock.Unlock()
+	select {
+	case <-ch:
+		// Already cancelled
+	default:
+		close(ch)
+	}
+}
+
+func (p *Packer) cancelChan() chan struct{} {
+	p.cancelLock.Lock()
+	defer p.cancelLock.Unlock()
+	if p.cancelCh == nil {
+		p.cancelCh = make(chan struct{})
+	}
+
+	return p.cancelCh
+}
+
+// execute runs Packer with the given arguments, routing the machine-readable
+// output to the given callbacks.
+func (p *Packer) execute(callbacks map[string]OutputCallback, commandRaw ...string) error {
+	path := "packer"
+	if p.Path != "" {
+		path = p.Path
+	}
+
+	// The templates need a minimum version of Packer, so check it first
+	// rather than failing with a confusing error halfway through.
+	if err := hashitools.CheckVersion("packer", path, packerMinVersion); err != nil {
+		return err
+	}
+
+	if err := p.checkVarFiles(); err != nil {
+		return err
+	}
+
 	varfile, err := p.varfile()
 	if err != nil {
 		return err
@@ -65,44 +296,76 @@ func (p *Packer) Execute(commandRaw ...string) error {
 	}
 
 	// The command must always be machine-readable. We use this
-	// exclusively to mirror the UI output.
-	command := make([]string, len(commandRaw)+3)
-	command[0] = commandRaw[0]
-	command[1] = "-machine-readable"
-	command[2] = "-var-file"
-	command[3] = varfile
-	copy(command[4:], commandRaw[1:])
+	// exclusively to mirror the UI output. Packer gives precedence to
+	// later var files, so our inline variables go last.
+	command := make([]string, 0, len(commandRaw)+2*len(p.VarFiles)+3)
+	command = append(command, commandRaw[0], "-machine-readable")
+	for _, path := range p.VarFiles {
+		command = append(command, "-var-file", path)
+	}
+	command = append(command, "-var-file", varfile)
+	command = append(command, commandRaw[1:]...)
 
 	// Build the command to execute
-	path := "packer"
-	if p.Path != "" {
-		path = p.Path
-	}
 	cmd := exec.Command(path, command...)
 	cmd.Dir = p.Dir
 
-	// Build our custom UI that we'll use that'll call the registered
-	// callbacks as well as streaming data to the UI.
-	callbacks := make(map[string]OutputCallback)
-	callbacks["ui"] = p.uiCallback
-	for n, cb := range p.Callbacks {
-		callbacks[n] = cb
+	// Secrets are redacted before any callback can see the output
+	if secrets := p.secrets(); len(secrets) > 0 {
+		callbacks = redactCallbacks(callbacks, secrets)
 	}
-	ui := &packerUi{Callbacks: callbacks}
 
 	// Execute!
-	err = execHelper.Run(ui, cmd)
+	ui := &packerUi{Callbacks: callbacks}
+	err = execHelper.RunCancel(ui, cmd, p.cancelChan())
 	ui.Finish()
-	if err != nil {
-		return fmt.Errorf(
-			"Error executing Packer: %s\n\n"+
-				"The error messages from Packer are usually very informative.\n"+
-				"Please read it carefully and fix any issues it mentions. If\n"+
-				"the message isn't clear, please report this to the Otto project.",
-			err)
+	return err
+}
+
+// chainCallbacks returns a callback that calls each of the given non-nil
+// callbacks in order.
+func chainCallbacks(cbs ...OutputCallback) OutputCallback {
+	return func(o *Output) {
+		for _, cb := range cbs {
+			if cb != nil {
+				cb(o)
+			}
+		}
+	}
+}
+
+// secrets returns the values of the SensitiveVars.
+func (p *Packer) secrets() []string {
+	var result []string
+	for _, k := range p.SensitiveVars {
+		if v := p.Variables[k]; v != "" {
+			result = append(result, v)
+		}
 	}
 
-	return nil
+	return result
+}
+
+// redactCallbacks returns callbacks that call the given callbacks with
+// the secrets redacted from the data of the output.
+func redactCallbacks(
+	callbacks map[string]OutputCallback, secrets []string) map[string]OutputCallback {
+	result := make(map[string]OutputCallback, len(callbacks))
+	for n, cb := range callbacks {
+		cb := cb
+		result[n] = func(o *Output) {
+			data := make([]string, len(o.Data))
+			for i, d := range o.Data {
+				data[i] = ui.Redact(d, secrets)
+			}
+
+			redacted := *o
+			redacted.Data = data
+			cb(&redacted)
+		}
+	}
+
+	return result
 }
 
 func (p *Packer) uiCallback(o *Output) {
@@ -116,6 +379,28 @@ func (p *Packer) uiCallback(o *Output) {
 	p.Ui.Raw(o.Data[1] + "\n")
 }
 
+// checkVarFiles verifies that all the VarFiles exist so that we can
+// give a clear error before running Packer.
+func (p *Packer) checkVarFiles() error {
+	for _, path := range p.VarFiles {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(p.Dir, path)
+		}
+
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf(
+					"Packer variable file doesn't exist: %s", path)
+			}
+
+			return fmt.Errorf(
+				"Error checking Packer variable file %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
 func (p *Packer) varfile() (string, error) {
 	f, err := ioutil.TempFile("", "otto")
 	if err != nil {
@@ -0,0 +1,166 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPackerCheckVarFiles(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	if err := ioutil.WriteFile(filepath.Join(td, "vars.json"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Relative to the directory
+	p := &Packer{Dir: td, VarFiles: []string{"vars.json"}}
+	if err := p.checkVarFiles(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Absolute
+	p = &Packer{VarFiles: []string{filepath.Join(td, "vars.json")}}
+	if err := p.checkVarFiles(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Missing
+	p = &Packer{Dir: td, VarFiles: []string{"vars.json", "missing.json"}}
+	if err := p.checkVarFiles(); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestPackerExecute_retry(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// A fake Packer that fails with a transient error until it has been
+	// run the given number of times.
+	script := `#!/bin/sh
+if [ "$1" = "--version" ]; then
+  echo "Packer v0.8.6"
+  exit 0
+fi
+echo x >> attempts
+if [ $(wc -l < attempts) -lt $FAKE_PACKER_SUCCEED ]; then
+  echo "1440649959,otto,error,RequestLimitExceeded: slow down"
+  exit 1
+fi
+echo "1440649959,otto,artifact,0,id,us-east-1:ami-1"
+`
+	path := filepath.Join(td, "packer")
+	if err := ioutil.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		Succeed    string
+		MaxRetries int
+		Attempts   int
+		Retries    int
+		Err        bool
+	}{
+		{"1", 2, 1, 0, false},
+		{"3", 2, 3, 2, false},
+		{"4", 2, 3, 2, true},
+		{"2", 0, 1, 0, true},
+	}
+
+	for i, tc := range cases {
+		os.Remove(filepath.Join(td, "attempts"))
+		os.Setenv("FAKE_PACKER_SUCCEED", tc.Succeed)
+
+		var artifacts, retries int
+		p := &Packer{
+			Path: path,
+			Dir:  td,
+			Callbacks: map[string]OutputCallback{
+				"artifact": func(*Output) { artifacts++ },
+			},
+			MaxRetries:   tc.MaxRetries,
+			RetryBackoff: time.Millisecond,
+			OnRetry:      func(int) { retries++ },
+		}
+
+		err := p.Execute("build", "template.json")
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if retries != tc.Retries {
+			t.Fatalf("%d: bad retries: %d", i, retries)
+		}
+
+		raw, err := ioutil.ReadFile(filepath.Join(td, "attempts"))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if n := strings.Count(string(raw), "\n"); n != tc.Attempts {
+			t.Fatalf("%d: bad attempts: %d", i, n)
+		}
+		if !tc.Err && artifacts != 1 {
+			t.Fatalf("%d: bad artifacts: %d", i, artifacts)
+		}
+	}
+	os.Unsetenv("FAKE_PACKER_SUCCEED")
+}
+
+func TestPackerRetryable(t *testing.T) {
+	p := &Packer{}
+	if !p.retryable(&ExecError{Output: []string{"RequestLimitExceeded"}}) {
+		t.Fatal("should retry output")
+	}
+	if !p.retryable(&ExecError{Errors: []*BuildError{
+		&BuildError{Message: "InsufficientInstanceCapacity: none left"}}}) {
+		t.Fatal("should retry errors")
+	}
+	if p.retryable(&ExecError{Output: []string{"AuthFailure"}}) {
+		t.Fatal("should not retry")
+	}
+
+	p.RetryPatterns = []*regexp.Regexp{regexp.MustCompile("AuthFailure")}
+	if !p.retryable(&ExecError{Output: []string{"AuthFailure"}}) {
+		t.Fatal("should retry custom pattern")
+	}
+}
+
+func TestRedactCallbacks(t *testing.T) {
+	p := &Packer{
+		Variables: map[string]string{
+			"aws_access_key": "access",
+			"aws_secret_key": "secret",
+		},
+		SensitiveVars: []string{"aws_secret_key", "aws_token"},
+	}
+
+	var actual []string
+	callbacks := redactCallbacks(map[string]OutputCallback{
+		"ui": func(o *Output) {
+			actual = append(actual, o.Data...)
+		},
+	}, p.secrets())
+
+	o := &Output{Type: "ui", Data: []string{"error", "bad secret for access"}}
+	callbacks["ui"](o)
+
+	expected := []string{"error", "bad *** for access"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if o.Data[1] != "bad secret for access" {
+		t.Fatalf("original modified: %#v", o.Data)
+	}
+}
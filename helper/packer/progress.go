@@ -0,0 +1,106 @@
+package packer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+// ProgressPhase is a phase of a Packer build that is used to report the
+// progress of the build.
+type ProgressPhase struct {
+	// Name is the name of the phase shown to the user, such as
+	// "Provisioning".
+	Name string
+
+	// Match is the list of messages that start this phase. The phase
+	// starts when a UI message from Packer contains any of them.
+	Match []string
+}
+
+// ProgressCallback returns a callback for the "ui" type that outputs a
+// coarse progress indicator to the Ui as the build reaches each phase,
+// such as "Provisioning (3/6, 33% complete)". The percentage is the
+// share of phases that are complete.
+//
+// Phases only move forward: a message matching an earlier phase is
+// ignored, and phases that never start (such as copying an AMI when no
+// other regions are given) are skipped.
+func ProgressCallback(u ui.Ui, phases []*ProgressPhase) OutputCallback {
+	current := -1
+	return func(o *Output) {
+		if len(o.Data) < 2 {
+			return
+		}
+
+		for i := current + 1; i < len(phases); i++ {
+			if !phases[i].matches(o.Data[1]) {
+				continue
+			}
+
+			current = i
+			u.Header(fmt.Sprintf(
+				"%s (%d/%d, %d%% complete)",
+				phases[i].Name, i+1, len(phases), i*100/len(phases)))
+			return
+		}
+	}
+}
+
+func (p *ProgressPhase) matches(msg string) bool {
+	for _, m := range p.Match {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// progressPhases are the phases used to report progress for each
+// infrastructure type.
+var progressPhases = map[string][]*ProgressPhase{
+	"aws":          ProgressPhasesAmazon,
+	"digitalocean": ProgressPhasesDigitalOcean,
+	"google":       ProgressPhasesGoogle,
+}
+
+// ProgressPhasesAmazon are the phases of the amazon-ebs builder.
+var ProgressPhasesAmazon = []*ProgressPhase{
+	&ProgressPhase{Name: "Launching instance", Match: []string{"Launching a source AWS instance"}},
+	&ProgressPhase{Name: "Waiting for SSH", Match: []string{"Waiting for SSH"}},
+	&ProgressPhase{Name: "Provisioning", Match: []string{"Provisioning with"}},
+	&ProgressPhase{Name: "Creating AMI", Match: []string{"Stopping the source instance", "Creating the AMI"}},
+	&ProgressPhase{Name: "Copying AMI to other regions", Match: []string{"Copying AMI"}},
+	&ProgressPhase{Name: "Cleaning up", Match: []string{"Terminating the source AWS instance"}},
+}
+
+// ProgressPhasesDigitalOcean are the phases of the digitalocean builder.
+var ProgressPhasesDigitalOcean = []*ProgressPhase{
+	&ProgressPhase{Name: "Creating droplet", Match: []string{"Creating droplet"}},
+	&ProgressPhase{Name: "Waiting for SSH", Match: []string{"Waiting for SSH"}},
+	&ProgressPhase{Name: "Provisioning", Match: []string{"Provisioning with"}},
+	&ProgressPhase{Name: "Creating snapshot", Match: []string{"shutting down droplet", "Creating snapshot"}},
+	&ProgressPhase{Name: "Cleaning up", Match: []string{"Destroying droplet"}},
+}
+
+// ProgressPhasesGoogle are the phases of the googlecompute builder. The
+// instance is deleted before the image is made from its disk.
+var ProgressPhasesGoogle = []*ProgressPhase{
+	&ProgressPhase{Name: "Creating instance", Match: []string{"Creating instance"}},
+	&ProgressPhase{Name: "Waiting for SSH", Match: []string{"Waiting for SSH"}},
+	&ProgressPhase{Name: "Provisioning", Match: []string{"Provisioning with"}},
+	&ProgressPhase{Name: "Creating image", Match: []string{"Deleting instance", "Creating image"}},
+	&ProgressPhase{Name: "Cleaning up", Match: []string{"Deleting disk"}},
+}
+
+// ProgressPhasesDocker are the phases of the docker builder along with
+// the tag and push post-processors.
+var ProgressPhasesDocker = []*ProgressPhase{
+	&ProgressPhase{Name: "Pulling image", Match: []string{"Pulling Docker image"}},
+	&ProgressPhase{Name: "Starting container", Match: []string{"Starting docker container"}},
+	&ProgressPhase{Name: "Provisioning", Match: []string{"Provisioning with"}},
+	&ProgressPhase{Name: "Committing image", Match: []string{"Committing the container"}},
+	&ProgressPhase{Name: "Pushing image", Match: []string{"Pushing"}},
+}
@@ -0,0 +1,41 @@
+package packer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+func TestProgressCallback(t *testing.T) {
+	phases := []*ProgressPhase{
+		&ProgressPhase{Name: "One", Match: []string{"one"}},
+		&ProgressPhase{Name: "Two", Match: []string{"two", "deux"}},
+		&ProgressPhase{Name: "Three", Match: []string{"three"}},
+		&ProgressPhase{Name: "Four", Match: []string{"four"}},
+	}
+
+	var mock ui.Mock
+	cb := ProgressCallback(&mock, phases)
+	for _, msg := range []string{
+		"==> otto: one",
+		"==> otto: one again",
+		"==> otto: deux",
+		"==> otto: one",
+		"==> otto: nothing",
+		"==> otto: four",
+		"==> otto: three",
+	} {
+		cb(&Output{Type: "ui", Data: []string{"say", msg}})
+	}
+	cb(&Output{Type: "ui", Data: []string{"say"}})
+
+	expected := []string{
+		"One (1/4, 0% complete)",
+		"Two (2/4, 25% complete)",
+		"Four (4/4, 75% complete)",
+	}
+	if !reflect.DeepEqual(mock.HeaderBuf, expected) {
+		t.Fatalf("bad: %#v", mock.HeaderBuf)
+	}
+}
@@ -0,0 +1,33 @@
+package packer
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+)
+
+// gitSHA returns the SHA of the commit checked out in dir, or an empty
+// string if dir isn't in a Git repository or Git isn't installed.
+func gitSHA(dir string) string {
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		log.Printf("[DEBUG] couldn't read git SHA in %s: %s", dir, err)
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// builderName returns the name of the user running the build, falling
+// back to the USER environment variable if the user can't be looked up.
+func builderName() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+
+	return os.Getenv("USER")
+}
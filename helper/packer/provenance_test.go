@@ -0,0 +1,40 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+func TestGitSHA(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not found")
+	}
+
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	// Not a repository
+	if sha := gitSHA(td); sha != "" {
+		t.Fatalf("bad: %q", sha)
+	}
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = td
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s\n\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	git("-c", "user.name=otto", "-c", "user.email=otto@example.com",
+		"commit", "-q", "--allow-empty", "-m", "initial")
+
+	if sha := gitSHA(td); len(sha) != 40 {
+		t.Fatalf("bad: %q", sha)
+	}
+}
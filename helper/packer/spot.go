@@ -0,0 +1,98 @@
+package packer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SpotRequestTimeout is how long a build waits for its spot request to
+// be fulfilled before the build is cancelled. Packer itself waits much
+// longer, which looks like a hang when there is no spot capacity or the
+// price is too low.
+var SpotRequestTimeout = 10 * time.Minute
+
+// spotPriceAutoProduct is the product used to find the current spot
+// price when the spot price is "auto". The built-in AWS infrastructures
+// all build in a VPC.
+const spotPriceAutoProduct = "Linux/UNIX (Amazon VPC)"
+
+// spotWatcher cancels a build whose spot request isn't fulfilled within
+// Timeout. It watches the output from Packer, so Callback should be
+// registered for the "ui" type.
+type spotWatcher struct {
+	Timeout time.Duration
+	Cancel  func()
+
+	lock     sync.Mutex
+	timer    *time.Timer
+	timedOut bool
+}
+
+// Callback starts the timer when Packer starts waiting for the spot
+// request, and stops it on the next output, which means the request
+// was either fulfilled or failed.
+func (w *spotWatcher) Callback(o *Output) {
+	if len(o.Data) < 2 {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if strings.Contains(o.Data[1], "Waiting for spot request") {
+		if w.timer == nil {
+			w.timer = time.AfterFunc(w.Timeout, w.timeout)
+		}
+
+		return
+	}
+
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+}
+
+// TimedOut returns true if the build was cancelled because the spot
+// request wasn't fulfilled in time.
+func (w *spotWatcher) TimedOut() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.timedOut
+}
+
+func (w *spotWatcher) timeout() {
+	w.lock.Lock()
+	w.timedOut = true
+	w.timer = nil
+	w.lock.Unlock()
+
+	w.Cancel()
+}
+
+// spotBuildError returns the error of a failed build that is about its
+// spot request, such as there being no capacity at the price, or nil if
+// the build failed for another reason.
+func spotBuildError(err *ExecError) *BuildError {
+	for _, e := range err.Errors {
+		if strings.Contains(strings.ToLower(e.Message), "spot") {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// spotBuildErr is the error returned when a build on a spot instance
+// fails because of the spot request.
+func spotBuildErr(price string, cause string) error {
+	return fmt.Errorf(
+		"%s\n\n"+
+			"The build uses a spot instance with a maximum price of %s\n"+
+			"(build_spot_price in the Appfile). Spot capacity for the build\n"+
+			"instance type may not be available at that price right now.\n"+
+			"Please raise the price, set it to \"auto\", try again later, or\n"+
+			"remove build_spot_price to build on an on-demand instance.",
+		cause, price)
+}
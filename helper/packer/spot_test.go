@@ -0,0 +1,58 @@
+package packer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpotWatcher(t *testing.T) {
+	cancelCh := make(chan struct{})
+	w := &spotWatcher{
+		Timeout: 10 * time.Millisecond,
+		Cancel:  func() { close(cancelCh) },
+	}
+
+	w.Callback(&Output{Type: "ui", Data: []string{"say", "==> otto: Launching a spot AWS instance..."}})
+	w.Callback(&Output{Type: "ui", Data: []string{"say", "    otto: Waiting for spot request (sir-1234) to become active..."}})
+
+	select {
+	case <-cancelCh:
+	case <-time.After(time.Second):
+		t.Fatal("should cancel")
+	}
+	if !w.TimedOut() {
+		t.Fatal("should be timed out")
+	}
+}
+
+func TestSpotWatcher_fulfilled(t *testing.T) {
+	w := &spotWatcher{
+		Timeout: 10 * time.Millisecond,
+		Cancel:  func() { t.Fatal("should not cancel") },
+	}
+
+	w.Callback(&Output{Type: "ui", Data: []string{"say", "    otto: Waiting for spot request (sir-1234) to become active..."}})
+	w.Callback(&Output{Type: "ui", Data: []string{"say", "    otto: Instance ID: i-1234"}})
+
+	time.Sleep(50 * time.Millisecond)
+	if w.TimedOut() {
+		t.Fatal("should not be timed out")
+	}
+}
+
+func TestSpotBuildError(t *testing.T) {
+	err := &ExecError{
+		Errors: []*BuildError{
+			&BuildError{Builder: "otto", Message: "Error launching source instance"},
+			&BuildError{Builder: "otto", Message: "Error waiting for Spot request: capacity-not-available"},
+		},
+	}
+	if e := spotBuildError(err); e == nil || e != err.Errors[1] {
+		t.Fatalf("bad: %#v", e)
+	}
+
+	err.Errors = err.Errors[:1]
+	if e := spotBuildError(err); e != nil {
+		t.Fatalf("bad: %#v", e)
+	}
+}
@@ -87,14 +87,14 @@ func (u *packerUi) Raw(msg string) {
 		if len(parts) < 3 {
 			// Uh, invalid event?
 			log.Printf("[ERROR] Invalid Packer event line: %s", buf)
-			return
+			continue
 		}
 
 		// Look for the callback
 		cb, ok := u.Callbacks[parts[2]]
 		if !ok {
 			// No callback registered for this type, drop it
-			return
+			continue
 		}
 
 		// We have a callback, construct the output!
@@ -174,6 +174,22 @@ func TestPackerUi(t *testing.T) {
 			[]string{"1376289459,,not-ui,say,foo bar"},
 			[]*Output{},
 		},
+
+		"unregistered type before registered": {
+			[]string{"ui"},
+			[]string{"1376289459,,not-ui,say,foo\n1376289459,,ui,say,bar\n"},
+			[]*Output{
+				&Output{
+					Timestamp: "1376289459",
+					Target:    "",
+					Type:      "ui",
+					Data: []string{
+						"say",
+						"bar",
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,117 @@
+package packer
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/otto/app"
+)
+
+// checkVolumeSize returns an error if the volume_size of the Appfile is
+// smaller than the root snapshot of the source AMI. AWS only reports
+// this once Packer launches the instance, after the archive is uploaded
+// and with a less helpful message.
+//
+// If the source AMI can't be looked up, such as when the credentials
+// can't describe images, the check is skipped and AWS reports it.
+func checkVolumeSize(
+	ctx *app.Context, vars map[string]string, templatePath string) error {
+	size := ctx.Appfile.Application.VolumeSize
+	if size == 0 || ctx.Tuple.Infra != "aws" {
+		return nil
+	}
+
+	ami := vars["source_ami"]
+	if ami == "" {
+		var err error
+		ami, err = templateVariable(templatePath, "source_ami")
+		if err != nil {
+			return fmt.Errorf("Error reading the Packer template: %s", err)
+		}
+	}
+	if ami == "" {
+		return nil
+	}
+
+	config := aws.NewConfig().WithRegion(vars["aws_region"])
+	if vars["aws_access_key"] != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			vars["aws_access_key"], vars["aws_secret_key"], vars["aws_token"]))
+	}
+	resp, err := ec2.New(session.New(config)).DescribeImages(
+		&ec2.DescribeImagesInput{ImageIds: []*string{aws.String(ami)}})
+	if err != nil {
+		log.Printf("[WARN] error describing source AMI %s: %s", ami, err)
+		return nil
+	}
+	if len(resp.Images) == 0 {
+		return nil
+	}
+
+	if min := rootSnapshotSize(resp.Images[0]); int64(size) < min {
+		return fmt.Errorf(
+			"The volume_size of %d GB in the Appfile is smaller than the %d GB\n"+
+				"snapshot of the source AMI %s. Please set volume_size to at\n"+
+				"least %d and build again.", size, min, ami, min)
+	}
+
+	return nil
+}
+
+// rootSnapshotSize returns the size in GB of the snapshot of the root
+// device of an image, or zero if it isn't known.
+func rootSnapshotSize(image *ec2.Image) int64 {
+	if image.RootDeviceName == nil {
+		return 0
+	}
+
+	for _, m := range image.BlockDeviceMappings {
+		if m.DeviceName == nil || *m.DeviceName != *image.RootDeviceName {
+			continue
+		}
+		if m.Ebs == nil || m.Ebs.VolumeSize == nil {
+			return 0
+		}
+
+		return *m.Ebs.VolumeSize
+	}
+
+	return 0
+}
+
+// templateVariable returns the default value of a variable of the
+// Packer template at path, or an empty string if it has none.
+func templateVariable(path, name string) (string, error) {
+	vars, err := templateVariables(path)
+	if err != nil {
+		return "", err
+	}
+
+	v, _ := vars[name].(string)
+	return v, nil
+}
+
+// templateVariables reads the variables declared in the Packer template
+// at path, with their defaults.
+func templateVariables(path string) (map[string]interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var template struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(f).Decode(&template); err != nil {
+		return nil, err
+	}
+
+	return template.Variables, nil
+}
@@ -0,0 +1,88 @@
+package packer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestRootSnapshotSize(t *testing.T) {
+	mapping := func(name string, size int64) *ec2.BlockDeviceMapping {
+		return &ec2.BlockDeviceMapping{
+			DeviceName: aws.String(name),
+			Ebs:        &ec2.EbsBlockDevice{VolumeSize: aws.Int64(size)},
+		}
+	}
+
+	cases := []struct {
+		Image  *ec2.Image
+		Result int64
+	}{
+		{
+			&ec2.Image{},
+			0,
+		},
+		{
+			&ec2.Image{
+				RootDeviceName: aws.String("/dev/sda1"),
+				BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+					mapping("/dev/sdb", 100),
+					mapping("/dev/sda1", 8),
+				},
+			},
+			8,
+		},
+		{
+			&ec2.Image{
+				RootDeviceName: aws.String("/dev/sda1"),
+				BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+					&ec2.BlockDeviceMapping{DeviceName: aws.String("/dev/sda1")},
+				},
+			},
+			0,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := rootSnapshotSize(tc.Image); actual != tc.Result {
+			t.Fatalf("%d: %d", i, actual)
+		}
+	}
+}
+
+func TestTemplateVariable(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "template.json")
+	template := `{"variables": {"source_ami": "ami-12345678", "slug_path": null}}`
+	if err := ioutil.WriteFile(path, []byte(template), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := map[string]string{
+		"source_ami": "ami-12345678",
+		"slug_path":  "",
+		"missing":    "",
+	}
+	for name, expected := range cases {
+		actual, err := templateVariable(path, name)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if actual != expected {
+			t.Fatalf("%s: %q", name, actual)
+		}
+	}
+
+	if _, err := templateVariable(filepath.Join(td, "missing.json"), "source_ami"); err == nil {
+		t.Fatal("should error")
+	}
+}
@@ -0,0 +1,106 @@
+package terraform
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// Backend configures Terraform to store its state remotely rather than
+// in the directory. This allows multiple people to safely share the same
+// state, since it is never copied into and out of the directory.
+type Backend struct {
+	// Type is the type of remote backend, such as "s3" or "consul".
+	Type string
+
+	// Config is the configuration for the backend, passed to Terraform
+	// as -backend-config arguments. The key (S3) or path (Consul) for the
+	// state will be set from StateId if it isn't set here.
+	//
+	// Config is on the command line, where anyone on the machine can see
+	// it, so it must not contain credentials. Use Env for those.
+	Config map[string]string
+
+	// Env is added to the environment of every Terraform command run
+	// with this backend, such as AWS_SECRET_ACCESS_KEY for S3. Its values
+	// are replaced with "***" in the output of Terraform.
+	Env map[string]string
+}
+
+// backendStateKeys is the configuration key for each backend type that
+// identifies where the state is stored.
+var backendStateKeys = map[string]string{
+	"atlas":  "name",
+	"consul": "path",
+	"s3":     "key",
+}
+
+// args returns the arguments to `terraform remote config` for this
+// backend, using stateId as the location of the state if needed.
+func (b *Backend) args(stateId string) ([]string, error) {
+	config := make(map[string]string)
+	for k, v := range b.Config {
+		config[k] = v
+	}
+
+	if key, ok := backendStateKeys[b.Type]; ok {
+		if _, ok := config[key]; !ok {
+			if stateId == "" {
+				return nil, fmt.Errorf(
+					"The %s backend requires the '%s' configuration or a state ID.",
+					b.Type, key)
+			}
+
+			config[key] = stateId
+		}
+	}
+
+	// Sort the keys so the arguments are deterministic
+	keys := make([]string, 0, len(config))
+	for k := range config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := []string{"remote", "config", "-backend=" + b.Type}
+	for _, k := range keys {
+		args = append(args, fmt.Sprintf("-backend-config=%s=%s", k, config[k]))
+	}
+
+	return args, nil
+}
+
+// env returns Env as "KEY=value" pairs for exec.Cmd, sorted by key.
+func (b *Backend) env() []string {
+	keys := make([]string, 0, len(b.Env))
+	for k := range b.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = fmt.Sprintf("%s=%s", k, b.Env[k])
+	}
+
+	return result
+}
+
+// lockKey returns the key of the directory lock that guards the state
+// in this backend, using stateId as the location of the state if needed.
+func (b *Backend) lockKey(stateId string) string {
+	location := stateId
+	if key, ok := backendStateKeys[b.Type]; ok {
+		if v, ok := b.Config[key]; ok {
+			location = v
+		}
+	}
+
+	return fmt.Sprintf("terraform-state/%s/%s", b.Type, location)
+}
+
+// remoteStatePath returns the path to the local copy of the remote state
+// that Terraform keeps within dir.
+func remoteStatePath(dir string) string {
+	return filepath.Join(dir, ".terraform", "terraform.tfstate")
+}
@@ -0,0 +1,127 @@
+package terraform
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBackendArgs(t *testing.T) {
+	cases := []struct {
+		Backend *Backend
+		StateId string
+		Result  []string
+		Err     bool
+	}{
+		{
+			&Backend{Type: "s3", Config: map[string]string{
+				"bucket": "foo",
+				"region": "us-east-1",
+			}},
+			"bar",
+			[]string{
+				"remote", "config", "-backend=s3",
+				"-backend-config=bucket=foo",
+				"-backend-config=key=bar",
+				"-backend-config=region=us-east-1",
+			},
+			false,
+		},
+
+		{
+			&Backend{Type: "consul", Config: map[string]string{
+				"path": "foo",
+			}},
+			"bar",
+			[]string{
+				"remote", "config", "-backend=consul",
+				"-backend-config=path=foo",
+			},
+			false,
+		},
+
+		{
+			&Backend{Type: "consul"},
+			"",
+			nil,
+			true,
+		},
+
+		{
+			&Backend{Type: "http", Config: map[string]string{
+				"address": "foo",
+			}},
+			"",
+			[]string{
+				"remote", "config", "-backend=http",
+				"-backend-config=address=foo",
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		result, err := tc.Backend.args(tc.StateId)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%#v: err: %s", tc.Backend, err)
+		}
+		if !reflect.DeepEqual(result, tc.Result) {
+			t.Fatalf("%#v: bad: %#v", tc.Backend, result)
+		}
+	}
+}
+
+func TestBackendEnv(t *testing.T) {
+	b := &Backend{
+		Type: "s3",
+		Env: map[string]string{
+			"AWS_SECRET_ACCESS_KEY": "secret",
+			"AWS_ACCESS_KEY_ID":     "access",
+		},
+	}
+
+	expected := []string{
+		"AWS_ACCESS_KEY_ID=access",
+		"AWS_SECRET_ACCESS_KEY=secret",
+	}
+	if actual := b.env(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	// The credentials must never be arguments
+	args, err := b.args("foo")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, arg := range args {
+		if strings.Contains(arg, "secret") {
+			t.Fatalf("bad: %#v", args)
+		}
+	}
+}
+
+func TestBackendLockKey(t *testing.T) {
+	cases := []struct {
+		Backend *Backend
+		StateId string
+		Result  string
+	}{
+		{
+			&Backend{Type: "s3", Config: map[string]string{"bucket": "foo"}},
+			"bar",
+			"terraform-state/s3/bar",
+		},
+
+		{
+			&Backend{Type: "consul", Config: map[string]string{"path": "foo"}},
+			"bar",
+			"terraform-state/consul/foo",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.Backend.lockKey(tc.StateId); actual != tc.Result {
+			t.Fatalf("%#v: bad: %s", tc.Backend, actual)
+		}
+	}
+}
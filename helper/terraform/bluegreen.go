@@ -0,0 +1,161 @@
+package terraform
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/directory"
+	execHelper "github.com/hashicorp/otto/helper/exec"
+	"github.com/hashicorp/otto/helper/hashitools"
+)
+
+const (
+	// DeployStrategyInPlace applies the new artifact to the existing
+	// deploy, letting Terraform replace instances as it sees fit.
+	DeployStrategyInPlace = "inplace"
+
+	// DeployStrategyBlueGreen brings up a second set of instances with
+	// the new artifact next to the old one, waits for them to be healthy,
+	// points the load balancer at them, and then destroys the old set.
+	//
+	// The Terraform configuration must accept the variables set by
+	// blueGreenVars and have a "<color>_ip" output with the address of
+	// each color's instances, which is used for the health check.
+	DeployStrategyBlueGreen = "bluegreen"
+)
+
+// blueGreenColors are the colors of the two sets of instances. The
+// index of a color is the "active_index" variable when it is active.
+var blueGreenColors = []string{"blue", "green"}
+
+// applyBlueGreen deploys the artifact of the deploy to the inactive
+// color and makes it the active color.
+func (opts *DeployOptions) applyBlueGreen(
+	ctx *app.Context,
+	project *hashitools.Project,
+	deploy *directory.Deploy,
+	vars map[string]string,
+	timeout time.Duration) error {
+	old, target := deploy.ActiveColor, deploy.InactiveColor
+	if old == "" {
+		old, target = blueGreenColors[1], blueGreenColors[0]
+	}
+
+	artifacts := make(map[string]map[string]string)
+	for k, v := range deploy.ColorArtifacts {
+		artifacts[k] = v
+	}
+	artifacts[target] = deploy.Artifact
+
+	// Bring up the new color next to the old one, which keeps serving
+	// traffic. If there is no old color the new one serves right away.
+	active := old
+	running := map[string]bool{target: true}
+	if artifacts[old] != nil {
+		running[old] = true
+	} else {
+		active = target
+	}
+
+	ctx.Ui.Header(fmt.Sprintf("Deploying to the %s instances...", target))
+	tf := opts.terraform(ctx, project, deploy,
+		blueGreenVars(vars, artifacts, active, running))
+	timer := &execHelper.Timer{Timeout: timeout, Cancel: tf.Cancel}
+	timer.Start()
+	defer timer.Stop()
+	if err := tf.Execute("apply"); err != nil {
+		return opts.failDeploy(ctx, deploy, deployExecErr(timer, err))
+	}
+
+	outputs, err := tf.Outputs()
+	if err != nil {
+		return opts.failDeploy(ctx, deploy, fmt.Errorf(
+			"Error reading Terraform outputs: %s", err))
+	}
+
+	// The new color must be healthy before it gets any traffic
+	err = opts.healthCheck(ctx, map[string]string{"ip": outputs[target+"_ip"]})
+	if err != nil {
+		return opts.failDeploy(ctx, deploy, err)
+	}
+
+	// The post_deploy command runs against the new color as well, so a
+	// failure leaves the old color serving and needs no rollback.
+	targetOutputs := make(map[string]string)
+	for k, v := range outputs {
+		targetOutputs[k] = v
+	}
+	targetOutputs["ip"] = outputs[target+"_ip"]
+	if err := postDeploy(ctx, targetOutputs); err != nil {
+		return opts.failDeploy(ctx, deploy, err)
+	}
+
+	if active != target {
+		ctx.Ui.Header(fmt.Sprintf(
+			"Switching the load balancer to the %s instances...", target))
+		tf.Variables = blueGreenVars(vars, artifacts, target, running)
+		if err := tf.Execute("apply"); err != nil {
+			return opts.failDeploy(ctx, deploy, deployExecErr(timer, err))
+		}
+
+		ctx.Ui.Header(fmt.Sprintf("Destroying the %s instances...", old))
+		delete(running, old)
+		tf.Variables = blueGreenVars(vars, artifacts, target, running)
+		if err := tf.Execute("apply"); err != nil {
+			return opts.failDeploy(ctx, deploy, deployExecErr(timer, err))
+		}
+
+		if outputs, err = tf.Outputs(); err != nil {
+			return opts.failDeploy(ctx, deploy, fmt.Errorf(
+				"Error reading Terraform outputs: %s", err))
+		}
+	}
+
+	// The old color's artifact is kept so a rollback can flip back to it
+	deploy.ActiveColor = target
+	deploy.InactiveColor = old
+	deploy.ColorArtifacts = artifacts
+	deploy.Outputs = outputs
+	return opts.succeedDeploy(ctx, deploy)
+}
+
+// blueGreenVars returns the Terraform variables for a blue-green deploy
+// with the given active color and set of colors with running instances.
+//
+// For each color, "<color>_count" is the "instance_count" variable (or 1
+// if it isn't set) if the color is running and 0 otherwise,
+// and each artifact variable is prefixed with "<color>_" (such as
+// "blue_ami"). "active_index" is the index of the active color in
+// blueGreenColors.
+func blueGreenVars(
+	vars map[string]string,
+	artifacts map[string]map[string]string,
+	active string,
+	running map[string]bool) map[string]string {
+	result := make(map[string]string)
+	for k, v := range vars {
+		result[k] = v
+	}
+
+	for i, color := range blueGreenColors {
+		if color == active {
+			result["active_index"] = fmt.Sprintf("%d", i)
+		}
+
+		count := "0"
+		if running[color] {
+			count = "1"
+			if v, ok := vars["instance_count"]; ok {
+				count = v
+			}
+		}
+		result[color+"_count"] = count
+
+		for k, v := range artifacts[color] {
+			result[color+"_"+k] = v
+		}
+	}
+
+	return result
+}
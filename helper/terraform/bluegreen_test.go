@@ -0,0 +1,65 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBlueGreenVars(t *testing.T) {
+	artifacts := map[string]map[string]string{
+		"blue":  map[string]string{"ami": "ami-1"},
+		"green": map[string]string{"ami": "ami-2"},
+	}
+
+	cases := []struct {
+		Active  string
+		Running map[string]bool
+		Result  map[string]string
+	}{
+		{
+			"blue",
+			map[string]bool{"blue": true},
+			map[string]string{
+				"aws_region":   "us-east-1",
+				"active_index": "0",
+				"blue_count":   "1",
+				"blue_ami":     "ami-1",
+				"green_count":  "0",
+				"green_ami":    "ami-2",
+			},
+		},
+
+		{
+			"green",
+			map[string]bool{"blue": true, "green": true},
+			map[string]string{
+				"aws_region":   "us-east-1",
+				"active_index": "1",
+				"blue_count":   "1",
+				"blue_ami":     "ami-1",
+				"green_count":  "1",
+				"green_ami":    "ami-2",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		vars := map[string]string{"aws_region": "us-east-1"}
+		result := blueGreenVars(vars, artifacts, tc.Active, tc.Running)
+		if !reflect.DeepEqual(result, tc.Result) {
+			t.Fatalf("%d: bad: %#v", i, result)
+		}
+	}
+}
+
+func TestBlueGreenVars_instanceCount(t *testing.T) {
+	vars := map[string]string{"instance_count": "3"}
+	running := map[string]bool{"blue": true}
+	result := blueGreenVars(vars, nil, "blue", running)
+	if result["blue_count"] != "3" {
+		t.Fatalf("bad: %#v", result)
+	}
+	if result["green_count"] != "0" {
+		t.Fatalf("bad: %#v", result)
+	}
+}
@@ -1,14 +1,28 @@
 package terraform
 
 import (
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/foundation"
+	execHelper "github.com/hashicorp/otto/helper/exec"
+	flagHelper "github.com/hashicorp/otto/helper/flag"
+	"github.com/hashicorp/otto/helper/hashitools"
 	"github.com/hashicorp/otto/helper/router"
+	"github.com/hashicorp/otto/ui"
 )
 
 type DeployOptions struct {
@@ -29,6 +43,29 @@ type DeployOptions struct {
 	// to a different key for a Terraform variable. The key of this map
 	// is the infra output key, and teh value is the Terraform variable name.
 	InfraOutputMap map[string]string
+
+	// Backend, if set, stores the Terraform state for the deploy in a
+	// remote backend rather than the directory. This lets multiple people
+	// deploy the same app without copying the state back and forth. If
+	// this isn't set, the deploy_backend of the Appfile is used.
+	Backend *Backend
+
+	// Strategy is how a new deploy replaces the old one. It defaults to
+	// DeployStrategyInPlace. See DeployStrategyBlueGreen for the
+	// requirements a Terraform configuration must meet to use it.
+	Strategy string
+
+	// AfterApply, if set, is called once Terraform has applied an
+	// in-place deploy (including rollbacks), before the health check.
+	// The outputs and artifact of the deploy are set. It can be used to
+	// finish deploys that Terraform can't do by itself, such as
+	// uploading files to a bucket that Terraform created. If it returns
+	// an error, the deploy fails.
+	AfterApply func(*app.Context, *directory.Deploy) error
+
+	// rollingBack is true while a deploy that failed its post_deploy
+	// command is rolled back, so the rollback isn't rolled back too.
+	rollingBack bool
 }
 
 // Deploy can be used as an implementation of app.App.Deploy to handle calling
@@ -53,17 +90,838 @@ func Deploy(opts *DeployOptions) *router.Router {
 				SynopsisText: actionDestroySyn,
 				HelpText:     strings.TrimSpace(actionDestroyHelp),
 			},
+			"history": &router.SimpleAction{
+				ExecuteFunc:  opts.actionHistory,
+				SynopsisText: actionHistorySyn,
+				HelpText:     strings.TrimSpace(actionHistoryHelp),
+			},
 			"info": &router.SimpleAction{
 				ExecuteFunc:  opts.actionInfo,
 				SynopsisText: actionInfoSyn,
 				HelpText:     strings.TrimSpace(actionInfoHelp),
 			},
+			"promote": &router.SimpleAction{
+				ExecuteFunc:  opts.actionPromote,
+				SynopsisText: actionPromoteSyn,
+				HelpText:     strings.TrimSpace(actionPromoteHelp),
+			},
+			"rollback": &router.SimpleAction{
+				ExecuteFunc:  opts.actionRollback,
+				SynopsisText: actionRollbackSyn,
+				HelpText:     strings.TrimSpace(actionRollbackHelp),
+			},
+			"state": &router.SimpleAction{
+				ExecuteFunc:  opts.actionState,
+				SynopsisText: actionStateSyn,
+				HelpText:     strings.TrimSpace(actionStateHelp),
+			},
+			"ssh": &router.SimpleAction{
+				ExecuteFunc:  opts.actionSSH,
+				SynopsisText: actionSSHSyn,
+				HelpText:     strings.TrimSpace(actionSSHHelp),
+			},
+		},
+	}
+}
+
+func (opts *DeployOptions) actionDeploy(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
+	}
+	vars := make(map[string]string)
+
+	infra, infraVars, err := opts.lookupInfraVars(ctx)
+	if err != nil {
+		return err
+	}
+	if infra == nil {
+		return fmt.Errorf(
+			"Infrastructure for this application hasn't been built yet.\n" +
+				"The deploy step requires this because the target infrastructure\n" +
+				"as well as its final properties can affect the deploy process.\n" +
+				"Please run `otto infra` to build the underlying infrastructure,\n" +
+				"then run `otto deploy` again.")
+	}
+	for k, v := range infraVars {
+		vars[k] = v
+	}
+
+	var build *directory.Build
+	var buildVars map[string]string
+	if !opts.DisableBuild {
+		build, err = opts.lookupBuild(ctx, "")
+		if err != nil {
+			return err
+		}
+		if build == nil {
+			return fmt.Errorf(
+				"This application hasn't been built yet. Please run `otto build`\n" +
+					"first so that the deploy step has an artifact to deploy.")
+		}
+		buildVars, err = opts.buildVars(ctx, build, infra)
+		if err != nil {
+			return err
+		}
+		for k, v := range buildVars {
+			vars[k] = v
+		}
+	}
+
+	// Setup the vars
+	if err := foundation.WriteVars(&ctx.Shared); err != nil {
+		return fmt.Errorf("Error preparing deploy: %s", err)
+	}
+
+	// Get our old deploy to populate the old state data if we have it.
+	// This step is critical to make sure that Terraform remains idempotent
+	// and that it handles migrations properly.
+	deploy, err := opts.lookupDeploy(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Every deploy is a new version so the prior ones can be rolled back to
+	deploy.MarkNewVersion()
+	deploy.Artifact = buildVars
+	deploy.BuildID = ""
+	if build != nil {
+		deploy.BuildID = build.ID
+	}
+	return opts.apply(ctx, project, deploy, vars)
+}
+
+func (opts *DeployOptions) actionPromote(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	if opts.DisableBuild {
+		return fmt.Errorf(
+			"This application doesn't deploy a built artifact, so there\n" +
+				"is no build to promote.")
+	}
+
+	id, err := deployStringArg(ctx, "build")
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf(
+			"The ID of the build to promote must be given with -build.\n" +
+				"Run `otto build list` to see the IDs of the builds.")
+	}
+
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
+	}
+
+	infra, infraVars, err := opts.lookupInfraVars(ctx)
+	if err != nil {
+		return err
+	}
+	if infra == nil {
+		return fmt.Errorf(
+			"Infrastructure for this application hasn't been built yet.\n" +
+				"Please run `otto infra` to build the underlying infrastructure,\n" +
+				"then run `otto deploy promote` again.")
+	}
+
+	build, err := opts.lookupBuild(ctx, id)
+	if err != nil {
+		return err
+	}
+	if build == nil {
+		return fmt.Errorf(
+			"The build '%s' could not be found. Run `otto build list`\n"+
+				"to see the IDs of the builds.", id)
+	}
+
+	// The artifact is extracted from the stored build exactly as a
+	// deploy would, so every environment runs the same artifact.
+	buildVars, err := opts.buildVars(ctx, build, infra)
+	if err != nil {
+		return err
+	}
+
+	vars := make(map[string]string)
+	for k, v := range infraVars {
+		vars[k] = v
+	}
+	for k, v := range buildVars {
+		vars[k] = v
+	}
+
+	// Setup the vars
+	if err := foundation.WriteVars(&ctx.Shared); err != nil {
+		return fmt.Errorf("Error preparing deploy: %s", err)
+	}
+
+	deploy, err := opts.lookupDeploy(ctx)
+	if err != nil {
+		return err
+	}
+
+	env := deploy.Environment
+	if env == "" {
+		env = "default"
+	}
+	ctx.Ui.Header(fmt.Sprintf(
+		"Promoting build %s to the %s environment...", build.ID, env))
+
+	deploy.MarkNewVersion()
+	deploy.Artifact = buildVars
+	deploy.BuildID = build.ID
+	return opts.apply(ctx, project, deploy, vars)
+}
+
+// apply runs Terraform to deploy with the given variables and records
+// the result of the deploy in the directory.
+func (opts *DeployOptions) apply(
+	ctx *app.Context,
+	project *hashitools.Project,
+	deploy *directory.Deploy,
+	vars map[string]string) error {
+	if err := opts.checkTfDir(ctx); err != nil {
+		return err
+	}
+
+	appVars, err := deployAppVars(ctx)
+	if err != nil {
+		return err
+	}
+	for k, v := range appVars {
+		vars[k] = v
+	}
+
+	// The user data is set before the deploy is hashed, so that changing
+	// it deploys new instances.
+	userData, err := deployUserData(ctx, vars)
+	if err != nil {
+		return err
+	}
+	if userData != "" {
+		vars["user_data"] = userData
+	}
+
+	// A targeted apply only applies some resources, so it can't be used
+	// to swap the instances of a blue-green deploy.
+	targets, err := deployStringsArg(ctx, "target")
+	if err != nil {
+		return err
+	}
+	if len(targets) > 0 && opts.Strategy == DeployStrategyBlueGreen {
+		return fmt.Errorf(
+			"The -target flag can't be used with the %q deploy strategy.\n"+
+				"Blue-green deploys must apply the whole configuration.",
+			DeployStrategyBlueGreen)
+	}
+
+	// If nothing changed since the last successful deploy, applying
+	// again would only waste time, so the deploy is skipped. Targeted
+	// applies always run since they're used to fix single resources.
+	force, err := deployBoolArg(ctx, "force")
+	if err != nil {
+		return err
+	}
+	force = force || len(targets) > 0
+	hash, err := deployHash(opts.tfDir(ctx), vars, ctx.DeployCredsVars())
+	if err != nil {
+		return fmt.Errorf("Error hashing the deploy: %s", err)
+	}
+	if !force && deploy.IsDeployed() && deploy.VarsHash == hash {
+		ctx.Ui.Header("[green]The deploy is already up to date!")
+		ctx.Ui.Message(
+			"The artifact, variables, and configuration haven't changed\n" +
+				"since the last successful deploy, so there is nothing to do.\n" +
+				"Run `otto deploy -force` to deploy anyway.")
+		return nil
+	}
+	deploy.VarsHash = hash
+
+	// The changes are only known from the plan of an in-place deploy
+	deploy.Changes = nil
+
+	timeout, err := deployTimeout(ctx)
+	if err != nil {
+		return err
+	}
+
+	ui.Emit(ctx.Ui, &ui.Event{
+		Type: ui.EventDeployStart,
+		Data: map[string]string{
+			"app":         ctx.Appfile.Application.Name,
+			"environment": deploy.Environment,
+		},
+	})
+
+	if opts.Strategy == DeployStrategyBlueGreen {
+		return opts.applyBlueGreen(ctx, project, deploy, vars, timeout)
+	}
+
+	// Show what the deploy will change before changing it
+	tf := opts.terraform(ctx, project, deploy, vars)
+	tf.Targets = targets
+	plan, err := opts.confirmPlan(ctx, tf)
+	if err != nil {
+		return err
+	}
+	deploy.Changes = plan.Changes
+
+	// Run Terraform! The apply is cancelled if it takes too long, so a
+	// stalled cloud operation fails the deploy instead of hanging.
+	timer := &execHelper.Timer{Timeout: timeout, Cancel: tf.Cancel}
+	timer.Start()
+	defer timer.Stop()
+	if err := tf.Execute("apply"); err != nil {
+		return opts.failDeploy(ctx, deploy, deployExecErr(timer, err))
+	}
+
+	// Read the outputs so that other commands can find out about the
+	// deploy without running Terraform.
+	outputs, err := tf.Outputs()
+	if err != nil {
+		return opts.failDeploy(ctx, deploy, fmt.Errorf(
+			"Error reading Terraform outputs: %s", err))
+	}
+
+	deploy.Outputs = outputs
+
+	// Only the targeted resources were applied, so the deploy isn't
+	// complete until the whole configuration is applied again.
+	if len(targets) > 0 {
+		return opts.partialDeploy(ctx, deploy)
+	}
+
+	if opts.AfterApply != nil {
+		if err := opts.AfterApply(ctx, deploy); err != nil {
+			return opts.failDeploy(ctx, deploy, err)
+		}
+	}
+
+	// If the application has a health check, the deploy isn't done
+	// until the application is healthy.
+	if err := opts.healthCheck(ctx, outputs); err != nil {
+		return opts.failDeploy(ctx, deploy, err)
+	}
+
+	if err := postDeploy(ctx, outputs); err != nil {
+		return opts.failPostDeploy(ctx, project, deploy, vars, err)
+	}
+
+	return opts.succeedDeploy(ctx, deploy)
+}
+
+// failPostDeploy fails a deploy whose post_deploy command failed. If the
+// Appfile asks for it, the artifact of the previous successful deploy is
+// deployed again, but only once: a rollback whose command fails too is
+// left failed.
+func (opts *DeployOptions) failPostDeploy(
+	ctx *app.Context,
+	project *hashitools.Project,
+	deploy *directory.Deploy,
+	vars map[string]string,
+	err error) error {
+	err = opts.failDeploy(ctx, deploy, err)
+	if !ctx.Appfile.Application.PostDeployRollback || opts.rollingBack {
+		return err
+	}
+
+	target, lookupErr := opts.lookupRollback(ctx, deploy)
+	if lookupErr != nil {
+		return fmt.Errorf(
+			"%s\n\nThe previous deploy to roll back to couldn't be found: %s",
+			err, lookupErr)
+	}
+	if target == nil {
+		return fmt.Errorf(
+			"%s\n\nThere is no previous successful deploy to roll back to.", err)
+	}
+
+	rollbackVars := make(map[string]string)
+	for k, v := range vars {
+		rollbackVars[k] = v
+	}
+	for k := range deploy.Artifact {
+		delete(rollbackVars, k)
+	}
+	for k, v := range target.Artifact {
+		rollbackVars[k] = v
+	}
+
+	ctx.Ui.Header(fmt.Sprintf(
+		"Rolling back to the artifact of deploy version %d...", target.Version))
+	opts.rollingBack = true
+	defer func() { opts.rollingBack = false }()
+	deploy.MarkNewVersion()
+	deploy.Artifact = target.Artifact
+	deploy.BuildID = target.BuildID
+	if rollbackErr := opts.apply(ctx, project, deploy, rollbackVars); rollbackErr != nil {
+		return fmt.Errorf(
+			"%s\n\nThe rollback to deploy version %d failed too: %s",
+			err, target.Version, rollbackErr)
+	}
+
+	return fmt.Errorf(
+		"%s\n\nThe deploy was rolled back to the artifact of deploy version %d.",
+		err, target.Version)
+}
+
+// deployAppVars returns the Terraform variables for the settings of the
+// application and the environment being deployed. The settings of the
+// environment take precedence over the application's, and its
+// variables take precedence over everything else.
+func deployAppVars(ctx *app.Context) (map[string]string, error) {
+	env, err := deployEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	application := *ctx.Appfile.Application
+	if env != nil {
+		if env.Count != 0 {
+			application.Count = env.Count
+		}
+		if env.InstanceType != "" {
+			application.InstanceType = env.InstanceType
+		}
+	}
+
+	// The number of instances is checked before Terraform runs so that
+	// a bad count can't scale the app in unexpectedly.
+	count, err := instanceCount(&application)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{
+		"instance_count": strconv.Itoa(count),
+	}
+	if application.InstanceType != "" {
+		result["instance_type"] = application.InstanceType
+	}
+	if application.SSHKeyName != "" {
+		// This replaces the key pair of the infrastructure
+		result["key_name"] = application.SSHKeyName
+	}
+	if application.Domain != "" {
+		// Environments can set their own domain with their variables
+		result["domain"] = application.Domain
+		result["domain_zone_id"] = application.DomainZoneID
+	}
+	if len(application.Ports) > 0 {
+		// Terraform variables can't be lists, so the ports are joined
+		// and the configuration splits them again.
+		ports := make([]string, len(application.Ports))
+		for i, p := range application.Ports {
+			ports[i] = strconv.Itoa(p)
+		}
+		result["ports"] = strings.Join(ports, ",")
+	}
+	if env != nil {
+		// Resources that need unique names include the suffix so that
+		// environments can share an infrastructure.
+		result["environment_suffix"] = "-" + env.Name
+		for k, v := range env.Variables {
+			result[k] = v
+		}
+	}
+
+	return result, nil
+}
+
+// deployEnv returns the Appfile environment chosen with the -env flag,
+// or nil if no environment was chosen.
+func deployEnv(ctx *app.Context) (*appfile.Environment, error) {
+	name, err := deployStringArg(ctx, "env")
+	if err != nil || name == "" {
+		return nil, err
+	}
+
+	env := ctx.Appfile.Environment(name)
+	if env == nil {
+		names := make([]string, 0, len(ctx.Appfile.Environments))
+		for _, e := range ctx.Appfile.Environments {
+			names = append(names, e.Name)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf(
+			"The environment '%s' isn't in the Appfile. The environments\n"+
+				"in the Appfile are: %s", name, strings.Join(names, ", "))
+	}
+
+	return env, nil
+}
+
+// instanceCount returns the number of instances to deploy for the
+// count of the Appfile application, which defaults to one.
+func instanceCount(app *appfile.Application) (int, error) {
+	if app == nil || app.Count == 0 {
+		return 1, nil
+	}
+	if app.Count < 1 {
+		return 0, fmt.Errorf(
+			"The application count must be at least 1, got %d. Please fix\n"+
+				"the count in the Appfile and run `otto deploy` again.", app.Count)
+	}
+
+	return app.Count, nil
+}
+
+// confirmPlan runs `terraform plan` and shows a summary of the changes
+// the deploy will make. With the -confirm flag, the full plan is shown
+// and the deploy only continues if the user confirms it. Otherwise the
+// plan is only logged, so deploys from CI aren't blocked. The plan is
+// returned so the deploy can record its changes.
+func (opts *DeployOptions) confirmPlan(ctx *app.Context, tf *Terraform) (*Plan, error) {
+	confirm, err := deployBoolArg(ctx, "confirm")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Ui.Header("Planning the deploy...")
+	plan, err := tf.Plan()
+	if err != nil {
+		return nil, terraformError(err)
+	}
+	log.Printf("[INFO] terraform plan:\n%s", plan.Output)
+
+	if plan.Empty() {
+		ctx.Ui.Message("The deploy doesn't need to change any resources.")
+		return plan, nil
+	}
+	ctx.Ui.Message(fmt.Sprintf("The deploy will change resources: %s.", plan))
+	if plan.Destroy > 0 {
+		ui.Warn(ctx.Ui, fmt.Sprintf(
+			"%d resource(s) will be destroyed or replaced.", plan.Destroy))
+	}
+
+	if !confirm {
+		return plan, nil
+	}
+
+	ctx.Ui.Raw(plan.Output + "\n")
+	v, err := ctx.Ui.Input(&ui.InputOpts{
+		Id:          "deploy_confirm",
+		Query:       "Do you want to deploy these changes?",
+		Description: "Only 'yes' will be accepted to confirm.",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error asking for confirmation: %s", err)
+	}
+	if v != "yes" {
+		return nil, fmt.Errorf("Deploy cancelled.")
+	}
+
+	return plan, nil
+}
+
+// terraform returns the Terraform to run for the deploy.
+func (opts *DeployOptions) terraform(
+	ctx *app.Context,
+	project *hashitools.Project,
+	deploy *directory.Deploy,
+	vars map[string]string) *Terraform {
+	return &Terraform{
+		Path:          project.Path(),
+		Dir:           opts.tfDir(ctx),
+		Ui:            ctx.Ui,
+		Variables:     vars,
+		SensitiveVars: context.SensitiveVars,
+		Directory:     ctx.Directory,
+		StateId:       deploy.ID,
+		Backend:       opts.backend(ctx),
+	}
+}
+
+// failDeploy marks the deploy as failed with the given error and stores
+// it, returning the error to show.
+func (opts *DeployOptions) failDeploy(
+	ctx *app.Context, deploy *directory.Deploy, err error) error {
+	ui.Emit(ctx.Ui, &ui.Event{Type: ui.EventDeployFailed, Message: err.Error()})
+
+	deploy.MarkFailed()
+	if putErr := ctx.Directory.PutDeploy(deploy); putErr != nil {
+		return fmt.Errorf("The deploy failed with err: %s\n\n"+
+			"And then there was an error storing it in the directory: %s\n"+
+			"This second error is a bug and should be reported.", err, putErr)
+	}
+
+	return err
+}
+
+// succeedDeploy marks the deploy as successful, stores it, and shows
+// its outputs.
+func (opts *DeployOptions) succeedDeploy(
+	ctx *app.Context, deploy *directory.Deploy) error {
+	// The resources were already changed, so the directory is retried
+	// for a while rather than losing track of them.
+	deploy.MarkSuccessful()
+	err := directory.RetryConnection(func() error {
+		return ctx.Directory.PutDeploy(deploy)
+	})
+	if err != nil {
+		return err
+	}
+
+	ui.Emit(ctx.Ui, &ui.Event{
+		Type:    ui.EventDeploySuccess,
+		Message: fmt.Sprintf("Deployed version %d", deploy.Version),
+		Data:    deploy.Outputs,
+	})
+
+	ctx.Ui.Header("[green]Deploy success!")
+	if len(deploy.Outputs) > 0 {
+		keys := make([]string, 0, len(deploy.Outputs))
+		for k := range deploy.Outputs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		ctx.Ui.Message("[green]The deploy has the following outputs:\n")
+		for _, k := range keys {
+			ctx.Ui.Message(fmt.Sprintf("  %s: %s", k, deploy.Outputs[k]))
+		}
+	}
+
+	// The deploy already succeeded, so not being able to show how to
+	// SSH into it isn't an error.
+	sshCommand, err := deploySSHCommand(ctx, deploy.Outputs)
+	if err != nil {
+		log.Printf("[WARN] error building SSH command: %s", err)
+	} else if sshCommand != "" {
+		ctx.Ui.Message(fmt.Sprintf(
+			"\n[green]To SSH into the deployed instance, run:\n\n  %s", sshCommand))
+	}
+
+	return nil
+}
+
+// partialDeploy marks the deploy as partially applied and stores it.
+func (opts *DeployOptions) partialDeploy(
+	ctx *app.Context, deploy *directory.Deploy) error {
+	deploy.MarkPartial()
+	if err := ctx.Directory.PutDeploy(deploy); err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("[yellow]Targeted deploy applied!")
+	ui.Warn(ctx.Ui,
+		"Only the targeted resources and their dependencies were applied,\n"+
+			"so the rest of the deploy may not match the configuration. The deploy\n"+
+			"is marked as partial until `otto deploy` is run without -target.")
+
+	return nil
+}
+
+func (opts *DeployOptions) actionRollback(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	if opts.DisableBuild {
+		return fmt.Errorf(
+			"This application doesn't deploy a built artifact, so there\n" +
+				"is nothing to roll back to.")
+	}
+
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
+	}
+
+	infra, infraVars, err := opts.lookupInfraVars(ctx)
+	if err != nil {
+		return err
+	}
+	if infra == nil {
+		return fmt.Errorf(
+			"Infrastructure for this application hasn't been built yet.\n" +
+				"Nothing to roll back.")
+	}
+
+	deploy, err := opts.lookupDeploy(ctx)
+	if err != nil {
+		return err
+	}
+	if deploy.IsNew() || deploy.IsDestroyed() {
+		return fmt.Errorf(
+			"This application hasn't been deployed yet. Nothing to roll back.")
+	}
+
+	var artifact map[string]string
+	var buildID string
+	if opts.Strategy == DeployStrategyBlueGreen {
+		// A blue-green rollback is a flip back to the inactive color
+		artifact = deploy.ColorArtifacts[deploy.InactiveColor]
+		if artifact == nil {
+			return fmt.Errorf(
+				"There is no previous deploy on the inactive color to roll back to.")
+		}
+
+		ctx.Ui.Header(fmt.Sprintf(
+			"Rolling back to the %s deploy...", deploy.InactiveColor))
+	} else {
+		target, err := opts.lookupRollback(ctx, deploy)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			return fmt.Errorf(
+				"There is no previous successful deploy with a different artifact\n" +
+					"to roll back to.")
+		}
+
+		artifact = target.Artifact
+		buildID = target.BuildID
+		ctx.Ui.Header(fmt.Sprintf(
+			"Rolling back to the artifact of deploy version %d...", target.Version))
+	}
+
+	vars := make(map[string]string)
+	for k, v := range infraVars {
+		vars[k] = v
+	}
+	for k, v := range artifact {
+		vars[k] = v
+	}
+
+	// Setup the vars
+	if err := foundation.WriteVars(&ctx.Shared); err != nil {
+		return fmt.Errorf("Error preparing deploy: %s", err)
+	}
+
+	deploy.MarkNewVersion()
+	deploy.Artifact = artifact
+	deploy.BuildID = buildID
+	return opts.apply(ctx, project, deploy, vars)
+}
+
+// lookupRollback returns the deploy to roll back to from the current
+// deploy: the latest successful deploy before it that deployed a
+// different artifact. It returns nil if there is no such deploy.
+func (opts *DeployOptions) lookupRollback(
+	ctx *app.Context, current *directory.Deploy) (*directory.Deploy, error) {
+	deploys, err := ctx.Directory.ListDeploys(current)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(deploys) - 1; i >= 0; i-- {
+		d := deploys[i]
+		if d.Version >= current.Version || !d.IsDeployed() {
+			continue
+		}
+		if d.Artifact == nil || reflect.DeepEqual(d.Artifact, current.Artifact) {
+			continue
+		}
+
+		return d, nil
+	}
+
+	return nil, nil
+}
+
+// healthCheck waits for the application to be healthy if the Appfile
+// configures a health check.
+func (opts *DeployOptions) healthCheck(
+	ctx *app.Context, outputs map[string]string) error {
+	check, err := appHealthCheck(ctx.Appfile.Application)
+	if err != nil || check == nil {
+		return err
+	}
+
+	url, err := check.URL(outputs)
+	if err != nil {
+		return err
+	}
+
+	ctx.Ui.Header(fmt.Sprintf(
+		"Waiting for the application to become healthy: %s", url))
+	return check.Wait(url)
+}
+
+// DeployResult returns the result of the current deploy of the
+// application in the environment chosen with the -env flag, from the
+// outputs stored in the directory. It returns nil if the application
+// isn't deployed.
+//
+// This function implements app.DeployReporter.DeployResult.
+func DeployResult(ctx *app.Context) (*app.DeployResult, error) {
+	env, err := deployEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := &directory.Deploy{
+		Lookup: directory.Lookup{
+			AppID:       ctx.Appfile.ID,
+			Infra:       ctx.Tuple.Infra,
+			InfraFlavor: ctx.Tuple.InfraFlavor,
 		},
 	}
+	if env != nil {
+		lookup.Environment = env.Name
+	}
+
+	deploy, err := ctx.Directory.GetDeploy(lookup)
+	if err != nil {
+		return nil, err
+	}
+	if deploy == nil || deploy.State != directory.DeployStateSuccess {
+		return nil, nil
+	}
+
+	return app.NewDeployResult(deploy), nil
+}
+
+// Verify checks the compiled Terraform configuration of the deploy with
+// `terraform validate`. Nothing is deployed and no variables are needed,
+// so this can run right after compiling.
+//
+// This function can be used to implement app.AppVerify.Verify.
+func Verify(ctx *app.Context, opts *DeployOptions) error {
+	if err := opts.checkTfDir(ctx); err != nil {
+		return err
+	}
+
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("Validating Terraform configuration...")
+	tf := &Terraform{
+		Path: project.Path(),
+		Dir:  opts.tfDir(ctx),
+		Ui:   ctx.Ui,
+	}
+	if err := tf.Execute("validate"); err != nil {
+		return fmt.Errorf(
+			"Error validating Terraform configuration in %s: %s\n\n"+
+				"The configuration was compiled from the Appfile and its\n"+
+				"customizations. Please fix the errors above and compile again.",
+			tf.Dir, err)
+	}
+
+	return nil
 }
 
-func (opts *DeployOptions) actionDeploy(rctx router.Context) error {
-	ctx := rctx.(*app.Context)
+// Destroy destroys everything the deploy of an application created,
+// using the same options that were used for the deploy. It is an error
+// if the application isn't deployed.
+//
+// This function implements app.AppDestroy.Destroy.
+func Destroy(ctx *app.Context, opts *DeployOptions) error {
+	return opts.destroy(ctx)
+}
+
+func (opts *DeployOptions) actionDestroy(rctx router.Context) error {
+	return opts.destroy(rctx.(*app.Context))
+}
+
+func (opts *DeployOptions) destroy(ctx *app.Context) error {
+	if err := opts.checkTfDir(ctx); err != nil {
+		return err
+	}
+
 	project, err := Project(&ctx.Shared)
 	if err != nil {
 		return err
@@ -77,15 +935,23 @@ func (opts *DeployOptions) actionDeploy(rctx router.Context) error {
 	if infra == nil {
 		return fmt.Errorf(
 			"Infrastructure for this application hasn't been built yet.\n" +
-				"The deploy step requires this because the target infrastructure\n" +
-				"as well as its final properties can affect the deploy process.\n" +
-				"Please run `otto infra` to build the underlying infrastructure,\n" +
-				"then run `otto deploy` again.")
+				"Nothing to destroy.")
 	}
 	for k, v := range infraVars {
 		vars[k] = v
 	}
 
+	// Check the deploy first, since running Terraform when nothing is
+	// deployed would only hide that there is nothing to destroy.
+	deploy, err := opts.lookupDeploy(ctx)
+	if err != nil {
+		return err
+	}
+	if deploy.IsNew() || deploy.IsDestroyed() {
+		return fmt.Errorf(
+			"This application hasn't been deployed yet. Nothing to destroy.")
+	}
+
 	if !opts.DisableBuild {
 		buildVars, err := opts.lookupBuildVars(ctx, infra)
 		if err != nil {
@@ -93,40 +959,38 @@ func (opts *DeployOptions) actionDeploy(rctx router.Context) error {
 		}
 		if buildVars == nil {
 			return fmt.Errorf(
-				"This application hasn't been built yet. Please run `otto build`\n" +
-					"first so that the deploy step has an artifact to deploy.")
+				"This application hasn't been built yet. Nothing to destroy.")
 		}
 		for k, v := range buildVars {
 			vars[k] = v
 		}
 	}
 
-	// Setup the vars
-	if err := foundation.WriteVars(&ctx.Shared); err != nil {
-		return fmt.Errorf("Error preparing deploy: %s", err)
-	}
-
-	// Get our old deploy to populate the old state data if we have it.
-	// This step is critical to make sure that Terraform remains idempotent
-	// and that it handles migrations properly.
-	deploy, err := opts.lookupDeploy(ctx)
+	// The environment's variables, such as its region, are needed to
+	// destroy what was deployed with them.
+	appVars, err := deployAppVars(ctx)
 	if err != nil {
 		return err
 	}
+	for k, v := range appVars {
+		vars[k] = v
+	}
 
 	// Run Terraform!
 	tf := &Terraform{
-		Path:      project.Path(),
-		Dir:       opts.tfDir(ctx),
-		Ui:        ctx.Ui,
-		Variables: vars,
-		Directory: ctx.Directory,
-		StateId:   deploy.ID,
+		Path:          project.Path(),
+		Dir:           opts.tfDir(ctx),
+		Ui:            ctx.Ui,
+		Variables:     vars,
+		SensitiveVars: context.SensitiveVars,
+		Directory:     ctx.Directory,
+		StateId:       deploy.ID,
+		Backend:       opts.backend(ctx),
 	}
-	if err := tf.Execute("apply"); err != nil {
+	if err := tf.Destroy(); err != nil {
 		deploy.MarkFailed()
 		if putErr := ctx.Directory.PutDeploy(deploy); putErr != nil {
-			return fmt.Errorf("The deploy failed with err: %s\n\n"+
+			return fmt.Errorf("The destroy failed with err: %s\n\n"+
 				"And then there was an error storing it in the directory: %s\n"+
 				"This second error is a bug and should be reported.", err, putErr)
 		}
@@ -134,55 +998,94 @@ func (opts *DeployOptions) actionDeploy(rctx router.Context) error {
 		return terraformError(err)
 	}
 
-	deploy.MarkSuccessful()
+	deploy.Outputs = map[string]string{}
+	deploy.ActiveColor = ""
+	deploy.InactiveColor = ""
+	deploy.ColorArtifacts = nil
+	deploy.MarkDestroyed()
 	if err := ctx.Directory.PutDeploy(deploy); err != nil {
 		return err
 	}
+
 	return nil
 }
 
-func (opts *DeployOptions) actionDestroy(rctx router.Context) error {
+func (opts *DeployOptions) actionHistory(rctx router.Context) error {
 	ctx := rctx.(*app.Context)
-	project, err := Project(&ctx.Shared)
+	deploy, err := opts.lookupDeploy(ctx)
 	if err != nil {
 		return err
 	}
-	vars := make(map[string]string)
 
-	infra, infraVars, err := opts.lookupInfraVars(ctx)
+	deploys, err := ctx.Directory.ListDeploys(deploy)
 	if err != nil {
-		return err
-	}
-	if infra == nil {
-		return fmt.Errorf(
-			"Infrastructure for this application hasn't been built yet.\n" +
-				"Nothing to destroy.")
+		return fmt.Errorf("Error loading the deploy history: %s", err)
 	}
-	for k, v := range infraVars {
-		vars[k] = v
+	if len(deploys) == 0 {
+		ctx.Ui.Message("This application hasn't been deployed yet.")
+		return nil
 	}
 
-	if !opts.DisableBuild {
-		buildVars, err := opts.lookupBuildVars(ctx, infra)
-		if err != nil {
-			return err
+	for i := len(deploys) - 1; i >= 0; i-- {
+		d := deploys[i]
+		ctx.Ui.Header(fmt.Sprintf("Deploy #%d (%s)", d.Version, deployStateName(d)))
+		if d.BuildID != "" {
+			ctx.Ui.Message(fmt.Sprintf("Build: %s", d.BuildID))
 		}
-		if buildVars == nil {
-			return fmt.Errorf(
-				"This application hasn't been built yet. Nothing to destroy.")
+		keys := make([]string, 0, len(d.Artifact))
+		for k := range d.Artifact {
+			keys = append(keys, k)
 		}
-		for k, v := range buildVars {
-			vars[k] = v
+		sort.Strings(keys)
+		for _, k := range keys {
+			ctx.Ui.Message(fmt.Sprintf("Artifact (%s): %s", k, d.Artifact[k]))
+		}
+
+		if len(d.Changes) == 0 {
+			ctx.Ui.Message("No changes recorded.")
+			continue
 		}
+		changes := make([]string, len(d.Changes))
+		for i, c := range d.Changes {
+			changes[i] = c.String()
+		}
+		ctx.Ui.Message(fmt.Sprintf("Changes: %s", strings.Join(changes, ", ")))
+	}
+
+	return nil
+}
+
+// deployStateName returns the human-friendly name of the state of a
+// deploy for the history.
+func deployStateName(d *directory.Deploy) string {
+	switch {
+	case d.IsDeployed():
+		return "success"
+	case d.IsFailed():
+		return "failed"
+	case d.IsPartial():
+		return "partial"
+	case d.IsDestroyed():
+		return "destroyed"
+	default:
+		return "not finished"
+	}
+}
+
+func (opts *DeployOptions) actionInfo(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
 	}
 
 	deploy, err := opts.lookupDeploy(ctx)
 	if err != nil {
 		return err
 	}
-	if deploy.IsNew() {
+	if deploy.IsNew() || deploy.IsDestroyed() {
 		return fmt.Errorf(
-			"This application hasn't been deployed yet. Nothing to destroy.")
+			"This application hasn't been deployed yet. Nothing to show.")
 	}
 
 	// Get the directory
@@ -191,31 +1094,42 @@ func (opts *DeployOptions) actionDestroy(rctx router.Context) error {
 		Path:      project.Path(),
 		Dir:       opts.tfDir(ctx),
 		Ui:        ctx.Ui,
-		Variables: vars,
 		Directory: ctx.Directory,
 		StateId:   deploy.ID,
+		Backend:   opts.backend(ctx),
 	}
-	if err := tf.Execute("destroy", "-force"); err != nil {
-		deploy.MarkFailed()
-		if putErr := ctx.Directory.PutDeploy(deploy); putErr != nil {
-			return fmt.Errorf("The destroy failed with err: %s\n\n"+
-				"And then there was an error storing it in the directory: %s\n"+
-				"This second error is a bug and should be reported.", err, putErr)
-		}
-
+	args := append([]string{"output"}, deployOtherArgs(ctx, "env")...)
+	if err := tf.Execute(args...); err != nil {
 		return terraformError(err)
 	}
 
-	deploy.MarkGone()
-	if err := ctx.Directory.PutDeploy(deploy); err != nil {
-		return err
-	}
-
 	return nil
 }
 
-func (opts *DeployOptions) actionInfo(rctx router.Context) error {
+// StateArgs returns the positional args of the "state" deploy action,
+// the subcommand first, leaving out its flags and their values.
+func StateArgs(args []string) []string {
+	fs := flag.NewFlagSet("state", flag.ContinueOnError)
+	fs.String("env", "", "")
+	fs.Bool("force", false, "")
+	_, _, result := flagHelper.FilterArgs(fs, args)
+	return result
+}
+
+func (opts *DeployOptions) actionState(rctx router.Context) error {
 	ctx := rctx.(*app.Context)
+	force, err := deployBoolArg(ctx, "force")
+	if err != nil {
+		return err
+	}
+
+	args := StateArgs(ctx.ActionArgs)
+	if len(args) == 0 || (args[0] != "pull" && args[0] != "push") {
+		return fmt.Errorf(
+			"The state subcommand must be \"pull\" or \"push\".\n" +
+				"Run `otto deploy help state` for more information.")
+	}
+
 	project, err := Project(&ctx.Shared)
 	if err != nil {
 		return err
@@ -225,27 +1139,71 @@ func (opts *DeployOptions) actionInfo(rctx router.Context) error {
 	if err != nil {
 		return err
 	}
-	if deploy.IsNew() {
-		return fmt.Errorf(
-			"This application hasn't been deployed yet. Nothing to show.")
-	}
 
-	// Get the directory
-	// Run Terraform!
 	tf := &Terraform{
 		Path:      project.Path(),
 		Dir:       opts.tfDir(ctx),
 		Ui:        ctx.Ui,
 		Directory: ctx.Directory,
 		StateId:   deploy.ID,
+		Backend:   opts.backend(ctx),
 	}
-	args := make([]string, len(ctx.ActionArgs)+1)
-	args[0] = "output"
-	copy(args[1:], ctx.ActionArgs)
-	if err := tf.Execute(args...); err != nil {
-		return terraformError(err)
+
+	if args[0] == "pull" {
+		data, err := tf.StatePull()
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			ctx.Ui.Message("There is no Terraform state for this deploy.")
+			return nil
+		}
+
+		ctx.Ui.Raw(string(data))
+		return nil
+	}
+
+	if len(args) != 2 {
+		return fmt.Errorf(
+			"The path to the state to push must be given, such as\n" +
+				"`otto deploy state push terraform.tfstate`.")
+	}
+	data, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("Error reading the state to push: %s", err)
+	}
+	state, err := validateState(data)
+	if err != nil {
+		return err
+	}
+
+	resources := 0
+	for _, m := range state.Modules {
+		resources += len(m.Resources)
+	}
+	ui.Warn(ctx.Ui, fmt.Sprintf(
+		"The Terraform state of this deploy will be replaced with a state of\n"+
+			"%d resource(s). Resources missing from it will no longer be managed\n"+
+			"by Otto, and will be left running.", resources))
+	if !force {
+		v, err := ctx.Ui.Input(&ui.InputOpts{
+			Id:          "state_push_confirm",
+			Query:       "Do you want to replace the Terraform state?",
+			Description: "Only 'yes' will be accepted to confirm.",
+		})
+		if err != nil {
+			return fmt.Errorf("Error asking for confirmation: %s", err)
+		}
+		if v != "yes" {
+			return fmt.Errorf("State push cancelled.")
+		}
+	}
+
+	if err := tf.StatePush(data); err != nil {
+		return err
 	}
 
+	ctx.Ui.Header("[green]The Terraform state was replaced!")
 	return nil
 }
 
@@ -255,9 +1213,16 @@ func (opts *DeployOptions) actionInfo(rctx router.Context) error {
 // not been created successfully yet.
 func (opts *DeployOptions) lookupInfraVars(
 	ctx *app.Context) (*directory.Infra, map[string]string, error) {
-	infra, err := ctx.Directory.GetInfra(&directory.Infra{
-		Lookup: directory.Lookup{
-			Infra: ctx.Appfile.ActiveInfrastructure().Name}})
+	// This is the first lookup of a deploy, so an unreachable directory
+	// is retried for a while, and otherwise fails before Terraform runs.
+	var infra *directory.Infra
+	err := directory.RetryConnection(func() error {
+		var err error
+		infra, err = ctx.Directory.GetInfra(&directory.Infra{
+			Lookup: directory.Lookup{
+				Infra: ctx.Appfile.ActiveInfrastructure().Name}})
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -266,8 +1231,26 @@ func (opts *DeployOptions) lookupInfraVars(
 		return nil, nil, nil
 	}
 
+	application := ctx.Appfile.Application
 	vars := make(map[string]string)
 	for k, v := range infra.Outputs {
+		// An existing VPC and subnet from the Appfile replace the network
+		// of the infrastructure, for every subnet the deploy uses.
+		if application.VPCID != "" {
+			if k == "vpc_id" {
+				v = application.VPCID
+			}
+			if infraSubnetOutputs[k] {
+				v = application.SubnetID
+			}
+		}
+
+		// Deploys can be made in another region than the infrastructure,
+		// as long as the build has an artifact there.
+		if k == "region" {
+			v = deployRegion(ctx, infra)
+		}
+
 		if opts.InfraOutputMap != nil {
 			if nk, ok := opts.InfraOutputMap[k]; ok {
 				k = nk
@@ -275,24 +1258,26 @@ func (opts *DeployOptions) lookupInfraVars(
 		}
 		vars[k] = v
 	}
-	for k, v := range ctx.InfraCreds {
+	for k, v := range ctx.DeployCredsVars() {
 		vars[k] = v
 	}
 	return infra, vars, nil
 }
 
+// infraSubnetOutputs are the outputs of the infrastructures that are
+// subnets, which are replaced by the subnet set in the Appfile.
+var infraSubnetOutputs = map[string]bool{
+	"subnet_public":  true,
+	"subnet-public":  true,
+	"subnet-private": true,
+}
+
 // lookupBuildVars collects information about the result of `otto build` and
 // yields a set of variables that can be used by the deploy to reference the
 // built artifact. It returns nil if `otto build` has not yet been run.
 func (opts *DeployOptions) lookupBuildVars(
 	ctx *app.Context, infra *directory.Infra) (map[string]string, error) {
-	build, err := ctx.Directory.GetBuild(&directory.Build{
-		Lookup: directory.Lookup{
-			AppID:       ctx.Appfile.ID,
-			Infra:       ctx.Tuple.Infra,
-			InfraFlavor: ctx.Tuple.InfraFlavor,
-		},
-	})
+	build, err := opts.lookupBuild(ctx, "")
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +1285,48 @@ func (opts *DeployOptions) lookupBuildVars(
 		return nil, nil
 	}
 
+	return opts.buildVars(ctx, build, infra)
+}
+
+// lookupBuild returns the build with the given ID from the build history,
+// or the latest build if the ID is empty. It returns nil if there is no
+// such build.
+func (opts *DeployOptions) lookupBuild(
+	ctx *app.Context, id string) (*directory.Build, error) {
+	lookup := directory.Lookup{
+		AppID:       ctx.Appfile.ID,
+		Infra:       ctx.Tuple.Infra,
+		InfraFlavor: ctx.Tuple.InfraFlavor,
+	}
+	if id == "" {
+		return ctx.Directory.GetBuild(&directory.Build{Lookup: lookup})
+	}
+
+	builds, err := ctx.Directory.ListBuilds(&lookup)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading builds: %s", err)
+	}
+
+	return findBuild(builds, id), nil
+}
+
+// findBuild returns the build with the given ID, or nil if there is none.
+func findBuild(builds []*directory.Build, id string) *directory.Build {
+	for _, b := range builds {
+		if b.ID != "" && b.ID == id {
+			return b
+		}
+	}
+
+	return nil
+}
+
+// buildVars yields the variables that the deploy uses to reference the
+// artifact of the given build.
+func (opts *DeployOptions) buildVars(
+	ctx *app.Context,
+	build *directory.Build,
+	infra *directory.Infra) (map[string]string, error) {
 	// Extract the artifact from the build. We do this based on the
 	// infrastructure type.
 	if opts.ArtifactExtractors == nil {
@@ -329,19 +1356,31 @@ func (opts *DeployOptions) lookupBuildVars(
 // gives us the UUID we can use for the state storage.
 func (opts *DeployOptions) lookupDeploy(
 	ctx *app.Context) (*directory.Deploy, error) {
-	deployLookup := directory.Lookup{
-		AppID:       ctx.Appfile.ID,
-		Infra:       ctx.Tuple.Infra,
-		InfraFlavor: ctx.Tuple.InfraFlavor,
+	env, err := deployEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each environment has its own deploys
+	lookup := &directory.Deploy{
+		Lookup: directory.Lookup{
+			AppID:       ctx.Appfile.ID,
+			Infra:       ctx.Tuple.Infra,
+			InfraFlavor: ctx.Tuple.InfraFlavor,
+		},
 	}
-	deploy, err := ctx.Directory.GetDeploy(&directory.Deploy{Lookup: deployLookup})
+	if env != nil {
+		lookup.Environment = env.Name
+	}
+
+	deploy, err := ctx.Directory.GetDeploy(lookup)
 	if err != nil {
 		return nil, err
 	}
 
 	if deploy == nil {
 		// If we have no deploy, put in a temporary one
-		deploy = &directory.Deploy{Lookup: deployLookup}
+		deploy = lookup
 		deploy.State = directory.DeployStateNew
 
 		// Write the temporary deploy so we have an ID to use for the state
@@ -353,15 +1392,136 @@ func (opts *DeployOptions) lookupDeploy(
 	return deploy, nil
 }
 
+// backend returns the remote backend the state of the deploy is stored
+// in: Backend if it is set, or else the deploy_backend of the Appfile.
+// It is nil if the state is stored in the directory.
+func (opts *DeployOptions) backend(ctx *app.Context) *Backend {
+	if opts.Backend != nil {
+		return opts.Backend
+	}
+
+	b := ctx.Appfile.Application.DeployBackend
+	if b == nil {
+		return nil
+	}
+
+	config := make(map[string]string, len(b.Config))
+	for k, v := range b.Config {
+		config[k] = v
+	}
+	if b.Type != "s3" {
+		return &Backend{Type: b.Type, Config: config}
+	}
+
+	// The S3 backend uses the credentials of the deploy unless the
+	// Appfile sets others. Either way they're passed in the environment
+	// so that they aren't on the command line.
+	env := make(map[string]string)
+	if _, ok := config["access_key"]; ok {
+		for k, envKey := range s3CredsEnv {
+			if v, ok := config[k]; ok {
+				env[envKey] = v
+				delete(config, k)
+			}
+		}
+	} else {
+		creds := ctx.DeployCredsVars()
+		for k, envKey := range s3CredsEnv {
+			if v := creds[s3CredsVars[k]]; v != "" {
+				env[envKey] = v
+			}
+		}
+	}
+
+	result := &Backend{Type: b.Type, Config: config}
+	if len(env) > 0 {
+		result.Env = env
+	}
+
+	return result
+}
+
+// s3CredsEnv maps the credentials in the configuration of the S3 backend
+// to the environment variables Terraform reads them from instead.
+var s3CredsEnv = map[string]string{
+	"access_key": "AWS_ACCESS_KEY_ID",
+	"secret_key": "AWS_SECRET_ACCESS_KEY",
+	"token":      "AWS_SESSION_TOKEN",
+}
+
+// s3CredsVars maps the credentials in the configuration of the S3 backend
+// to the credentials variables of the deploy.
+var s3CredsVars = map[string]string{
+	"access_key": "aws_access_key",
+	"secret_key": "aws_secret_key",
+	"token":      "aws_token",
+}
+
 // tfDir returns the appropriate terraform working dir
 func (opts *DeployOptions) tfDir(ctx *app.Context) string {
 	tfDir := opts.Dir
 	if tfDir == "" {
 		tfDir = filepath.Join(ctx.Dir, "deploy")
+		if opts.Strategy == DeployStrategyBlueGreen {
+			tfDir = filepath.Join(ctx.Dir, "deploy-bluegreen")
+		}
 	}
 	return tfDir
 }
 
+// checkTfDir returns an error if there is no compiled Terraform
+// configuration to deploy with, such as when the app has no deploy
+// templates for the infrastructure.
+func (opts *DeployOptions) checkTfDir(ctx *app.Context) error {
+	_, err := os.Stat(opts.tfDir(ctx))
+	if os.IsNotExist(err) {
+		return fmt.Errorf(
+			"The %q app type has no deploy templates for the infrastructure %q\n"+
+				"with the flavor %q, so it can't be deployed. `otto compile` lists\n"+
+				"the infrastructures and flavors the app can be deployed to.",
+			ctx.Tuple.App, ctx.Tuple.Infra, ctx.Tuple.InfraFlavor)
+	}
+
+	return err
+}
+
+// DefaultDeployTimeout is how long the Terraform runs of a deploy may
+// take before they are cancelled if the Appfile doesn't set
+// deploy_timeout.
+var DefaultDeployTimeout = time.Hour
+
+// deployTimeout returns the deploy_timeout from the Appfile, or
+// DefaultDeployTimeout if it isn't set.
+func deployTimeout(ctx *app.Context) (time.Duration, error) {
+	raw := ctx.Appfile.Application.DeployTimeout
+	if raw == "" {
+		return DefaultDeployTimeout, nil
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing deploy_timeout: %s", err)
+	}
+
+	return d, nil
+}
+
+// deployExecErr returns the error for a failed Terraform run of a
+// deploy, explaining the timeout if the timer cancelled it.
+func deployExecErr(timer *execHelper.Timer, err error) error {
+	if err == execHelper.ErrInterrupted && timer.TimedOut() {
+		return fmt.Errorf(
+			"The deploy didn't finish within %s, so Terraform was stopped.\n"+
+				"The resources it created so far are kept in the state.\n\n"+
+				"A cloud operation may have stalled. Please run `otto deploy`\n"+
+				"again, or raise deploy_timeout in the Appfile if the deploy\n"+
+				"needs more time.",
+			timer.Timeout)
+	}
+
+	return terraformError(err)
+}
+
 // terraformError wraps an error from Terraform in a friendlier message.
 func terraformError(err error) error {
 	return fmt.Errorf(
@@ -374,24 +1534,50 @@ func terraformError(err error) error {
 
 // Synopsis text for actions
 const (
-	actionDeploySyn  = "Deploy the latest built artifact into your infrastructure"
-	actionDestroySyn = "Destroy all deployed resources for this application"
-	actionInfoSyn    = "Display information about this application's deploy"
+	actionDeploySyn   = "Deploy the latest built artifact into your infrastructure"
+	actionDestroySyn  = "Destroy all deployed resources for this application"
+	actionHistorySyn  = "Show every deploy of this application and what it changed"
+	actionInfoSyn     = "Display information about this application's deploy"
+	actionPromoteSyn  = "Deploy the artifact of an earlier build without rebuilding"
+	actionRollbackSyn = "Deploy the artifact of the previous successful deploy"
+	actionSSHSyn      = "SSH into a deployed instance"
+	actionStateSyn    = "Inspect or replace the Terraform state of the deploy"
 )
 
 // Help text for actions
 const actionDeployHelp = `
-Usage: otto deploy
+Usage: otto deploy [-ami=ID] [-confirm] [-env=NAME] [-force] [-target=RESOURCE]
 
   Deploys a built artifact into your infrastructure.
 
   This command will take the latest built artifact and deploy it into your
   infrastructure. Otto will create or replace any necessary resources required
   to run your app.
+
+  If the build created more than one AMI for the target region, the most
+  recent one is deployed. The -ami flag can be used to choose another one.
+
+  Before changing anything, Otto plans the deploy and shows how many
+  resources will be added, changed, and destroyed. With the -confirm flag,
+  the full plan is shown and Otto asks for confirmation before deploying.
+
+  The -env flag deploys to the named environment in the Appfile. Each
+  environment has its own deploys, and its settings take precedence over
+  the settings of the application.
+
+  If the artifact, the variables, and the compiled configuration haven't
+  changed since the last successful deploy, the deploy is skipped. The
+  -force flag deploys anyway.
+
+  The -target flag only applies the given Terraform resource, such as
+  "aws_instance.app", and the resources it depends on. It can be given
+  more than once. This is faster when iterating on a single resource, but
+  can leave the deploy partially applied, so it is marked as partial until
+  the next deploy without -target. It can't be used with blue-green deploys.
 `
 
 const actionDestroyHelp = `
-Usage: otto deploy destroy [-force]
+Usage: otto deploy destroy [-force] [-env=NAME]
 
   Destroys any deployed resources associated with this application.
 
@@ -403,8 +1589,19 @@ Usage: otto deploy destroy [-force]
 	can provide the -force flag to skip this check.
 `
 
+const actionHistoryHelp = `
+Usage: otto deploy history [-env=NAME]
+
+  Shows every deploy of this application, newest first.
+
+  For each deploy, this shows whether it succeeded, the artifact that
+  was deployed, and the resources it added, changed, destroyed, or
+  replaced, as Terraform planned them. Blue-green deploys don't record
+  their changes.
+`
+
 const actionInfoHelp = `
-Usage: otto deploy info [NAME]
+Usage: otto deploy info [-env=NAME] [NAME]
 
   Displays information about this application's deploy.
 
@@ -412,3 +1609,53 @@ Usage: otto deploy info [NAME]
   no NAME is specified, all outputs will be listed. If NAME is specified, just
   the contents of that output will be printed.
 `
+
+const actionPromoteHelp = `
+Usage: otto deploy promote -build=ID [-env=NAME]
+
+  Deploys the artifact of an earlier build without building again.
+
+  This is how a build that was tested in one environment is deployed to
+  another, such as from "staging" to "production": the artifact that is
+  deployed is exactly the one that was built, not a new build of the same
+  code. Run "otto build list" to see the IDs of the builds.
+
+  The deploy is recorded in the deploy history of the environment with
+  the ID of the promoted build.
+`
+
+const actionRollbackHelp = `
+Usage: otto deploy rollback [-env=NAME]
+
+  Deploys the artifact of the previous successful deploy.
+
+  This looks through the deploy history of this application for the latest
+  successful deploy before the current one that deployed a different
+  artifact, and deploys that artifact again. The rollback is recorded as a
+  new deploy, so the history is never rewritten.
+`
+
+const actionSSHHelp = `
+Usage: otto deploy ssh [-env=NAME] [COMMAND]
+
+  Opens an SSH session to the first instance of this application's deploy.
+  If COMMAND is given, it is run on the instance instead.
+
+  The private key set with "ssh_private_key" in the Appfile is used if
+  there is one. Otherwise, SSH authenticates with your SSH agent, which
+  holds the key of the infrastructure.
+`
+
+const actionStateHelp = `
+Usage: otto deploy state [-env=NAME] pull
+       otto deploy state [-env=NAME] [-force] push PATH
+
+  Inspects or replaces the Terraform state of this application's deploy,
+  to repair it by hand when it no longer matches the real resources, such
+  as after a resource was deleted outside of Otto.
+
+  "pull" outputs the state. "push" replaces the state with the one at
+  PATH, which must be a valid Terraform state. Otto asks for confirmation
+  before pushing unless the -force flag is given. Resources missing from
+  the pushed state are no longer managed by Otto, so use this with care.
+`
@@ -1,10 +1,14 @@
 package terraform
 
 import (
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"github.com/hashicorp/otto/app"
 	"github.com/hashicorp/otto/directory"
+	flagHelper "github.com/hashicorp/otto/helper/flag"
 )
 
 // DeployArtifactExtractor is the function type that is used to
@@ -13,20 +17,159 @@ type DeployArtifactExtractor func(
 	*app.Context, *directory.Build, *directory.Infra) (map[string]string, error)
 
 var deployArtifactExtractors = map[string]DeployArtifactExtractor{
-	"aws": deployArtifactExtractAWS,
+	"aws":          deployArtifactExtractAWS,
+	"digitalocean": deployArtifactExtractDigitalOcean,
+	"google":       deployArtifactExtractGoogle,
 }
 
 func deployArtifactExtractAWS(
 	ctx *app.Context,
 	build *directory.Build,
 	infra *directory.Infra) (map[string]string, error) {
-	ami, ok := build.Artifact[infra.Outputs["region"]]
+	region := deployRegion(ctx, infra)
+	ami, ok := build.Artifact[region]
 	if !ok {
 		return nil, fmt.Errorf(
 			"An artifact for the region '%s' could not be found. Please run\n"+
-				"`otto build` and try again.",
-			infra.Outputs["region"])
+				"`otto build` and try again. If the deploy_region isn't the region\n"+
+				"of the infrastructure, it must be in the build_regions too.",
+			region)
+	}
+
+	// If a specific AMI was requested, it must be one that was built
+	// for this region.
+	requested, err := deployStringArg(ctx, "ami")
+	if err != nil {
+		return nil, err
+	}
+	if requested != "" && requested != ami {
+		found := false
+		for _, id := range build.Artifacts[region] {
+			if id == requested {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(
+				"The AMI '%s' was not built for the region '%s'. The AMIs\n"+
+					"available for this region are: %s",
+				requested, region, strings.Join(build.Artifacts[region], ", "))
+		}
+
+		ami = requested
 	}
 
 	return map[string]string{"ami": ami}, nil
 }
+
+func deployArtifactExtractDigitalOcean(
+	ctx *app.Context,
+	build *directory.Build,
+	infra *directory.Infra) (map[string]string, error) {
+	region := deployRegion(ctx, infra)
+	image, ok := build.Artifact[region]
+	if !ok {
+		return nil, fmt.Errorf(
+			"An artifact for the region '%s' could not be found. Please run\n"+
+				"`otto build` and try again. If the deploy_region isn't the region\n"+
+				"of the infrastructure, it must be in the build_regions too.",
+			region)
+	}
+
+	token := ctx.DeployCredsVars()["do_token"]
+	if token == "" {
+		return nil, fmt.Errorf(
+			"A DigitalOcean API token wasn't found in the credentials of the\n" +
+				"infrastructure. Please set do_token in the credentials and try again.")
+	}
+
+	return map[string]string{
+		"do_image":  image,
+		"do_region": region,
+		"do_token":  token,
+	}, nil
+}
+
+// deployArtifactExtractGoogle returns the GCE image of the build. Images
+// aren't tied to a region, so the same image is deployed in any zone.
+func deployArtifactExtractGoogle(
+	ctx *app.Context,
+	build *directory.Build,
+	infra *directory.Infra) (map[string]string, error) {
+	image, ok := build.Artifact["image"]
+	if !ok {
+		return nil, fmt.Errorf(
+			"An image could not be found in the build. Please run\n" +
+				"`otto build` and try again.")
+	}
+
+	return map[string]string{"gcp_image": image}, nil
+}
+
+// deployRegion returns the region the deploy is made in. This is the
+// deploy_region of the infrastructure in the Appfile, or the region of
+// the infrastructure if it isn't set.
+func deployRegion(ctx *app.Context, infra *directory.Infra) string {
+	if r := ctx.Appfile.ActiveInfrastructure().DeployRegion; r != "" {
+		return r
+	}
+
+	return infra.Outputs["region"]
+}
+
+// deployStringArg reads the value of a string flag, such as one used to
+// choose a specific artifact, from the action arguments. Any other
+// arguments are ignored.
+func deployStringArg(ctx *app.Context, name string) (string, error) {
+	var value string
+	fs := flag.NewFlagSet("deploy", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.StringVar(&value, name, "", "")
+	args, _, _ := flagHelper.FilterArgs(fs, ctx.ActionArgs)
+	if err := fs.Parse(args); err != nil {
+		return "", fmt.Errorf("Error parsing -%s: %s", name, err)
+	}
+
+	return value, nil
+}
+
+// deployStringsArg reads every value of a string flag that can be given
+// more than once from the action arguments. Any other arguments are
+// ignored.
+func deployStringsArg(ctx *app.Context, name string) ([]string, error) {
+	var value flagHelper.StringSlice
+	fs := flag.NewFlagSet("deploy", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.Var(&value, name, "")
+	args, _, _ := flagHelper.FilterArgs(fs, ctx.ActionArgs)
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("Error parsing -%s: %s", name, err)
+	}
+
+	return value, nil
+}
+
+// deployBoolArg reads the value of a boolean flag from the action
+// arguments. Any other arguments are ignored.
+func deployBoolArg(ctx *app.Context, name string) (bool, error) {
+	var value bool
+	fs := flag.NewFlagSet("deploy", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.BoolVar(&value, name, false, "")
+	args, _, _ := flagHelper.FilterArgs(fs, ctx.ActionArgs)
+	if err := fs.Parse(args); err != nil {
+		return false, fmt.Errorf("Error parsing -%s: %s", name, err)
+	}
+
+	return value, nil
+}
+
+// deployOtherArgs returns the action arguments without the given string
+// flag, such as to pass the rest of them on to Terraform.
+func deployOtherArgs(ctx *app.Context, name string) []string {
+	fs := flag.NewFlagSet("deploy", flag.ContinueOnError)
+	fs.String(name, "", "")
+	_, other, pos := flagHelper.FilterArgs(fs, ctx.ActionArgs)
+	return append(other, pos...)
+}
@@ -0,0 +1,70 @@
+package terraform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// deployHash returns a hash of the variables and the Terraform
+// configuration in dir for a deploy. If the hash is the same as the hash
+// of the last successful deploy, applying again wouldn't change anything.
+//
+// The variables in skip aren't hashed. These are the credentials, which
+// can change without changing the deploy (such as session tokens).
+func deployHash(dir string, vars map[string]string, skip map[string]string) (string, error) {
+	h := sha256.New()
+
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		if _, ok := skip[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte(k + "\x00" + vars[k] + "\x00"))
+	}
+
+	// filepath.Walk walks in lexical order, so the hash is stable
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if info.Name() == ".terraform" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+		if !info.Mode().IsRegular() || strings.Contains(info.Name(), ".tfstate") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		h.Write([]byte(filepath.ToSlash(rel) + "\x00"))
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
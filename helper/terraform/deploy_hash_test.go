@@ -0,0 +1,54 @@
+package terraform
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDeployHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "main.tf")
+	if err := ioutil.WriteFile(path, []byte("foo"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	vars := map[string]string{"ami": "ami-1", "aws_token": "a"}
+	skip := map[string]string{"aws_token": "a"}
+	hash, err := deployHash(dir, vars, skip)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Skipped variables and state don't change the hash
+	vars["aws_token"] = "b"
+	if err := ioutil.WriteFile(
+		filepath.Join(dir, "terraform.tfstate"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual, _ := deployHash(dir, vars, skip); actual != hash {
+		t.Fatal("hash should not change")
+	}
+
+	// Other variables do
+	vars["ami"] = "ami-2"
+	actual, _ := deployHash(dir, vars, skip)
+	if actual == hash {
+		t.Fatal("hash should change with the variables")
+	}
+	hash = actual
+
+	// And so does the configuration
+	if err := ioutil.WriteFile(path, []byte("bar"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual, _ := deployHash(dir, vars, skip); actual == hash {
+		t.Fatal("hash should change with the configuration")
+	}
+}
@@ -0,0 +1,93 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/helper/router"
+)
+
+// defaultSSHUser is the user to SSH into deployed instances as if the
+// Appfile doesn't set one. Every image Otto builds is based on Ubuntu.
+const defaultSSHUser = "ubuntu"
+
+func (opts *DeployOptions) actionSSH(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	deploy, err := opts.lookupDeploy(ctx)
+	if err != nil {
+		return err
+	}
+	if deploy.IsNew() || deploy.IsDestroyed() {
+		return fmt.Errorf(
+			"This application hasn't been deployed yet. Nothing to SSH into.")
+	}
+
+	ip := deploy.Outputs["ip"]
+	if ip == "" {
+		return fmt.Errorf(
+			"The deploy of this application doesn't have an address Otto can\n" +
+				"SSH into. Only app types that deploy instances with a reachable\n" +
+				"IP address support `otto deploy ssh`.")
+	}
+
+	args, err := deploySSHArgs(ctx, ip)
+	if err != nil {
+		return err
+	}
+	args = append(args, deployOtherArgs(ctx, "env")...)
+
+	ctx.Ui.Header(fmt.Sprintf("Executing SSH to %s...", ip))
+	cmd := exec.Command("ssh", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// deploySSHArgs returns the arguments to SSH into the deployed instance
+// at host.
+//
+// The private key from the Appfile is used if there is one. Otherwise
+// SSH uses the agent, which has the key of the infrastructure.
+func deploySSHArgs(ctx *app.Context, host string) ([]string, error) {
+	var args []string
+	keyPath, err := ctx.Appfile.SSHPrivateKeyPath()
+	if err != nil {
+		return nil, fmt.Errorf("Error loading SSH private key path: %s", err)
+	}
+	if keyPath != "" {
+		args = append(args, "-i", keyPath)
+	}
+
+	user := defaultSSHUser
+	if ctx.Appfile.Application != nil && ctx.Appfile.Application.SSHUser != "" {
+		user = ctx.Appfile.Application.SSHUser
+	}
+
+	return append(args, fmt.Sprintf("%s@%s", user, host)), nil
+}
+
+// deploySSHCommand returns the SSH command to log in to the instance of
+// a deploy with the given outputs, ready to be pasted into a shell. It
+// is empty if the deploy doesn't have an address to SSH into.
+func deploySSHCommand(ctx *app.Context, outputs map[string]string) (string, error) {
+	ip := outputs["ip"]
+	if ip == "" {
+		return "", nil
+	}
+
+	args, err := deploySSHArgs(ctx, ip)
+	if err != nil {
+		return "", err
+	}
+	for i, arg := range args {
+		if strings.ContainsAny(arg, " \t'\"") {
+			args[i] = "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+		}
+	}
+
+	return "ssh " + strings.Join(args, " "), nil
+}
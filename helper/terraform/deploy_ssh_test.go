@@ -0,0 +1,56 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+)
+
+func TestDeploySSHCommand(t *testing.T) {
+	cases := []struct {
+		Application *appfile.Application
+		Outputs     map[string]string
+		Result      string
+	}{
+		{
+			&appfile.Application{},
+			map[string]string{"ip": "10.0.0.1"},
+			"ssh ubuntu@10.0.0.1",
+		},
+		{
+			&appfile.Application{
+				SSHPrivateKey: "keys/deploy.pem",
+				SSHUser:       "ec2-user",
+			},
+			map[string]string{"ip": "10.0.0.1"},
+			"ssh -i /repo/keys/deploy.pem ec2-user@10.0.0.1",
+		},
+		{
+			&appfile.Application{SSHPrivateKey: "my keys/deploy.pem"},
+			map[string]string{"ip": "10.0.0.1"},
+			"ssh -i '/repo/my keys/deploy.pem' ubuntu@10.0.0.1",
+		},
+		{
+			&appfile.Application{},
+			map[string]string{"url": "http://example.com"},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		ctx := &app.Context{}
+		ctx.Appfile = &appfile.File{
+			Path:        "/repo/Appfile",
+			Application: tc.Application,
+		}
+
+		actual, err := deploySSHCommand(ctx, tc.Outputs)
+		if err != nil {
+			t.Fatalf("%#v: %s", tc.Application, err)
+		}
+		if actual != tc.Result {
+			t.Fatalf("%#v: %s", tc.Application, actual)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestInstanceCount(t *testing.T) {
+	cases := []struct {
+		App   *appfile.Application
+		Count int
+		Err   bool
+	}{
+		{nil, 1, false},
+		{&appfile.Application{}, 1, false},
+		{&appfile.Application{Count: 3}, 3, false},
+		{&appfile.Application{Count: -1}, 0, true},
+	}
+
+	for i, tc := range cases {
+		count, err := instanceCount(tc.App)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if count != tc.Count {
+			t.Fatalf("%d: bad: %d", i, count)
+		}
+	}
+}
+
+func TestDeployAppVars(t *testing.T) {
+	f := &appfile.File{
+		Application: &appfile.Application{
+			Count:        2,
+			InstanceType: "t2.small",
+			Ports:        []int{80, 8080},
+			SSHKeyName:   "deploy",
+			Domain:       "staging.example.com",
+			DomainZoneID: "Z123",
+		},
+		Environments: []*appfile.Environment{
+			&appfile.Environment{
+				Name:  "production",
+				Count: 4,
+				Variables: map[string]string{
+					"instance_type": "m3.large",
+					"aws_region":    "us-west-2",
+					"domain":        "example.com",
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Args   []string
+		Result map[string]string
+		Err    bool
+	}{
+		{
+			nil,
+			map[string]string{
+				"instance_count": "2",
+				"instance_type":  "t2.small",
+				"ports":          "80,8080",
+				"key_name":       "deploy",
+				"domain":         "staging.example.com",
+				"domain_zone_id": "Z123",
+			},
+			false,
+		},
+
+		{
+			[]string{"-env=production"},
+			map[string]string{
+				"instance_count":     "4",
+				"instance_type":      "m3.large",
+				"aws_region":         "us-west-2",
+				"environment_suffix": "-production",
+				"ports":              "80,8080",
+				"key_name":           "deploy",
+				"domain":             "example.com",
+				"domain_zone_id":     "Z123",
+			},
+			false,
+		},
+
+		{
+			[]string{"-env=staging"},
+			nil,
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		ctx := &app.Context{ActionArgs: tc.Args}
+		ctx.Appfile = f
+
+		result, err := deployAppVars(ctx)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if !reflect.DeepEqual(result, tc.Result) {
+			t.Fatalf("%d: bad: %#v", i, result)
+		}
+	}
+}
+
+func TestDeployRegion(t *testing.T) {
+	infra := &directory.Infra{Outputs: map[string]string{"region": "us-east-1"}}
+
+	cases := []struct {
+		DeployRegion string
+		Result       string
+	}{
+		{"", "us-east-1"},
+		{"us-west-2", "us-west-2"},
+	}
+
+	for _, tc := range cases {
+		ctx := &app.Context{}
+		ctx.Appfile = &appfile.File{
+			Project: &appfile.Project{Infrastructure: "aws"},
+			Infrastructure: []*appfile.Infrastructure{
+				&appfile.Infrastructure{
+					Name:         "aws",
+					DeployRegion: tc.DeployRegion,
+				},
+			},
+		}
+
+		if actual := deployRegion(ctx, infra); actual != tc.Result {
+			t.Fatalf("%q: %s", tc.DeployRegion, actual)
+		}
+	}
+}
+
+func TestFindBuild(t *testing.T) {
+	builds := []*directory.Build{
+		&directory.Build{Artifact: map[string]string{"a": "1"}},
+		&directory.Build{ID: "foo", Artifact: map[string]string{"a": "2"}},
+		&directory.Build{ID: "bar", Artifact: map[string]string{"a": "3"}},
+	}
+
+	cases := []struct {
+		ID     string
+		Result *directory.Build
+	}{
+		{"foo", builds[1]},
+		{"bar", builds[2]},
+		{"baz", nil},
+		{"", nil},
+	}
+
+	for _, tc := range cases {
+		if actual := findBuild(builds, tc.ID); actual != tc.Result {
+			t.Fatalf("%q: %#v", tc.ID, actual)
+		}
+	}
+}
+
+func TestDeployOptionsBackend(t *testing.T) {
+	cases := []struct {
+		Opts    *DeployOptions
+		Backend *appfile.DeployBackend
+		Creds   map[string]string
+		Result  *Backend
+	}{
+		{&DeployOptions{}, nil, nil, nil},
+
+		{
+			&DeployOptions{Backend: &Backend{Type: "consul"}},
+			&appfile.DeployBackend{Type: "s3"},
+			nil,
+			&Backend{Type: "consul"},
+		},
+
+		{
+			&DeployOptions{},
+			&appfile.DeployBackend{
+				Type:   "s3",
+				Config: map[string]string{"bucket": "foo"},
+			},
+			map[string]string{
+				"aws_access_key":    "access",
+				"aws_secret_key":    "secret",
+				"aws_session_token": "token",
+			},
+			&Backend{
+				Type:   "s3",
+				Config: map[string]string{"bucket": "foo"},
+				Env: map[string]string{
+					"AWS_ACCESS_KEY_ID":     "access",
+					"AWS_SECRET_ACCESS_KEY": "secret",
+					"AWS_SESSION_TOKEN":     "token",
+				},
+			},
+		},
+
+		{
+			&DeployOptions{},
+			&appfile.DeployBackend{
+				Type: "s3",
+				Config: map[string]string{
+					"bucket":     "foo",
+					"access_key": "appfile",
+					"secret_key": "appfile-secret",
+				},
+			},
+			map[string]string{
+				"aws_access_key": "access",
+				"aws_secret_key": "secret",
+			},
+			&Backend{
+				Type:   "s3",
+				Config: map[string]string{"bucket": "foo"},
+				Env: map[string]string{
+					"AWS_ACCESS_KEY_ID":     "appfile",
+					"AWS_SECRET_ACCESS_KEY": "appfile-secret",
+				},
+			},
+		},
+
+		{
+			&DeployOptions{},
+			&appfile.DeployBackend{
+				Type:   "consul",
+				Config: map[string]string{"address": "foo"},
+			},
+			map[string]string{
+				"aws_access_key": "access",
+				"aws_secret_key": "secret",
+			},
+			&Backend{Type: "consul", Config: map[string]string{
+				"address": "foo",
+			}},
+		},
+	}
+
+	for i, tc := range cases {
+		ctx := &app.Context{}
+		ctx.InfraCreds = tc.Creds
+		ctx.Appfile = &appfile.File{
+			Application: &appfile.Application{DeployBackend: tc.Backend},
+		}
+
+		if actual := tc.Opts.backend(ctx); !reflect.DeepEqual(actual, tc.Result) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
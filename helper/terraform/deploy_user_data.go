@@ -0,0 +1,71 @@
+package terraform
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/context"
+	"gopkg.in/flosch/pongo2.v3"
+)
+
+// maxUserDataSize is the most user data an EC2 instance can have.
+const maxUserDataSize = 16 * 1024
+
+// deployUserData returns the user data of the deployed instances from the
+// Appfile, or an empty string if it isn't set. A user_data_file ending in
+// ".tpl" is rendered with the given Terraform variables of the deploy, so
+// that it can reference the infrastructure and the environment being
+// deployed.
+func deployUserData(ctx *app.Context, vars map[string]string) (string, error) {
+	if ctx.Appfile.Application == nil {
+		return "", nil
+	}
+
+	data := ctx.Appfile.Application.UserData
+	if path := ctx.Appfile.UserDataPath(); path != "" {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Error reading the user_data_file: %s", err)
+		}
+		data = string(raw)
+
+		if strings.HasSuffix(path, ".tpl") {
+			data, err = renderUserData(data, vars)
+			if err != nil {
+				return "", fmt.Errorf("Error rendering the user_data template: %s", err)
+			}
+		}
+	}
+
+	if len(data) > maxUserDataSize {
+		return "", fmt.Errorf(
+			"The user data of the deploy is %d bytes, but EC2 limits user data\n"+
+				"to %d bytes. Please shorten it, such as by downloading the rest of\n"+
+				"the configuration when the instance boots.",
+			len(data), maxUserDataSize)
+	}
+
+	return data, nil
+}
+
+// renderUserData renders a user data template with the variables of the
+// deploy. Secret credentials are left out so they can't end up in the
+// instance metadata.
+func renderUserData(tplString string, vars map[string]string) (string, error) {
+	tpl, err := pongo2.FromString(tplString)
+	if err != nil {
+		return "", err
+	}
+
+	tplCtx := make(pongo2.Context)
+	for k, v := range vars {
+		tplCtx[k] = v
+	}
+	for _, k := range context.SensitiveVars {
+		delete(tplCtx, k)
+	}
+
+	return tpl.Execute(tplCtx)
+}
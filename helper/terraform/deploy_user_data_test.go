@@ -0,0 +1,72 @@
+package terraform
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+)
+
+func TestDeployUserData(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	files := map[string]string{
+		"plain.sh":      "#!/bin/sh\necho {{ aws_region }}\n",
+		"render.sh.tpl": "#!/bin/sh\necho {{ aws_region }}{{ aws_secret_key }}\n",
+		"large.sh":      strings.Repeat("x", maxUserDataSize+1),
+	}
+	for name, data := range files {
+		path := filepath.Join(td, name)
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	vars := map[string]string{
+		"aws_region":     "us-east-1",
+		"aws_secret_key": "secret",
+	}
+
+	cases := []struct {
+		UserData     string
+		UserDataFile string
+		Result       string
+		Err          bool
+	}{
+		{"", "", "", false},
+		{"#!/bin/sh\necho inline\n", "", "#!/bin/sh\necho inline\n", false},
+		{"echo inline", "", "echo inline", false},
+		{"", "plain.sh", "#!/bin/sh\necho {{ aws_region }}\n", false},
+		{"", "render.sh.tpl", "#!/bin/sh\necho us-east-1\n", false},
+		{strings.Repeat("x", maxUserDataSize+1), "", "", true},
+		{"", "large.sh", "", true},
+		{"", "missing.sh", "", true},
+	}
+
+	for i, tc := range cases {
+		ctx := &app.Context{}
+		ctx.Appfile = &appfile.File{
+			Path: filepath.Join(td, "Appfile"),
+			Application: &appfile.Application{
+				UserData:     tc.UserData,
+				UserDataFile: tc.UserDataFile,
+			},
+		}
+
+		actual, err := deployUserData(ctx, vars)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if actual != tc.Result {
+			t.Fatalf("%d: %q", i, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/foundation"
 )
@@ -98,7 +99,7 @@ func (f *Foundation) execute(ctx *foundation.Context, args ...string) error {
 	for k, v := range infra.Outputs {
 		vars[k] = v
 	}
-	for k, v := range ctx.InfraCreds {
+	for k, v := range ctx.InfraCredsVars() {
 		vars[k] = v
 	}
 
@@ -110,12 +111,13 @@ func (f *Foundation) execute(ctx *foundation.Context, args ...string) error {
 
 	// Run Terraform!
 	tf := &Terraform{
-		Path:      project.Path(),
-		Dir:       tfDir,
-		Ui:        ctx.Ui,
-		Variables: vars,
-		Directory: ctx.Directory,
-		StateId:   foundationInfra.ID,
+		Path:          project.Path(),
+		Dir:           tfDir,
+		Ui:            ctx.Ui,
+		Variables:     vars,
+		SensitiveVars: context.SensitiveVars,
+		Directory:     ctx.Directory,
+		StateId:       foundationInfra.ID,
 	}
 	err = tf.Execute(args...)
 	if err != nil {
@@ -0,0 +1,115 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/otto/appfile"
+)
+
+// healthCheckDefaultTimeout is the timeout used when the health check
+// doesn't set one.
+const healthCheckDefaultTimeout = 5 * time.Minute
+
+// HealthCheck checks that a deployed application is healthy by requesting
+// a URL until it returns a 200 or the timeout elapses.
+type HealthCheck struct {
+	// Path is the HTTP path to request, such as "/health".
+	Path string
+
+	// Port is the port to request when the address is from the "ip"
+	// output. This defaults to 80.
+	Port int
+
+	// Timeout is how long to wait for the application to be healthy.
+	Timeout time.Duration
+
+	// Interval is the time to wait between requests. This defaults
+	// to 5 seconds.
+	Interval time.Duration
+}
+
+// appHealthCheck returns the HealthCheck for the health_check block of
+// the Appfile application, or nil if there isn't one.
+func appHealthCheck(app *appfile.Application) (*HealthCheck, error) {
+	if app == nil || app.HealthCheck == nil {
+		return nil, nil
+	}
+
+	timeout := healthCheckDefaultTimeout
+	if raw := app.HealthCheck.Timeout; raw != "" {
+		var err error
+		timeout, err = time.ParseDuration(raw)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Error parsing health_check timeout: %s", err)
+		}
+	}
+
+	return &HealthCheck{
+		Path:    app.HealthCheck.Path,
+		Port:    app.HealthCheck.Port,
+		Timeout: timeout,
+	}, nil
+}
+
+// URL returns the URL to check using the outputs of the deploy. The
+// "url" output is used if it exists, otherwise the "ip" output.
+func (h *HealthCheck) URL(outputs map[string]string) (string, error) {
+	if u, ok := outputs["url"]; ok && u != "" {
+		return strings.TrimRight(u, "/") + h.Path, nil
+	}
+
+	if ip, ok := outputs["ip"]; ok && ip != "" {
+		port := h.Port
+		if port == 0 {
+			port = 80
+		}
+
+		return fmt.Sprintf("http://%s:%d%s", ip, port, h.Path), nil
+	}
+
+	return "", fmt.Errorf(
+		"The deploy has no 'url' or 'ip' output, so the health check\n" +
+			"doesn't know what address to check.")
+}
+
+// Wait requests the URL until it returns a 200 or the timeout elapses.
+// If the timeout elapses, the error includes the last status seen.
+func (h *HealthCheck) Wait(url string) error {
+	interval := h.Interval
+	if interval == 0 {
+		interval = 5 * time.Second
+	}
+
+	client := &http.Client{Timeout: interval}
+	deadline := time.Now().Add(h.Timeout)
+	last := "no response"
+	for {
+		resp, err := client.Get(url)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+
+			last = resp.Status
+		} else {
+			last = err.Error()
+		}
+		log.Printf("[DEBUG] health check %s: %s", url, last)
+
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf(
+				"The application didn't become healthy within %s.\n"+
+					"The health check requested %s and the last\n"+
+					"status seen was: %s",
+				h.Timeout, url, last)
+		}
+
+		time.Sleep(interval)
+	}
+}
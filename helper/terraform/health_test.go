@@ -0,0 +1,93 @@
+package terraform
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHealthCheckURL(t *testing.T) {
+	cases := []struct {
+		Check   *HealthCheck
+		Outputs map[string]string
+		Result  string
+		Err     bool
+	}{
+		{
+			&HealthCheck{Path: "/health"},
+			map[string]string{"url": "http://foo.com/", "ip": "1.2.3.4"},
+			"http://foo.com/health",
+			false,
+		},
+
+		{
+			&HealthCheck{Path: "/health"},
+			map[string]string{"ip": "1.2.3.4"},
+			"http://1.2.3.4:80/health",
+			false,
+		},
+
+		{
+			&HealthCheck{Path: "/", Port: 8080},
+			map[string]string{"ip": "1.2.3.4"},
+			"http://1.2.3.4:8080/",
+			false,
+		},
+
+		{
+			&HealthCheck{Path: "/"},
+			nil,
+			"",
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		result, err := tc.Check.URL(tc.Outputs)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if result != tc.Result {
+			t.Fatalf("%d: bad: %s", i, result)
+		}
+	}
+}
+
+func TestHealthCheckWait(t *testing.T) {
+	var count int32
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&count, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+	defer ts.Close()
+
+	h := &HealthCheck{Timeout: 5 * time.Second, Interval: 10 * time.Millisecond}
+	if err := h.Wait(ts.URL); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if count != 3 {
+		t.Fatalf("bad: %d", count)
+	}
+}
+
+func TestHealthCheckWait_timeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+	defer ts.Close()
+
+	h := &HealthCheck{Timeout: 50 * time.Millisecond, Interval: 10 * time.Millisecond}
+	err := h.Wait(ts.URL)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "502 Bad Gateway") {
+		t.Fatalf("should include status: %s", err)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/helper/bindata"
 	"github.com/hashicorp/otto/helper/router"
 	"github.com/hashicorp/otto/infrastructure"
+	"github.com/hashicorp/otto/ui"
 )
 
 // Infrastructure implements infrastructure.Infrastructure and is a
@@ -64,6 +66,11 @@ func (i *Infrastructure) Execute(ctx *infrastructure.Context) error {
 				SynopsisText: infraInfoSyn,
 				HelpText:     strings.TrimSpace(infraInfoHelp),
 			},
+			"refresh": &router.SimpleAction{
+				ExecuteFunc:  i.actionRefresh,
+				SynopsisText: infraRefreshSyn,
+				HelpText:     strings.TrimSpace(infraRefreshHelp),
+			},
 		},
 	}
 	return r.Route(ctx)
@@ -120,21 +127,85 @@ func (i *Infrastructure) actionInfo(rctx router.Context) error {
 	return nil
 }
 
-func (i *Infrastructure) execute(ctx *infrastructure.Context, command ...string) error {
+// actionRefresh refreshes the Terraform state of the infrastructure to
+// check that its resources still exist, since they can be destroyed
+// outside of Otto. If any are gone, the infrastructure is no longer
+// considered ready, so builds and deploys don't proceed against it.
+func (i *Infrastructure) actionRefresh(rctx router.Context) error {
+	ctx := rctx.(*infrastructure.Context)
 	project, err := Project(&ctx.Shared)
 	if err != nil {
 		return err
 	}
 
-	// Build the variables
-	vars := make(map[string]string)
-	for k, v := range ctx.InfraCreds {
-		vars[k] = v
+	lookup := directory.Lookup{Infra: ctx.Infra.Name}
+	infra, err := ctx.Directory.GetInfra(&directory.Infra{Lookup: lookup})
+	if err != nil {
+		return fmt.Errorf(
+			"Error looking up existing infrastructure data: %s", err)
 	}
-	for k, v := range i.Variables {
-		vars[k] = v
+
+	// Only infrastructure that is considered ready can be stale
+	if !infra.IsReady() {
+		return nil
+	}
+
+	tf := &Terraform{
+		Path:          project.Path(),
+		Dir:           ctx.Dir,
+		Ui:            ctx.Ui,
+		Variables:     i.vars(ctx),
+		SensitiveVars: context.SensitiveVars,
+		Directory:     ctx.Directory,
+		StateId:       infra.ID,
+	}
+
+	before, err := tf.Resources()
+	if err != nil {
+		return fmt.Errorf("Error reading Terraform state: %s", err)
 	}
 
+	ctx.Ui.Header("Refreshing infrastructure state...")
+	if err := tf.Execute("refresh"); err != nil {
+		return fmt.Errorf("Error refreshing infrastructure: %s", err)
+	}
+
+	after, err := tf.Resources()
+	if err != nil {
+		return fmt.Errorf("Error reading Terraform state: %s", err)
+	}
+	if after >= before {
+		ctx.Ui.Message("The infrastructure still exists.")
+		return nil
+	}
+
+	// Some resources are gone. If all of them are, the infrastructure is
+	// as good as destroyed.
+	infra.State = directory.InfraStatePartial
+	if after == 0 {
+		infra.State = directory.InfraStateInvalid
+		infra.Outputs = map[string]string{}
+	}
+	if err := ctx.Directory.PutInfra(infra); err != nil {
+		return fmt.Errorf("Error storing infrastructure data: %s", err)
+	}
+
+	ui.Warn(ctx.Ui, fmt.Sprintf(
+		"%d of the %d infrastructure resources no longer exist. They were\n"+
+			"likely destroyed outside of Otto. Run `otto infra` to create them again.",
+		before-after, before))
+	return nil
+}
+
+func (i *Infrastructure) execute(ctx *infrastructure.Context, command ...string) error {
+	project, err := Project(&ctx.Shared)
+	if err != nil {
+		return err
+	}
+
+	// Build the variables
+	vars := i.vars(ctx)
+
 	// Setup the lookup information and query the existing infra so we
 	// can get our UUID for storing data.
 	lookup := directory.Lookup{Infra: ctx.Infra.Name}
@@ -165,12 +236,13 @@ func (i *Infrastructure) execute(ctx *infrastructure.Context, command ...string)
 
 	// Build our executor
 	tf := &Terraform{
-		Path:      project.Path(),
-		Dir:       ctx.Dir,
-		Ui:        ctx.Ui,
-		Variables: vars,
-		Directory: ctx.Directory,
-		StateId:   infra.ID,
+		Path:          project.Path(),
+		Dir:           ctx.Dir,
+		Ui:            ctx.Ui,
+		Variables:     vars,
+		SensitiveVars: context.SensitiveVars,
+		Directory:     ctx.Directory,
+		StateId:       infra.ID,
 	}
 
 	ctx.Ui.Header("Executing Terraform to manage infrastructure...")
@@ -234,6 +306,20 @@ func (i *Infrastructure) execute(ctx *infrastructure.Context, command ...string)
 	return nil
 }
 
+// vars returns the variables for Terraform: the infrastructure
+// credentials and the configured Variables.
+func (i *Infrastructure) vars(ctx *infrastructure.Context) map[string]string {
+	vars := make(map[string]string)
+	for k, v := range ctx.InfraCredsVars() {
+		vars[k] = v
+	}
+	for k, v := range i.Variables {
+		vars[k] = v
+	}
+
+	return vars
+}
+
 // TODO: test
 func (i *Infrastructure) Compile(ctx *infrastructure.Context) (*infrastructure.CompileResult, error) {
 	if err := i.Bindata.CopyDir(ctx.Dir, "data/"+ctx.Infra.Flavor); err != nil {
@@ -253,6 +339,7 @@ const (
 	infraApplySyn   = "Create or update infrastructure resources for this application"
 	infraDestroySyn = "Destroy infrastructure resources for this application"
 	infraInfoSyn    = "Display information about this application's infrastructure"
+	infraRefreshSyn = "Check that the infrastructure resources still exist"
 )
 
 // Help text for actions
@@ -287,3 +374,16 @@ Usage: otto infra info [NAME]
   outputs. If no NAME is specified, all outputs will be listed. If NAME is
   specified, just the contents of that output will be printed.
 `
+
+const infraRefreshHelp = `
+Usage: otto infra refresh
+
+  Checks that the infrastructure resources still exist.
+
+  This command refreshes the state of the infrastructure from the
+  infrastructure provider. If any resources were destroyed outside of Otto,
+  the infrastructure is no longer considered ready, and 'otto infra' must be
+  run to create them again before building or deploying.
+
+  'otto build' and 'otto deploy' do this first with the -refresh-infra flag.
+`
@@ -22,6 +22,22 @@ func Outputs(path string) (map[string]string, error) {
 	return state.RootModule().Outputs, nil
 }
 
+// Resources returns the number of resources in the Terraform state at
+// the given path, in all modules.
+func Resources(path string) (int, error) {
+	state, err := readState(path)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, m := range state.Modules {
+		count += len(m.Resources)
+	}
+
+	return count, nil
+}
+
 func readState(path string) (*terraform.State, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -36,3 +36,24 @@ func TestOutputs(t *testing.T) {
 		}
 	}
 }
+
+func TestResources(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Result int
+	}{
+		{"outputs-empty.tfstate", 0},
+		{"resources-basic.tfstate", 3},
+	}
+
+	for _, tc := range cases {
+		result, err := Resources(filepath.Join("./test-fixtures", tc.Input))
+		if err != nil {
+			t.Fatalf("bad: %s, %s", tc.Input, err)
+		}
+
+		if result != tc.Result {
+			t.Fatalf("bad: %s, %d", tc.Input, result)
+		}
+	}
+}
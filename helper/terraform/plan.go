@@ -0,0 +1,122 @@
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/otto/directory"
+	"github.com/hashicorp/otto/ui"
+)
+
+// Plan is the summary of the changes `terraform plan` would make.
+type Plan struct {
+	// Add, Change, and Destroy are the number of resources that would
+	// be created, updated in place, and destroyed. A resource that must
+	// be replaced counts as both an add and a destroy.
+	Add     int
+	Change  int
+	Destroy int
+
+	// Changes are the resources that would be changed, in the order
+	// Terraform lists them.
+	Changes []*directory.DeployChange
+
+	// Output is the raw output of `terraform plan`.
+	Output string
+}
+
+// Empty returns true if the plan doesn't change anything.
+func (p *Plan) Empty() bool {
+	return p.Add == 0 && p.Change == 0 && p.Destroy == 0
+}
+
+func (p *Plan) String() string {
+	return fmt.Sprintf(
+		"%d to add, %d to change, %d to destroy", p.Add, p.Change, p.Destroy)
+}
+
+// planSummaryRegexp matches the summary line of `terraform plan`.
+var planSummaryRegexp = regexp.MustCompile(
+	`Plan: (\d+) to add, (\d+) to change, (\d+) to destroy`)
+
+// Plan runs `terraform plan` and returns a summary of the changes an
+// apply would make. The state is read the same way as Execute, but
+// nothing is stored.
+//
+// The output of Terraform isn't streamed to the Ui. It is available in
+// the returned Plan instead.
+func (t *Terraform) Plan() (*Plan, error) {
+	var mockUi ui.Mock
+	plan := &Terraform{
+		Path:              t.Path,
+		Dir:               t.Dir,
+		Ui:                &mockUi,
+		Variables:         t.Variables,
+		SensitiveVars:     t.SensitiveVars,
+		Directory:         t.Directory,
+		StateId:           t.StateId,
+		Backend:           t.Backend,
+		Targets:           t.Targets,
+		HeartbeatInterval: t.HeartbeatInterval,
+
+		// Cancelling t cancels the plan as well
+		cancelCh: t.cancelChan(),
+	}
+	err := plan.Execute("plan", "-no-color")
+	output := strings.Join(mockUi.RawBuf, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s\n\n%s", err, strings.TrimSpace(output))
+	}
+
+	return parsePlan(output)
+}
+
+// planChangeRegexp matches the line of a resource that is changed in the
+// output of `terraform plan`. Its attributes are indented below it.
+var planChangeRegexp = regexp.MustCompile(`^(-/\+|\+|~|-) (\S+)`)
+
+// planChangeActions are the actions of the prefixes of planChangeRegexp.
+var planChangeActions = map[string]string{
+	"+":   directory.DeployChangeAdd,
+	"~":   directory.DeployChangeChange,
+	"-":   directory.DeployChangeDestroy,
+	"-/+": directory.DeployChangeReplace,
+}
+
+// parsePlan parses the output of `terraform plan`.
+func parsePlan(output string) (*Plan, error) {
+	result := &Plan{Output: output}
+	match := planSummaryRegexp.FindStringSubmatch(output)
+	if match == nil {
+		if strings.Contains(output, "No changes") {
+			return result, nil
+		}
+
+		return nil, fmt.Errorf(
+			"Couldn't find the summary in the Terraform plan:\n\n%s",
+			strings.TrimSpace(output))
+	}
+
+	counts := []*int{&result.Add, &result.Change, &result.Destroy}
+	for i, raw := range match[1:] {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		*counts[i] = v
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if m := planChangeRegexp.FindStringSubmatch(line); m != nil {
+			result.Changes = append(result.Changes, &directory.DeployChange{
+				Action:   planChangeActions[m[1]],
+				Resource: m[2],
+			})
+		}
+	}
+
+	return result, nil
+}
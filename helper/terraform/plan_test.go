@@ -0,0 +1,82 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestParsePlan(t *testing.T) {
+	cases := []struct {
+		Output string
+		Result *Plan
+		Err    bool
+	}{
+		{
+			"+ aws_instance.app\n\nPlan: 1 to add, 0 to change, 1 to destroy.\n",
+			&Plan{
+				Add:     1,
+				Destroy: 1,
+				Changes: []*directory.DeployChange{
+					{Action: directory.DeployChangeAdd, Resource: "aws_instance.app"},
+				},
+			},
+			false,
+		},
+
+		{
+			"-/+ aws_instance.app\n    ami: \"ami-1\" => \"ami-2\" (forces new resource)\n\n" +
+				"~ aws_elb.app\n    instances.#: \"1\" => \"<computed>\"\n\n" +
+				"- aws_eip.app\n\n" +
+				"Plan: 1 to add, 1 to change, 2 to destroy.\n",
+			&Plan{
+				Add:     1,
+				Change:  1,
+				Destroy: 2,
+				Changes: []*directory.DeployChange{
+					{Action: directory.DeployChangeReplace, Resource: "aws_instance.app"},
+					{Action: directory.DeployChangeChange, Resource: "aws_elb.app"},
+					{Action: directory.DeployChangeDestroy, Resource: "aws_eip.app"},
+				},
+			},
+			false,
+		},
+
+		{
+			"No changes. Infrastructure is up-to-date.\n",
+			&Plan{},
+			false,
+		},
+
+		{
+			"Something else\n",
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parsePlan(tc.Output)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%q: err: %s", tc.Output, err)
+		}
+		if tc.Err {
+			continue
+		}
+
+		tc.Result.Output = tc.Output
+		if !reflect.DeepEqual(actual, tc.Result) {
+			t.Fatalf("%q: bad: %#v", tc.Output, actual)
+		}
+	}
+}
+
+func TestPlanEmpty(t *testing.T) {
+	if !(&Plan{}).Empty() {
+		t.Fatal("should be empty")
+	}
+	if (&Plan{Change: 1}).Empty() {
+		t.Fatal("should not be empty")
+	}
+}
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/otto/app"
+	execHelper "github.com/hashicorp/otto/helper/exec"
+)
+
+// postDeployEnvPrefix is the prefix of the environment variables that
+// the post_deploy command gets the outputs of the deploy in.
+const postDeployEnvPrefix = "OTTO_OUTPUT_"
+
+// postDeployEnvInvalid matches the characters of an output name that
+// can't be in an environment variable name.
+var postDeployEnvInvalid = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// postDeploy runs the post_deploy command of the Appfile application, if
+// it has one, with the outputs of the deploy set as environment variables.
+func postDeploy(ctx *app.Context, outputs map[string]string) error {
+	application := ctx.Appfile.Application
+	if application == nil || application.PostDeploy == "" {
+		return nil
+	}
+
+	ctx.Ui.Header("Running the post-deploy command...")
+	cmd := exec.Command("sh", "-c", application.PostDeploy)
+	cmd.Dir = ctx.Appfile.SourceDir()
+	cmd.Env = append(os.Environ(), postDeployEnv(outputs)...)
+	if err := execHelper.Run(ctx.Ui, cmd); err != nil {
+		return fmt.Errorf(
+			"The post-deploy command %q failed: %s", application.PostDeploy, err)
+	}
+
+	return nil
+}
+
+// postDeployEnv returns the environment variables for the outputs of a
+// deploy, such as OTTO_OUTPUT_IP for the "ip" output.
+func postDeployEnv(outputs map[string]string) []string {
+	keys := make([]string, 0, len(outputs))
+	for k := range outputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		name := postDeployEnvInvalid.ReplaceAllString(strings.ToUpper(k), "_")
+		result[i] = fmt.Sprintf("%s%s=%s", postDeployEnvPrefix, name, outputs[k])
+	}
+
+	return result
+}
@@ -0,0 +1,25 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPostDeployEnv(t *testing.T) {
+	actual := postDeployEnv(map[string]string{
+		"ip":       "10.0.0.1",
+		"url":      "http://example.com",
+		"blue-ip":  "10.0.0.2",
+		"empty.ok": "",
+	})
+
+	expected := []string{
+		"OTTO_OUTPUT_BLUE_IP=10.0.0.2",
+		"OTTO_OUTPUT_EMPTY_OK=",
+		"OTTO_OUTPUT_IP=10.0.0.1",
+		"OTTO_OUTPUT_URL=http://example.com",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
@@ -0,0 +1,95 @@
+package terraform
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/hashicorp/otto/directory"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// StatePull returns the raw Terraform state from the configured directory
+// storage, or from the remote backend if one is configured. It is nil if
+// there is no state.
+//
+// This is meant for inspecting the state by hand, such as when it no
+// longer matches the real resources.
+func (t *Terraform) StatePull() ([]byte, error) {
+	var result []byte
+	err := t.withState(func(path string) error {
+		var err error
+		result, err = ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			// The remote backend has no state yet
+			return nil
+		}
+
+		return err
+	})
+
+	return result, err
+}
+
+// StatePush replaces the Terraform state in the configured directory
+// storage, or in the remote backend if one is configured, with data. The
+// data must be a valid Terraform state. Nothing is checked against the
+// current state, so this can lose track of resources if it is used
+// carelessly.
+func (t *Terraform) StatePush(data []byte) error {
+	if _, err := validateState(data); err != nil {
+		return err
+	}
+
+	if t.Backend != nil {
+		unlock, err := t.lockState()
+		if err != nil {
+			return err
+		}
+		defer unlock()
+
+		// Configuring the backend pulls the latest state, which is then
+		// overwritten and pushed back.
+		if err := t.remoteConfig(); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(remoteStatePath(t.Dir), data, 0644); err != nil {
+			return fmt.Errorf("Error writing Terraform state: %s", err)
+		}
+
+		path := "terraform"
+		if t.Path != "" {
+			path = t.Path
+		}
+		args := []string{"remote", "push", "-force"}
+		log.Printf("[DEBUG] executing terraform: %v", args)
+		cmd := t.command(path, args...)
+		if err := t.run(cmd, args[0]); err != nil {
+			return fmt.Errorf("Error pushing Terraform state: %s", err)
+		}
+
+		return nil
+	}
+
+	err := t.Directory.PutBlob(t.StateId, &directory.BlobData{
+		Data: bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("Error storing Terraform state: %s", err)
+	}
+
+	return nil
+}
+
+// validateState parses data as a Terraform state, returning an error if it
+// isn't one.
+func validateState(data []byte) (*terraform.State, error) {
+	state, err := terraform.ReadState(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Terraform state: %s", err)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,73 @@
+package terraform
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/otto/directory"
+)
+
+func TestTerraformStatePushPull(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	tf := &Terraform{
+		Directory: &directory.BoltBackend{Dir: td},
+		StateId:   "foo",
+	}
+
+	// No state
+	data, err := tf.StatePull()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if data != nil {
+		t.Fatalf("bad: %s", data)
+	}
+
+	// Invalid states aren't pushed
+	if err := tf.StatePush([]byte("{")); err == nil {
+		t.Fatal("should error")
+	}
+
+	state, err := ioutil.ReadFile(filepath.Join("./test-fixtures", "resources-basic.tfstate"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := tf.StatePush(state); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	data, err = tf.StatePull()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(data, state) {
+		t.Fatalf("bad: %s", data)
+	}
+}
+
+func TestValidateState(t *testing.T) {
+	cases := []struct {
+		Input string
+		Err   bool
+	}{
+		{"", true},
+		{"{", true},
+		{"not a state", true},
+		{`{"version": 1, "serial": 1, "modules": []}`, false},
+	}
+
+	for _, tc := range cases {
+		_, err := validateState([]byte(tc.Input))
+		if (err != nil) != tc.Err {
+			t.Fatalf("%q: %s", tc.Input, err)
+		}
+	}
+}
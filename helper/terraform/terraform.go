@@ -9,6 +9,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/otto/context"
@@ -53,6 +55,11 @@ type Terraform struct {
 	// Variables is a list of variables to pass to Terraform.
 	Variables map[string]string
 
+	// SensitiveVars are the names of the Variables that are secret, such
+	// as credentials. Their values are replaced with "***" in all the
+	// output of Terraform before it reaches the Ui.
+	SensitiveVars []string
+
 	// Directory can be set to point to a directory where data can be
 	// stored. If this is set, then the state will be loaded/stored here
 	// automatically.
@@ -67,10 +74,52 @@ type Terraform struct {
 	// case we can't write it to a directory.
 	Directory directory.Backend
 	StateId   string
+
+	// Backend, if set, stores the state remotely instead of in Directory.
+	// StateId is used to choose where the state is stored within the
+	// backend. See Backend for more details.
+	Backend *Backend
+
+	// Targets limits plan and apply to these resources and the resources
+	// they depend on, such as "aws_instance.app", using -target. This
+	// can leave the configuration partially applied.
+	Targets []string
+
+	// HeartbeatInterval is how long an apply or destroy can go without
+	// output before a message shows that it is still running, since
+	// Terraform is quiet while resources are being created. This
+	// defaults to DefaultHeartbeatInterval, and a negative value turns
+	// the heartbeat off.
+	HeartbeatInterval time.Duration
+
+	cancelLock sync.Mutex
+	cancelCh   chan struct{}
 }
 
+// DefaultHeartbeatInterval is the default for Terraform.HeartbeatInterval.
+const DefaultHeartbeatInterval = 30 * time.Second
+
 // Execute executes a raw Terraform command
 func (t *Terraform) Execute(commandRaw ...string) error {
+	path := "terraform"
+	if t.Path != "" {
+		path = t.Path
+	}
+
+	// The configurations need a minimum version of Terraform, so check
+	// it first rather than failing with a confusing error halfway
+	// through. This must happen before any state is touched.
+	if err := hashitools.CheckVersion("terraform", path, tfMinVersion); err != nil {
+		return err
+	}
+
+	// Don't start anything new once cancelled
+	select {
+	case <-t.cancelChan():
+		return execHelper.ErrInterrupted
+	default:
+	}
+
 	command := make([]string, 1, len(commandRaw)*2)
 	command[0] = commandRaw[0]
 	commandArgs := commandRaw[1:]
@@ -103,9 +152,28 @@ func (t *Terraform) Execute(commandRaw ...string) error {
 	stateSkip := false
 	stateSkip = command[0] == "get"
 
-	// Output needs state but not state-out; more hard-coding
+	// If we have a remote backend, configure it. Terraform manages the
+	// state itself in this case so we don't touch it, but the state is
+	// shared, so it is locked while the command can change it.
+	if !stateSkip && t.Backend != nil {
+		if stateLockCommands[command[0]] {
+			unlock, err := t.lockState()
+			if err != nil {
+				return err
+			}
+			defer unlock()
+		}
+
+		if err := t.remoteConfig(); err != nil {
+			return err
+		}
+
+		stateSkip = true
+	}
+
+	// Output and plan need state but not state-out; more hard-coding
 	stateOutSkip := false
-	stateOutSkip = command[0] == "output"
+	stateOutSkip = command[0] == "output" || command[0] == "plan"
 
 	// If we care about state, then setup the state directory and
 	// load it up.
@@ -148,23 +216,25 @@ func (t *Terraform) Execute(commandRaw ...string) error {
 		}
 	}
 
+	// Targeting only applies to the commands that change resources
+	if command[0] == "plan" || command[0] == "apply" {
+		for _, target := range t.Targets {
+			command = append(command, "-target="+target)
+		}
+	}
+
 	// Append all the final args
 	command = append(command, commandArgs...)
 
 	// Build the command to execute
 	log.Printf("[DEBUG] executing terraform: %v", command)
-	path := "terraform"
-	if t.Path != "" {
-		path = t.Path
-	}
-	cmd := exec.Command(path, command...)
-	cmd.Dir = t.Dir
+	cmd := t.command(path, command...)
 
 	// Start the Terraform command. If there is an error we just store
 	// the error but can't exit yet because we have to store partial
 	// state if there is any.
-	err := execHelper.Run(t.Ui, cmd)
-	if err != nil {
+	err := t.run(cmd, command[0])
+	if err != nil && err != execHelper.ErrInterrupted {
 		err = fmt.Errorf("Error running Terraform: %s", err)
 	}
 
@@ -204,12 +274,104 @@ func (t *Terraform) Execute(commandRaw ...string) error {
 	return err
 }
 
-// Outputs reads the outputs from the configured directory storage.
+// Cancel interrupts any running Terraform command and waits for Terraform
+// to gracefully stop. The state is still saved. Execute will return
+// exec.ErrInterrupted (from helper/exec) when it is cancelled.
+//
+// Once cancelled, all future commands on this Terraform will also be
+// cancelled immediately.
+func (t *Terraform) Cancel() {
+	ch := t.cancelChan()
+
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+	select {
+	case <-ch:
+		// Already cancelled
+	default:
+		close(ch)
+	}
+}
+
+func (t *Terraform) cancelChan() chan struct{} {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+	if t.cancelCh == nil {
+		t.cancelCh = make(chan struct{})
+	}
+
+	return t.cancelCh
+}
+
+// Destroy runs `terraform destroy` without asking for confirmation. Any
+// args are appended to the command.
+//
+// If the destroy succeeds, the state stored in Directory is removed so
+// that a later apply starts fresh.
+func (t *Terraform) Destroy(args ...string) error {
+	command := make([]string, 2, len(args)+2)
+	command[0] = "destroy"
+	command[1] = "-force"
+	command = append(command, args...)
+	if err := t.Execute(command...); err != nil {
+		return err
+	}
+
+	if t.Backend == nil && t.StateId != "" && t.Directory != nil {
+		if err := t.Directory.DeleteBlob(t.StateId); err != nil {
+			return fmt.Errorf(
+				"Error removing Terraform state: %s\n\n"+
+					"The resources were destroyed, but the state couldn't be\n"+
+					"removed from the directory.", err)
+		}
+	}
+
+	return nil
+}
+
+// Outputs reads the outputs from the configured directory storage, or
+// from the remote backend if one is configured.
 func (t *Terraform) Outputs() (map[string]string, error) {
+	var result map[string]string
+	err := t.withState(func(path string) error {
+		var err error
+		result, err = Outputs(path)
+		return err
+	})
+
+	return result, err
+}
+
+// Resources returns the number of resources in the state in the
+// configured directory storage, or in the remote backend if one is
+// configured. It is zero if there is no state.
+func (t *Terraform) Resources() (int, error) {
+	var result int
+	err := t.withState(func(path string) error {
+		var err error
+		result, err = Resources(path)
+		return err
+	})
+
+	return result, err
+}
+
+// withState calls f with the path to a local copy of the state. If there
+// is no state, f isn't called.
+func (t *Terraform) withState(f func(string) error) error {
+	if t.Backend != nil {
+		// Configuring the backend pulls the latest state
+		if err := t.remoteConfig(); err != nil {
+			return err
+		}
+
+		return f(remoteStatePath(t.Dir))
+	}
+
 	// Make a temporary file to store our state
 	tf, err := ioutil.TempFile("", "otto-tf")
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if execHelper.ShouldCleanup() {
 		defer os.Remove(tf.Name())
@@ -220,7 +382,8 @@ func (t *Terraform) Outputs() (map[string]string, error) {
 	data, err := t.Directory.GetBlob(t.StateId)
 	if err == nil {
 		if data == nil {
-			return nil, nil
+			tf.Close()
+			return nil
 		}
 
 		_, err = io.Copy(tf, data.Data)
@@ -228,11 +391,74 @@ func (t *Terraform) Outputs() (map[string]string, error) {
 	}
 	tf.Close()
 	if err != nil {
-		return nil, fmt.Errorf("Error loading Terraform state: %s", err)
+		return fmt.Errorf("Error loading Terraform state: %s", err)
+	}
+
+	// Defers will clean up our temp file.
+	return f(tf.Name())
+}
+
+// stateLockCommands are the commands that can change the state, during
+// which a remote state is locked.
+var stateLockCommands = map[string]bool{
+	"apply":   true,
+	"destroy": true,
+	"refresh": true,
+}
+
+// lockState takes the directory lock of the remote state, so that two
+// Otto runs sharing the backend and the directory can't change the
+// state at the same time. The returned function releases the lock. If
+// there is no Directory, nothing is locked.
+func (t *Terraform) lockState() (func(), error) {
+	if t.Directory == nil {
+		return func() {}, nil
+	}
+
+	unlock, err := t.Directory.Lock(t.Backend.lockKey(t.StateId))
+	if err == directory.ErrLocked {
+		return nil, fmt.Errorf(
+			"The remote Terraform state is locked. Someone sharing it is\n" +
+				"changing it right now. Please wait for that to finish and try again.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error locking the Terraform state: %s", err)
+	}
+
+	return unlock, nil
+}
+
+// remoteConfig configures Terraform to use the remote backend, pulling
+// down the latest state.
+func (t *Terraform) remoteConfig() error {
+	args, err := t.Backend.args(t.StateId)
+	if err != nil {
+		return err
+	}
+
+	path := "terraform"
+	if t.Path != "" {
+		path = t.Path
+	}
+	cmd := t.command(path, args...)
+	if err := t.run(cmd, args[0]); err != nil {
+		return fmt.Errorf("Error configuring Terraform remote state: %s", err)
+	}
+
+	return nil
+}
+
+// command returns the command to run Terraform with args in Dir. With a
+// remote backend, its Env is added to the environment, so credentials for
+// the backend never have to be on the command line.
+func (t *Terraform) command(path string, args ...string) *exec.Cmd {
+	cmd := exec.Command(path, args...)
+	cmd.Dir = t.Dir
+	if t.Backend != nil && len(t.Backend.Env) > 0 {
+		cmd.Env = append(os.Environ(), t.Backend.env()...)
 	}
 
-	// Read the outputs as normal. Defers will clean up our temp file.
-	return Outputs(tf.Name())
+	return cmd
 }
 
 func (t *Terraform) varfile() (string, error) {
@@ -249,3 +475,73 @@ func (t *Terraform) varfile() (string, error) {
 
 	return f.Name(), err
 }
+
+// run runs a Terraform command, streaming its output to the Ui. Every
+// Terraform command must be run with this, so that the secrets are
+// redacted from all of the output.
+func (t *Terraform) run(cmd *exec.Cmd, command string) error {
+	runUi := t.Ui
+	var redacted *ui.Redacted
+	if secrets := t.secrets(); len(secrets) > 0 && runUi != nil {
+		redacted = &ui.Redacted{Ui: runUi, Secrets: secrets}
+		runUi = redacted
+	}
+	hb := t.heartbeat(runUi, command)
+	if hb != nil {
+		hb.Start()
+		runUi = hb
+	}
+	err := execHelper.RunCancel(runUi, cmd, t.cancelChan())
+	if hb != nil {
+		hb.Stop()
+	}
+	if redacted != nil {
+		redacted.Flush()
+	}
+
+	return err
+}
+
+// secrets returns the values of the SensitiveVars and the environment of
+// the remote backend, which holds its credentials.
+func (t *Terraform) secrets() []string {
+	var result []string
+	for _, k := range t.SensitiveVars {
+		if v := t.Variables[k]; v != "" {
+			result = append(result, v)
+		}
+	}
+	if t.Backend != nil {
+		for _, v := range t.Backend.Env {
+			if v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result
+}
+
+// heartbeat returns a Ui wrapping u that outputs heartbeats while the
+// command is quiet, or nil if the command doesn't need them.
+func (t *Terraform) heartbeat(u ui.Ui, command string) *ui.Heartbeat {
+	var text string
+	switch command {
+	case "apply":
+		text = "Still applying..."
+	case "destroy":
+		text = "Still destroying..."
+	default:
+		return nil
+	}
+
+	interval := t.HeartbeatInterval
+	if interval == 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	if interval < 0 || u == nil {
+		return nil
+	}
+
+	return &ui.Heartbeat{Ui: u, Interval: interval, Text: text}
+}
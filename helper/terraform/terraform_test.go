@@ -0,0 +1,32 @@
+package terraform
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTerraformSecrets(t *testing.T) {
+	tf := &Terraform{
+		Variables: map[string]string{
+			"aws_access_key": "access",
+			"aws_secret_key": "secret",
+			"aws_token":      "",
+		},
+		SensitiveVars: []string{"aws_secret_key", "aws_token"},
+		Backend: &Backend{
+			Type: "s3",
+			Env: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "backend",
+				"AWS_SECRET_ACCESS_KEY": "backend-secret",
+			},
+		},
+	}
+
+	actual := tf.secrets()
+	sort.Strings(actual)
+	expected := []string{"backend", "backend-secret", "secret"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
@@ -0,0 +1,124 @@
+package vagrant
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/otto/helper/lockfile"
+	"github.com/hashicorp/otto/ui"
+)
+
+// boxLockFile is the name of the lock file within the global cache
+// directory that is held while a box is added.
+const boxLockFile = "vagrant-box.lock"
+
+// boxRegexp matches the box that a Vagrantfile uses.
+var boxRegexp = regexp.MustCompile(
+	`(?m)^\s*config\.vm\.box\s*=\s*["']([^"']+)["']`)
+
+// boxURLRegexp matches the URL the box of a Vagrantfile is added from,
+// such as a box file for offline builds.
+var boxURLRegexp = regexp.MustCompile(
+	`(?m)^\s*config\.vm\.box_url\s*=\s*["']([^"']+)["']`)
+
+// addBox adds the box used by the Vagrantfile in the directory if it
+// isn't already installed.
+//
+// Vagrant stores boxes in its home directory, which is shared by every
+// project, so a box only needs to be downloaded once. But Vagrant doesn't
+// protect against two processes downloading the same box at the same
+// time, which can corrupt it. addBox holds a lock in lockDir while it
+// checks for and adds the box so concurrent Otto runs and parallel
+// builds wait for each other. If lockDir is empty, nothing is done and
+// `vagrant up` will add the box as usual.
+func (v *Vagrant) addBox(lockDir, provider string) error {
+	if lockDir == "" {
+		return nil
+	}
+
+	raw, err := ioutil.ReadFile(filepath.Join(v.Dir, "Vagrantfile"))
+	if err != nil {
+		// No Vagrantfile, let Vagrant report the error
+		return nil
+	}
+	match := boxRegexp.FindSubmatch(raw)
+	if match == nil {
+		return nil
+	}
+	box := string(match[1])
+
+	// Vagrant asks which provider to add if a box has many, so we must
+	// always tell it.
+	if provider == "" {
+		provider = os.Getenv("VAGRANT_DEFAULT_PROVIDER")
+	}
+	if provider == "" {
+		provider = "virtualbox"
+	}
+
+	unlock, err := lockfile.Lock(
+		filepath.Join(lockDir, boxLockFile), func() {
+			if v.Ui != nil {
+				v.Ui.Message(
+					"Waiting for another build to finish adding boxes...")
+			}
+		})
+	if err != nil {
+		return fmt.Errorf("Error locking the Vagrant box cache: %s", err)
+	}
+	defer unlock()
+
+	// Check if the box is installed. We copy the Vagrant instance so the
+	// output goes to a buffer rather than the user.
+	var mockUi ui.Mock
+	list := *v
+	list.Ui = &mockUi
+	if err := list.Execute("box", "list"); err != nil {
+		return err
+	}
+	if boxInstalled(strings.Join(mockUi.RawBuf, ""), box, provider) {
+		return nil
+	}
+
+	// If adding the box fails, we don't error. `vagrant up` will try to
+	// add it again and report any real problem.
+	if err := v.Execute(boxAddArgs(raw, box, provider)...); err != nil {
+		log.Printf("[WARN] error adding box '%s': %s", box, err)
+	}
+
+	return nil
+}
+
+// boxAddArgs returns the arguments to add the box of the Vagrantfile
+// contents raw. A box with a URL is added from it rather than from Atlas.
+func boxAddArgs(raw []byte, box, provider string) []string {
+	if match := boxURLRegexp.FindSubmatch(raw); match != nil {
+		return []string{
+			"box", "add", "--name", box, "--provider", provider, string(match[1])}
+	}
+
+	return []string{"box", "add", box, "--provider", provider}
+}
+
+// boxInstalled checks the output of `vagrant box list` to see if the
+// box is installed for the provider.
+func boxInstalled(output, box, provider string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		// Lines look like: hashicorp/precise64 (virtualbox, 1.1.0)
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != box {
+			continue
+		}
+
+		if strings.Trim(fields[1], "(),") == provider {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package vagrant
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoxInstalled(t *testing.T) {
+	output := "hashicorp/precise64 (virtualbox, 1.1.0)\n" +
+		"hashicorp/precise64 (vmware_desktop, 1.1.0)\n" +
+		"ubuntu/trusty64     (virtualbox, 20151020.0.0)\n"
+
+	cases := []struct {
+		Box      string
+		Provider string
+		Result   bool
+	}{
+		{"hashicorp/precise64", "virtualbox", true},
+		{"hashicorp/precise64", "vmware_desktop", true},
+		{"hashicorp/precise64", "parallels", false},
+		{"ubuntu/trusty64", "virtualbox", true},
+		{"hashicorp/precise32", "virtualbox", false},
+	}
+
+	for _, tc := range cases {
+		if result := boxInstalled(output, tc.Box, tc.Provider); result != tc.Result {
+			t.Fatalf("%s %s: bad: %v", tc.Box, tc.Provider, result)
+		}
+	}
+}
+
+func TestBoxAddArgs(t *testing.T) {
+	cases := []struct {
+		Vagrantfile string
+		Result      []string
+	}{
+		{
+			`config.vm.box = "hashicorp/precise64"`,
+			[]string{"box", "add", "hashicorp/precise64", "--provider", "virtualbox"},
+		},
+		{
+			"config.vm.box = \"hashicorp/precise64\"\n" +
+				"  config.vm.box_url = \"file:///offline/dev.box\"",
+			[]string{
+				"box", "add", "--name", "hashicorp/precise64",
+				"--provider", "virtualbox", "file:///offline/dev.box",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := boxAddArgs([]byte(tc.Vagrantfile), "hashicorp/precise64", "virtualbox")
+		if !reflect.DeepEqual(actual, tc.Result) {
+			t.Fatalf("%q: %#v", tc.Vagrantfile, actual)
+		}
+	}
+}
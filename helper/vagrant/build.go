@@ -3,28 +3,80 @@ package vagrant
 import (
 	"fmt"
 	"log"
+	"path"
+	"regexp"
 
 	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/ui"
 )
 
+// DefaultBuildScript is the script that is run to build within the VM
+// if BuildOptions doesn't set one. The built-in app types generate it.
+const DefaultBuildScript = "/otto/build.sh"
+
 type BuildOptions struct {
 	// Dir is the directory where Vagrant will be executed for the
 	// build. This should be the directory with the Vagrantfile, usually.
 	Dir string
 
 	// Script is the script to execute within the VM. This script must
-	// not ask for input.
+	// not ask for input. It must be an absolute path within the VM and
+	// defaults to DefaultBuildScript.
 	Script string
+
+	// Provider is the Vagrant provider to build with. If this is empty,
+	// then Vagrant's default provider is used.
+	Provider string
+
+	// SSHRetries is the number of times `vagrant provision` is run again
+	// if the SSH connection to the VM is lost while it is provisioned,
+	// such as when the VM reboots after a kernel update. Zero disables
+	// retries.
+	SSHRetries int
+}
+
+// sshDisconnectPatterns match the output of Vagrant when the SSH
+// connection to the VM was lost.
+var sshDisconnectPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`SSH connection was unexpectedly closed`),
+	regexp.MustCompile(`(?i)connection reset by peer`),
+	regexp.MustCompile(`ECONNRESET`),
+	regexp.MustCompile(`Net::SSH::Disconnect`),
+}
+
+// sshDisconnected returns true if the line of Vagrant output shows that
+// the SSH connection to the VM was lost.
+func sshDisconnected(line string) bool {
+	for _, re := range sshDisconnectPatterns {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Build can be used to use Vagrant to build something. This will handle
 // starting Vagrant, running the script, collecting files into a list,
 // and destroying the Vagrant environment.
+//
+// Build is safe to call from multiple goroutines as long as each call
+// uses a distinct Dir. The output goes to the Ui of the context, so give
+// each call its own Ui, such as a ui.Prefixed, to keep the output of
+// parallel builds apart.
 func Build(ctx *app.Context, opts *BuildOptions) error {
 	log.Printf(
 		"[INFO] Vagrant build for '%s' in dir: %s",
 		ctx.Appfile.Application.Name, opts.Dir)
 
+	script := opts.Script
+	if script == "" {
+		script = DefaultBuildScript
+	}
+	if err := ValidateBuildScript(script); err != nil {
+		return err
+	}
+
 	vagrant := &Vagrant{Dir: opts.Dir, Ui: ctx.Ui}
 
 	// tryDestroy is a helper function that we make a local here
@@ -32,8 +84,8 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 	tryDestroy := func() error {
 		err := vagrant.Execute("destroy", "-f")
 		if err != nil {
-			ctx.Ui.Header(fmt.Sprintf(
-				"[red]Error destroying the Vagrant environment! There may be\n"+
+			ui.Error(ctx.Ui, fmt.Sprintf(
+				"Error destroying the Vagrant environment! There may be\n"+
 					"lingering resources. The working directory where Vagrant\n"+
 					"can be run to check is below. Please manually clean up\n"+
 					"the resources:\n\n%s\n\n%s",
@@ -43,24 +95,49 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 		return err
 	}
 
-	// Bring the environment up. If there is an error, we need to
-	// destroy the VM because `vagrant up` can error even after the
-	// VM is built.
-	if err := vagrant.Execute("up"); err != nil {
-		ctx.Ui.Header(fmt.Sprintf(
-			"[red]Error while bringing up the Vagrant environment.\n" +
-				"The error message will be shown below. First, Otto\n" +
-				"will attempt to destroy the machine."))
+	// Add the box first so that concurrent builds don't download it twice
+	if err := vagrant.addBox(ctx.GlobalCacheDir, opts.Provider); err != nil {
+		return err
+	}
+
+	// Bring the environment up. If the SSH connection is lost while the
+	// VM is provisioned, it likely rebooted, so provision it again once
+	// it is back.
+	var disconnected bool
+	watch := func(line string) {
+		if sshDisconnected(line) {
+			disconnected = true
+		}
+	}
+	err := vagrant.executeLines(watch, upArgs(opts.Provider)...)
+	for attempt := 1; err != nil && disconnected && attempt <= opts.SSHRetries; attempt++ {
+		log.Printf("[WARN] retrying Vagrant provisioning after SSH disconnect: %s", err)
+		ui.Warn(ctx.Ui, fmt.Sprintf(
+			"The SSH connection to the Vagrant environment was lost while\n"+
+				"provisioning, likely because it rebooted. Provisioning again\n"+
+				"(retry %d of %d)...", attempt, opts.SSHRetries))
+
+		disconnected = false
+		err = vagrant.executeLines(watch, "provision")
+	}
+
+	// If there is an error, we need to destroy the VM because
+	// `vagrant up` can error even after the VM is built.
+	if err != nil {
+		ui.Error(ctx.Ui,
+			"Error while bringing up the Vagrant environment.\n"+
+				"The error message will be shown below. First, Otto\n"+
+				"will attempt to destroy the machine.")
 		tryDestroy()
 		return err
 	}
 
 	// The environment is running. Execute the build script.
-	if err := vagrant.Execute("ssh", "-c", opts.Script); err != nil {
-		ctx.Ui.Header(fmt.Sprintf(
-			"[red]Error while building in the Vagrant environment!\n" +
-				"The error message will be shown below. First, Otto will\n" +
-				"attempt to destroy the machine."))
+	if err := vagrant.Execute("ssh", "-c", script); err != nil {
+		ui.Error(ctx.Ui,
+			"Error while building in the Vagrant environment!\n"+
+				"The error message will be shown below. First, Otto will\n"+
+				"attempt to destroy the machine.")
 		tryDestroy()
 		return err
 	}
@@ -70,3 +147,17 @@ func Build(ctx *app.Context, opts *BuildOptions) error {
 	tryDestroy()
 	return nil
 }
+
+// ValidateBuildScript checks that a build script can be run within the
+// VM, which means it must be an absolute path there. The VM always runs
+// Linux, so the path is checked with forward slashes on every host.
+func ValidateBuildScript(script string) error {
+	if !path.IsAbs(script) {
+		return fmt.Errorf(
+			"The build script %q must be an absolute path within the\n"+
+				"development environment, such as %q.",
+			script, DefaultBuildScript)
+	}
+
+	return nil
+}
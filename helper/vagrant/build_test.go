@@ -0,0 +1,44 @@
+package vagrant
+
+import (
+	"testing"
+)
+
+func TestSSHDisconnected(t *testing.T) {
+	cases := []struct {
+		Line   string
+		Result bool
+	}{
+		{"==> default: Setting up linux-image-generic ...\n", false},
+		{"The SSH connection was unexpectedly closed by the remote end.\n", true},
+		{"Connection reset by peer - recvfrom(2)\n", true},
+		{"Errno::ECONNRESET\n", true},
+		{"Net::SSH::Disconnect: connection closed by remote host\n", true},
+	}
+
+	for _, tc := range cases {
+		if result := sshDisconnected(tc.Line); result != tc.Result {
+			t.Fatalf("%q: %v", tc.Line, result)
+		}
+	}
+}
+
+func TestValidateBuildScript(t *testing.T) {
+	cases := []struct {
+		Script string
+		Err    bool
+	}{
+		{DefaultBuildScript, false},
+		{"/vagrant/scripts/build.sh", false},
+		{"build.sh", true},
+		{"./scripts/build.sh", true},
+		{"", true},
+	}
+
+	for _, tc := range cases {
+		err := ValidateBuildScript(tc.Script)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%q: %s", tc.Script, err)
+		}
+	}
+}
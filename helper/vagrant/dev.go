@@ -2,12 +2,14 @@ package vagrant
 
 import (
 	"fmt"
+	"log"
 	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/otto/app"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/helper/router"
+	"github.com/hashicorp/otto/ui"
 )
 
 // DevOptions is the configuration struct used for Dev.
@@ -23,13 +25,42 @@ type DevOptions struct {
 	// Instructions are help text that is shown after creating the
 	// development environment.
 	Instructions string
+
+	// Provider is the Vagrant provider to create the development
+	// environment with, such as "vmware_fusion". If this is empty, then
+	// Vagrant's default provider is used, which is VirtualBox unless
+	// configured otherwise.
+	Provider string
+
+	// SyncType is the type of synced folder used by the Vagrantfile,
+	// such as "rsync". This must match the Vagrantfile. If it is "rsync",
+	// then `vagrant rsync-auto` is run in the background while SSHed
+	// into the development environment so that changes are synced.
+	SyncType string
+
+	// ForwardedPorts are the ports of the development environment that
+	// are forwarded to the host. The Vagrantfile must forward the same
+	// ports; see PortMapping.
+	ForwardedPorts []PortMapping
+
+	// WatchScript, if set, is the path within the development environment
+	// to a script that rebuilds and restarts the application whenever its
+	// source changes. It enables 'otto dev watch', which runs the script
+	// until it is interrupted. The Vagrantfile must upload the script with
+	// a provisioner named DevWatchProvisioner, which is run again before
+	// watching so that the script is up to date.
+	WatchScript string
 }
 
+// DevWatchProvisioner is the name of the Vagrant provisioner that uploads
+// DevOptions.WatchScript into the development environment.
+const DevWatchProvisioner = "otto-watch"
+
 // Dev can be used as an implementation of app.App.Dev to automatically
 // handle creating a development environment and forwarding commands down
 // to Vagrant.
 func Dev(opts *DevOptions) *router.Router {
-	return &router.Router{
+	r := &router.Router{
 		Actions: map[string]router.Action{
 			"": &router.SimpleAction{
 				ExecuteFunc:  opts.actionUp,
@@ -49,12 +80,30 @@ func Dev(opts *DevOptions) *router.Router {
 				HelpText:     strings.TrimSpace(actionDestroyHelp),
 			},
 
+			"halt": &router.SimpleAction{
+				ExecuteFunc:  opts.actionHalt,
+				SynopsisText: actionHaltSyn,
+				HelpText:     strings.TrimSpace(actionHaltHelp),
+			},
+
 			"ssh": &router.SimpleAction{
 				ExecuteFunc:  opts.actionSSH,
 				SynopsisText: actionSSHSyn,
 				HelpText:     strings.TrimSpace(actionSSHHelp),
 			},
 
+			"ssh-config": &router.SimpleAction{
+				ExecuteFunc:  opts.actionSSHConfig,
+				SynopsisText: actionSSHConfigSyn,
+				HelpText:     strings.TrimSpace(actionSSHConfigHelp),
+			},
+
+			"suspend": &router.SimpleAction{
+				ExecuteFunc:  opts.actionSuspend,
+				SynopsisText: actionSuspendSyn,
+				HelpText:     strings.TrimSpace(actionSuspendHelp),
+			},
+
 			"vagrant": &router.SimpleAction{
 				ExecuteFunc:  opts.actionRaw,
 				SynopsisText: actionVagrantSyn,
@@ -62,6 +111,16 @@ func Dev(opts *DevOptions) *router.Router {
 			},
 		},
 	}
+
+	if opts.WatchScript != "" {
+		r.Actions["watch"] = &router.SimpleAction{
+			ExecuteFunc:  opts.actionWatch,
+			SynopsisText: actionWatchSyn,
+			HelpText:     strings.TrimSpace(actionWatchHelp),
+		}
+	}
+
+	return r
 }
 
 func (opts *DevOptions) actionAddress(rctx router.Context) error {
@@ -71,7 +130,31 @@ func (opts *DevOptions) actionAddress(rctx router.Context) error {
 }
 
 func (opts *DevOptions) actionDestroy(rctx router.Context) error {
-	ctx := rctx.(*app.Context)
+	return DevDestroy(rctx.(*app.Context), opts)
+}
+
+// DevDestroy destroys the development environment with
+// `vagrant destroy -f` in the dev directory and removes its metadata,
+// freeing the disk and memory it uses. If there is no development
+// environment, the user is told so and nothing is done.
+//
+// This function can be used to implement app.AppDevDestroy.DevDestroy.
+func DevDestroy(ctx *app.Context, opts *DevOptions) error {
+	lookup := directory.Lookup{AppID: ctx.Appfile.ID}
+	dev, err := ctx.Directory.GetDev(&directory.Dev{Lookup: lookup})
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading development environment metadata: %s", err)
+	}
+
+	// The metadata is stored before Vagrant runs, but check with Vagrant
+	// too in case the metadata was lost.
+	vagrant := opts.vagrant(ctx)
+	if !dev.IsReady() && !vagrant.hasMachine() {
+		ctx.Ui.Header("No development environment exists. Nothing to destroy.")
+		return nil
+	}
+
 	project := Project(&ctx.Shared)
 	if err := project.InstallIfNeeded(); err != nil {
 		return err
@@ -79,17 +162,13 @@ func (opts *DevOptions) actionDestroy(rctx router.Context) error {
 
 	ctx.Ui.Header("Destroying the local development environment...")
 
-	if err := opts.vagrant(ctx).Execute("destroy", "-f"); err != nil {
+	if err := vagrant.Execute("destroy", "-f"); err != nil {
 		return err
 	}
 	ctx.Ui.Raw("\n")
 
-	// Store the dev status into the directory. We just do this before
-	// since there are a lot of cases where Vagrant fails but still imported.
-	// We just override any prior dev.
 	ctx.Ui.Header("Deleting development environment metadata...")
-	dev := &directory.Dev{Lookup: directory.Lookup{AppID: ctx.Appfile.ID}}
-	if err := ctx.Directory.DeleteDev(dev); err != nil {
+	if err := ctx.Directory.DeleteDev(&directory.Dev{Lookup: lookup}); err != nil {
 		return fmt.Errorf(
 			"Error deleting dev environment metadata: %s", err)
 	}
@@ -99,10 +178,78 @@ func (opts *DevOptions) actionDestroy(rctx router.Context) error {
 			"Error cleaning SSH cache: %s", err)
 	}
 
+	// A new environment can use different ports for those selected
+	// automatically.
+	if err := writePorts(opts.portsPath(ctx), nil); err != nil {
+		return fmt.Errorf(
+			"Error cleaning forwarded ports: %s", err)
+	}
+
 	ctx.Ui.Header("[green]Development environment has been destroyed!")
 	return nil
 }
 
+// Halt gracefully shuts down the development environment. It can be
+// started again with `otto dev`. An error is returned if the development
+// environment hasn't been created.
+func Halt(ctx *app.Context, opts *DevOptions) error {
+	return opts.execExisting(ctx,
+		"Halting the development environment...", "halt")
+}
+
+// Suspend saves the state of the development environment and stops it.
+// It can be resumed with `otto dev`. An error is returned if the
+// development environment hasn't been created.
+func Suspend(ctx *app.Context, opts *DevOptions) error {
+	return opts.execExisting(ctx,
+		"Suspending the development environment...", "suspend")
+}
+
+func (opts *DevOptions) actionHalt(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	if err := Halt(ctx, opts); err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("[green]Development environment halted!")
+	ctx.Ui.Message("Run 'otto dev' to start it again.")
+	return nil
+}
+
+func (opts *DevOptions) actionSuspend(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	if err := Suspend(ctx, opts); err != nil {
+		return err
+	}
+
+	ctx.Ui.Header("[green]Development environment suspended!")
+	ctx.Ui.Message("Run 'otto dev' to resume it.")
+	return nil
+}
+
+// execExisting runs a Vagrant command against the development
+// environment, first verifying that it has been created.
+func (opts *DevOptions) execExisting(
+	ctx *app.Context, header string, command ...string) error {
+	project := Project(&ctx.Shared)
+	if err := project.InstallIfNeeded(); err != nil {
+		return err
+	}
+
+	dev, err := ctx.Directory.GetDev(&directory.Dev{
+		Lookup: directory.Lookup{AppID: ctx.Appfile.ID}})
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading development environment metadata: %s", err)
+	}
+	if !dev.IsReady() {
+		return fmt.Errorf(strings.TrimSpace(errDevNotCreated))
+	}
+
+	ctx.Ui.Header(header)
+	return opts.vagrant(ctx).Execute(command...)
+}
+
 func (opts *DevOptions) actionRaw(rctx router.Context) error {
 	ctx := rctx.(*app.Context)
 	project := Project(&ctx.Shared)
@@ -113,7 +260,7 @@ func (opts *DevOptions) actionRaw(rctx router.Context) error {
 	ctx.Ui.Header(fmt.Sprintf(
 		"Executing: 'vagrant %s'", strings.Join(ctx.ActionArgs, " ")))
 
-	if err := opts.vagrant(ctx).Execute(ctx.ActionArgs...); err != nil {
+	if err := opts.vagrant(ctx).ExecuteInteractive(ctx.ActionArgs...); err != nil {
 		return err
 	}
 
@@ -127,10 +274,98 @@ func (opts *DevOptions) actionSSH(rctx router.Context) error {
 		return err
 	}
 
+	// If we're syncing with rsync, keep the files in sync while the
+	// SSH session is open. The sync is stopped when the session ends.
+	if opts.SyncType == "rsync" {
+		ctx.Ui.Header("Starting rsync to sync file changes...")
+		stop, err := opts.vagrant(ctx).Background("rsync-auto")
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+
 	ctx.Ui.Header("Executing SSH. This may take a few seconds...")
 	return opts.sshCache(ctx).Exec(true)
 }
 
+// SSHConfig returns the OpenSSH configuration for connecting to the
+// development environment, as `vagrant ssh-config` outputs it. The host
+// is named "otto-APP" so the configuration can be added to ~/.ssh/config
+// for editors and other tools that connect over SSH.
+//
+// An error is returned if the development environment hasn't been
+// created or isn't running.
+func SSHConfig(ctx *app.Context, opts *DevOptions) (string, error) {
+	dev, err := ctx.Directory.GetDev(&directory.Dev{
+		Lookup: directory.Lookup{AppID: ctx.Appfile.ID}})
+	if err != nil {
+		return "", fmt.Errorf(
+			"Error loading development environment metadata: %s", err)
+	}
+	if !dev.IsReady() {
+		return "", fmt.Errorf(strings.TrimSpace(errDevNotCreated))
+	}
+
+	// The output goes to a buffer rather than the user, like the
+	// SSH cache does.
+	var mockUi ui.Mock
+	vagrant := opts.vagrant(ctx)
+	vagrant.Ui = &mockUi
+	err = vagrant.Execute(
+		"ssh-config", "--host", "otto-"+ctx.Appfile.Application.Name)
+	if err != nil {
+		return "", fmt.Errorf(
+			"Error reading the SSH configuration of the development environment.\n"+
+				"This usually means it isn't running. Please run 'otto dev' to\n"+
+				"start it and try again.\n\n%s", err)
+	}
+
+	return strings.Join(mockUi.RawBuf, ""), nil
+}
+
+func (opts *DevOptions) actionWatch(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	err := opts.execExisting(ctx, "Uploading the watch script...",
+		"provision", "--provision-with", DevWatchProvisioner)
+	if err != nil {
+		return err
+	}
+
+	// As with SSH, changes must be synced for the script to see them
+	if opts.SyncType == "rsync" {
+		ctx.Ui.Header("Starting rsync to sync file changes...")
+		stop, err := opts.vagrant(ctx).Background("rsync-auto")
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+
+	// A TTY is allocated so that an interrupt stops the script, and the
+	// application it runs, within the development environment too.
+	ctx.Ui.Header("Watching for changes. Press Ctrl-C to stop...")
+	return opts.vagrant(ctx).ExecuteInteractive(
+		"ssh", "-c", "bash "+opts.WatchScript, "--", "-t")
+}
+
+func (opts *DevOptions) actionSSHConfig(rctx router.Context) error {
+	ctx := rctx.(*app.Context)
+	project := Project(&ctx.Shared)
+	if err := project.InstallIfNeeded(); err != nil {
+		return err
+	}
+
+	// Only the configuration is output so it can be redirected to a file
+	config, err := SSHConfig(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	ctx.Ui.Raw(config)
+	return nil
+}
+
 func (opts *DevOptions) actionUp(rctx router.Context) error {
 	ctx := rctx.(*app.Context)
 	project := Project(&ctx.Shared)
@@ -156,8 +391,21 @@ func (opts *DevOptions) actionUp(rctx router.Context) error {
 			"Error saving dev environment metadata: %s", err)
 	}
 
+	// Pick the host ports before Vagrant runs, since the Vagrantfile
+	// reads the ports that were selected automatically.
+	ports, err := selectPorts(ctx.Ui, opts.portsPath(ctx), opts.ForwardedPorts)
+	if err != nil {
+		return err
+	}
+
+	// Add the box first so that concurrent runs don't download it twice
+	vagrant := opts.vagrant(ctx)
+	if err := vagrant.addBox(ctx.GlobalCacheDir, opts.Provider); err != nil {
+		return err
+	}
+
 	// Run it!
-	if err := opts.vagrant(ctx).Execute("up"); err != nil {
+	if err := vagrant.Execute(upArgs(opts.Provider)...); err != nil {
 		return err
 	}
 
@@ -170,9 +418,16 @@ func (opts *DevOptions) actionUp(rctx router.Context) error {
 	// Success, let the user know whats up
 	ctx.Ui.Header("[green]Development environment successfully created!")
 	ctx.Ui.Message(fmt.Sprintf("IP address: %s", ctx.DevIPAddress))
+	for _, m := range opts.ForwardedPorts {
+		ctx.Ui.Message(fmt.Sprintf(
+			"Port %d is forwarded to localhost:%d", m.Guest, ports[m.Guest]))
+	}
 	if opts.Instructions != "" {
 		ctx.Ui.Message("\n" + opts.Instructions)
 	}
+	if opts.SyncType == "rsync" {
+		ctx.Ui.Message("\n" + strings.TrimSpace(rsyncInstructions))
+	}
 
 	return nil
 }
@@ -186,13 +441,27 @@ func (opts *DevOptions) vagrant(ctx *app.Context) *Vagrant {
 	if dataDir == "" {
 		dataDir = filepath.Join(ctx.LocalDir, "vagrant")
 	}
+	// Every command gets the selected ports, since Vagrant reads the
+	// Vagrantfile for all of them.
+	env, err := portsEnv(opts.portsPath(ctx))
+	if err != nil {
+		log.Printf("[WARN] error reading forwarded ports: %s", err)
+	}
+
 	return &Vagrant{
 		Dir:     dir,
 		DataDir: dataDir,
 		Ui:      ctx.Ui,
+		Env:     env,
 	}
 }
 
+// portsPath returns the path where the host ports that were selected
+// automatically are stored.
+func (opts *DevOptions) portsPath(ctx *app.Context) string {
+	return filepath.Join(ctx.LocalDir, devPortsFile)
+}
+
 func (opts *DevOptions) sshCache(ctx *app.Context) *SSHCache {
 	return &SSHCache{
 		Path:    filepath.Join(ctx.CacheDir, "dev_ssh_cache"),
@@ -200,13 +469,35 @@ func (opts *DevOptions) sshCache(ctx *app.Context) *SSHCache {
 	}
 }
 
+// rsyncInstructions are shown after the instructions when files are
+// synced with rsync.
+const rsyncInstructions = `
+Files are synced into the development environment with rsync. The sync is
+one-way: changes made on this machine are copied into the development
+environment while 'otto dev ssh' is running, but changes made within the
+development environment are never copied back. To sync once without
+SSHing in, run 'otto dev vagrant rsync'.
+`
+
+// errDevNotCreated is the error when an action requires a development
+// environment that hasn't been created.
+const errDevNotCreated = `
+A development environment hasn't been created yet. Please run
+'otto dev' to create one.
+`
+
 // Synopsis text for actions
 const (
 	actionAddressSyn = "Shows the address to reach the development environment"
 	actionUpSyn      = "Starts the development environment"
 	actionDestroySyn = "Destroy the development environment"
+	actionHaltSyn    = "Shut down the development environment"
 	actionSSHSyn     = "SSH into the development environment"
+	actionSuspendSyn = "Suspend the development environment"
 	actionVagrantSyn = "Run arbitrary Vagrant commands"
+	actionWatchSyn   = "Rebuild and restart the app when its source changes"
+
+	actionSSHConfigSyn = "Output the SSH configuration of the development environment"
 )
 
 // Help text for actions
@@ -235,6 +526,28 @@ Usage: otto dev destroy
 
 `
 
+const actionHaltHelp = `
+Usage: otto dev halt
+
+  Shuts down the development environment.
+
+  The development environment is gracefully shut down but not deleted.
+  Run 'otto dev' to start it again. This is useful for freeing up the
+  resources it uses when you aren't working on the project.
+
+`
+
+const actionSuspendHelp = `
+Usage: otto dev suspend
+
+  Suspends the development environment.
+
+  The state of the development environment is saved to disk and it is
+  stopped. Run 'otto dev' to resume it exactly where it left off. This
+  is faster to resume than halting, but uses more disk space.
+
+`
+
 const actionSSHHelp = `
 Usage: otto dev ssh
 
@@ -246,6 +559,22 @@ Usage: otto dev ssh
 
 `
 
+const actionSSHConfigHelp = `
+Usage: otto dev ssh-config
+
+  Output the SSH configuration of the development environment.
+
+  The configuration is in the format of ~/.ssh/config with the host
+  named "otto-APP", where APP is the name of the application. Add it to
+  your SSH configuration to connect to the development environment with
+  editors and other tools that use SSH, for example:
+
+    otto dev ssh-config >> ~/.ssh/config
+
+  The development environment must be running.
+
+`
+
 const actionAddressHelp = `
 Usage: otto dev address
 
@@ -272,3 +601,18 @@ Usage: otto dev vagrant [command...]
   "otto dev vagrant ssh-config"
 
 `
+
+const actionWatchHelp = `
+Usage: otto dev watch
+
+  Rebuild and restart the application whenever its source changes.
+
+  The application is built and run within the development environment,
+  and rebuilt and restarted every time a source file changes. Edits made
+  in quick succession, such as saving many files at once, only cause a
+  single rebuild. This runs until it is interrupted with Ctrl-C, which
+  stops the application as well.
+
+  The development environment must be running.
+
+`
@@ -0,0 +1,51 @@
+package vagrant
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/otto/app"
+	"github.com/hashicorp/otto/appfile"
+	"github.com/hashicorp/otto/context"
+	"github.com/hashicorp/otto/directory"
+	"github.com/hashicorp/otto/ui"
+)
+
+func TestDev_watch(t *testing.T) {
+	r := Dev(&DevOptions{})
+	if _, ok := r.Actions["watch"]; ok {
+		t.Fatal("should not have watch action")
+	}
+
+	r = Dev(&DevOptions{WatchScript: "/home/vagrant/watch.sh"})
+	if _, ok := r.Actions["watch"]; !ok {
+		t.Fatal("should have watch action")
+	}
+}
+
+func TestDevDestroy_notCreated(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	mock := new(ui.Mock)
+	ctx := &app.Context{
+		Dir:      td,
+		LocalDir: td,
+		Shared: context.Shared{
+			Ui:        mock,
+			Directory: &directory.BoltBackend{Dir: td},
+			Appfile:   &appfile.File{ID: "foo"},
+		},
+	}
+
+	if err := DevDestroy(ctx, &DevOptions{}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(mock.HeaderBuf) != 1 {
+		t.Fatalf("bad: %#v", mock.HeaderBuf)
+	}
+}
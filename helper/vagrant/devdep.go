@@ -1,7 +1,14 @@
 package vagrant
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
 
 	"github.com/hashicorp/otto/app"
 )
@@ -10,22 +17,75 @@ type DevDepOptions struct {
 	// Dir is the directory where Vagrant will be executed.
 	Dir string
 
-	// Script is the script to run to build the dev dependency.
+	// Script is the script to run to build the dev dependency. See
+	// BuildOptions.Script.
 	Script string
 
-	// Files are the resulting files relative to the cache directory
-	// that are part of the dep. If these don't exist, an error will be
-	// generated.
+	// Files are the resulting files relative to the app cache directory
+	// (app.Context.AppCacheDir) that are part of the dep. If these don't
+	// exist, an error will be generated.
 	Files []string
+
+	// Provider is the Vagrant provider to build with. If this is empty,
+	// then Vagrant's default provider is used.
+	Provider string
+
+	// SSHRetries is the number of times provisioning is retried if the
+	// SSH connection is lost. See BuildOptions.SSHRetries.
+	SSHRetries int
+
+	// LockFiles are the files or directories, relative to the source
+	// directory of the application, that pin the versions of its
+	// dependencies, such as a lockfile or a vendored tree. A hash of the
+	// ones that exist is the Version of the resulting app.DevDep and part
+	// of the cache key, so changing them rebuilds the dependency.
+	LockFiles []string
 }
 
+// devDepHashFile is the file in the app cache directory where the hash of
+// the sources used for the last build is stored.
+const devDepHashFile = "dev-dep.hash"
+
 // DevDep builds a dev dependency using Vagrant.
 //
 // This function uses Build to build the dev dependency. Please see
 // the documentation of that function for more details on how that works.
 //
+// The build is cached: a hash of the application source and of Dir
+// (which contains the build script) is stored in the app cache directory,
+// which is namespaced by the application type. If neither has changed
+// since the last build and all the Files still exist, the build is
+// skipped. The hash of the LockFiles is part of the cache key as well.
+//
 // This function implements app.App.DevDep.
 func DevDep(dst *app.Context, src *app.Context, opts *DevDepOptions) (*app.DevDep, error) {
+	hash, err := devDepHash(src.Appfile.SourceDir(), opts.Dir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error hashing the dev dependency sources: %s", err)
+	}
+
+	version, err := devDepVersion(src.Appfile.SourceDir(), opts.LockFiles)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error hashing the dev dependency lock: %s", err)
+	}
+	if version != "" {
+		hash += "-" + version
+	}
+
+	cacheDir := src.AppCacheDir()
+	hashPath := filepath.Join(cacheDir, devDepHashFile)
+	if devDepCached(cacheDir, hashPath, hash, opts.Files) {
+		src.Ui.Header(fmt.Sprintf(
+			"Using cached dev dependency for '%s'",
+			src.Appfile.Application.Name))
+		return &app.DevDep{
+			Files:   devDepFiles(cacheDir, opts.Files),
+			Version: version,
+		}, nil
+	}
+
 	src.Ui.Header(fmt.Sprintf(
 		"Building the dev dependency: '%s'", src.Appfile.Application.Name))
 	src.Ui.Message(
@@ -35,16 +95,148 @@ func DevDep(dst *app.Context, src *app.Context, opts *DevDepOptions) (*app.DevDe
 			"do this. As long as the application doesn't change, Otto will\n" +
 			"cache the results of this build.\n\n")
 
+	// Remove any prior hash so a failed build is never used from the cache
+	if err := os.Remove(hashPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
 	// Use the Build function to do so...
-	err := Build(src, &BuildOptions{
-		Dir:    opts.Dir,
-		Script: opts.Script,
+	err = Build(src, &BuildOptions{
+		Dir:        opts.Dir,
+		Script:     opts.Script,
+		Provider:   opts.Provider,
+		SSHRetries: opts.SSHRetries,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Store the hash so the next call can use the cache
+	if err := ioutil.WriteFile(hashPath, []byte(hash), 0644); err != nil {
+		return nil, fmt.Errorf(
+			"Error caching the dev dependency: %s", err)
+	}
+
 	// Return the dep with the configured files. Eventually we'll verify
 	// these files exist. For now, we don't.
-	return &app.DevDep{Files: opts.Files}, nil
+	return &app.DevDep{
+		Files:   devDepFiles(cacheDir, opts.Files),
+		Version: version,
+	}, nil
+}
+
+// devDepVersion returns a hash of the lock files that exist within the
+// source directory, or an empty string if none do.
+func devDepVersion(sourceDir string, lockFiles []string) (string, error) {
+	var paths []string
+	for _, f := range lockFiles {
+		path := filepath.Join(sourceDir, f)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return "", nil
+	}
+
+	return devDepHash(paths...)
+}
+
+// devDepFiles returns the files of a dev dep with the relative paths
+// expanded within the app cache directory, since app.DevDep files are
+// otherwise relative to the cache directory shared by all app types.
+func devDepFiles(cacheDir string, files []string) []string {
+	result := make([]string, len(files))
+	for i, f := range files {
+		if !filepath.IsAbs(f) {
+			f = filepath.Join(cacheDir, f)
+		}
+
+		result[i] = f
+	}
+
+	return result
+}
+
+// devDepCached returns true if the hash at hashPath matches the given
+// hash and all the files exist in the cache directory.
+func devDepCached(cacheDir, hashPath, hash string, files []string) bool {
+	existing, err := ioutil.ReadFile(hashPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] error reading dev dep hash: %s", err)
+		}
+
+		return false
+	}
+	if string(existing) != hash {
+		log.Printf("[DEBUG] dev dep hash changed, rebuilding")
+		return false
+	}
+
+	for _, f := range files {
+		if !filepath.IsAbs(f) {
+			f = filepath.Join(cacheDir, f)
+		}
+
+		if _, err := os.Stat(f); err != nil {
+			log.Printf("[DEBUG] dev dep file missing, rebuilding: %s", f)
+			return false
+		}
+	}
+
+	return true
+}
+
+// devDepHash returns a hash of the contents of all the files within
+// the given directories. Otto and VCS metadata directories are skipped,
+// as are empty directory names.
+func devDepHash(dirs ...string) (string, error) {
+	h := sha256.New()
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() {
+				switch info.Name() {
+				case ".otto", ".vagrant", ".git", ".hg", ".svn":
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			// Include the path so that renames change the hash
+			h.Write([]byte(filepath.ToSlash(rel) + "\x00"))
+			_, err = io.Copy(h, f)
+			return err
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
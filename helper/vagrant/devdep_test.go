@@ -0,0 +1,147 @@
+package vagrant
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDevDepHash(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	write := func(path, contents string) {
+		path = filepath.Join(td, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	hash := func() string {
+		result, err := devDepHash(td, "")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		return result
+	}
+
+	write("main.go", "package main")
+	original := hash()
+	if original != hash() {
+		t.Fatal("hash should be stable")
+	}
+
+	// Changes in ignored directories don't matter
+	write(".otto/foo", "bar")
+	write(".git/HEAD", "bar")
+	if hash() != original {
+		t.Fatal("ignored directories should not change the hash")
+	}
+
+	// Changes in sources do
+	write("main.go", "package main\n")
+	if hash() == original {
+		t.Fatal("source changes should change the hash")
+	}
+}
+
+func TestDevDepCached(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	hashPath := filepath.Join(td, devDepHashFile)
+	files := []string{"output"}
+
+	// No hash
+	if devDepCached(td, hashPath, "foo", files) {
+		t.Fatal("should not be cached")
+	}
+
+	// Hash but no file
+	if err := ioutil.WriteFile(hashPath, []byte("foo"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if devDepCached(td, hashPath, "foo", files) {
+		t.Fatal("should not be cached")
+	}
+
+	// Hash and file
+	if err := ioutil.WriteFile(filepath.Join(td, "output"), nil, 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !devDepCached(td, hashPath, "foo", files) {
+		t.Fatal("should be cached")
+	}
+
+	// Different hash
+	if devDepCached(td, hashPath, "bar", files) {
+		t.Fatal("should not be cached")
+	}
+}
+
+func TestDevDepFiles(t *testing.T) {
+	cacheDir := filepath.Join("cache", "go")
+	abs, err := filepath.Abs("output")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := devDepFiles(cacheDir, []string{"output", abs})
+	expected := []string{filepath.Join(cacheDir, "output"), abs}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestDevDepVersion(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	lockFiles := []string{"vendor", "glide.lock"}
+	version := func() string {
+		result, err := devDepVersion(td, lockFiles)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		return result
+	}
+
+	// No lock
+	if v := version(); v != "" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Lock
+	path := filepath.Join(td, "glide.lock")
+	if err := ioutil.WriteFile(path, []byte("foo"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	original := version()
+	if original == "" {
+		t.Fatal("should have a version")
+	}
+
+	// Changed lock
+	if err := ioutil.WriteFile(path, []byte("bar"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if version() == original {
+		t.Fatal("lock changes should change the version")
+	}
+}
@@ -0,0 +1,202 @@
+package vagrant
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+// PortMapping is a port of the development environment that is forwarded
+// to the host.
+//
+// The Vagrantfile must forward the port, using the host port in the
+// environment variable named by PortEnvVar if it is set. Otto sets it when
+// the host port was selected automatically.
+type PortMapping struct {
+	// Guest is the port in the development environment.
+	Guest int
+
+	// Host is the port on the host. If it is zero, Guest is used.
+	Host int
+
+	// AutoSelect, if true, forwards the port to a free host port if Host
+	// is already in use when the development environment is created.
+	AutoSelect bool
+}
+
+// HostPort returns the host port that is forwarded if it isn't in use.
+func (m PortMapping) HostPort() int {
+	if m.Host == 0 {
+		return m.Guest
+	}
+
+	return m.Host
+}
+
+// PortEnvVar returns the name of the environment variable that sets the
+// host port forwarded to the given guest port.
+func PortEnvVar(guest int) string {
+	return fmt.Sprintf("OTTO_DEV_PORT_%d", guest)
+}
+
+// devPortsFile is the file in the local directory where the host ports
+// that were selected automatically are stored, so that the same ports are
+// used for as long as the development environment exists.
+const devPortsFile = "dev_ports.json"
+
+// selectPorts returns the host port forwarded to each guest port of the
+// mappings. Ports with AutoSelect that are in use are replaced with free
+// ports, which is reported to the Ui. The selected ports are stored at
+// path and reused by later calls, since the port is in use by the
+// development environment itself once it is running.
+func selectPorts(
+	u ui.Ui, path string, mappings []PortMapping) (map[int]int, error) {
+	selected, err := readPorts(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]int)
+	stored := make(map[int]int)
+	for _, m := range mappings {
+		host := m.HostPort()
+		if m.AutoSelect {
+			if prev, ok := selected[m.Guest]; ok {
+				host = prev
+			} else if !portFree(host) {
+				free, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf(
+						"Error finding a free port to forward port %d: %s",
+						m.Guest, err)
+				}
+
+				u.Message(fmt.Sprintf(
+					"Port %d on this machine is in use. Forwarding port %d of the\n"+
+						"development environment to port %d instead.",
+					host, m.Guest, free))
+				host = free
+			}
+
+			stored[m.Guest] = host
+		}
+
+		result[m.Guest] = host
+	}
+
+	if err := writePorts(path, stored); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// portsEnv returns the environment variables that set the host ports
+// stored at path for the Vagrantfile.
+func portsEnv(path string) ([]string, error) {
+	ports, err := readPorts(path)
+	if err != nil {
+		return nil, err
+	}
+
+	guests := make([]int, 0, len(ports))
+	for guest := range ports {
+		guests = append(guests, guest)
+	}
+	sort.Ints(guests)
+
+	result := make([]string, len(guests))
+	for i, guest := range guests {
+		result[i] = fmt.Sprintf("%s=%d", PortEnvVar(guest), ports[guest])
+	}
+
+	return result, nil
+}
+
+// readPorts reads the host ports stored at path, keyed by guest port. A
+// missing file has no ports.
+func readPorts(path string) (map[int]int, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[int]int{}, nil
+		}
+
+		return nil, err
+	}
+
+	// JSON object keys are strings, so the ports are stored as such
+	var stored map[string]int
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil, fmt.Errorf("Error reading forwarded ports: %s", err)
+	}
+
+	result := make(map[int]int, len(stored))
+	for k, v := range stored {
+		guest, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading forwarded ports: %s", err)
+		}
+
+		result[guest] = v
+	}
+
+	return result, nil
+}
+
+// writePorts stores the host ports at path, or deletes the file if there
+// are none.
+func writePorts(path string, ports map[int]int) error {
+	if len(ports) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	stored := make(map[string]int, len(ports))
+	for k, v := range ports {
+		stored[strconv.Itoa(k)] = v
+	}
+
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0644)
+}
+
+// portFree returns true if nothing on this machine listens on the port.
+func portFree(port int) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+
+	l.Close()
+	return true
+}
+
+// freePort returns a port that nothing on this machine listens on.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
@@ -0,0 +1,91 @@
+package vagrant
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+func TestSelectPorts(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+	path := filepath.Join(td, devPortsFile)
+
+	// Take a port so that it must be replaced
+	l, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer l.Close()
+	used := l.Addr().(*net.TCPAddr).Port
+
+	mappings := []PortMapping{
+		PortMapping{Guest: 8080, Host: used, AutoSelect: true},
+		PortMapping{Guest: 9090, Host: used},
+	}
+
+	var u ui.Mock
+	ports, err := selectPorts(&u, path, mappings)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if ports[8080] == used || ports[8080] == 0 {
+		t.Fatalf("port should be replaced: %#v", ports)
+	}
+	if ports[9090] != used {
+		t.Fatalf("port without auto select should be kept: %#v", ports)
+	}
+	if len(u.MessageBuf) != 1 {
+		t.Fatalf("replaced port should be reported: %#v", u.MessageBuf)
+	}
+
+	// The selected port is reused even though it is in use now
+	l2, err := net.Listen("tcp", fmt.Sprintf(":%d", ports[8080]))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer l2.Close()
+
+	again, err := selectPorts(&ui.Mock{}, path, mappings)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(again, ports) {
+		t.Fatalf("bad: %#v", again)
+	}
+
+	env, err := portsEnv(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := []string{fmt.Sprintf("%s=%d", PortEnvVar(8080), ports[8080])}
+	if !reflect.DeepEqual(env, expected) {
+		t.Fatalf("bad: %#v", env)
+	}
+
+	// Clearing the ports deletes the file
+	if err := writePorts(path, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("ports should be deleted: %s", err)
+	}
+}
+
+func TestPortMappingHostPort(t *testing.T) {
+	if p := (PortMapping{Guest: 80}).HostPort(); p != 80 {
+		t.Fatalf("bad: %d", p)
+	}
+	if p := (PortMapping{Guest: 80, Host: 8080}).HostPort(); p != 8080 {
+		t.Fatalf("bad: %d", p)
+	}
+}
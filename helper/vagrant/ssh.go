@@ -41,7 +41,7 @@ func (c *SSHCache) Exec(cacheOkay bool) error {
 	}
 
 	// Otherwise raw SSH
-	return c.Vagrant.Execute("ssh")
+	return c.Vagrant.ExecuteInteractive("ssh")
 }
 
 // Cache will execute "ssh-config" and cache the SSH info.
@@ -0,0 +1,84 @@
+package vagrant
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+// vagrantUi is an implementation of ui.Ui that we pass to helper/exec
+// that passes the output of Vagrant on to the Ui a line at a time, as
+// soon as each line is complete. Every line is also logged, so the
+// output isn't lost if there is no Ui.
+type vagrantUi struct {
+	Ui ui.Ui
+
+	// Callback, if set, is called with every line of output.
+	Callback func(string)
+
+	buf bytes.Buffer
+	l   sync.Mutex
+}
+
+func (u *vagrantUi) Header(msg string) {
+	u.Finish()
+	if u.Ui != nil {
+		u.Ui.Header(msg)
+	}
+}
+
+func (u *vagrantUi) Message(msg string) {
+	u.Finish()
+	if u.Ui != nil {
+		u.Ui.Message(msg)
+	}
+}
+
+func (u *vagrantUi) Input(opts *ui.InputOpts) (string, error) {
+	if u.Ui == nil {
+		return "", nil
+	}
+
+	return u.Ui.Input(opts)
+}
+
+func (u *vagrantUi) Raw(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.buf.WriteString(msg)
+	for {
+		idx := bytes.IndexByte(u.buf.Bytes(), '\n')
+		if idx < 0 {
+			return
+		}
+
+		u.line(string(u.buf.Next(idx + 1)))
+	}
+}
+
+// Finish outputs any partial line that is still buffered. It should be
+// called once the command exits. Header and Message call it so that
+// the partial line comes before them.
+func (u *vagrantUi) Finish() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	if u.buf.Len() > 0 {
+		u.line(u.buf.String() + "\n")
+		u.buf.Reset()
+	}
+}
+
+func (u *vagrantUi) line(line string) {
+	log.Printf("[DEBUG] vagrant: %s", strings.TrimRight(line, "\r\n"))
+	if u.Callback != nil {
+		u.Callback(line)
+	}
+	if u.Ui != nil {
+		u.Ui.Raw(line)
+	}
+}
@@ -0,0 +1,71 @@
+package vagrant
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/otto/ui"
+)
+
+func TestVagrantUi_impl(t *testing.T) {
+	var _ ui.Ui = new(vagrantUi)
+}
+
+func TestVagrantUi(t *testing.T) {
+	mock := new(ui.Mock)
+	u := &vagrantUi{Ui: mock}
+
+	u.Raw("==> default: one")
+	if len(mock.RawBuf) != 0 {
+		t.Fatalf("partial line output: %#v", mock.RawBuf)
+	}
+
+	u.Raw(" two\n==> default: three\n==> def")
+	u.Raw("ault: four")
+	u.Finish()
+	u.Finish()
+
+	expected := []string{
+		"==> default: one two\n",
+		"==> default: three\n",
+		"==> default: four\n",
+	}
+	if !reflect.DeepEqual(mock.RawBuf, expected) {
+		t.Fatalf("bad: %#v", mock.RawBuf)
+	}
+}
+
+func TestVagrantUi_callback(t *testing.T) {
+	var lines []string
+	u := &vagrantUi{Callback: func(line string) {
+		lines = append(lines, line)
+	}}
+
+	u.Raw("one\ntw")
+	u.Raw("o")
+	u.Finish()
+
+	if !reflect.DeepEqual(lines, []string{"one\n", "two\n"}) {
+		t.Fatalf("bad: %#v", lines)
+	}
+}
+
+func TestVagrantUi_message(t *testing.T) {
+	mock := new(ui.Mock)
+	u := &vagrantUi{Ui: mock}
+
+	u.Raw("one")
+	u.Message("")
+	if !reflect.DeepEqual(mock.RawBuf, []string{"one\n"}) {
+		t.Fatalf("bad: %#v", mock.RawBuf)
+	}
+	if !reflect.DeepEqual(mock.MessageBuf, []string{""}) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
+
+func TestVagrantUi_noUi(t *testing.T) {
+	u := new(vagrantUi)
+	u.Raw("one\ntwo")
+	u.Finish()
+}
@@ -2,8 +2,11 @@ package vagrant
 
 import (
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"sync"
 
 	"github.com/hashicorp/go-version"
@@ -41,33 +44,85 @@ type Vagrant struct {
 	// commands. If this is nil, then the output will be logged but
 	// won't be visible to the user.
 	Ui ui.Ui
+
+	// Env are extra environment variables, in "key=value" form, for the
+	// Vagrant commands. The Vagrantfile can read them.
+	Env []string
 }
 
-// A global mutex to prevent any Vagrant commands from running in parallel,
-// which is not a supported mode of operation for Vagrant.
-var vagrantMutex = &sync.Mutex{}
+// Vagrant doesn't support running multiple commands against the same
+// environment in parallel, so commands are serialized per directory.
+// Commands in different directories are independent and can run at the
+// same time, which lets dev dependencies be built in parallel.
+var (
+	vagrantLocks     = make(map[string]*sync.Mutex)
+	vagrantLocksLock sync.Mutex
+)
+
+// vagrantLock returns the lock for the environment in dir.
+func vagrantLock(dir string) *sync.Mutex {
+	vagrantLocksLock.Lock()
+	defer vagrantLocksLock.Unlock()
+
+	l, ok := vagrantLocks[dir]
+	if !ok {
+		l = new(sync.Mutex)
+		vagrantLocks[dir] = l
+	}
+
+	return l
+}
 
 // The environment variable that Vagrant uses to configure its data dir.
 const vagrantDataDirEnvVar = "VAGRANT_DOTFILE_PATH"
 
-// Execute executes a raw Vagrant command.
+// env returns the environment of Vagrant commands.
+func (v *Vagrant) env() []string {
+	env := append(os.Environ(), vagrantDataDirEnvVar+"="+v.DataDir)
+	return append(env, v.Env...)
+}
+
+// Execute executes a raw Vagrant command. The output is passed on to the
+// Ui a line at a time as soon as each line is complete.
+//
+// Execute is safe to call from multiple goroutines. Commands for the
+// same Dir wait for each other, but commands for different Dirs run in
+// parallel.
 func (v *Vagrant) Execute(command ...string) error {
-	vagrantMutex.Lock()
-	defer vagrantMutex.Unlock()
+	out := &vagrantUi{Ui: v.Ui}
+	defer out.Finish()
+	return v.execute(out, command...)
+}
 
-	// Build the command to execute
+// executeLines is the same as Execute, but f is also called with every
+// line of output, such as to look for errors in it.
+func (v *Vagrant) executeLines(f func(string), command ...string) error {
+	out := &vagrantUi{Ui: v.Ui, Callback: f}
+	defer out.Finish()
+	return v.execute(out, command...)
+}
+
+// ExecuteInteractive is the same as Execute, but the output is passed on
+// as is, without waiting for complete lines. Use it for commands the user
+// interacts with, such as `vagrant ssh`, since prompts don't end with a
+// newline.
+func (v *Vagrant) ExecuteInteractive(command ...string) error {
+	return v.execute(v.Ui, command...)
+}
+
+func (v *Vagrant) execute(out ui.Ui, command ...string) error {
+	l := vagrantLock(v.Dir)
+	l.Lock()
+	defer l.Unlock()
+
+	// Build the command to execute. The data dir is set in the command's
+	// environment rather than ours so parallel commands don't conflict.
 	cmd := exec.Command("vagrant", command...)
 	cmd.Dir = v.Dir
-
-	// Tell vagrant where to store data
-	origDataDir := os.Getenv(vagrantDataDirEnvVar)
-	defer os.Setenv(vagrantDataDirEnvVar, origDataDir)
-	if err := os.Setenv(vagrantDataDirEnvVar, v.DataDir); err != nil {
-		return err
-	}
+	cmd.Env = v.env()
 
 	// Run it with the execHelper
-	if err := execHelper.Run(v.Ui, cmd); err != nil {
+	if err := execHelper.Run(out, cmd); err != nil {
 		return fmt.Errorf(
 			"Error executing Vagrant: %s\n\n"+
 				"The error messages from Vagrant are usually very informative.\n"+
@@ -78,3 +133,78 @@ func (v *Vagrant) Execute(command ...string) error {
 
 	return nil
 }
+
+// Background starts a Vagrant command in the background, such as
+// `vagrant rsync-auto`, and returns a function that stops it and waits
+// for it to exit. The output of the command is logged but not shown.
+//
+// Unlike Execute, the lock for Dir is only held while the command
+// starts, so other Vagrant commands can run while it is running.
+func (v *Vagrant) Background(command ...string) (func(), error) {
+	l := vagrantLock(v.Dir)
+	l.Lock()
+	defer l.Unlock()
+
+	pr, pw := io.Pipe()
+	cmd := exec.Command("vagrant", command...)
+	cmd.Dir = v.Dir
+	cmd.Env = v.env()
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+
+	log.Printf("[DEBUG] background exec: vagrant %v", command)
+	if err := cmd.Start(); err != nil {
+		pw.Close()
+		return nil, fmt.Errorf("Error executing Vagrant: %s", err)
+	}
+
+	go func() {
+		var buf [1024]byte
+		for {
+			n, err := pr.Read(buf[:])
+			if n > 0 {
+				log.Printf("[DEBUG] vagrant %v: %s", command, buf[:n])
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		cmd.Wait()
+		pw.Close()
+	}()
+
+	return func() {
+		// Vagrant cleans up after itself on an interrupt. If we can't
+		// send one, the best we can do is kill it.
+		if err := cmd.Process.Signal(os.Interrupt); err != nil {
+			log.Printf("[WARN] error interrupting Vagrant, killing: %s", err)
+			cmd.Process.Kill()
+		}
+
+		<-doneCh
+	}, nil
+}
+
+// hasMachine returns true if Vagrant has created a machine in DataDir,
+// even if it isn't running.
+func (v *Vagrant) hasMachine() bool {
+	ids, err := filepath.Glob(filepath.Join(v.DataDir, "machines", "*", "*", "id"))
+	return err == nil && len(ids) > 0
+}
+
+// upArgs returns the arguments for `vagrant up` with the given provider.
+// If provider is empty, the flag is omitted so that Vagrant uses its
+// default provider.
+func upArgs(provider string) []string {
+	args := []string{"up"}
+	if provider != "" {
+		args = append(args, "--provider", provider)
+	}
+
+	return args
+}
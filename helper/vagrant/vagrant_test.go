@@ -0,0 +1,63 @@
+package vagrant
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestUpArgs(t *testing.T) {
+	cases := []struct {
+		Provider string
+		Result   []string
+	}{
+		{"", []string{"up"}},
+		{"vmware_fusion", []string{"up", "--provider", "vmware_fusion"}},
+	}
+
+	for _, tc := range cases {
+		result := upArgs(tc.Provider)
+		if !reflect.DeepEqual(result, tc.Result) {
+			t.Fatalf("%s: bad: %#v", tc.Provider, result)
+		}
+	}
+}
+
+func TestVagrantLock(t *testing.T) {
+	if vagrantLock("foo") != vagrantLock("foo") {
+		t.Fatal("same dir should have the same lock")
+	}
+	if vagrantLock("foo") == vagrantLock("bar") {
+		t.Fatal("different dirs should have different locks")
+	}
+}
+
+func TestVagrantHasMachine(t *testing.T) {
+	td, err := ioutil.TempDir("", "otto")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	v := &Vagrant{DataDir: td}
+	if v.hasMachine() {
+		t.Fatal("should not have machine")
+	}
+
+	dir := filepath.Join(td, "machines", "default", "virtualbox")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v.hasMachine() {
+		t.Fatal("should not have machine without an id")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "id"), []byte("foo"), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !v.hasMachine() {
+		t.Fatal("should have machine")
+	}
+}
@@ -6,17 +6,20 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/otto/app"
 	"github.com/hashicorp/otto/appfile"
 	"github.com/hashicorp/otto/context"
 	"github.com/hashicorp/otto/directory"
 	"github.com/hashicorp/otto/foundation"
 	"github.com/hashicorp/otto/helper/localaddr"
+	"github.com/hashicorp/otto/helper/terraform"
 	"github.com/hashicorp/otto/infrastructure"
 	"github.com/hashicorp/otto/ui"
 	"github.com/hashicorp/terraform/dag"
@@ -33,9 +36,15 @@ type Core struct {
 	dataDir         string
 	localDir        string
 	compileDir      string
+	devParallelism  int
+	refreshInfra    bool
 	ui              ui.Ui
 }
 
+// DefaultDevParallelism is the number of dev dependencies that are built
+// at the same time if CoreConfig.DevParallelism isn't set.
+const DefaultDevParallelism = 4
+
 // CoreConfig is configuration for creating a new core with NewCore.
 type CoreConfig struct {
 	// DataDir is the directory where local data will be stored that
@@ -68,6 +77,16 @@ type CoreConfig struct {
 	// value is a factory that can create the impl.
 	Foundations map[foundation.Tuple]foundation.Factory
 
+	// DevParallelism is the maximum number of dev dependencies that are
+	// built at the same time. If this is zero, DefaultDevParallelism is
+	// used.
+	DevParallelism int
+
+	// RefreshInfra, if true, refreshes the infrastructure before a build
+	// or deploy to check that its resources still exist. Otherwise, the
+	// infrastructure is trusted to be ready if the directory says so.
+	RefreshInfra bool
+
 	// Ui is the Ui that will be used to communicate with the user.
 	Ui ui.Ui
 }
@@ -87,6 +106,8 @@ func NewCore(c *CoreConfig) (*Core, error) {
 		dataDir:         c.DataDir,
 		localDir:        c.LocalDir,
 		compileDir:      c.CompileDir,
+		devParallelism:  c.DevParallelism,
+		refreshInfra:    c.RefreshInfra,
 		ui:              c.Ui,
 	}, nil
 }
@@ -276,9 +297,171 @@ func (c *Core) Build() error {
 	// Just update our shared data so we get the creds
 	rootCtx.Shared.InfraCreds = infraCtx.Shared.InfraCreds
 
+	unlock, err := c.lockApp(c.appfile)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := c.refresh(infra, infraCtx); err != nil {
+		return err
+	}
+
 	return rootApp.Build(rootCtx)
 }
 
+// BuildAll builds the application and all of its dependencies at the
+// same time, reporting the success or failure of each at the end. A
+// failed build doesn't stop the others.
+func (c *Core) BuildAll() error {
+	infra, infraCtx, err := c.infra()
+	if err != nil {
+		return err
+	}
+	if err := c.creds(infra, infraCtx); err != nil {
+		return err
+	}
+
+	// Gather every app of the Appfile to build
+	var jobs []*app.BuildJob
+	var jobsLock sync.Mutex
+	err = c.walk(func(appImpl app.App, ctx *app.Context, root bool) error {
+		ctx.Shared.InfraCreds = infraCtx.Shared.InfraCreds
+
+		jobsLock.Lock()
+		defer jobsLock.Unlock()
+		jobs = append(jobs, &app.BuildJob{App: appImpl, Ctx: ctx})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Sort the jobs by name so the results are always reported, and the
+	// locks below always taken, in the same order.
+	sort.Sort(buildJobsByName(jobs))
+
+	// Take the lock of every app before building any of them
+	for _, job := range jobs {
+		unlock, err := c.lockApp(job.Ctx.Appfile)
+		if err != nil {
+			return fmt.Errorf(
+				"Error locking '%s': %s", job.Ctx.Appfile.Application.Name, err)
+		}
+		defer unlock()
+	}
+
+	if err := c.refresh(infra, infraCtx); err != nil {
+		return err
+	}
+
+	_, err = app.BuildAll(c.ui, jobs, 0)
+	return err
+}
+
+// buildJobsByName sorts build jobs by the name of their application.
+type buildJobsByName []*app.BuildJob
+
+func (s buildJobsByName) Len() int      { return len(s) }
+func (s buildJobsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s buildJobsByName) Less(i, j int) bool {
+	return s[i].Ctx.Appfile.Application.Name < s[j].Ctx.Appfile.Application.Name
+}
+
+// BuildList outputs to the UI every build of the application for the
+// active infrastructure, newest first.
+func (c *Core) BuildList() error {
+	infra := c.appfile.ActiveInfrastructure()
+	builds, err := c.dir.ListBuilds(&directory.Lookup{
+		AppID:       c.appfile.ID,
+		Infra:       infra.Type,
+		InfraFlavor: infra.Flavor,
+	})
+	if err != nil {
+		return fmt.Errorf("Error loading builds: %s", err)
+	}
+	if len(builds) == 0 {
+		c.ui.Message("This application hasn't been built yet.")
+		return nil
+	}
+
+	for i := len(builds) - 1; i >= 0; i-- {
+		b := builds[i]
+		created := "unknown time"
+		if !b.CreatedAt.IsZero() {
+			created = b.CreatedAt.Local().Format(time.RFC1123)
+		}
+		c.ui.Header(fmt.Sprintf("Build from %s", created))
+		if b.ID != "" {
+			c.ui.Message(fmt.Sprintf("ID:      %s", b.ID))
+		}
+		if b.Builder != "" {
+			c.ui.Message(fmt.Sprintf("Builder: %s", b.Builder))
+		}
+		if b.GitSHA != "" {
+			c.ui.Message(fmt.Sprintf("Commit:  %s", b.GitSHA))
+		}
+
+		for _, k := range sortedKeys(b.Artifact) {
+			c.ui.Message(fmt.Sprintf("Artifact (%s): %s", k, b.Artifact[k]))
+		}
+	}
+
+	return nil
+}
+
+// BuildLog outputs to the UI the Packer output of the latest build of the
+// application for the active infrastructure, whether it succeeded or not.
+func (c *Core) BuildLog() error {
+	infra := c.appfile.ActiveInfrastructure()
+	log, err := directory.GetBuildLog(c.dir, &directory.Lookup{
+		AppID:       c.appfile.ID,
+		Infra:       infra.Type,
+		InfraFlavor: infra.Flavor,
+	})
+	if err != nil {
+		return fmt.Errorf("Error loading build log: %s", err)
+	}
+	if log == nil {
+		c.ui.Message("There is no build log for this application yet.")
+		return nil
+	}
+
+	c.ui.Raw(string(log))
+	return nil
+}
+
+// BuildStore stores the build that was saved locally because the
+// directory couldn't be reached when the build completed.
+func (c *Core) BuildStore() error {
+	build, err := directory.ReadUnstoredBuild(c.localDir)
+	if err != nil {
+		return err
+	}
+	if build == nil {
+		c.ui.Message("There is no unstored build for this application.")
+		return nil
+	}
+
+	err = directory.RetryConnection(func() error {
+		return c.dir.PutBuild(build)
+	})
+	if err != nil {
+		return fmt.Errorf("Error storing build: %s", err)
+	}
+	if err := directory.DeleteUnstoredBuild(c.localDir); err != nil {
+		return fmt.Errorf(
+			"The build was stored, but the local copy couldn't be deleted: %s", err)
+	}
+
+	c.ui.Header("[green]Build stored!")
+	for _, k := range sortedKeys(build.Artifact) {
+		c.ui.Message(fmt.Sprintf("Artifact (%s): %s", k, build.Artifact[k]))
+	}
+
+	return nil
+}
+
 // Deploy deploys the application.
 //
 // Deploy supports subactions, which can be specified with action and args.
@@ -323,9 +506,137 @@ func (c *Core) Deploy(action string, args []string) error {
 	rootCtx.Action = action
 	rootCtx.ActionArgs = args
 
+	// Actions that only read the deploy can run alongside others
+	if !deployReadOnly(action, args) {
+		unlock, err := c.lockApp(c.appfile)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	if action == "" {
+		if err := c.refresh(infra, infraCtx); err != nil {
+			return err
+		}
+	}
+
+	if err := rootApp.Deploy(rootCtx); err != nil {
+		return err
+	}
+
+	// After a deploy, show where the app is if the app can tell us
+	if action == "" {
+		if r, ok := rootApp.(app.DeployReporter); ok {
+			result, err := r.DeployResult(rootCtx)
+			if err != nil {
+				return fmt.Errorf(
+					"Error reading the result of the deploy: %s", err)
+			}
+			if result != nil && result.URL != "" {
+				c.ui.Header(fmt.Sprintf(
+					"[green]Your app is available at %s", result.URL))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Destroy destroys the deploy of the application. If the app implements
+// app.AppDestroy it is used, otherwise the "destroy" action of the deploy
+// is run. The args are passed through like the args of a deploy action.
+func (c *Core) Destroy(args []string) error {
+	infra, infraCtx, err := c.infra()
+	if err != nil {
+		return err
+	}
+	if err := c.creds(infra, infraCtx); err != nil {
+		return err
+	}
+
+	root, err := c.appfileCompiled.Graph.Root()
+	if err != nil {
+		return err
+	}
+	rootCtx, err := c.appContext(root.(*appfile.CompiledGraphVertex).File)
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+	rootApp, err := c.app(rootCtx)
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+
+	rootCtx.Shared.InfraCreds = infraCtx.Shared.InfraCreds
+	rootCtx.Action = "destroy"
+	rootCtx.ActionArgs = args
+
+	unlock, err := c.lockApp(c.appfile)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if d, ok := rootApp.(app.AppDestroy); ok {
+		return d.Destroy(rootCtx)
+	}
+
 	return rootApp.Deploy(rootCtx)
 }
 
+// deployReadOnly returns true if the deploy action only reads the
+// deploy, so it doesn't need the lock of the app. For "state", only
+// pulling the state reads it.
+func deployReadOnly(action string, args []string) bool {
+	switch action {
+	case "help", "info", "ssh", "history":
+		return true
+	case "state":
+		args = terraform.StateArgs(args)
+		return len(args) > 0 && args[0] == "pull"
+	}
+
+	return false
+}
+
+// lockApp takes the directory lock for the builds and deploys of the
+// given app on the active infrastructure, so that two Otto runs sharing a
+// directory can't change them at the same time. The returned function
+// releases the lock.
+func (c *Core) lockApp(f *appfile.File) (func(), error) {
+	infra := f.ActiveInfrastructure()
+	key := directory.AppLockKey(&directory.Lookup{
+		AppID:       f.ID,
+		Infra:       infra.Type,
+		InfraFlavor: infra.Flavor,
+	})
+
+	// This is the first use of the directory by builds and deploys, so
+	// an unreachable directory is retried for a while, and otherwise
+	// fails before any work is done.
+	var unlock func()
+	err := directory.RetryConnection(func() error {
+		var err error
+		unlock, err = c.dir.Lock(key)
+		return err
+	})
+	if err == directory.ErrLocked {
+		return nil, fmt.Errorf(
+			"Another operation is in progress for this application. Someone\n" +
+				"sharing the directory, or another Otto on this machine, is\n" +
+				"building or deploying it right now. Please wait for that to\n" +
+				"finish and try again.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error acquiring the app lock: %s", err)
+	}
+
+	return unlock, nil
+}
+
 // Dev starts a dev environment for the current application. For destroying
 // and other tasks against the dev environment, use the generic `Execute`
 // method.
@@ -347,65 +658,173 @@ func (c *Core) Dev() error {
 			"Error loading App: %s", err)
 	}
 
-	// Go through all the dependencies and build their immutable
-	// dev environment pieces for the final configuration.
+	// Go through all the dependencies to find the immutable dev
+	// environment pieces to build for the final configuration. The root
+	// is a special case since we're building the actual dev environment
+	// for it, so it is skipped.
+	var deps []*devDepApp
+	var depsLock sync.Mutex
 	err = c.walk(func(appImpl app.App, ctx *app.Context, root bool) error {
-		// If it is the root, we just return and do nothing else since
-		// the root is a special case where we're building the actual
-		// dev environment.
-		if root {
-			return nil
+		if !root {
+			depsLock.Lock()
+			defer depsLock.Unlock()
+			deps = append(deps, &devDepApp{App: appImpl, Ctx: ctx})
 		}
 
-		// Get the path to where we'd cache the dependency if we have
-		// cached it...
-		cachePath := filepath.Join(ctx.CacheDir, "dev-dep.json")
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		// Check if we've cached this. If so, then use the cache.
-		if _, err := app.ReadDevDep(cachePath); err == nil {
-			ctx.Ui.Header(fmt.Sprintf(
-				"Using cached dev dependency for '%s'",
-				ctx.Appfile.Application.Name))
-			return nil
+	// Build the dependencies. These don't depend on each other, so
+	// they're built in parallel.
+	if err := c.devDeps(rootCtx, deps); err != nil {
+		return err
+	}
+
+	// All the development dependencies are built/loaded. We now have
+	// everything we need to build the complete development environment.
+	return rootApp.Dev(rootCtx)
+}
+
+// devDepApp is an upstream dependency whose dev dependency is built
+// by devDeps.
+type devDepApp struct {
+	App app.App
+	Ctx *app.Context
+}
+
+// devDeps builds the dev dependencies of the given apps with a pool of
+// workers, so at most devParallelism are built at the same time. If any
+// build fails, the builds that haven't started yet are skipped.
+func (c *Core) devDeps(rootCtx *app.Context, deps []*devDepApp) error {
+	parallelism := c.devParallelism
+	if parallelism <= 0 {
+		parallelism = DefaultDevParallelism
+	}
+	if parallelism > len(deps) {
+		parallelism = len(deps)
+	}
+
+	var errs error
+	var files int
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	depCh := make(chan *devDepApp)
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range depCh {
+				lock.Lock()
+				stop := errs != nil
+				lock.Unlock()
+				if stop {
+					continue
+				}
+
+				dep, err := c.devDep(rootCtx, d.App, d.Ctx, parallelism > 1)
+
+				lock.Lock()
+				if err != nil {
+					errs = multierror.Append(errs, err)
+				} else if dep != nil {
+					files += len(dep.Files)
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+
+	for _, d := range deps {
+		depCh <- d
+	}
+	close(depCh)
+	wg.Wait()
+
+	log.Printf(
+		"[INFO] built %d dev dependencies with %d files", len(deps), files)
+	return errs
+}
+
+// devDep builds and caches the dev dependency for a single app. If
+// prefix is true, the output is prefixed with the name of the app so
+// that it can be told apart from other builds running at the same time.
+func (c *Core) devDep(
+	rootCtx *app.Context, appImpl app.App, ctx *app.Context, prefix bool) (*app.DevDep, error) {
+	if prefix {
+		prefixUi := &ui.Prefixed{
+			Ui:     ctx.Ui,
+			Prefix: fmt.Sprintf("%s: ", ctx.Appfile.Application.Name),
 		}
+		defer prefixUi.Flush()
 
-		// Build the development dependency
-		dep, err := appImpl.DevDep(rootCtx, ctx)
-		if err != nil {
-			return fmt.Errorf(
-				"Error building dependency for dev '%s': %s",
+		ctx.Ui = prefixUi
+	}
+
+	// Get the path to where we'll cache the dependency
+	cachePath := filepath.Join(ctx.CacheDir, "dev-dep.json")
+
+	// Build the development dependency. We always ask the app since
+	// only it knows if its sources changed. Apps are expected to cache
+	// their own builds so this is fast if nothing changed.
+	dep, err := appImpl.DevDep(rootCtx, ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error building dependency for dev '%s': %s",
+			ctx.Appfile.Application.Name,
+			err)
+	}
+
+	// If we have a dependency with files, then verify the files
+	// and store it in our cache directory so we can retrieve it
+	// later.
+	if dep != nil && len(dep.Files) > 0 {
+		if err := dep.RelFiles(ctx.CacheDir); err != nil {
+			return nil, fmt.Errorf(
+				"Error caching dependency for dev '%s': %s",
 				ctx.Appfile.Application.Name,
 				err)
 		}
 
-		// If we have a dependency with files, then verify the files
-		// and store it in our cache directory so we can retrieve it
-		// later.
-		if dep != nil && len(dep.Files) > 0 {
-			if err := dep.RelFiles(ctx.CacheDir); err != nil {
-				return fmt.Errorf(
-					"Error caching dependency for dev '%s': %s",
-					ctx.Appfile.Application.Name,
-					err)
-			}
+		if err := app.WriteDevDep(cachePath, dep); err != nil {
+			return nil, fmt.Errorf(
+				"Error caching dependency for dev '%s': %s",
+				ctx.Appfile.Application.Name,
+				err)
+		}
+	}
 
-			if err := app.WriteDevDep(cachePath, dep); err != nil {
-				return fmt.Errorf(
-					"Error caching dependency for dev '%s': %s",
-					ctx.Appfile.Application.Name,
-					err)
-			}
+	return dep, nil
+}
+
+// CacheClear deletes the data the application and its dependencies have
+// cached, such as built dev dependencies, so the next run starts fresh.
+// Only the namespace of each app type within the cache is deleted.
+func (c *Core) CacheClear() error {
+	var lock sync.Mutex
+	var cleared int
+	err := c.walk(func(appImpl app.App, ctx *app.Context, root bool) error {
+		dir := ctx.AppCacheDir()
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf(
+				"Error clearing the cache of '%s': %s",
+				ctx.Appfile.Application.Name, err)
 		}
 
+		lock.Lock()
+		defer lock.Unlock()
+		cleared++
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
-	// All the development dependencies are built/loaded. We now have
-	// everything we need to build the complete development environment.
-	return rootApp.Dev(rootCtx)
+	c.ui.Header(fmt.Sprintf(
+		"[green]Cleared the cache of %d application(s).", cleared))
+	return nil
 }
 
 // Infra manages the infrastructure for this Appfile.
@@ -420,7 +839,7 @@ func (c *Core) Infra(action string, args []string) error {
 	if err != nil {
 		return err
 	}
-	if action == "" || action == "destroy" {
+	if action == "" || action == "destroy" || action == "refresh" {
 		if err := c.creds(infra, infraCtx); err != nil {
 			return err
 		}
@@ -485,6 +904,12 @@ func (c *Core) Infra(action string, args []string) error {
 		if err := infra.Execute(infraCtx); err != nil {
 			return err
 		}
+
+		// The build artifacts and deploy of this application lived in
+		// the infrastructure, so they're of no use anymore.
+		if err := c.deleteAppRecords(); err != nil {
+			return err
+		}
 	}
 
 	// Output the right thing
@@ -504,6 +929,50 @@ func (c *Core) Infra(action string, args []string) error {
 	return nil
 }
 
+// refresh refreshes the infrastructure if the core is configured to, so
+// that a build or deploy doesn't start against infrastructure that was
+// destroyed outside of Otto. The infrastructure implementation updates
+// the directory if it is gone, which the app then sees as not ready.
+func (c *Core) refresh(
+	infra infrastructure.Infrastructure,
+	infraCtx *infrastructure.Context) error {
+	if !c.refreshInfra {
+		return nil
+	}
+
+	infraCtx.Action = "refresh"
+	infraCtx.ActionArgs = nil
+	return infra.Execute(infraCtx)
+}
+
+// deleteAppRecords deletes the builds and the deploys of every environment
+// of the application for the active infrastructure from the directory.
+func (c *Core) deleteAppRecords() error {
+	infra := c.appfile.ActiveInfrastructure()
+	lookup := directory.Lookup{
+		AppID:       c.appfile.ID,
+		Infra:       infra.Type,
+		InfraFlavor: infra.Flavor,
+	}
+
+	if err := c.dir.DeleteBuild(&directory.Build{Lookup: lookup}); err != nil {
+		return fmt.Errorf("Error deleting builds from the directory: %s", err)
+	}
+	if err := c.dir.DeleteDeploy(&directory.Deploy{Lookup: lookup}); err != nil {
+		return fmt.Errorf("Error deleting deploy from the directory: %s", err)
+	}
+	for _, env := range c.appfile.Environments {
+		err := c.dir.DeleteDeploy(&directory.Deploy{
+			Lookup: lookup, Environment: env.Name})
+		if err != nil {
+			return fmt.Errorf(
+				"Error deleting %s deploy from the directory: %s", env.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // Status outputs to the UI the status of all the stages of this application.
 func (c *Core) Status() error {
 	// Start loading the status info in a goroutine
@@ -526,25 +995,40 @@ func (c *Core) Status() error {
 		status = <-statusCh
 	}
 
+	// The app reports its own status if it can. Otherwise the status
+	// comes from the directory records loaded above.
+	appStatus := app.NewStatus(status.Infra, status.Build, status.Deploy)
+	if s, err := c.appStatus(); err != nil {
+		return err
+	} else if s != nil {
+		appStatus = s
+	}
+
 	// Create the status texts
 	devStatus := "[reset]NOT CREATED"
 	if status.Dev.IsReady() {
 		devStatus = "[green]CREATED"
 	}
 	buildStatus := "[reset]NOT BUILT"
-	if status.Build != nil {
+	if appStatus.Built {
 		buildStatus = "[green]BUILD READY"
 	}
 	deployStatus := "[reset]NOT DEPLOYED"
-	if status.Deploy.IsDeployed() {
+	switch appStatus.DeployState {
+	case directory.DeployStateSuccess:
 		deployStatus = "[green]DEPLOYED"
-	} else if status.Deploy.IsFailed() {
+	case directory.DeployStateFail:
 		deployStatus = "[reset]DEPLOY FAILED"
+	case directory.DeployStatePartial:
+		deployStatus = "[yellow]PARTIALLY DEPLOYED"
+	case directory.DeployStateDestroyed:
+		deployStatus = "[reset]DESTROYED"
 	}
 	infraStatus := "[reset]NOT CREATED"
-	if status.Infra.IsReady() {
+	switch appStatus.InfraState {
+	case directory.InfraStateReady:
 		infraStatus = "[green]READY"
-	} else if status.Infra.IsPartial() {
+	case directory.InfraStatePartial:
 		infraStatus = "[yellow]PARTIAL"
 	}
 
@@ -566,9 +1050,96 @@ func (c *Core) Status() error {
 	c.ui.Message(fmt.Sprintf("Build:           %s", buildStatus))
 	c.ui.Message(fmt.Sprintf("Deploy:          %s", deployStatus))
 
+	// Show where the build came from. Builds stored by older versions
+	// of Otto don't have this information.
+	if b := status.Build; b != nil && !b.CreatedAt.IsZero() {
+		c.ui.Header("Build Info")
+		c.ui.Message(fmt.Sprintf(
+			"Built:    %s", b.CreatedAt.Local().Format(time.RFC1123)))
+		if b.Builder != "" {
+			c.ui.Message(fmt.Sprintf("Builder:  %s", b.Builder))
+		}
+		if b.GitSHA != "" {
+			c.ui.Message(fmt.Sprintf("Commit:   %s", b.GitSHA))
+		}
+	}
+	if len(appStatus.Artifact) > 0 {
+		c.ui.Header("Build Artifact")
+		for _, k := range sortedKeys(appStatus.Artifact) {
+			c.ui.Message(fmt.Sprintf("%s: %s", k, appStatus.Artifact[k]))
+		}
+	}
+
+	// Show where the app is deployed
+	if appStatus.DeployState == directory.DeployStateSuccess {
+		c.ui.Header("Deploy Info")
+		c.ui.Message(fmt.Sprintf("Version: %d", appStatus.DeployVersion))
+		for _, k := range sortedKeys(appStatus.DeployOutputs) {
+			c.ui.Message(fmt.Sprintf("%s: %s", k, appStatus.DeployOutputs[k]))
+		}
+	}
+
 	return nil
 }
 
+// appStatus returns the status reported by the root app, or nil if the
+// app doesn't implement app.AppStatus.
+func (c *Core) appStatus() (*app.Status, error) {
+	root, err := c.appfileCompiled.Graph.Root()
+	if err != nil {
+		return nil, err
+	}
+	rootCtx, err := c.appContext(root.(*appfile.CompiledGraphVertex).File)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+	rootApp, err := c.app(rootCtx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+
+	s, ok := rootApp.(app.AppStatus)
+	if !ok {
+		return nil, nil
+	}
+
+	return s.Status(rootCtx)
+}
+
+// Verify checks the compiled files of the root application, such as
+// the Packer and Terraform templates, without building or deploying
+// anything. Compile must be called first.
+func (c *Core) Verify() error {
+	root, err := c.appfileCompiled.Graph.Root()
+	if err != nil {
+		return err
+	}
+	rootCtx, err := c.appContext(root.(*appfile.CompiledGraphVertex).File)
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+	rootApp, err := c.app(rootCtx)
+	if err != nil {
+		return fmt.Errorf(
+			"Error loading App: %s", err)
+	}
+
+	v, ok := rootApp.(app.AppVerify)
+	if !ok {
+		c.ui.Header("Skipping verification...")
+		c.ui.Message(fmt.Sprintf(
+			"The %s application type doesn't support verifying its\n"+
+				"compiled files yet, so there is nothing to check.",
+			rootCtx.Tuple.App))
+		return nil
+	}
+
+	return v.Verify(rootCtx)
+}
+
 // Execute executes the given task for this Appfile.
 func (c *Core) Execute(opts *ExecuteOpts) error {
 	switch opts.Task {
@@ -639,8 +1210,20 @@ func (c *Core) creds(
 		}
 	}
 
+	// Without cached credentials, someone else using the infrastructure
+	// may have shared them through the directory.
+	var sharedPassword string
+	if !exists {
+		var err error
+		creds, sharedPassword, err = c.sharedCreds(infraCtx)
+		if err != nil {
+			return err
+		}
+	}
+
 	// If we don't have creds, then we need to query the user via
 	// the infrastructure implementation.
+	var shared *directory.Creds
 	if creds == nil {
 		infraCtx.Ui.Message(
 			"Existing infrastructure credentials were not found! Otto will\n" +
@@ -683,27 +1266,124 @@ func (c *Core) creds(
 			return fmt.Errorf(
 				"error writing encrypted credentials: %s", err)
 		}
+
+		// They're also shared through the directory with the same
+		// password, so others sharing it don't have to enter them.
+		ciphertext, err := cryptEncrypt(password, plaintext)
+		if err != nil {
+			return fmt.Errorf(
+				"error encrypting credentials: %s", err)
+		}
+		shared = &directory.Creds{
+			Lookup: directory.Lookup{Infra: infraCtx.Infra.Name},
+			Data:   ciphertext,
+		}
 	}
 
 	// Set the credentials
 	infraCtx.InfraCreds = creds
 
+	// Directories stored in the infrastructure use its credentials
+	if d, ok := c.dir.(directory.InfraCredsBackend); ok {
+		d.SetInfraCreds(creds)
+	}
+
 	// Let the infrastructure do whatever it likes to verify that the credentials
 	// are good, so we can fail fast in case there's a problem.
 	if err := infra.VerifyCreds(infraCtx); err != nil {
 		return err
 	}
 
+	// Only verified credentials are shared. Sharing them is best-effort
+	// since they're cached locally either way.
+	if shared != nil {
+		if err := c.dir.PutCreds(shared); err != nil {
+			ui.Warn(infraCtx.Ui, fmt.Sprintf(
+				"The credentials couldn't be shared through the directory: %s\n"+
+					"They are still saved locally, so Otto won't ask for them again.",
+				err))
+		}
+	}
+
+	// Credentials read from the directory are cached locally with the
+	// same password, so the directory isn't read again next time. This
+	// is best-effort as well, since they can be read from it again.
+	if sharedPassword != "" {
+		plaintext, err := json.Marshal(creds)
+		if err != nil {
+			// creds is a map[string]string, so this shouldn't ever fail
+			panic(err)
+		}
+
+		if err := cryptWrite(path, sharedPassword, plaintext); err != nil {
+			ui.Warn(infraCtx.Ui, fmt.Sprintf(
+				"The shared credentials couldn't be cached locally: %s", err))
+		}
+	}
+
 	return nil
 }
 
+// sharedCreds reads the infrastructure credentials shared through the
+// directory and asks for the password to decrypt them. It returns them
+// along with the password, or nil if none are shared or the password
+// is left blank.
+func (c *Core) sharedCreds(
+	infraCtx *infrastructure.Context) (map[string]string, string, error) {
+	stored, err := c.dir.GetCreds(&directory.Creds{
+		Lookup: directory.Lookup{Infra: infraCtx.Infra.Name}})
+	if err != nil {
+		// Some directories, such as S3, may need the credentials to be
+		// read at all, so this isn't fatal.
+		log.Printf("[WARN] error reading shared credentials: %s", err)
+		return nil, "", nil
+	}
+	if stored == nil {
+		return nil, "", nil
+	}
+
+	infraCtx.Ui.Message(
+		"Shared and encrypted infrastructure credentials were found in the\n" +
+			"directory. Otto will now ask you for the password to decrypt these\n" +
+			"credentials.\n\n")
+
+	value, err := infraCtx.Ui.Input(&ui.InputOpts{
+		Id:          "creds_password",
+		Query:       "Encrypted Credentials Password",
+		Description: strings.TrimSpace(credsQueryPassShared),
+		Hide:        true,
+		EnvVars:     []string{"OTTO_CREDS_PASSWORD"},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if value == "" {
+		return nil, "", nil
+	}
+
+	var creds map[string]string
+	plaintext, err := cryptDecrypt(value, stored.Data)
+	if err == nil {
+		err = json.Unmarshal(plaintext, &creds)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf(
+			"error reading shared encrypted credentials: %s\n\n"+
+				"If this error persists, you can force Otto to ask for credentials\n"+
+				"again by inputting the empty password as the password.",
+			err)
+	}
+
+	return creds, value, nil
+}
+
 func (c *Core) executeApp(opts *ExecuteOpts) error {
 	// Get the infra implementation for this
 	appCtx, err := c.appContext(c.appfile)
 	if err != nil {
 		return err
 	}
-	app, err := c.app(appCtx)
+	appImpl, err := c.app(appCtx)
 	if err != nil {
 		return err
 	}
@@ -715,7 +1395,11 @@ func (c *Core) executeApp(opts *ExecuteOpts) error {
 	// Build the infrastructure compilation context
 	switch opts.Task {
 	case ExecuteTaskDev:
-		return app.Dev(appCtx)
+		if d, ok := appImpl.(app.AppDevDestroy); ok && opts.Action == "destroy" {
+			return d.DevDestroy(appCtx)
+		}
+
+		return appImpl.Dev(appCtx)
 	default:
 		panic(fmt.Sprintf("uknown task: %s", opts.Task))
 	}
@@ -749,12 +1433,22 @@ func (c *Core) appContext(f *appfile.File) (*app.Context, error) {
 			c.compileDir, fmt.Sprintf("dep-%s", id))
 	}
 
-	// The cache directory for this app
+	// The cache directory for this app. The namespace of the app type
+	// within it is created too, since that is where apps cache data.
 	cacheDir := filepath.Join(c.dataDir, "cache", f.ID)
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+	appCacheDir := filepath.Join(cacheDir, tuple.App)
+	if err := os.MkdirAll(appCacheDir, 0755); err != nil {
+		return nil, fmt.Errorf(
+			"error making cache directory '%s': %s",
+			appCacheDir, err)
+	}
+
+	// The cache directory shared by all apps
+	globalCacheDir := filepath.Join(c.dataDir, "cache", "global")
+	if err := os.MkdirAll(globalCacheDir, 0755); err != nil {
 		return nil, fmt.Errorf(
 			"error making cache directory '%s': %s",
-			cacheDir, err)
+			globalCacheDir, err)
 	}
 
 	// Build the contexts for the foundations. We use this
@@ -777,12 +1471,13 @@ func (c *Core) appContext(f *appfile.File) (*app.Context, error) {
 	}
 
 	return &app.Context{
-		Dir:          outputDir,
-		CacheDir:     cacheDir,
-		LocalDir:     c.localDir,
-		Tuple:        tuple,
-		Application:  f.Application,
-		DevIPAddress: ip.String(),
+		Dir:            outputDir,
+		CacheDir:       cacheDir,
+		GlobalCacheDir: globalCacheDir,
+		LocalDir:       c.localDir,
+		Tuple:          tuple,
+		Application:    f.Application,
+		DevIPAddress:   ip.String(),
 		Shared: context.Shared{
 			Appfile:        f,
 			FoundationDirs: foundationDirs,
@@ -796,8 +1491,12 @@ func (c *Core) appContext(f *appfile.File) (*app.Context, error) {
 func (c *Core) app(ctx *app.Context) (app.App, error) {
 	log.Printf("[INFO] Loading app implementation for Tuple: %s", ctx.Tuple)
 
-	// Look for the app impl. factory
+	// Look for the app impl. factory, falling back to the apps that
+	// registered themselves with the app package.
 	f := app.TupleMap(c.apps).Lookup(ctx.Tuple)
+	if f == nil {
+		f = app.Registered().Lookup(ctx.Tuple)
+	}
 	if f == nil {
 		return nil, fmt.Errorf(
 			"app implementation for tuple not found: %s", ctx.Tuple)
@@ -926,7 +1625,16 @@ to decrypt these credentials. You may also just hit <enter> and leave
 the password blank to force Otto to ask for the credentials again.
 `
 
+const credsQueryPassShared = `
+Infrastructure credentials are required for this operation. Otto found
+credentials shared through the directory that are password protected.
+Please enter the password that was used to save them. You may also just
+hit <enter> and leave the password blank to enter your own credentials.
+`
+
 const credsQueryPassNew = `
 This password will be used to encrypt and save the credentials so they
-don't need to be repeated multiple times.
+don't need to be repeated multiple times. The encrypted credentials are
+also shared through the directory, and anyone using it will need this
+password to use them.
 `
@@ -0,0 +1,31 @@
+package otto
+
+import (
+	"testing"
+)
+
+func TestDeployReadOnly(t *testing.T) {
+	cases := []struct {
+		Action string
+		Args   []string
+		Result bool
+	}{
+		{"", nil, false},
+		{"info", nil, true},
+		{"history", []string{"-limit=5"}, true},
+		{"rollback", nil, false},
+		{"state", []string{"pull"}, true},
+		{"state", []string{"-env=prod", "pull"}, true},
+		{"state", []string{"-env", "prod", "pull"}, true},
+		{"state", []string{"-force", "pull"}, true},
+		{"state", []string{"-env", "pull", "push", "terraform.tfstate"}, false},
+		{"state", []string{"push", "terraform.tfstate"}, false},
+		{"state", nil, false},
+	}
+
+	for _, tc := range cases {
+		if actual := deployReadOnly(tc.Action, tc.Args); actual != tc.Result {
+			t.Fatalf("%s %v: bad: %v", tc.Action, tc.Args, actual)
+		}
+	}
+}
@@ -19,32 +19,51 @@ const (
 )
 
 // cryptWrite is a helper to encrypt data and then write it to a file.
-// Encryption is done by using bcrypt as a KDF followed by AES-GCM.
 func cryptWrite(dst string, password string, plaintext []byte) error {
+	ciphertext, err := cryptEncrypt(password, plaintext)
+	if err != nil {
+		return err
+	}
+
+	// Create the file for writing, making sure it is opened as 0600
+	// for a little additional security.
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, bytes.NewReader(ciphertext))
+	return err
+}
+
+// cryptEncrypt encrypts data with the password. Encryption is done by
+// using scrypt as a KDF followed by AES-GCM.
+func cryptEncrypt(password string, plaintext []byte) ([]byte, error) {
 	keySalt := make([]byte, cryptKeySaltLen)
 	if _, err := rand.Read(keySalt); err != nil {
-		return err
+		return nil, err
 	}
 
 	key, err := scrypt.Key([]byte(password), keySalt, 16384, 8, 1, 32)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	aesCipher, err := aes.NewCipher(key)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	gcm, err := cipher.NewGCM(aesCipher)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Compute random nonce
 	nonce := make([]byte, gcm.NonceSize())
 	if _, err := rand.Read(nonce); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Encrypt and tag with GCM
@@ -54,20 +73,11 @@ func cryptWrite(dst string, password string, plaintext []byte) error {
 	ciphertext = append(ciphertext, keySalt...)
 	ciphertext = append(ciphertext, nonce...)
 	ciphertext = append(ciphertext, out...)
-	out = nil
-
-	// Create the file for writing, making sure it is opened as 0600
-	// for a little additional security.
-	f, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	_, err = io.Copy(f, bytes.NewReader(ciphertext))
-	return err
+	return ciphertext, nil
 }
 
+// cryptRead is a helper to read a file written by cryptWrite and
+// decrypt it.
 func cryptRead(path string, password string) ([]byte, error) {
 	// Read the contents of the path first
 	ciphertext, err := ioutil.ReadFile(path)
@@ -75,8 +85,14 @@ func cryptRead(path string, password string) ([]byte, error) {
 		return nil, err
 	}
 
+	return cryptDecrypt(password, ciphertext)
+}
+
+// cryptDecrypt decrypts data encrypted by cryptEncrypt.
+func cryptDecrypt(password string, ciphertext []byte) ([]byte, error) {
 	// Verify that the data looks valid
-	if !bytes.HasPrefix(ciphertext, []byte(cryptPrefixV0)) {
+	if !bytes.HasPrefix(ciphertext, []byte(cryptPrefixV0)) ||
+		len(ciphertext) < len(cryptPrefixV0)+cryptKeySaltLen {
 		return nil, fmt.Errorf("corrupt encrypted data")
 	}
 
@@ -104,6 +120,9 @@ func cryptRead(path string, password string) ([]byte, error) {
 	}
 
 	// Get the nonce and ciphertext out
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("corrupt encrypted data")
+	}
 	nonce := ciphertext[:gcm.NonceSize()]
 	ciphertext = ciphertext[gcm.NonceSize():]
 
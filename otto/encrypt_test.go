@@ -43,3 +43,17 @@ func TestCrypt(t *testing.T) {
 		}
 	}
 }
+
+func TestCryptDecrypt_bad(t *testing.T) {
+	ciphertext, err := cryptEncrypt("foo", []byte("bar"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := cryptDecrypt("baz", ciphertext); err == nil {
+		t.Fatal("should error with the wrong password")
+	}
+	if _, err := cryptDecrypt("foo", ciphertext[:10]); err == nil {
+		t.Fatal("should error with truncated data")
+	}
+}
@@ -2,6 +2,7 @@ package otto
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/otto/directory"
@@ -63,3 +64,15 @@ func (c *Core) statusInfo(resultCh chan<- *statusInfo) {
 
 	resultCh <- &result
 }
+
+// sortedKeys returns the keys of the map in sorted order, so that maps
+// such as artifacts and outputs are shown in a stable order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,57 @@
+package ui
+
+// Event is a machine-readable event about the progress of Otto, such
+// as a build producing an artifact. Events are only output by Ui
+// implementations that support them, such as JSON, so that tools like
+// CI jobs don't need to parse the human-readable output.
+type Event struct {
+	// Type is the type of the event, one of the Event* constants.
+	Type string `json:"type"`
+
+	// Message is an optional human-readable description of the event.
+	Message string `json:"message,omitempty"`
+
+	// Data is the data of the event. The keys depend on the type.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+const (
+	// EventBuildStart is emitted when a build starts. The data has the
+	// "app" and the "infra" it is built for.
+	EventBuildStart = "build-start"
+
+	// EventBuildArtifact is emitted for every artifact of a successful
+	// build. The data has the "region" and the "id" of the artifact,
+	// such as the AMI ID.
+	EventBuildArtifact = "build-artifact"
+
+	// EventBuildSuccess is emitted once a build is stored. The data
+	// maps each region to the artifact that will be deployed there.
+	EventBuildSuccess = "build-success"
+
+	// EventDeployStart is emitted when a deploy starts. The data has
+	// the "app" and the "environment" being deployed.
+	EventDeployStart = "deploy-start"
+
+	// EventDeploySuccess is emitted when a deploy is applied. The data
+	// has the outputs of the deploy, such as its URL.
+	EventDeploySuccess = "deploy-success"
+
+	// EventDeployFailed is emitted when a deploy fails. The message is
+	// the error.
+	EventDeployFailed = "deploy-failed"
+)
+
+// EventUi is implemented by Ui implementations that can output events.
+type EventUi interface {
+	Event(*Event)
+}
+
+// Emit outputs the event to the Ui if it supports events, and does
+// nothing otherwise. Implementations wrapping another Ui should
+// implement EventUi by calling Emit with the wrapped Ui.
+func Emit(u Ui, e *Event) {
+	if eu, ok := u.(EventUi); ok {
+		eu.Event(e)
+	}
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"log"
+)
+
+// Level is the importance of a message output to the Ui.
+type Level int
+
+const (
+	// LevelDebug is for messages that are only useful to debug Otto.
+	// Unless the Ui supports levels, they are only logged.
+	LevelDebug Level = iota
+
+	// LevelInfo is for the human-friendly messages about what Otto is
+	// doing. Header and Message output at this level.
+	LevelInfo
+
+	// LevelWarn is for problems that don't stop Otto, but that the user
+	// should know about.
+	LevelWarn
+
+	// LevelError is for errors.
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// LevelUi is implemented by Ui implementations that handle the level of
+// messages, such as Quiet.
+type LevelUi interface {
+	Log(Level, string)
+}
+
+// Log outputs the message to the Ui at the given level. If the Ui doesn't
+// support levels, debug messages are only logged, and the others are
+// output with Message, colored by their level.
+//
+// Implementations wrapping another Ui should implement LevelUi by calling
+// Log with the wrapped Ui.
+func Log(u Ui, l Level, msg string) {
+	if lu, ok := u.(LevelUi); ok {
+		lu.Log(l, msg)
+		return
+	}
+
+	switch l {
+	case LevelDebug:
+		log.Printf("[DEBUG] ui: %s", StripColors(msg))
+	case LevelWarn:
+		u.Message("[yellow]" + msg)
+	case LevelError:
+		u.Message("[red]" + msg)
+	default:
+		u.Message(msg)
+	}
+}
+
+// Debug outputs the message to the Ui at LevelDebug.
+func Debug(u Ui, msg string) {
+	Log(u, LevelDebug, msg)
+}
+
+// Info outputs the message to the Ui at LevelInfo.
+func Info(u Ui, msg string) {
+	Log(u, LevelInfo, msg)
+}
+
+// Warn outputs the message to the Ui at LevelWarn.
+func Warn(u Ui, msg string) {
+	Log(u, LevelWarn, msg)
+}
+
+// Error outputs the message to the Ui at LevelError.
+func Error(u Ui, msg string) {
+	Log(u, LevelError, msg)
+}
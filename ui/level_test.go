@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	mock := new(Mock)
+
+	Debug(mock, "zero")
+	Info(mock, "one")
+	Warn(mock, "two")
+	Error(mock, "three")
+
+	expected := []string{"one", "[yellow]two", "[red]three"}
+	if !reflect.DeepEqual(mock.MessageBuf, expected) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
+
+func TestLog_levelUi(t *testing.T) {
+	mock := new(Mock)
+	u := &Quiet{Ui: &Styled{Ui: mock}}
+
+	Info(u, "one")
+	Error(u, "two")
+
+	expected := []string{"[red]    two"}
+	if !reflect.DeepEqual(mock.MessageBuf, expected) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
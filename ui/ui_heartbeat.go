@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Heartbeat is a wrapper around an existing UI that outputs a message
+// whenever nothing has been output for Interval, such as while a command
+// waits quietly on a slow API. This shows that Otto hasn't hung.
+//
+// Start must be called to start the timer, and Stop must be called once
+// the command completes. No heartbeat is output after Stop returns.
+type Heartbeat struct {
+	Ui
+
+	// Interval is how long output can be idle before a heartbeat.
+	Interval time.Duration
+
+	// Text is the message of the heartbeat, such as "Still applying...".
+	// The time elapsed since Start is added to it.
+	Text string
+
+	l       sync.Mutex
+	start   time.Time
+	timer   *time.Timer
+	stopped bool
+}
+
+// Start starts the timer for the heartbeats.
+func (u *Heartbeat) Start() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.start = time.Now()
+	u.timer = time.AfterFunc(u.Interval, u.beat)
+}
+
+// Stop stops the heartbeats.
+func (u *Heartbeat) Stop() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.stopped = true
+	if u.timer != nil {
+		u.timer.Stop()
+	}
+}
+
+func (u *Heartbeat) Header(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.reset()
+	u.Ui.Header(msg)
+}
+
+func (u *Heartbeat) Message(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.reset()
+	u.Ui.Message(msg)
+}
+
+func (u *Heartbeat) Raw(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.reset()
+	u.Ui.Raw(msg)
+}
+
+func (u *Heartbeat) Log(l Level, msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.reset()
+	Log(u.Ui, l, msg)
+}
+
+func (u *Heartbeat) Event(e *Event) {
+	Emit(u.Ui, e)
+}
+
+// beat outputs the heartbeat and waits for the next one.
+func (u *Heartbeat) beat() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	if u.stopped {
+		return
+	}
+
+	elapsed := time.Since(u.start) / time.Second * time.Second
+	u.Ui.Message(fmt.Sprintf("%s (%s elapsed)", u.Text, elapsed))
+	u.timer.Reset(u.Interval)
+}
+
+// reset restarts the wait for the next heartbeat since there was output.
+// The lock must be held.
+func (u *Heartbeat) reset() {
+	if !u.stopped && u.timer != nil {
+		u.timer.Reset(u.Interval)
+	}
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHeartbeat_impl(t *testing.T) {
+	var _ Ui = new(Heartbeat)
+}
+
+func TestHeartbeat(t *testing.T) {
+	mock := new(Mock)
+	u := &Heartbeat{Ui: mock, Interval: 20 * time.Millisecond, Text: "Still applying..."}
+	u.Start()
+	u.Raw("foo\n")
+	time.Sleep(70 * time.Millisecond)
+	u.Stop()
+
+	u.l.Lock()
+	beats := len(mock.MessageBuf)
+	u.l.Unlock()
+	if beats == 0 {
+		t.Fatal("no heartbeat")
+	}
+	for _, msg := range mock.MessageBuf {
+		if !strings.HasPrefix(msg, "Still applying... (") {
+			t.Fatalf("bad: %#v", mock.MessageBuf)
+		}
+	}
+
+	// Nothing is output once stopped
+	time.Sleep(50 * time.Millisecond)
+	if len(mock.MessageBuf) != beats {
+		t.Fatalf("heartbeat after stop: %#v", mock.MessageBuf)
+	}
+}
+
+func TestHeartbeat_output(t *testing.T) {
+	mock := new(Mock)
+	u := &Heartbeat{Ui: mock, Interval: 50 * time.Millisecond, Text: "Still applying..."}
+	u.Start()
+	defer u.Stop()
+
+	// Output more often than the interval holds off the heartbeat
+	for i := 0; i < 6; i++ {
+		time.Sleep(10 * time.Millisecond)
+		u.Raw("foo\n")
+	}
+
+	u.l.Lock()
+	defer u.l.Unlock()
+	if len(mock.MessageBuf) != 0 {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
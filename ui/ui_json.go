@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// JSON is an implementation of Ui that writes everything as newline
+// delimited JSON so that the output can be read by other programs, such
+// as CI jobs.
+//
+// Every line is an Event. Events emitted by Otto have the types of the
+// Event* constants, and all other output has the type "header",
+// "message", or "output" (for raw output), or the level of messages
+// output with Log, such as "warn", with the text as the message.
+// Colors are stripped.
+//
+// JSON can't ask for input, so input must be given with the environment
+// variables of each input.
+type JSON struct {
+	Writer io.Writer
+
+	l sync.Mutex
+}
+
+func (u *JSON) Header(msg string) {
+	u.Event(&Event{Type: "header", Message: StripColors(msg)})
+}
+
+func (u *JSON) Message(msg string) {
+	u.Event(&Event{Type: "message", Message: StripColors(msg)})
+}
+
+func (u *JSON) Raw(msg string) {
+	u.Event(&Event{Type: "output", Message: StripColors(msg)})
+}
+
+func (u *JSON) Log(l Level, msg string) {
+	u.Event(&Event{Type: l.String(), Message: StripColors(msg)})
+}
+
+func (u *JSON) Event(e *Event) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	// An Event is only strings, so this can't fail
+	data, err := json.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+
+	u.Writer.Write(append(data, '\n'))
+}
+
+func (u *JSON) Input(opts *InputOpts) (string, error) {
+	if value := opts.EnvVarValue(); value != "" {
+		return value, nil
+	}
+
+	if len(opts.EnvVars) == 0 {
+		return "", fmt.Errorf(
+			"Otto needs input for %q, but can't ask for it with JSON output.",
+			opts.Query)
+	}
+
+	return "", fmt.Errorf(
+		"Otto needs input for %q, but can't ask for it with JSON output.\n"+
+			"Please set it with one of these environment variables: %s",
+		opts.Query, strings.Join(opts.EnvVars, ", "))
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestJSON_impl(t *testing.T) {
+	var _ Ui = new(JSON)
+	var _ EventUi = new(JSON)
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	u := &JSON{Writer: &buf}
+
+	u.Header("[bold]one")
+	u.Message("two\nthree")
+	Warn(u, "[yellow]four")
+	Emit(u, &Event{
+		Type: EventBuildArtifact,
+		Data: map[string]string{"region": "us-east-1", "id": "ami-123"},
+	})
+
+	expected := `{"type":"header","message":"one"}
+{"type":"message","message":"two\nthree"}
+{"type":"warn","message":"four"}
+{"type":"build-artifact","data":{"id":"ami-123","region":"us-east-1"}}
+`
+	if buf.String() != expected {
+		t.Fatalf("bad:\n\n%s", buf.String())
+	}
+}
+
+func TestJSON_input(t *testing.T) {
+	defer os.Setenv("OTTO_TEST_JSON_INPUT", os.Getenv("OTTO_TEST_JSON_INPUT"))
+	os.Setenv("OTTO_TEST_JSON_INPUT", "")
+
+	u := &JSON{Writer: new(bytes.Buffer)}
+	opts := &InputOpts{
+		Query:   "Foo",
+		EnvVars: []string{"OTTO_TEST_JSON_INPUT"},
+	}
+	if _, err := u.Input(opts); err == nil {
+		t.Fatal("should error")
+	}
+
+	os.Setenv("OTTO_TEST_JSON_INPUT", "bar")
+	v, err := u.Input(opts)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v != "bar" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestEmit(t *testing.T) {
+	mock := new(Mock)
+	u := &Styled{Ui: &Prefixed{Ui: mock, Prefix: "foo: "}}
+
+	Emit(u, &Event{Type: EventBuildStart})
+	if len(mock.EventBuf) != 1 || mock.EventBuf[0].Type != EventBuildStart {
+		t.Fatalf("bad: %#v", mock.EventBuf)
+	}
+}
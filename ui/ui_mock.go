@@ -6,6 +6,7 @@ type Mock struct {
 	HeaderBuf  []string
 	MessageBuf []string
 	RawBuf     []string
+	EventBuf   []*Event
 
 	InputResult string
 	InputError  error
@@ -23,6 +24,10 @@ func (u *Mock) Raw(msg string) {
 	u.RawBuf = append(u.RawBuf, msg)
 }
 
+func (u *Mock) Event(e *Event) {
+	u.EventBuf = append(u.EventBuf, e)
+}
+
 func (u *Mock) Input(opts *InputOpts) (string, error) {
 	return u.InputResult, u.InputError
 }
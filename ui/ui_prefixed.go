@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/colorstring"
+)
+
+// Prefixed is a wrapper around an existing UI that adds a prefix to
+// every line of output. It is used to tell apart the output of tasks
+// that run in parallel and share the same UI.
+//
+// Raw output is buffered until a complete line is available so that
+// lines from parallel tasks aren't mixed together. Flush must be called
+// once the task is done to output any remaining partial line.
+type Prefixed struct {
+	Ui
+	Prefix string
+
+	buf bytes.Buffer
+	l   sync.Mutex
+}
+
+func (u *Prefixed) Header(msg string) {
+	u.Ui.Header(u.prefix(msg))
+}
+
+func (u *Prefixed) Message(msg string) {
+	u.Ui.Message(u.prefix(msg))
+}
+
+func (u *Prefixed) Raw(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.buf.WriteString(msg)
+
+	// Output all the complete lines at once and keep the rest
+	data := u.buf.Bytes()
+	idx := bytes.LastIndexByte(data, '\n')
+	if idx < 0 {
+		return
+	}
+
+	lines := string(data[:idx+1])
+	u.buf.Next(idx + 1)
+	u.Ui.Raw(u.prefixRaw(lines))
+}
+
+func (u *Prefixed) Log(l Level, msg string) {
+	Log(u.Ui, l, u.prefix(msg))
+}
+
+func (u *Prefixed) Event(e *Event) {
+	Emit(u.Ui, e)
+}
+
+// Flush outputs any partial line of Raw output that is still buffered.
+func (u *Prefixed) Flush() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	if u.buf.Len() == 0 {
+		return
+	}
+
+	line := u.buf.String() + "\n"
+	u.buf.Reset()
+	u.Ui.Raw(u.prefixRaw(line))
+}
+
+func (u *Prefixed) prefix(msg string) string {
+	var buf bytes.Buffer
+
+	// Like Styled, we write the color sequence of the message first so
+	// that the prefix inherits the color of the message.
+	buf.WriteString(colorstring.ColorPrefix(msg))
+
+	scan := bufio.NewScanner(strings.NewReader(msg))
+	for scan.Scan() {
+		buf.WriteString(u.Prefix)
+		buf.WriteString(scan.Text() + "\n")
+	}
+
+	str := buf.String()
+	if msg != "" {
+		str = str[:len(str)-1]
+	}
+
+	return str
+}
+
+// prefixRaw prefixes each line of raw output, which always ends in
+// a newline.
+func (u *Prefixed) prefixRaw(lines string) string {
+	var buf bytes.Buffer
+	for _, line := range strings.SplitAfter(lines, "\n") {
+		if line == "" {
+			continue
+		}
+
+		buf.WriteString(u.Prefix)
+		buf.WriteString(line)
+	}
+
+	return buf.String()
+}
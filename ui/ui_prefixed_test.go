@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrefixed_impl(t *testing.T) {
+	var _ Ui = new(Prefixed)
+}
+
+func TestPrefixed(t *testing.T) {
+	mock := new(Mock)
+	u := &Prefixed{Ui: mock, Prefix: "foo: "}
+
+	u.Header("one\ntwo")
+	u.Message("[red]three")
+	if !reflect.DeepEqual(mock.HeaderBuf, []string{"foo: one\nfoo: two"}) {
+		t.Fatalf("bad: %#v", mock.HeaderBuf)
+	}
+	if !reflect.DeepEqual(mock.MessageBuf, []string{"[red]foo: [red]three"}) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
+
+func TestPrefixed_raw(t *testing.T) {
+	mock := new(Mock)
+	u := &Prefixed{Ui: mock, Prefix: "foo: "}
+
+	u.Raw("one")
+	if len(mock.RawBuf) != 0 {
+		t.Fatalf("partial line output: %#v", mock.RawBuf)
+	}
+
+	u.Raw(" two\nthree\nfo")
+	u.Raw("ur")
+	u.Flush()
+	u.Flush()
+
+	expected := []string{
+		"foo: one two\nfoo: three\n",
+		"foo: four\n",
+	}
+	if !reflect.DeepEqual(mock.RawBuf, expected) {
+		t.Fatalf("bad: %#v", mock.RawBuf)
+	}
+}
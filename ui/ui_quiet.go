@@ -0,0 +1,28 @@
+package ui
+
+// Quiet is a wrapper around an existing UI that only outputs warnings
+// and errors, for runs such as CI jobs where the human-friendly messages
+// are noise. Header and Message are output at LevelInfo, so they are
+// dropped.
+//
+// Raw output, such as the output of Terraform and Packer, and events are
+// still output since they are needed to debug a failure.
+type Quiet struct {
+	Ui
+}
+
+func (u *Quiet) Header(msg string) {}
+
+func (u *Quiet) Message(msg string) {}
+
+func (u *Quiet) Log(l Level, msg string) {
+	if l < LevelWarn {
+		return
+	}
+
+	Log(u.Ui, l, msg)
+}
+
+func (u *Quiet) Event(e *Event) {
+	Emit(u.Ui, e)
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuiet_impl(t *testing.T) {
+	var _ Ui = new(Quiet)
+	var _ EventUi = new(Quiet)
+	var _ LevelUi = new(Quiet)
+}
+
+func TestQuiet(t *testing.T) {
+	mock := new(Mock)
+	u := &Quiet{Ui: mock}
+
+	u.Header("one")
+	u.Message("two")
+	u.Raw("three")
+	Debug(u, "four")
+	Info(u, "five")
+	Warn(u, "six")
+	Error(u, "seven")
+	Emit(u, &Event{Type: EventDeployStart})
+
+	if len(mock.HeaderBuf) != 0 {
+		t.Fatalf("bad: %#v", mock.HeaderBuf)
+	}
+	if !reflect.DeepEqual(mock.MessageBuf, []string{"[yellow]six", "[red]seven"}) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+	if !reflect.DeepEqual(mock.RawBuf, []string{"three"}) {
+		t.Fatalf("bad: %#v", mock.RawBuf)
+	}
+	if len(mock.EventBuf) != 1 {
+		t.Fatalf("bad: %#v", mock.EventBuf)
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RedactedText replaces secrets in the output of Redacted and Redact.
+const RedactedText = "***"
+
+// Redacted is a wrapper around an existing UI that replaces every
+// occurrence of the Secrets in the output with RedactedText. It is used
+// so that tools that echo their variables, such as on errors, don't leak
+// credentials into shared logs.
+//
+// Raw output that could be the start of a secret is held back until the
+// next write so that a secret split across writes is still found. Other
+// output, such as a prompt, is passed on right away. Flush must be called
+// once the command is done to output anything that is still held back.
+type Redacted struct {
+	Ui
+	Secrets []string
+
+	buf bytes.Buffer
+	l   sync.Mutex
+}
+
+func (u *Redacted) Header(msg string) {
+	u.Ui.Header(Redact(msg, u.Secrets))
+}
+
+func (u *Redacted) Message(msg string) {
+	u.Ui.Message(Redact(msg, u.Secrets))
+}
+
+func (u *Redacted) Raw(msg string) {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	u.buf.WriteString(msg)
+
+	// Output everything except for a partial secret at the end
+	data := u.buf.String()
+	idx := len(data) - u.partialSecret(data)
+	if idx == 0 {
+		return
+	}
+
+	u.buf.Next(idx)
+	u.Ui.Raw(Redact(data[:idx], u.Secrets))
+}
+
+func (u *Redacted) Log(l Level, msg string) {
+	Log(u.Ui, l, Redact(msg, u.Secrets))
+}
+
+func (u *Redacted) Event(e *Event) {
+	Emit(u.Ui, e)
+}
+
+// Flush outputs any partial line of Raw output that is still buffered.
+func (u *Redacted) Flush() {
+	u.l.Lock()
+	defer u.l.Unlock()
+
+	if u.buf.Len() == 0 {
+		return
+	}
+
+	line := u.buf.String()
+	u.buf.Reset()
+	u.Ui.Raw(Redact(line, u.Secrets))
+}
+
+// partialSecret returns the length of the longest suffix of data that is
+// the start of a secret, but not the whole secret.
+func (u *Redacted) partialSecret(data string) int {
+	result := 0
+	for _, s := range u.Secrets {
+		for n := len(s) - 1; n > result; n-- {
+			if strings.HasSuffix(data, s[:n]) {
+				result = n
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Redact returns msg with every occurrence of the secrets replaced with
+// RedactedText. Empty secrets are ignored, and longer secrets are
+// replaced first so that a secret containing another is fully redacted.
+func Redact(msg string, secrets []string) string {
+	if len(secrets) == 0 {
+		return msg
+	}
+
+	sorted := make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		if s != "" {
+			sorted = append(sorted, s)
+		}
+	}
+	sort.Sort(byLength(sorted))
+
+	for _, s := range sorted {
+		msg = strings.Replace(msg, s, RedactedText, -1)
+	}
+
+	return msg
+}
+
+// byLength sorts strings from the longest to the shortest.
+type byLength []string
+
+func (s byLength) Len() int           { return len(s) }
+func (s byLength) Less(i, j int) bool { return len(s[i]) > len(s[j]) }
+func (s byLength) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
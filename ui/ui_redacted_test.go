@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedacted_impl(t *testing.T) {
+	var _ Ui = new(Redacted)
+	var _ LevelUi = new(Redacted)
+}
+
+func TestRedacted(t *testing.T) {
+	mock := new(Mock)
+	u := &Redacted{Ui: mock, Secrets: []string{"hunter2"}}
+
+	u.Header("key hunter2")
+	Warn(u, "bad key: hunter2")
+	if !reflect.DeepEqual(mock.HeaderBuf, []string{"key ***"}) {
+		t.Fatalf("bad: %#v", mock.HeaderBuf)
+	}
+	if !reflect.DeepEqual(mock.MessageBuf, []string{"[yellow]bad key: ***"}) {
+		t.Fatalf("bad: %#v", mock.MessageBuf)
+	}
+}
+
+func TestRedacted_raw(t *testing.T) {
+	mock := new(Mock)
+	u := &Redacted{Ui: mock, Secrets: []string{"hunter2"}}
+
+	u.Raw("var: hun")
+	u.Raw("ter2\nEnter a value: ")
+	u.Raw("hunt")
+	u.Raw("er")
+	u.Flush()
+	u.Flush()
+
+	expected := []string{
+		"var: ",
+		"***\nEnter a value: ",
+		"hunter",
+	}
+	if !reflect.DeepEqual(mock.RawBuf, expected) {
+		t.Fatalf("bad: %#v", mock.RawBuf)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		Msg     string
+		Secrets []string
+		Result  string
+	}{
+		{"foo", nil, "foo"},
+		{"foo", []string{""}, "foo"},
+		{"a foo b foo", []string{"foo"}, "a *** b ***"},
+		{"foobar", []string{"foo", "foobar"}, "***"},
+	}
+
+	for _, tc := range cases {
+		actual := Redact(tc.Msg, tc.Secrets)
+		if actual != tc.Result {
+			t.Fatalf("%q %v: %q", tc.Msg, tc.Secrets, actual)
+		}
+	}
+}
@@ -22,6 +22,14 @@ func (u *Styled) Message(msg string) {
 	u.Ui.Message(u.prefix("    ", msg))
 }
 
+func (u *Styled) Log(l Level, msg string) {
+	Log(u.Ui, l, u.prefix("    ", msg))
+}
+
+func (u *Styled) Event(e *Event) {
+	Emit(u.Ui, e)
+}
+
 func (u *Styled) prefix(prefix, msg string) string {
 	var buf bytes.Buffer
 